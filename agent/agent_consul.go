@@ -16,8 +16,6 @@ package agent
 
 import (
 	"fmt"
-	"os"
-	"os/exec"
 	"strings"
 
 	"github.com/coreos/dbtester/dbtesterpb"
@@ -29,7 +27,7 @@ func startConsul(fs *flags, t *transporterServer) error {
 		return fmt.Errorf("Consul binary %q does not exist", globalFlags.consulExec)
 	}
 
-	if err := os.RemoveAll(fs.consulDataDir); err != nil {
+	if err := t.resetDataDir(fs.consulDataDir); err != nil {
 		return err
 	}
 
@@ -65,7 +63,10 @@ func startConsul(fs *flags, t *transporterServer) error {
 
 	flagString := strings.Join(flags, " ")
 
-	cmd := exec.Command(fs.consulExec, flags...)
+	cmd, err := t.databaseCommand(fs.consulExec, []string{fs.consulDataDir}, flags...)
+	if err != nil {
+		return err
+	}
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
 	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
@@ -76,7 +77,11 @@ func startConsul(fs *flags, t *transporterServer) error {
 	}
 	t.cmd = cmd
 	t.cmdWait = make(chan struct{})
-	t.pid = int64(cmd.Process.Pid)
+	pid, err := t.databasePID(cmd)
+	if err != nil {
+		return err
+	}
+	t.pid = pid
 
 	plog.Infof("started database %q (PID: %d)", cs, t.pid)
 	return nil
@@ -16,20 +16,26 @@ package agent
 
 import (
 	"fmt"
-	"os"
-	"os/exec"
 	"strings"
 
 	"github.com/coreos/dbtester/dbtesterpb"
 )
 
+// etcdClientPort returns the configured client port of etcd.
+func (t *transporterServer) etcdClientPort() int64 {
+	if t.req.DatabasePort == 0 {
+		return 2379
+	}
+	return t.req.DatabasePort
+}
+
 // startEtcd starts etcd v3.
 func startEtcd(fs *flags, t *transporterServer) error {
 	if !exist(fs.etcdExec) {
 		return fmt.Errorf("etcd binary %q does not exist", globalFlags.etcdExec)
 	}
 
-	if err := os.RemoveAll(fs.etcdDataDir); err != nil {
+	if err := t.resetDataDir(fs.etcdDataDir); err != nil {
 		return err
 	}
 
@@ -41,7 +47,7 @@ func startEtcd(fs *flags, t *transporterServer) error {
 	members := make([]string, len(peerIPs))
 	for i, u := range peerIPs {
 		names[i] = fmt.Sprintf("etcd-%d", i+1)
-		clientURLs[i] = fmt.Sprintf("http://%s:2379", u)
+		clientURLs[i] = fmt.Sprintf("http://%s:%d", u, t.etcdClientPort())
 		peerURLs[i] = fmt.Sprintf("http://%s:2380", u)
 		members[i] = fmt.Sprintf("%s=%s", names[i], peerURLs[i])
 	}
@@ -73,9 +79,16 @@ func startEtcd(fs *flags, t *transporterServer) error {
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
 	}
 
+	if t.req.EnablePprof {
+		flags = append(flags, "--enable-pprof")
+	}
+
 	flagString := strings.Join(flags, " ")
 
-	cmd := exec.Command(fs.etcdExec, flags...)
+	cmd, err := t.databaseCommand(fs.etcdExec, []string{fs.etcdDataDir}, flags...)
+	if err != nil {
+		return err
+	}
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
 	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
@@ -86,7 +99,11 @@ func startEtcd(fs *flags, t *transporterServer) error {
 	}
 	t.cmd = cmd
 	t.cmdWait = make(chan struct{})
-	t.pid = int64(cmd.Process.Pid)
+	pid, err := t.databasePID(cmd)
+	if err != nil {
+		return err
+	}
+	t.pid = pid
 
 	plog.Infof("started database %q (PID: %d)", cs, t.pid)
 	return nil
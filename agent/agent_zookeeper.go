@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"html/template"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -82,7 +81,7 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 	if !exist(fs.javaExec) {
 		return fmt.Errorf("Java binary %q does not exist", globalFlags.javaExec)
 	}
-	if err := os.RemoveAll(fs.zkDataDir); err != nil {
+	if err := t.resetDataDir(fs.zkDataDir); err != nil {
 		return err
 	}
 	if err := os.MkdirAll(fs.zkDataDir, 0777); err != nil {
@@ -172,11 +171,14 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
 	}
 
-	args := []string{shell, "-c", fs.javaExec + " " + flagString + " " + fs.zkConfig}
-	cmd := exec.Command(args[0], args[1:]...)
+	cmdline := fs.javaExec + " " + flagString + " " + fs.zkConfig
+	cmd, err := t.shellCommand(cmdline, fs.zkWorkDir, []string{fs.zkWorkDir, fs.zkDataDir}, []string{fs.zkConfig})
+	if err != nil {
+		return err
+	}
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
-	cs := fmt.Sprintf("%s %s", cmd.Path, strings.Join(args[1:], " "))
+	cs := fmt.Sprintf("%s %s", cmd.Path, strings.Join(cmd.Args[1:], " "))
 
 	plog.Infof("starting database %q", cs)
 	if err := cmd.Start(); err != nil {
@@ -184,7 +186,11 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 	}
 	t.cmd = cmd
 	t.cmdWait = make(chan struct{})
-	t.pid = int64(cmd.Process.Pid)
+	pid, err := t.databasePID(cmd)
+	if err != nil {
+		return err
+	}
+	t.pid = pid
 
 	plog.Infof("started database %q (PID: %d)", cs, t.pid)
 	return nil
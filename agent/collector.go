@@ -0,0 +1,331 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/resample"
+)
+
+// Collector samples extra metrics on the agent, in addition
+// to system metrics (e.g. store-specific metrics).
+type Collector interface {
+	// Name returns the name of the collector.
+	Name() string
+	// Columns returns the column names of samples.
+	Columns() []string
+	// Sample returns the values of columns at 'ts'.
+	// Values that cannot be sampled are empty.
+	Sample(ts time.Time) []string
+}
+
+// NewCollectorFunc creates a Collector from the configuration.
+type NewCollectorFunc func(cfg dbtesterpb.ConfigCollector) (Collector, error)
+
+var (
+	collectorTypesMu sync.Mutex
+	collectorTypes   = map[string]NewCollectorFunc{
+		"exec": newExecCollector,
+		"http": newHTTPCollector,
+	}
+)
+
+// RegisterCollector registers the collector type,
+// to be configured in 'collectors' of the agent control.
+func RegisterCollector(typ string, fn NewCollectorFunc) {
+	collectorTypesMu.Lock()
+	defer collectorTypesMu.Unlock()
+	if _, ok := collectorTypes[typ]; ok {
+		panic(fmt.Sprintf("collector type %q is already registered", typ))
+	}
+	collectorTypes[typ] = fn
+}
+
+// newCollector creates the collector of the registered type.
+func newCollector(cfg dbtesterpb.ConfigCollector) (Collector, error) {
+	collectorTypesMu.Lock()
+	fn, ok := collectorTypes[cfg.Type]
+	collectorTypesMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown collector type %q (collector %q)", cfg.Type, cfg.Name)
+	}
+	return fn(cfg)
+}
+
+// collectorTimeout is the timeout of each sample,
+// so that each collector samples every second.
+const collectorTimeout = 900 * time.Millisecond
+
+// collectorCSV holds the samples of a collector,
+// to save as '<system-metrics-csv>-<name>.csv'.
+// Each collector runs in its own goroutine, so that a slow collector
+// does not delay system metrics or other collectors.
+type collectorCSV struct {
+	collector Collector
+	filePath  string
+	interval  time.Duration
+	rows      [][]string
+}
+
+// newCollectorCSV returns the collector CSV to sample at the monitor
+// interval, but not more often than every second, since rows are
+// keyed by unix second.
+func newCollectorCSV(c Collector, systemMetricsCSV string, interval time.Duration) *collectorCSV {
+	if interval < time.Second {
+		interval = time.Second
+	}
+	return &collectorCSV{
+		collector: c,
+		filePath:  collectorCSVPath(systemMetricsCSV, c.Name()),
+		interval:  interval,
+	}
+}
+
+func collectorCSVPath(systemMetricsCSV, name string) string {
+	return fmt.Sprintf("%s-%s.csv", strings.TrimSuffix(systemMetricsCSV, ".csv"), name)
+}
+
+// run samples the collector every interval until 'stopc' is closed.
+// Ticker keeps the interval regardless of how long sampling takes.
+func (c *collectorCSV) run(stopc <-chan struct{}) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case ts := <-ticker.C:
+			vs := c.collector.Sample(ts)
+			if len(vs) != len(c.collector.Columns()) {
+				plog.Warningf("collector %q returned %d values, expected %d", c.collector.Name(), len(vs), len(c.collector.Columns()))
+				vs = make([]string, len(c.collector.Columns()))
+			}
+			c.rows = append(c.rows, append([]string{fmt.Sprintf("%d", ts.Unix())}, vs...))
+		case <-stopc:
+			return
+		}
+	}
+}
+
+// save saves the samples resampled to one row per second from
+// 'minUnixSecond' to 'maxUnixSecond', the timeline of the interpolated
+// system metrics, so that they can be joined with other timeseries.
+func (c *collectorCSV) save(minUnixSecond, maxUnixSecond int64) error {
+	rows, err := resample.Rows(c.rows, minUnixSecond, maxUnixSecond)
+	if err != nil {
+		return err
+	}
+	f, err := openToOverwrite(c.filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	if err = wr.Write(append([]string{"UNIX-SECOND"}, c.collector.Columns()...)); err != nil {
+		return err
+	}
+	if err = wr.WriteAll(rows); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// startCollectors starts sampling host metrics and collectors in the request.
+func (t *transporterServer) startCollectors(systemMetricsCSV string, interval time.Duration) error {
+	t.collectors = []*collectorCSV{newCollectorCSV(&hostCollector{}, systemMetricsCSV, interval)}
+	for _, cfg := range t.req.Collectors {
+		c, err := newCollector(*cfg)
+		if err != nil {
+			return err
+		}
+		plog.Infof("starting collector %q (type %q, columns %q)", cfg.Name, cfg.Type, c.Columns())
+		t.collectors = append(t.collectors, newCollectorCSV(c, systemMetricsCSV, interval))
+	}
+
+	t.collectorsStop = make(chan struct{})
+	for _, c := range t.collectors {
+		t.collectorsWg.Add(1)
+		go func(c *collectorCSV) {
+			defer t.collectorsWg.Done()
+			c.run(t.collectorsStop)
+		}(c)
+	}
+	return nil
+}
+
+// stopCollectors stops sampling, and saves the samples of each collector
+// on the per-second timeline from 'minUnixSecond' to 'maxUnixSecond'.
+func (t *transporterServer) stopCollectors(minUnixSecond, maxUnixSecond int64) {
+	if t.collectorsStop == nil {
+		return
+	}
+	close(t.collectorsStop)
+	t.collectorsWg.Wait()
+	t.collectorsStop = nil
+
+	for _, c := range t.collectors {
+		if err := c.save(minUnixSecond, maxUnixSecond); err != nil {
+			plog.Errorf("failed to save collector CSV %q (%v)", c.filePath, err)
+		} else {
+			plog.Infof("CSV saved at %q", c.filePath)
+			writeChecksum(c.filePath)
+		}
+	}
+}
+
+// execCollector runs the command every sample,
+// and reads the last line of its output in CSV.
+type execCollector struct {
+	name    string
+	command []string
+	columns []string
+}
+
+func newExecCollector(cfg dbtesterpb.ConfigCollector) (Collector, error) {
+	if len(cfg.Command) == 0 {
+		return nil, fmt.Errorf("collector %q has no command", cfg.Name)
+	}
+	if len(cfg.Columns) == 0 {
+		return nil, fmt.Errorf("collector %q has no columns", cfg.Name)
+	}
+	return &execCollector{name: cfg.Name, command: cfg.Command, columns: cfg.Columns}, nil
+}
+
+func (c *execCollector) Name() string      { return c.name }
+func (c *execCollector) Columns() []string { return c.columns }
+
+func (c *execCollector) Sample(ts time.Time) []string {
+	ctx, cancel := context.WithTimeout(context.Background(), collectorTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, c.command[0], c.command[1:]...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("UNIX_SECOND=%d", ts.Unix()))
+	out, err := cmd.Output()
+	if err != nil {
+		plog.Warningf("collector %q failed (%v)", c.name, err)
+		return make([]string, len(c.columns))
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	row, err := csv.NewReader(strings.NewReader(lines[len(lines)-1])).Read()
+	if err != nil {
+		plog.Warningf("collector %q returned invalid CSV %q (%v)", c.name, lines[len(lines)-1], err)
+		return make([]string, len(c.columns))
+	}
+	return row
+}
+
+// httpCollector reads metrics from the endpoint in Prometheus text format,
+// where each column is the sum of all samples of the metric name.
+type httpCollector struct {
+	name     string
+	endpoint string
+	columns  []string
+	cli      *http.Client
+}
+
+func newHTTPCollector(cfg dbtesterpb.ConfigCollector) (Collector, error) {
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("collector %q has no endpoint", cfg.Name)
+	}
+	if len(cfg.Columns) == 0 {
+		return nil, fmt.Errorf("collector %q has no columns", cfg.Name)
+	}
+	return &httpCollector{
+		name:     cfg.Name,
+		endpoint: cfg.Endpoint,
+		columns:  cfg.Columns,
+		cli:      &http.Client{Timeout: collectorTimeout},
+	}, nil
+}
+
+func (c *httpCollector) Name() string      { return c.name }
+func (c *httpCollector) Columns() []string { return c.columns }
+
+func (c *httpCollector) Sample(ts time.Time) []string {
+	resp, err := c.cli.Get(c.endpoint)
+	if err != nil {
+		plog.Warningf("collector %q failed (%v)", c.name, err)
+		return make([]string, len(c.columns))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		plog.Warningf("collector %q got status %q", c.name, resp.Status)
+		return make([]string, len(c.columns))
+	}
+	return sumMetrics(resp.Body, c.columns)
+}
+
+// sumMetrics returns the sum of all samples of each metric name
+// in Prometheus text format, or empty if the metric is not found.
+func sumMetrics(r io.Reader, names []string) []string {
+	idx := make(map[string]int, len(names))
+	for i, name := range names {
+		idx[name] = i
+	}
+	sums := make([]float64, len(names))
+	found := make([]bool, len(names))
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		name := line
+		if i := bytes.IndexAny(line, "{ "); i > 0 {
+			name = line[:i]
+		}
+		i, ok := idx[string(name)]
+		if !ok {
+			continue
+		}
+		// value follows the labels, and may be followed by a timestamp
+		rest := line[len(name):]
+		if j := bytes.LastIndexByte(rest, '}'); j >= 0 {
+			rest = rest[j+1:]
+		}
+		fields := strings.Fields(string(rest))
+		if len(fields) == 0 {
+			continue
+		}
+		v, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			continue
+		}
+		sums[i] += v
+		found[i] = true
+	}
+
+	vs := make([]string, len(names))
+	for i := range names {
+		if found[i] {
+			vs[i] = strconv.FormatFloat(sums[i], 'f', -1, 64)
+		}
+	}
+	return vs
+}
@@ -0,0 +1,177 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// hostCollectorName is the name of the host metrics collector,
+// which always runs with system metrics of the database process.
+const hostCollectorName = "host"
+
+// hostStealWarnPercent is the CPU steal time of the host to warn at,
+// since noisy neighbors on cloud VMs invalidate benchmark results.
+const hostStealWarnPercent = 5.0
+
+var hostColumns = []string{
+	"CPU-STEAL-PERCENT",
+	"CPU-IOWAIT-PERCENT",
+	"LOAD-AVERAGE-1",
+	"LOAD-AVERAGE-5",
+	"LOAD-AVERAGE-15",
+	"CONTEXT-SWITCHES-PER-SECOND",
+	"INTERRUPTS-PER-SECOND",
+	"PROCS-RUNNING",
+}
+
+// hostStat is the host-wide counters in '/proc/stat'.
+type hostStat struct {
+	ts time.Time
+
+	cpuTotal  uint64
+	cpuIOWait uint64
+	cpuSteal  uint64
+
+	ctxt         uint64
+	intr         uint64
+	procsRunning uint64
+}
+
+// hostCollector samples host-level metrics from '/proc', such as CPU steal
+// time and load average, which are not visible in process metrics.
+type hostCollector struct {
+	prev        *hostStat
+	stealWarned bool
+}
+
+func (c *hostCollector) Name() string      { return hostCollectorName }
+func (c *hostCollector) Columns() []string { return hostColumns }
+
+func (c *hostCollector) Sample(ts time.Time) []string {
+	vs := make([]string, len(hostColumns))
+
+	cur, err := readHostStat("/proc/stat")
+	if err != nil {
+		plog.Warningf("collector %q failed (%v)", hostCollectorName, err)
+	} else {
+		cur.ts = ts
+		if prev := c.prev; prev != nil && cur.cpuTotal > prev.cpuTotal {
+			total := float64(cur.cpuTotal - prev.cpuTotal)
+			steal := 100 * float64(cur.cpuSteal-prev.cpuSteal) / total
+			vs[0] = fmt.Sprintf("%.2f", steal)
+			vs[1] = fmt.Sprintf("%.2f", 100*float64(cur.cpuIOWait-prev.cpuIOWait)/total)
+			if sec := cur.ts.Sub(prev.ts).Seconds(); sec > 0 {
+				vs[5] = fmt.Sprintf("%.2f", float64(cur.ctxt-prev.ctxt)/sec)
+				vs[6] = fmt.Sprintf("%.2f", float64(cur.intr-prev.intr)/sec)
+			}
+			c.warnSteal(steal)
+		}
+		vs[7] = fmt.Sprintf("%d", cur.procsRunning)
+		c.prev = &cur
+	}
+
+	if loads, err := readLoadAverage("/proc/loadavg"); err != nil {
+		plog.Warningf("collector %q failed (%v)", hostCollectorName, err)
+	} else {
+		copy(vs[2:5], loads)
+	}
+	return vs
+}
+
+// warnSteal warns once when CPU steal time goes above the threshold,
+// and again after it goes back below.
+func (c *hostCollector) warnSteal(steal float64) {
+	switch {
+	case steal >= hostStealWarnPercent && !c.stealWarned:
+		plog.Warningf("CPU steal time is %.2f%% on host (noisy neighbor?); results may be invalid", steal)
+		c.stealWarned = true
+	case steal < hostStealWarnPercent && c.stealWarned:
+		plog.Infof("CPU steal time is back to %.2f%% on host", steal)
+		c.stealWarned = false
+	}
+}
+
+// readHostStat parses the aggregated 'cpu' line, 'ctxt', 'intr',
+// and 'procs_running' in '/proc/stat'.
+func readHostStat(fpath string) (st hostStat, err error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return st, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024) // 'intr' line can be long
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "cpu":
+			// user nice system idle iowait irq softirq steal guest guest_nice,
+			// where guest time is already included in user time
+			for i, v := range fields[1:] {
+				if i >= 8 {
+					break
+				}
+				n, err := strconv.ParseUint(v, 10, 64)
+				if err != nil {
+					return st, fmt.Errorf("invalid 'cpu' line in %q (%v)", fpath, err)
+				}
+				st.cpuTotal += n
+				switch i {
+				case 4:
+					st.cpuIOWait = n
+				case 7:
+					st.cpuSteal = n
+				}
+			}
+		case "ctxt":
+			st.ctxt, _ = strconv.ParseUint(fields[1], 10, 64)
+		case "intr":
+			st.intr, _ = strconv.ParseUint(fields[1], 10, 64)
+		case "procs_running":
+			st.procsRunning, _ = strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+	if err = sc.Err(); err != nil {
+		return st, err
+	}
+	if st.cpuTotal == 0 {
+		return st, fmt.Errorf("no 'cpu' line in %q", fpath)
+	}
+	return st, nil
+}
+
+// readLoadAverage returns 1, 5, and 15-minute load averages in '/proc/loadavg'.
+func readLoadAverage(fpath string) ([]string, error) {
+	b, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(string(b))
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("unexpected %q in %q", string(b), fpath)
+	}
+	return fields[:3], nil
+}
@@ -51,6 +51,8 @@ type flags struct {
 	diskDevice       string
 	networkInterface string
 	clientNumPath    string
+
+	diskWriteMBPerSecond float64
 }
 
 var globalFlags flags
@@ -91,6 +93,7 @@ func init() {
 	Command.PersistentFlags().StringVar(&globalFlags.diskDevice, "disk-device", dn, "Disk device to collect disk statistics metrics from.")
 	Command.PersistentFlags().StringVar(&globalFlags.networkInterface, "network-interface", nt, "Network interface to record in/outgoing packets.")
 	Command.PersistentFlags().StringVar(&globalFlags.clientNumPath, "client-num-path", filepath.Join(homeDir(), "client-num"), "File path to store client number.")
+	Command.PersistentFlags().Float64Var(&globalFlags.diskWriteMBPerSecond, "disk-write-mb-per-second", 0, "Write throughput of the disk device from its spec in MB/s, to detect disk-bound runs (0 to disable).")
 }
 
 // Command implements 'agent' command.
@@ -0,0 +1,213 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// diskDelayName is the device-mapper device name of the delayed disk.
+const diskDelayName = "dbtester-delay"
+
+// delayedDisk is a file-backed loop device, wrapped with
+// a 'dm-delay' target and mounted at the database data directory.
+type delayedDisk struct {
+	image      string
+	loop       string
+	mountPoint string
+}
+
+// diskDelayTable returns the 'dmsetup' table to delay reads and writes
+// on the whole loop device.
+func diskDelayTable(sectors int64, loop string, dd *dbtesterpb.ConfigDiskDelay) string {
+	return fmt.Sprintf("0 %d delay %s 0 %d %s 0 %d", sectors, loop, dd.ReadDelayMilliseconds, loop, dd.WriteDelayMilliseconds)
+}
+
+// resetDataDir removes the database data directory. If disk delay
+// is configured, the directory is re-created on a delayed disk.
+func (t *transporterServer) resetDataDir(dir string) error {
+	if err := t.removeDiskDelay(); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	dd := t.req.ConfigDiskDelay
+	if dd == nil {
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	plog.Infof("mounting delayed disk at %q (read %d ms, write %d ms)", dir, dd.ReadDelayMilliseconds, dd.WriteDelayMilliseconds)
+	d, err := mountDelayedDisk(dir, dd)
+	if err != nil {
+		return err
+	}
+	t.delayedDisk = d
+	return nil
+}
+
+// removeDiskDelay unmounts the disk mounted by resetDataDir, if any.
+func (t *transporterServer) removeDiskDelay() error {
+	if t.delayedDisk == nil {
+		return nil
+	}
+	d := t.delayedDisk
+	t.delayedDisk = nil
+	plog.Infof("unmounting delayed disk at %q", d.mountPoint)
+	return d.unmount()
+}
+
+func mountDelayedDisk(dir string, dd *dbtesterpb.ConfigDiskDelay) (d *delayedDisk, err error) {
+	size := dd.SizeGigabytes
+	if size == 0 {
+		size = 10
+	}
+	d = &delayedDisk{image: filepath.Clean(dir) + ".delay.img"}
+	if err = removeStaleDiskDelay(d.image); err != nil {
+		return d, err
+	}
+	defer func() {
+		if err != nil {
+			if uerr := d.unmount(); uerr != nil {
+				plog.Warningf("unmount error %v", uerr)
+			}
+		}
+	}()
+
+	// sparse file, so that only written blocks take space
+	f, err := os.Create(d.image)
+	if err != nil {
+		return d, err
+	}
+	err = f.Truncate(size << 30)
+	f.Close()
+	if err != nil {
+		return d, err
+	}
+
+	// direct I/O bypasses the page cache of the backing file, so that
+	// writes (and fsyncs) reach the disk through the delay device
+	// instead of being absorbed by the host cache
+	if d.loop, err = runDiskCommand("losetup", "--find", "--show", "--direct-io=on", d.image); err != nil {
+		return d, err
+	}
+	out, err := runDiskCommand("blockdev", "--getsz", d.loop)
+	if err != nil {
+		return d, err
+	}
+	sectors, err := strconv.ParseInt(out, 10, 64)
+	if err != nil {
+		return d, err
+	}
+	if _, err = runDiskCommand("dmsetup", "create", diskDelayName, "--table", diskDelayTable(sectors, d.loop, dd)); err != nil {
+		return d, err
+	}
+	dev := "/dev/mapper/" + diskDelayName
+	if _, err = runDiskCommand("mkfs.ext4", "-q", "-F", dev); err != nil {
+		return d, err
+	}
+	if _, err = runDiskCommand("mount", dev, dir); err != nil {
+		return d, err
+	}
+	d.mountPoint = dir
+
+	// keep the data directory empty, as databases expect
+	return d, os.RemoveAll(filepath.Join(dir, "lost+found"))
+}
+
+// removeStaleDiskDelay tears down the delayed disk left behind by
+// a crashed agent, since 'dmsetup create' fails if the device exists.
+func removeStaleDiskDelay(image string) error {
+	if _, err := runDiskCommand("dmsetup", "info", diskDelayName); err == nil {
+		plog.Warningf("removing stale device %q", diskDelayName)
+		dev := "/dev/mapper/" + diskDelayName
+		if _, err = runDiskCommand("umount", dev); err != nil {
+			plog.Warningf("%q is not mounted (%v)", dev, err)
+		}
+		if _, err = runDiskCommand("dmsetup", "remove", diskDelayName); err != nil {
+			return err
+		}
+	}
+	if _, err := os.Stat(image); err != nil {
+		return nil
+	}
+	out, err := runDiskCommand("losetup", "--associated", image)
+	if err != nil {
+		return err
+	}
+	for _, loop := range associatedLoops(out) {
+		plog.Warningf("detaching stale loop device %q", loop)
+		if _, err = runDiskCommand("losetup", "--detach", loop); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// associatedLoops parses the loop devices from 'losetup --associated'
+// output (e.g. "/dev/loop0: [2049]:12 (/var/lib/data.delay.img)").
+func associatedLoops(out string) []string {
+	var loops []string
+	for _, line := range strings.Split(out, "\n") {
+		if i := strings.Index(line, ":"); i > 0 {
+			loops = append(loops, strings.TrimSpace(line[:i]))
+		}
+	}
+	return loops
+}
+
+// unmount tears down whatever has been set up, in reverse order.
+func (d *delayedDisk) unmount() error {
+	var errs []string
+	if d.mountPoint != "" {
+		if _, err := runDiskCommand("umount", d.mountPoint); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if d.loop != "" {
+		// device-mapper device exists if the loop device does
+		if _, err := runDiskCommand("dmsetup", "remove", diskDelayName); err != nil {
+			errs = append(errs, err.Error())
+		}
+		if _, err := runDiskCommand("losetup", "--detach", d.loop); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if err := os.RemoveAll(d.image); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func runDiskCommand(name string, args ...string) (string, error) {
+	plog.Infof("%s %s", name, strings.Join(args, " "))
+	out, err := exec.Command(name, args...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%s %s failed %v (%q)", name, strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
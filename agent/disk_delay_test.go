@@ -0,0 +1,41 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestDiskDelayTable(t *testing.T) {
+	dd := &dbtesterpb.ConfigDiskDelay{ReadDelayMilliseconds: 5, WriteDelayMilliseconds: 20}
+	exp := "0 20971520 delay /dev/loop0 0 5 /dev/loop0 0 20"
+	if s := diskDelayTable(20971520, "/dev/loop0", dd); s != exp {
+		t.Fatalf("expected %q, got %q", exp, s)
+	}
+}
+
+func TestAssociatedLoops(t *testing.T) {
+	out := "/dev/loop0: [2049]:12 (/var/lib/data.delay.img)\n/dev/loop3: [2049]:12 (/var/lib/data.delay.img)"
+	exp := []string{"/dev/loop0", "/dev/loop3"}
+	if loops := associatedLoops(out); !reflect.DeepEqual(loops, exp) {
+		t.Fatalf("expected %v, got %v", exp, loops)
+	}
+	if loops := associatedLoops(""); len(loops) != 0 {
+		t.Fatalf("expected no loop devices, got %v", loops)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// containerName returns the name of Docker container running the database.
+func (t *transporterServer) containerName() string {
+	return "dbtester-" + t.req.DatabaseID.String()
+}
+
+// databaseCommand returns the command to run the database. If Docker
+// is configured, the command runs the database in a container, with
+// data directories mounted at the same paths as on the host.
+// Environment variables of the request are set to the database process.
+func (t *transporterServer) databaseCommand(execPath string, dataDirs []string, args ...string) (*exec.Cmd, error) {
+	if len(t.req.DatabaseEnv) > 0 {
+		plog.Infof("database environment variables %q", t.req.DatabaseEnv)
+	}
+	dcfg := t.req.ConfigDocker
+	if dcfg == nil || dcfg.Image == "" {
+		cmd := exec.Command(execPath, args...)
+		if len(t.req.DatabaseEnv) > 0 {
+			cmd.Env = append(os.Environ(), t.req.DatabaseEnv...)
+		}
+		return cmd, nil
+	}
+
+	flags, image, err := t.dockerRunFlags(dataDirs)
+	if err != nil {
+		return nil, err
+	}
+	flags = append(flags, image)
+	if dcfg.Command != "" {
+		flags = append(flags, dcfg.Command)
+	}
+	flags = append(flags, args...)
+
+	// 'docker run' in foreground proxies SIGINT to the container
+	return exec.Command("docker", flags...), nil
+}
+
+// shellCommand returns the command to run the command line in the shell,
+// in the working directory. In Docker, the shell is the entrypoint of the
+// container, and the files (e.g. database config) are mounted read-only
+// at the same paths as on the host, along with the data directories.
+func (t *transporterServer) shellCommand(cmdline, workDir string, dataDirs, files []string) (*exec.Cmd, error) {
+	dcfg := t.req.ConfigDocker
+	if dcfg == nil || dcfg.Image == "" {
+		cmd, err := t.databaseCommand(shell, dataDirs, "-c", cmdline)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Dir = workDir
+		return cmd, nil
+	}
+
+	flags, image, err := t.dockerRunFlags(dataDirs)
+	if err != nil {
+		return nil, err
+	}
+	for _, fpath := range files {
+		flags = append(flags, "--volume", fpath+":"+fpath+":ro")
+	}
+	// shell of the host may not exist in the image
+	flags = append(flags, "--workdir", workDir, "--entrypoint", "/bin/sh", image, "-c", cmdline)
+	return exec.Command("docker", flags...), nil
+}
+
+// dockerRunFlags pulls the image, removes the container left by previous
+// runs, and returns the flags of 'docker run' before the image.
+func (t *transporterServer) dockerRunFlags(dataDirs []string) (flags []string, image string, err error) {
+	dcfg := t.req.ConfigDocker
+	image = dcfg.Image
+	if dcfg.Tag != "" {
+		image += ":" + dcfg.Tag
+	}
+	plog.Infof("pulling %q", image)
+	if out, err := exec.Command("docker", "pull", image).CombinedOutput(); err != nil {
+		return nil, "", fmt.Errorf("docker pull %q failed %v (%s)", image, err, strings.TrimSpace(string(out)))
+	}
+
+	// remove the container left by previous runs, if any
+	exec.Command("docker", "rm", "--force", t.containerName()).Run()
+
+	network := dcfg.Network
+	if network == "" {
+		network = "host"
+	}
+	flags = []string{"run", "--rm", "--name", t.containerName(), "--network", network}
+	for _, dir := range dataDirs {
+		if err = os.MkdirAll(dir, 0777); err != nil {
+			return nil, "", err
+		}
+		flags = append(flags, "--volume", dir+":"+dir)
+	}
+	for _, v := range dcfg.Volumes {
+		flags = append(flags, "--volume", v)
+	}
+	for _, env := range t.req.DatabaseEnv {
+		flags = append(flags, "--env", env)
+	}
+	return flags, image, nil
+}
+
+// databasePID returns the PID of the database process, to collect system
+// metrics from. Docker returns the PID of container's main process on host.
+func (t *transporterServer) databasePID(cmd *exec.Cmd) (int64, error) {
+	if t.req.ConfigDocker == nil || t.req.ConfigDocker.Image == "" {
+		return int64(cmd.Process.Pid), nil
+	}
+
+	for i := 0; i < 30; i++ {
+		out, err := exec.Command("docker", "inspect", "--format", "{{.State.Pid}}", t.containerName()).Output()
+		if err == nil {
+			pid, err := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 64)
+			if err == nil && pid > 0 {
+				return pid, nil
+			}
+		}
+		time.Sleep(time.Second)
+	}
+	return 0, fmt.Errorf("container %q did not start", t.containerName())
+}
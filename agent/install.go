@@ -0,0 +1,86 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// Install receives the database binary from the control node, and
+// replaces the executable after verifying its checksum.
+func (t *transporterServer) Install(stream dbtesterpb.Transporter_InstallServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	var execPath string
+	switch first.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__tip,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__v3_3:
+		execPath = globalFlags.etcdExec
+	case dbtesterpb.DatabaseID_consul__v1_0_2:
+		execPath = globalFlags.consulExec
+	default:
+		return fmt.Errorf("install is not supported for %q", first.DatabaseID)
+	}
+	plog.Infof("received gRPC install request with database %q %s", first.DatabaseID, first.Version)
+
+	if err = os.MkdirAll(filepath.Dir(execPath), 0777); err != nil {
+		return err
+	}
+	tmpPath := execPath + ".installing"
+	f, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0755)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	h := sha256.New()
+	w := io.MultiWriter(f, h)
+	for chunk := first; ; {
+		if _, err = w.Write(chunk.Data); err != nil {
+			f.Close()
+			return err
+		}
+		chunk, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	if got := hex.EncodeToString(h.Sum(nil)); got != first.SHA256 {
+		return fmt.Errorf("checksum mismatch (expected %s, got %s)", first.SHA256, got)
+	}
+	if err = os.Rename(tmpPath, execPath); err != nil {
+		return err
+	}
+	plog.Infof("installed %q %s at %q (sha256 %s)", first.DatabaseID, first.Version, execPath, first.SHA256)
+	return stream.SendAndClose(&dbtesterpb.Response{Success: true})
+}
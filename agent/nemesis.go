@@ -0,0 +1,167 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// nemesis injects, or recovers from, the fault on this machine.
+func (t *transporterServer) nemesis(step *dbtesterpb.ConfigNemesisStep, recover bool) error {
+	if step == nil {
+		return fmt.Errorf("nemesis step is not defined")
+	}
+	plog.Infof("nemesis %q (recover: %v)", step.Operation, recover)
+
+	switch step.Operation {
+	case "partition":
+		return t.partition(recover)
+	case "kill":
+		if recover {
+			return t.restartDatabase()
+		}
+		return t.killDatabase()
+	case "stop":
+		if recover {
+			return t.restartDatabase()
+		}
+		return t.stopDatabase()
+	case "clock-skew":
+		skew := time.Duration(step.ClockSkewMilliseconds) * time.Millisecond
+		if recover {
+			skew = -skew
+		}
+		return skewClock(skew)
+	default:
+		return fmt.Errorf("unknown nemesis operation %q", step.Operation)
+	}
+}
+
+// partition drops all packets from and to other peers,
+// or removes the rules if 'recover' is true.
+func (t *transporterServer) partition(recover bool) error {
+	action := "-A"
+	if recover {
+		action = "-D"
+	}
+	peerIPs := strings.Split(t.req.PeerIPsString, "___")
+	for i, ip := range peerIPs {
+		if uint32(i) == t.req.IPIndex {
+			continue
+		}
+		for _, args := range [][]string{
+			{action, "INPUT", "-s", ip, "-j", "DROP"},
+			{action, "OUTPUT", "-d", ip, "-j", "DROP"},
+		} {
+			plog.Infof("iptables %s", strings.Join(args, " "))
+			if out, err := exec.Command("iptables", args...).CombinedOutput(); err != nil {
+				return fmt.Errorf("iptables %s failed %v (%q)", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+			}
+		}
+	}
+	return nil
+}
+
+// killDatabase sends SIGKILL to the database process.
+func (t *transporterServer) killDatabase() error {
+	if t.cmd == nil {
+		return fmt.Errorf("nil command")
+	}
+	plog.Infof("sending %q to %q [PID: %d]", syscall.SIGKILL, t.cmd.Path, t.pid)
+	if err := syscall.Kill(int(t.pid), syscall.SIGKILL); err != nil {
+		return err
+	}
+	<-t.cmdWait
+	return nil
+}
+
+// gracefulStopTimeout is the time to wait for the database to exit
+// after SIGTERM, before SIGKILL.
+const gracefulStopTimeout = 30 * time.Second
+
+// stopDatabase sends SIGTERM to the database process for graceful
+// shutdown, and SIGKILL if it does not exit in time.
+func (t *transporterServer) stopDatabase() error {
+	if t.cmd == nil {
+		return fmt.Errorf("nil command")
+	}
+	plog.Infof("sending %q to %q [PID: %d]", syscall.SIGTERM, t.cmd.Path, t.pid)
+	if err := syscall.Kill(int(t.pid), syscall.SIGTERM); err != nil {
+		return err
+	}
+	select {
+	case <-t.cmdWait:
+		return nil
+	case <-time.After(gracefulStopTimeout):
+	}
+	plog.Warningf("%q did not exit in %v after %q", t.cmd.Path, gracefulStopTimeout, syscall.SIGTERM)
+	return t.killDatabase()
+}
+
+// restartDatabase starts the killed database with the same command,
+// keeping the data directory. System metrics keep tracking the
+// previous PID, so the restarted process is not measured.
+func (t *transporterServer) restartDatabase() error {
+	if t.cmd == nil {
+		return fmt.Errorf("nil command")
+	}
+	cmd := exec.Command(t.cmd.Path, t.cmd.Args[1:]...)
+	cmd.Env = t.cmd.Env
+	cmd.Stdout = t.cmd.Stdout
+	cmd.Stderr = t.cmd.Stderr
+
+	plog.Infof("restarting database %q", strings.Join(cmd.Args, " "))
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	t.cmd = cmd
+	t.cmdWait = make(chan struct{})
+	pid, err := t.databasePID(cmd)
+	if err != nil {
+		return err
+	}
+	t.pid = pid
+	if err := t.trackMetricsPID(pid); err != nil {
+		return err
+	}
+
+	go func() {
+		defer close(t.cmdWait)
+		if err := t.cmd.Wait(); err != nil {
+			plog.Errorf("cmd.Wait %q returned error %v", t.cmd.Path, err)
+			return
+		}
+		plog.Infof("exiting %q", t.cmd.Path)
+	}()
+	plog.Infof("restarted database %q (PID: %d)", cmd.Path, t.pid)
+	return nil
+}
+
+// skewClock moves the system clock by 'skew'.
+func skewClock(skew time.Duration) error {
+	now := time.Now().Add(skew)
+	arg := fmt.Sprintf("@%d.%09d", now.Unix(), now.Nanosecond())
+	plog.Infof("date -s %s (skew %v)", arg, skew)
+	if out, err := exec.Command("date", "-s", arg).CombinedOutput(); err != nil {
+		return fmt.Errorf("date -s %s failed %v (%q)", arg, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
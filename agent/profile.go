@@ -0,0 +1,111 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// profile captures CPU profile for 'seconds', and heap profile from the
+// pprof endpoint of the database. If 'perf' is true, 'perf record' runs
+// on the database process during the same window.
+func (t *transporterServer) profile(seconds int64, perf bool, perfArgs []string) (cpu, heap, perfScript []byte, err error) {
+	var ep string
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__tip,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__v3_3:
+		peerIPs := strings.Split(t.req.PeerIPsString, "___")
+		ep = fmt.Sprintf("http://%s:%d/debug/pprof", peerIPs[t.req.IPIndex], t.etcdClientPort())
+	default:
+		if !perf {
+			return nil, nil, nil, fmt.Errorf("profile is not supported for %q", t.req.DatabaseID)
+		}
+	}
+	if seconds <= 0 {
+		seconds = 10
+	}
+
+	var perfErr error
+	perfDone := make(chan struct{})
+	go func() {
+		defer close(perfDone)
+		if perf {
+			perfScript, perfErr = t.perfRecord(seconds, perfArgs)
+		}
+	}()
+
+	if ep != "" {
+		plog.Infof("capturing %d-second CPU profile from %q", seconds, ep)
+		cpu, err = getProfile(fmt.Sprintf("%s/profile?seconds=%d", ep, seconds), time.Duration(seconds)*time.Second+time.Minute)
+		if err == nil {
+			plog.Infof("capturing heap profile from %q", ep)
+			heap, err = getProfile(ep+"/heap", time.Minute)
+		}
+	}
+	<-perfDone
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if perfErr != nil {
+		return nil, nil, nil, perfErr
+	}
+	plog.Infof("captured profiles (CPU %d bytes, heap %d bytes, perf %d bytes)", len(cpu), len(heap), len(perfScript))
+	return cpu, heap, perfScript, nil
+}
+
+// perfRecord runs 'perf record' on the database process for 'seconds',
+// and returns the 'perf script' output, which can be converted
+// to flame graphs without the symbols on this machine.
+func (t *transporterServer) perfRecord(seconds int64, args []string) ([]byte, error) {
+	if len(args) == 0 {
+		args = []string{"-g"}
+	}
+	f, err := ioutil.TempFile("", "dbtester-perf")
+	if err != nil {
+		return nil, err
+	}
+	f.Close()
+	defer os.RemoveAll(f.Name())
+
+	rargs := append([]string{"record", "-p", fmt.Sprintf("%d", t.pid), "-o", f.Name()}, args...)
+	rargs = append(rargs, "--", "sleep", fmt.Sprintf("%d", seconds))
+	plog.Infof("perf %s", strings.Join(rargs, " "))
+	if out, err := exec.Command("perf", rargs...).CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("perf record failed %v (%q)", err, strings.TrimSpace(string(out)))
+	}
+	return exec.Command("perf", "script", "-i", f.Name()).Output()
+}
+
+func getProfile(u string, timeout time.Duration) ([]byte, error) {
+	cli := &http.Client{Timeout: timeout}
+	resp, err := cli.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %q (%s)", u, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
@@ -16,13 +16,16 @@ package agent
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/cpuinfo"
 	"github.com/coreos/dbtester/pkg/fileinspect"
 
 	"github.com/gyuho/linux-inspect/inspect"
@@ -49,8 +52,30 @@ type transporterServer struct {
 	proxyCmdWait chan struct{}
 	proxyPid     int64
 
+	// metricsMu protects metricsCSV, which is re-pointed
+	// to the new process when the database restarts
+	metricsMu  sync.Mutex
 	metricsCSV *inspect.CSV
 
+	// collectors sample extra metrics until collectorsStop is closed
+	collectors     []*collectorCSV
+	collectorsStop chan struct{}
+	collectorsWg   sync.WaitGroup
+
+	// wanDevice is the network interface with WAN latency injected,
+	// empty if none
+	wanDevice string
+
+	// delayedDisk is mounted at the database data directory
+	// with disk latency injected, nil if none
+	delayedDisk *delayedDisk
+
+	// samples are streamed to the control node
+	// as soon as they are collected
+	samplesMu     sync.Mutex
+	samples       []dbtesterpb.MonitorSample
+	samplesNotify chan struct{}
+
 	// trigger log uploads to cloud storage
 	// this should be triggered before we shut down
 	// the agent server
@@ -70,6 +95,7 @@ func NewServer() dbtesterpb.TransporterServer {
 		clientNumPath: globalFlags.clientNumPath,
 		uploadSig:     make(chan struct{}, 1),
 		csvReady:      make(chan struct{}),
+		samplesNotify: make(chan struct{}, 1),
 		notifier:      notifier,
 	}
 }
@@ -98,6 +124,9 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			plog.Infof("proxy-database log path: %q", proxyLog)
 		}
 		plog.Infof("system metrics CSV path: %q", globalFlags.systemMetricsCSV)
+		if req.ConfigDocker != nil && req.ConfigDocker.Image != "" {
+			plog.Infof("Docker image: %q (tag %q)", req.ConfigDocker.Image, req.ConfigDocker.Tag)
+		}
 
 		switch req.DatabaseID {
 		case dbtesterpb.DatabaseID_etcd__tip,
@@ -133,8 +162,18 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 	}
 
 	var diskSpaceUsageBytes int64
+	var cpuProfile, heapProfile, perfScript []byte
+	var cpu cpuinfo.Info
+	var unixNanosecond int64
+	var serverCommandLine, proxyCommandLine, serverConfigPath, serverConfig string
 	switch req.Operation {
 	case dbtesterpb.Operation_Start:
+		// inject before start, so that the cluster forms over WAN
+		if err := t.applyWAN(globalFlags.networkInterface); err != nil {
+			plog.Errorf("applyWAN error %v", err)
+			return nil, err
+		}
+
 		switch t.req.DatabaseID {
 		case dbtesterpb.DatabaseID_etcd__tip,
 			dbtesterpb.DatabaseID_etcd__v3_2,
@@ -151,6 +190,7 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 					plog.Errorf("startZetcd error %v", err)
 					return nil, err
 				}
+				proxyCommandLine = processCommandLine(t.proxyPid, t.proxyCmd)
 				go func() {
 					defer close(t.proxyCmdWait)
 					if err := t.proxyCmd.Wait(); err != nil {
@@ -164,6 +204,7 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 					plog.Errorf("startCetcd error %v", err)
 					return nil, err
 				}
+				proxyCommandLine = processCommandLine(t.proxyPid, t.proxyCmd)
 				go func() {
 					defer close(t.proxyCmdWait)
 					if err := t.proxyCmd.Wait(); err != nil {
@@ -178,6 +219,7 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 				plog.Errorf("startZookeeper error %v", err)
 				return nil, err
 			}
+			serverConfigPath = globalFlags.zkConfig
 		case dbtesterpb.DatabaseID_consul__v1_0_2:
 			if err := startConsul(&globalFlags, t); err != nil {
 				plog.Errorf("startConsul error %v", err)
@@ -187,6 +229,16 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			return nil, fmt.Errorf("unknown database %q", t.req.DatabaseID)
 		}
 
+		// flags and config of the database, to record in run metadata
+		serverCommandLine = processCommandLine(t.pid, t.cmd)
+		if serverConfigPath != "" {
+			bts, err := ioutil.ReadFile(serverConfigPath)
+			if err != nil {
+				plog.Warningf("failed to read config file %q (%v)", serverConfigPath, err)
+			}
+			serverConfig = string(bts)
+		}
+
 		go func() {
 			defer close(t.cmdWait)
 			if err := t.cmd.Wait(); err != nil {
@@ -247,6 +299,9 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			t.proxyDatabaseLogfile.Sync()
 			t.proxyDatabaseLogfile.Close()
 		}
+		if err := t.removeWAN(); err != nil {
+			plog.Warningf("removeWAN error %v", err)
+		}
 
 		t.uploadSig <- struct{}{}
 		<-t.csvReady
@@ -259,24 +314,66 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 		}
 
 		dbs, err := measureDatabasSize(globalFlags, req.DatabaseID)
+		if rerr := t.removeDiskDelay(); rerr != nil {
+			plog.Warningf("removeDiskDelay error %v", rerr)
+		}
 		if err != nil {
 			plog.Warningf("measureDatabasSize error %v", err)
 			return nil, err
 		}
 		diskSpaceUsageBytes = dbs
 
+		// hardware of this machine, to normalize results across machines
+		if cpu, err = cpuinfo.Read(); err != nil {
+			plog.Warningf("cpuinfo.Read error %v", err)
+		}
+
 	case dbtesterpb.Operation_Heartbeat:
 		plog.Infof("overwriting clients num %d to %q", t.req.CurrentClientNumber, t.clientNumPath)
 		if err := toFile(fmt.Sprintf("%d", t.req.CurrentClientNumber), t.clientNumPath); err != nil {
 			return nil, err
 		}
 
+	case dbtesterpb.Operation_Nemesis:
+		if err := t.nemesis(req.NemesisStep, req.NemesisRecover); err != nil {
+			plog.Warningf("nemesis error %v", err)
+			return nil, err
+		}
+
+	case dbtesterpb.Operation_ClockOffset:
+		unixNanosecond = time.Now().UnixNano()
+
+	case dbtesterpb.Operation_Profile:
+		var err error
+		cpuProfile, heapProfile, perfScript, err = t.profile(req.ProfileSeconds, req.Perf, req.PerfArgs)
+		if err != nil {
+			plog.Warningf("profile error %v", err)
+			return nil, err
+		}
+
 	default:
 		return nil, fmt.Errorf("Not implemented %v", req.Operation)
 	}
 
 	plog.Info("Transfer success!")
-	return &dbtesterpb.Response{Success: true, DiskSpaceUsageBytes: diskSpaceUsageBytes}, nil
+	return &dbtesterpb.Response{
+		Success:             true,
+		DiskSpaceUsageBytes: diskSpaceUsageBytes,
+		CPUProfile:          cpuProfile,
+		HeapProfile:         heapProfile,
+		PerfScript:          perfScript,
+		CPUModel:            cpu.Model,
+		CPUCores:            cpu.Cores,
+		CPUMHz:              cpu.MHz,
+		UnixNanosecond:      unixNanosecond,
+
+		DiskWriteMBPerSecond: globalFlags.diskWriteMBPerSecond,
+
+		ServerCommandLine: serverCommandLine,
+		ProxyCommandLine:  proxyCommandLine,
+		ServerConfigPath:  serverConfigPath,
+		ServerConfig:      serverConfig,
+	}, nil
 }
 
 func measureDatabasSize(flg flags, rdb dbtesterpb.DatabaseID) (int64, error) {
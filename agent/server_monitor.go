@@ -0,0 +1,90 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/gyuho/linux-inspect/inspect"
+)
+
+// Monitor streams system metrics samples to the control node.
+// It first sends all samples collected so far, and returns
+// after the last sample is sent once the metrics CSV is saved.
+func (t *transporterServer) Monitor(req *dbtesterpb.Request, stream dbtesterpb.Transporter_MonitorServer) error {
+	plog.Infof("received gRPC monitor request with database %q", req.DatabaseID)
+
+	sent, done := 0, false
+	for {
+		t.samplesMu.Lock()
+		ss := t.samples[sent:]
+		t.samplesMu.Unlock()
+
+		for i := range ss {
+			// samples are shared with 'addSample' and other streams,
+			// so the header is set on a copy
+			sample := ss[i]
+			if sent == 0 {
+				sample.Header = toCSVLine(inspect.ProcHeader)
+			}
+			if err := stream.Send(&sample); err != nil {
+				plog.Warningf("failed to send sample (%v)", err)
+				return err
+			}
+			sent++
+		}
+		if done {
+			plog.Infof("finished streaming %d samples", sent)
+			return nil
+		}
+
+		select {
+		case <-t.samplesNotify:
+		case <-t.csvReady:
+			// flush the remaining samples
+			done = true
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// addSample records the latest system metrics row
+// to be streamed to the control node.
+func (t *transporterServer) addSample(row inspect.Proc) {
+	t.samplesMu.Lock()
+	t.samples = append(t.samples, dbtesterpb.MonitorSample{
+		UnixNanosecond: row.UnixNanosecond,
+		Row:            toCSVLine(row.ToRow()),
+	})
+	t.samplesMu.Unlock()
+
+	select {
+	case t.samplesNotify <- struct{}{}:
+	default:
+	}
+}
+
+func toCSVLine(fields []string) string {
+	buf := new(bytes.Buffer)
+	wr := csv.NewWriter(buf)
+	wr.Write(fields)
+	wr.Flush()
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -19,6 +19,9 @@ import (
 	"os"
 	"time"
 
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/resample"
+
 	"github.com/gyuho/linux-inspect/inspect"
 	"github.com/gyuho/linux-inspect/top"
 )
@@ -28,8 +31,9 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 	if fs == nil || t == nil || t.cmd == nil {
 		return fmt.Errorf("cannot find process to track (%+v, %+v)", fs, t)
 	}
-	plog.Infof("starting collecting metrics [database %q | PID: %d | disk device: %q | network interface: %q]",
-		t.req.DatabaseID, t.pid, fs.diskDevice, fs.networkInterface)
+	interval := dbtesterpb.MonitorInterval(t.req.ConfigClientMachineInitial)
+	plog.Infof("starting collecting metrics [database %q | PID: %d | disk device: %q | network interface: %q | interval: %v]",
+		t.req.DatabaseID, t.pid, fs.diskDevice, fs.networkInterface, interval)
 
 	if err = os.RemoveAll(fs.systemMetricsCSV); err != nil {
 		return err
@@ -40,7 +44,7 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 
 	tcfg := &top.Config{
 		Exec:           top.DefaultExecPath,
-		IntervalSecond: 1,
+		IntervalSecond: interval.Seconds(),
 		PID:            t.pid,
 	}
 	t.metricsCSV, err = inspect.NewCSV(
@@ -54,36 +58,52 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 	if err := t.metricsCSV.Add(); err != nil {
 		return err
 	}
+	t.addSample(t.metricsCSV.Rows[len(t.metricsCSV.Rows)-1])
+
+	if err = t.startCollectors(fs.systemMetricsCSV, interval); err != nil {
+		return err
+	}
 
 	go func() {
 		for {
 			select {
-			case <-time.After(time.Second):
+			case <-time.After(interval):
+				t.metricsMu.Lock()
 				if err := t.metricsCSV.Add(); err != nil {
+					t.metricsMu.Unlock()
 					plog.Errorf("inspect.CSV.Add error (%v)", err)
 					continue
 				}
+				t.addSample(t.metricsCSV.Rows[len(t.metricsCSV.Rows)-1])
+				t.metricsMu.Unlock()
 
 			case <-t.uploadSig:
 				plog.Infof("upload signal received; saving CSV at %q", t.metricsCSV.FilePath)
 
+				t.metricsMu.Lock()
+				defer t.metricsMu.Unlock()
+
 				if err := t.metricsCSV.Save(); err != nil {
 					plog.Errorf("inspect.CSV.Save(%q) error %v", t.metricsCSV.FilePath, err)
 				} else {
 					plog.Infof("CSV saved at %q", t.metricsCSV.FilePath)
+					writeChecksum(t.metricsCSV.FilePath)
 				}
 
-				interpolated, err := t.metricsCSV.Interpolate()
+				interpolated, err := resample.Interpolate(t.metricsCSV, interval)
 				if err != nil {
-					plog.Fatalf("inspect.CSV.Interpolate(%q) failed with %v", t.metricsCSV.FilePath, err)
+					plog.Fatalf("resample.Interpolate(%q) failed with %v", t.metricsCSV.FilePath, err)
 				}
 				interpolated.FilePath = fs.systemMetricsCSVInterpolated
 				if err := interpolated.Save(); err != nil {
 					plog.Errorf("inspect.CSV.Save(%q) error %v", interpolated.FilePath, err)
 				} else {
 					plog.Infof("CSV saved at %q", interpolated.FilePath)
+					writeChecksum(interpolated.FilePath)
 				}
 
+				t.stopCollectors(interpolated.MinUnixSecond, interpolated.MaxUnixSecond)
+
 				close(t.csvReady)
 				return
 
@@ -95,3 +115,32 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 	}()
 	return nil
 }
+
+// trackMetricsPID points the running metrics collection at the
+// restarted database process, keeping the rows collected so far.
+func (t *transporterServer) trackMetricsPID(pid int64) error {
+	t.metricsMu.Lock()
+	defer t.metricsMu.Unlock()
+
+	if t.metricsCSV == nil {
+		return nil
+	}
+	if t.metricsCSV.TopStream != nil {
+		if err := t.metricsCSV.TopStream.Stop(); err != nil {
+			plog.Warningf("failed to stop top stream for PID %d (%v)", t.metricsCSV.PID, err)
+		}
+	}
+	interval := dbtesterpb.MonitorInterval(t.req.ConfigClientMachineInitial)
+	str, err := (&top.Config{
+		Exec:           top.DefaultExecPath,
+		IntervalSecond: interval.Seconds(),
+		PID:            pid,
+	}).StartStream()
+	if err != nil {
+		return err
+	}
+	plog.Infof("collecting metrics for restarted database [PID: %d -> %d]", t.metricsCSV.PID, pid)
+	t.metricsCSV.PID = pid
+	t.metricsCSV.TopStream = str
+	return nil
+}
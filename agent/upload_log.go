@@ -16,11 +16,16 @@ package agent
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/anonymize"
+	"github.com/coreos/dbtester/pkg/checksum"
+	"github.com/coreos/dbtester/pkg/fileutil"
 	"github.com/coreos/dbtester/pkg/remotestorage"
 )
 
@@ -32,117 +37,188 @@ func uploadLog(fs *flags, t *transporterServer) error {
 		return err
 	}
 
-	var uerr error
+	var an *anonymize.Anonymizer
+	var anonymizedDir string
+	if t.req.ConfigClientMachineInitial.Anonymize {
+		an = newAnonymizer(t)
+		anonymizedDir, err = ioutil.TempDir("", "dbtester-anonymized")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(anonymizedDir)
+	}
+	bucket := t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName
 
 	{
-		srcDatabaseLogPath := fs.databaseLog
-		dstDatabaseLogPath := filepath.Base(fs.databaseLog)
-		if !strings.HasPrefix(filepath.Base(fs.databaseLog), t.req.DatabaseTag) {
-			dstDatabaseLogPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.databaseLog))
+		srcDatabaseLogPath, err := localPath(an, anonymizedDir, fs.databaseLog)
+		if err != nil {
+			return err
 		}
-		dstDatabaseLogPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstDatabaseLogPath)
+		dstDatabaseLogPath := uploadPath(t, fs.databaseLog)
 		plog.Infof("uploading database log [%q -> %q]", srcDatabaseLogPath, dstDatabaseLogPath)
-		for k := 0; k < 30; k++ {
-			if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcDatabaseLogPath, dstDatabaseLogPath); uerr != nil {
-				plog.Warningf("UploadFile error... sleep and retry... (%v)", uerr)
-				time.Sleep(2 * time.Second)
-				continue
-			} else {
-				break
-			}
-		}
-		if uerr != nil {
-			return uerr
+		if err = uploadFile(u, bucket, srcDatabaseLogPath, dstDatabaseLogPath); err != nil {
+			return err
 		}
 	}
 
 	{
 		if t.req.DatabaseID == dbtesterpb.DatabaseID_zetcd__beta || t.req.DatabaseID == dbtesterpb.DatabaseID_cetcd__beta {
 			dpath := fs.databaseLog + "-" + t.req.DatabaseID.String()
-			srcDatabaseLogPath2 := dpath
-			dstDatabaseLogPath2 := filepath.Base(dpath)
-			if !strings.HasPrefix(filepath.Base(dpath), t.req.DatabaseTag) {
-				dstDatabaseLogPath2 = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(dpath))
+			srcDatabaseLogPath2, err := localPath(an, anonymizedDir, dpath)
+			if err != nil {
+				return err
 			}
-			dstDatabaseLogPath2 = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstDatabaseLogPath2)
+			dstDatabaseLogPath2 := uploadPath(t, dpath)
 			plog.Infof("uploading proxy-database log [%q -> %q]", srcDatabaseLogPath2, dstDatabaseLogPath2)
-			for k := 0; k < 30; k++ {
-				if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcDatabaseLogPath2, dstDatabaseLogPath2); uerr != nil {
-					plog.Warningf("UploadFile error... sleep and retry... (%v)", uerr)
-					time.Sleep(2 * time.Second)
-					continue
-				} else {
-					break
-				}
-			}
-			if uerr != nil {
-				return uerr
+			if err = uploadFile(u, bucket, srcDatabaseLogPath2, dstDatabaseLogPath2); err != nil {
+				return err
 			}
 		}
 	}
 
 	{
-		srcSysMetricsDataPath := fs.systemMetricsCSV
-		dstSysMetricsDataPath := filepath.Base(fs.systemMetricsCSV)
-		if !strings.HasPrefix(filepath.Base(fs.systemMetricsCSV), t.req.DatabaseTag) {
-			dstSysMetricsDataPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.systemMetricsCSV))
+		if err = verifyChecksum(fs.systemMetricsCSV); err != nil {
+			return err
 		}
-		dstSysMetricsDataPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstSysMetricsDataPath)
-		plog.Infof("uploading system metrics data [%q -> %q]", srcSysMetricsDataPath, dstSysMetricsDataPath)
-		for k := 0; k < 30; k++ {
-			if uerr := u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcSysMetricsDataPath, dstSysMetricsDataPath); uerr != nil {
-				plog.Warningf("upload error... sleep and retry... (%v)", uerr)
-				time.Sleep(2 * time.Second)
-				continue
-			} else {
-				break
-			}
+		srcSysMetricsDataPath, err := localPath(an, anonymizedDir, fs.systemMetricsCSV)
+		if err != nil {
+			return err
 		}
-		if uerr != nil {
-			return uerr
+		dstSysMetricsDataPath := uploadPath(t, fs.systemMetricsCSV)
+		plog.Infof("uploading system metrics data [%q -> %q]", srcSysMetricsDataPath, dstSysMetricsDataPath)
+		if err = uploadFile(u, bucket, srcSysMetricsDataPath, dstSysMetricsDataPath); err != nil {
+			return err
 		}
 	}
 
 	{
-		srcSysMetricsInterpolatedDataPath := fs.systemMetricsCSVInterpolated
-		dstSysMetricsInterpolatedDataPath := filepath.Base(fs.systemMetricsCSVInterpolated)
-		if !strings.HasPrefix(filepath.Base(fs.systemMetricsCSVInterpolated), t.req.DatabaseTag) {
-			dstSysMetricsInterpolatedDataPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.systemMetricsCSVInterpolated))
+		if err = verifyChecksum(fs.systemMetricsCSVInterpolated); err != nil {
+			return err
 		}
-		dstSysMetricsInterpolatedDataPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstSysMetricsInterpolatedDataPath)
+		srcSysMetricsInterpolatedDataPath, err := localPath(an, anonymizedDir, fs.systemMetricsCSVInterpolated)
+		if err != nil {
+			return err
+		}
+		dstSysMetricsInterpolatedDataPath := uploadPath(t, fs.systemMetricsCSVInterpolated)
 		plog.Infof("uploading system metrics interpolated data [%q -> %q]", srcSysMetricsInterpolatedDataPath, dstSysMetricsInterpolatedDataPath)
-		for k := 0; k < 30; k++ {
-			if uerr := u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcSysMetricsInterpolatedDataPath, dstSysMetricsInterpolatedDataPath); uerr != nil {
-				plog.Warningf("upload error... sleep and retry... (%v)", uerr)
-				time.Sleep(2 * time.Second)
-				continue
-			} else {
-				break
-			}
+		if err = uploadFile(u, bucket, srcSysMetricsInterpolatedDataPath, dstSysMetricsInterpolatedDataPath); err != nil {
+			return err
 		}
-		if uerr != nil {
-			return uerr
+	}
+
+	for _, c := range t.collectors {
+		if err = verifyChecksum(c.filePath); err != nil {
+			return err
+		}
+		srcCollectorPath, err := localPath(an, anonymizedDir, c.filePath)
+		if err != nil {
+			return err
+		}
+		dstCollectorPath := uploadPath(t, c.filePath)
+		plog.Infof("uploading collector %q data [%q -> %q]", c.collector.Name(), srcCollectorPath, dstCollectorPath)
+		if err = uploadFile(u, bucket, srcCollectorPath, dstCollectorPath); err != nil {
+			return err
 		}
 	}
 
 	{
-		srcAgentLogPath := fs.agentLog
-		dstAgentLogPath := filepath.Base(fs.agentLog)
-		if !strings.HasPrefix(filepath.Base(fs.agentLog), t.req.DatabaseTag) {
-			dstAgentLogPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.agentLog))
+		// agent log is still being written
+		snapshotDir, err := ioutil.TempDir("", "dbtester-snapshot")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(snapshotDir)
+		srcAgentLogPath := filepath.Join(snapshotDir, filepath.Base(fs.agentLog))
+		if an != nil {
+			err = an.File(fs.agentLog, srcAgentLogPath)
+		} else {
+			err = fileutil.Copy(fs.agentLog, srcAgentLogPath)
+		}
+		if err != nil {
+			return err
 		}
-		dstAgentLogPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstAgentLogPath)
+		dstAgentLogPath := uploadPath(t, fs.agentLog)
 		plog.Infof("uploading agent logs [%q -> %q]", srcAgentLogPath, dstAgentLogPath)
+		if err = uploadFile(u, bucket, srcAgentLogPath, dstAgentLogPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// uploadFile uploads the file, and the checksum of the uploaded file.
+func uploadFile(u remotestorage.Uploader, bucket, srcPath, dstPath string) error {
+	upload := func(src, dst string) (uerr error) {
 		for k := 0; k < 30; k++ {
-			if uerr := u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcAgentLogPath, dstAgentLogPath); uerr != nil {
+			if uerr = u.UploadFile(bucket, src, dst); uerr != nil {
 				plog.Warningf("UploadFile error... sleep and retry... (%v)", uerr)
 				time.Sleep(2 * time.Second)
 				continue
-			} else {
-				break
 			}
+			break
 		}
+		return uerr
+	}
+	if err := upload(srcPath, dstPath); err != nil {
+		return err
+	}
+	if err := checksum.WriteAs(srcPath, filepath.Base(dstPath)); err != nil {
+		return err
+	}
+	return upload(checksum.Path(srcPath), dstPath+checksum.Suffix)
+}
+
+// writeChecksum writes the checksum of the saved result file.
+func writeChecksum(fpath string) {
+	if err := checksum.Write(fpath); err != nil {
+		plog.Warningf("failed to write checksum of %q (%v)", fpath, err)
+	}
+}
+
+// verifyChecksum verifies the result file against its checksum, to
+// detect the file truncated after saved.
+func verifyChecksum(fpath string) error {
+	if err := checksum.Verify(fpath); err != nil && err != checksum.ErrNoChecksum {
+		return err
 	}
+	return nil
+}
+
+// uploadPath returns the path in the storage to upload the file to.
+// With 'run_id', it is in the 'server-N' directory of the standard layout.
+func uploadPath(t *transporterServer, fpath string) string {
+	dst := filepath.Base(fpath)
+	if t.req.ConfigClientMachineInitial.RunID != "" {
+		dst = filepath.Join(dbtesterpb.ServerResultDir(t.req.ConfigClientMachineInitial.RunID, t.req.DatabaseTag, int(t.req.IPIndex)), dst)
+	} else if !strings.HasPrefix(dst, t.req.DatabaseTag) {
+		dst = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, dst)
+	}
+	return filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dst)
+}
 
-	return uerr
+// newAnonymizer returns the Anonymizer for logs of the database,
+// where servers are named in the order of peer IPs, as in control.
+func newAnonymizer(t *transporterServer) *anonymize.Anonymizer {
+	names := map[string]string{
+		t.req.ConfigClientMachineInitial.GoogleCloudProjectName:       "project",
+		t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName: "bucket",
+	}
+	if host, err := os.Hostname(); err == nil {
+		names[host] = fmt.Sprintf("server-%d", t.req.IPIndex+1)
+	}
+	for i, ip := range strings.Split(t.req.PeerIPsString, "___") {
+		names[ip] = fmt.Sprintf("server-%d", i+1)
+	}
+	return anonymize.New(names)
+}
+
+// localPath returns the path of the file to upload, which is
+// the anonymized copy in 'dir' if 'an' is not nil.
+func localPath(an *anonymize.Anonymizer, dir, fpath string) (string, error) {
+	if an == nil {
+		return fpath, nil
+	}
+	dst := filepath.Join(dir, filepath.Base(fpath))
+	return dst, an.File(fpath, dst)
 }
@@ -15,8 +15,12 @@
 package agent
 
 import (
+	"fmt"
+	"io/ioutil"
 	"os"
+	"os/exec"
 	"runtime"
+	"strings"
 )
 
 func openToAppend(fpath string) (*os.File, error) {
@@ -77,3 +81,13 @@ func exist(fpath string) bool {
 	}
 	return true
 }
+
+// processCommandLine returns the command line of the process from
+// '/proc/<pid>/cmdline', or the arguments of the command if not readable.
+func processCommandLine(pid int64, cmd *exec.Cmd) string {
+	bts, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil || len(bts) == 0 {
+		return strings.Join(cmd.Args, " ")
+	}
+	return strings.Join(strings.Split(strings.TrimRight(string(bts), "\x00"), "\x00"), " ")
+}
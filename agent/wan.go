@@ -0,0 +1,108 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/tc"
+)
+
+// wanCommands returns 'tc' arguments to delay and drop packets to peers
+// in other regions, with one 'netem' band per peer. Other packets go to
+// the first band with no delay.
+func wanCommands(dev string, tp *dbtesterpb.ConfigWANTopology, idx int, peerIPs []string) ([][]string, error) {
+	type peer struct {
+		ip   string
+		link *dbtesterpb.ConfigWANLink
+	}
+	var peers []peer
+	for i, ip := range peerIPs {
+		if i == idx {
+			continue
+		}
+		if l := tp.Link(idx, i); l != nil {
+			peers = append(peers, peer{ip: ip, link: l})
+		}
+	}
+	if len(peers) == 0 {
+		return nil, nil
+	}
+	// 'prio' qdisc has at most 16 bands
+	bands := len(peers) + 1
+	if bands > 16 {
+		return nil, fmt.Errorf("too many peers with WAN links (%d, expected at most 15)", len(peers))
+	}
+
+	root := []string{"qdisc", "add", "dev", dev, "root", "handle", "1:", "prio", "bands", fmt.Sprint(bands), "priomap"}
+	for i := 0; i < 16; i++ {
+		root = append(root, "0")
+	}
+	cmds := [][]string{root}
+	for i, p := range peers {
+		band := i + 2
+		netem := []string{"qdisc", "add", "dev", dev, "parent", fmt.Sprintf("1:%d", band), "handle", fmt.Sprintf("%d0:", band), "netem", "delay", fmt.Sprintf("%dms", p.link.DelayMilliseconds)}
+		if p.link.JitterMilliseconds > 0 {
+			netem = append(netem, fmt.Sprintf("%dms", p.link.JitterMilliseconds))
+		}
+		if p.link.LossPercent > 0 {
+			netem = append(netem, "loss", fmt.Sprintf("%g%%", p.link.LossPercent))
+		}
+		cmds = append(cmds,
+			netem,
+			[]string{"filter", "add", "dev", dev, "protocol", "ip", "parent", "1:0", "prio", "1", "u32", "match", "ip", "dst", p.ip + "/32", "flowid", fmt.Sprintf("1:%d", band)},
+		)
+	}
+	return cmds, nil
+}
+
+// applyWAN injects latency and packet loss to peers in other regions.
+// Rules left by previous runs are removed first.
+func (t *transporterServer) applyWAN(dev string) error {
+	tp := t.req.ConfigWANTopology
+	if tp == nil {
+		return nil
+	}
+	cmds, err := wanCommands(dev, tp, int(t.req.IPIndex), strings.Split(t.req.PeerIPsString, "___"))
+	if err != nil {
+		return err
+	}
+	if len(cmds) == 0 {
+		plog.Infof("no WAN link from region %q", tp.Regions[t.req.IPIndex])
+		return nil
+	}
+	tc.Reset(dev)
+	plog.Infof("injecting WAN latency from region %q on %q", tp.Regions[t.req.IPIndex], dev)
+	t.wanDevice = dev
+	for _, args := range cmds {
+		if err = tc.Run(args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeWAN removes the rules added by applyWAN, if any.
+func (t *transporterServer) removeWAN() error {
+	if t.wanDevice == "" {
+		return nil
+	}
+	dev := t.wanDevice
+	t.wanDevice = ""
+	plog.Infof("removing WAN latency on %q", dev)
+	return tc.Run(tc.DeleteRootArgs(dev))
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/coreos/dbtester/pkg/csvmmap"
 	"github.com/gyuho/dataframe"
 )
 
@@ -55,27 +56,23 @@ type testData struct {
 }
 
 // readSystemMetrics extracts only the columns that we need for analyze.
+// The file is memory-mapped and only those columns are parsed, since
+// monitoring CSVs can be several gigabytes.
 func readSystemMetrics(fpath string) (data testData, err error) {
-	originalFrame, err := dataframe.NewFromCSV(nil, fpath)
+	rd, err := csvmmap.Open(fpath)
 	if err != nil {
 		return testData{}, err
 	}
+	defer rd.Close()
 
 	data.filePath = fpath
-	data.frame = dataframe.New()
-	var unixSecondCol dataframe.Column
-	for _, name := range sysMetricsColumnsToRead {
-		var column dataframe.Column
-		column, err = originalFrame.Column(name)
-		if err != nil {
-			return testData{}, err
-		}
-		if err = data.frame.AddColumn(column); err != nil {
-			return testData{}, err
-		}
-		if name == "UNIX-SECOND" {
-			unixSecondCol = column
-		}
+	data.frame, err = rd.Frame(sysMetricsColumnsToRead...)
+	if err != nil {
+		return testData{}, err
+	}
+	unixSecondCol, err := data.frame.Column("UNIX-SECOND")
+	if err != nil {
+		return testData{}, err
 	}
 
 	// get first(minimum) unix second
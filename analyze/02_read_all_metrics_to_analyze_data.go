@@ -37,6 +37,9 @@ type analyzeData struct {
 	aggregated dataframe.Frame
 
 	allAggregatedOutputPath string
+
+	// timeAxis formats "TIME" column of the saved timeseries
+	timeAxis timeAxis
 }
 
 // readSystemMetricsAll reads all system metric files
@@ -17,18 +17,28 @@ package analyze
 import (
 	"fmt"
 
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/pkg/csvmmap"
 	"github.com/gyuho/dataframe"
 )
 
 // importBenchMetrics adds benchmark metrics from client-side
 // and aggregates this to system metrics by unix timestamps.
+// Like system metrics, only the needed columns are read from the
+// memory-mapped file.
 func (data *analyzeData) importBenchMetrics(fpath string) (err error) {
 	data.benchMetricsFilePath = fpath
 
+	rd, err := csvmmap.Open(fpath)
+	if err != nil {
+		return err
+	}
+	defer rd.Close()
+
 	var tdf dataframe.Frame
-	tdf, err = dataframe.NewFromCSV(nil, fpath)
+	tdf, err = rd.Frame("UNIX-SECOND", "CONTROL-CLIENT-NUM", "MIN-LATENCY-MS", "AVG-LATENCY-MS", "MAX-LATENCY-MS", "AVG-THROUGHPUT")
 	if err != nil {
-		return
+		return err
 	}
 
 	var oldTSCol dataframe.Column
@@ -216,6 +226,47 @@ func (data *analyzeData) importBenchMetrics(fpath string) (err error) {
 		return err
 	}
 
+	// per-operation-type columns in "mixed" type benchmark
+	// (e.g. READ-AVG-LATENCY-MS), with the same rows
+	for _, op := range dbtester.OperationTypes {
+		for _, c := range dbtester.OperationTimeseriesColumns {
+			header := dbtester.OperationColumn(op, c)
+			oldCol, err := tdf.Column(header)
+			if err != nil {
+				continue
+			}
+			sec2Value := make(map[int64]float64)
+			for i := 0; i < oldTSCol.Count() && i < oldCol.Count(); i++ {
+				tv, err := oldTSCol.Value(i)
+				if err != nil {
+					return err
+				}
+				ts, _ := tv.Int64()
+				v, err := oldCol.Value(i)
+				if err != nil {
+					return err
+				}
+				fv, _ := v.Float64()
+				if pv, ok := sec2Value[ts]; ok {
+					// duplicate timestamps: add up the throughput, average the latencies
+					if c == "AVG-THROUGHPUT" {
+						fv += pv
+					} else {
+						fv = (fv + pv) / 2.0
+					}
+				}
+				sec2Value[ts] = fv
+			}
+			newCol := dataframe.NewColumn(header)
+			for i := int64(0); i < expectedRowN; i++ {
+				newCol.PushBack(dataframe.NewStringValue(sec2Value[data.benchMetrics.frontUnixSecond+i]))
+			}
+			if err = df.AddColumn(newCol); err != nil {
+				return err
+			}
+		}
+	}
+
 	data.benchMetrics.frame = df
 	return
 }
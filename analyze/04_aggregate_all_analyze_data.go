@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/pkg/table"
 	humanize "github.com/dustin/go-humanize"
 	"github.com/gyuho/dataframe"
 )
@@ -570,5 +571,24 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 }
 
 func (data *analyzeData) save() error {
-	return data.aggregated.CSV(data.allAggregatedOutputPath)
+	fr := data.aggregated
+	if data.timeAxis.enabled() {
+		var err error
+		if fr, err = data.timeAxis.withTimeColumn(data.aggregated, data.databaseTag); err != nil {
+			return err
+		}
+	}
+	if err := fr.CSV(data.allAggregatedOutputPath); err != nil {
+		return err
+	}
+	return saveUnits(data.allAggregatedOutputPath, fr.Headers(), dbtester.ColumnUnit)
+}
+
+// saveUnits saves the units of the CSV file columns.
+func saveUnits(fpath string, header []string, unitOf func(string) string) error {
+	units := make([]string, len(header))
+	for i, h := range header {
+		units[i] = unitOf(h)
+	}
+	return table.WriteUnits(fpath, header, units)
 }
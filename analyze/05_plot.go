@@ -16,6 +16,7 @@ package analyze
 
 import (
 	"fmt"
+	"image/color"
 
 	"github.com/coreos/dbtester/dbtesterpb"
 
@@ -29,17 +30,33 @@ import (
 var (
 	plotWidth  = 12 * vg.Inch
 	plotHeight = 8 * vg.Inch
+
+	defaultLineWidth = vg.Points(1.5)
 )
 
 func init() {
 	plot.DefaultFont = "Helvetica"
-	plotter.DefaultLineStyle.Width = vg.Points(1.5)
+	plotter.DefaultLineStyle.Width = defaultLineWidth
 	plotter.DefaultGlyphStyle.Radius = vg.Points(2.0)
 }
 
 type pair struct {
 	x dataframe.Column
 	y dataframe.Column
+
+	// band is the polygon of the shaded band around y, if any
+	band plotter.XYs
+
+	// start is the Unix second of the first row of timeseries
+	start int64
+
+	// events are the seconds since start with client connection
+	// events, to overlay if any
+	events []float64
+
+	// nemesis are the seconds since start with nemesis events,
+	// to overlay if any
+	nemesis []float64
 }
 
 type triplet struct {
@@ -52,7 +69,7 @@ type triplet struct {
 func (all *allAggregatedData) draw(cfg dbtesterpb.ConfigAnalyzeMachinePlot, pairs ...pair) error {
 	// frame now contains
 	// AVG-LATENCY-MS-etcd-v3.1-go1.7.4, AVG-LATENCY-MS-zookeeper-r3.4.9-java8, AVG-LATENCY-MS-consul-v0.7.2-go1.7.4
-	plt, err := plot.New()
+	plt, err := newPlot()
 	if err != nil {
 		return err
 	}
@@ -61,37 +78,95 @@ func (all *allAggregatedData) draw(cfg dbtesterpb.ConfigAnalyzeMachinePlot, pair
 	plt.Y.Label.Text = cfg.YAxis
 	plt.Legend.Top = true
 
-	var ps []plot.Plotter
+	// in wall-clock time, databases tested back to back
+	// are plotted from their own start time
+	var start int64
+	for i, p := range pairs {
+		if i == 0 || p.start < start {
+			start = p.start
+		}
+	}
+	all.timeAxis.apply(plt, start)
+
+	data := &plotData{}
+	var bands, ps []plot.Plotter
+	var eventPairs []pair
+	var eventPoints []plotter.XYs
+	var eventColors []color.Color
 	for i, p := range pairs {
 		pt, err := points(p.y)
 		if err != nil {
 			return err
 		}
+		if all.timeAxis.mode == timeAxisWallClock {
+			shiftX(pt, float64(p.start-start))
+			shiftX(p.band, float64(p.start-start))
+			for j := range p.events {
+				p.events[j] += float64(p.start - start)
+			}
+			for j := range p.nemesis {
+				p.nemesis[j] += float64(p.start - start)
+			}
+		}
 
 		l, err := plotter.NewLine(pt)
 		if err != nil {
 			return err
 		}
-		l.Color = dbtesterpb.GetRGBI(all.headerToDatabaseID[p.y.Header()], i)
+		l.Color = lineColor(dbtesterpb.GetRGBI(all.headerToDatabaseID[p.y.Header()], i), i)
 		l.Dashes = plotutil.Dashes(i)
 		ps = append(ps, l)
 
 		plt.Legend.Add(all.headerToDatabaseDescription[p.y.Header()], l)
+		data.add(all.headerToDatabaseDescription[p.y.Header()], pt)
+
+		if len(p.events) > 0 || len(p.nemesis) > 0 {
+			eventPairs = append(eventPairs, p)
+			eventPoints = append(eventPoints, pt)
+			eventColors = append(eventColors, l.Color)
+		}
+		if len(p.band) > 2 {
+			pg, err := plotter.NewPolygon(p.band)
+			if err != nil {
+				return err
+			}
+			pg.Color = translucent(l.Color)
+			pg.LineStyle.Color = pg.Color
+			bands = append(bands, pg)
+		}
 	}
+	// draw bands under lines
+	plt.Add(bands...)
 	plt.Add(ps...)
 
-	for _, outputPath := range cfg.OutputPathList {
-		if err = plt.Save(plotWidth, plotHeight, outputPath); err != nil {
+	// connection (dashed) and nemesis (solid) events across the y range of all lines
+	ymin, ymax := plt.Y.Min, plt.Y.Max
+	for i, p := range eventPairs {
+		desc := all.headerToDatabaseDescription[p.y.Header()]
+		ls, err := eventLines(p.events, eventPoints[i], ymin, ymax, eventColors[i], connectionEventDashes)
+		if err != nil {
+			return err
+		}
+		if len(ls) > 0 {
+			plt.Add(ls...)
+			plt.Legend.Add(desc+" connection events", ls[0].(plot.Thumbnailer))
+		}
+		if ls, err = eventLines(p.nemesis, eventPoints[i], ymin, ymax, eventColors[i], nil); err != nil {
 			return err
 		}
+		if len(ls) > 0 {
+			plt.Add(ls...)
+			plt.Legend.Add(desc+" nemesis events", ls[0].(plot.Thumbnailer))
+		}
 	}
-	return nil
+
+	return savePlot(plt, cfg.OutputPathList, data)
 }
 
 func (all *allAggregatedData) drawXY(cfg dbtesterpb.ConfigAnalyzeMachinePlot, pairs ...pair) error {
 	// frame now contains
 	// KEYS-DB-TAG-X, AVG-LATENCY-MS-DB-TAG-Y, ...
-	plt, err := plot.New()
+	plt, err := newPlot()
 	if err != nil {
 		return err
 	}
@@ -100,6 +175,7 @@ func (all *allAggregatedData) drawXY(cfg dbtesterpb.ConfigAnalyzeMachinePlot, pa
 	plt.Y.Label.Text = cfg.YAxis
 	plt.Legend.Top = true
 
+	data := &plotData{}
 	var ps []plot.Plotter
 	for i, p := range pairs {
 		pt, err := pointsXY(p.x, p.y)
@@ -111,26 +187,22 @@ func (all *allAggregatedData) drawXY(cfg dbtesterpb.ConfigAnalyzeMachinePlot, pa
 		if err != nil {
 			return err
 		}
-		l.Color = dbtesterpb.GetRGBI(all.headerToDatabaseID[p.y.Header()], i)
+		l.Color = lineColor(dbtesterpb.GetRGBI(all.headerToDatabaseID[p.y.Header()], i), i)
 		l.Dashes = plotutil.Dashes(i)
 		ps = append(ps, l)
 
 		plt.Legend.Add(all.headerToDatabaseDescription[p.y.Header()], l)
+		data.add(all.headerToDatabaseDescription[p.y.Header()], pt)
 	}
 	plt.Add(ps...)
 
-	for _, outputPath := range cfg.OutputPathList {
-		if err = plt.Save(plotWidth, plotHeight, outputPath); err != nil {
-			return err
-		}
-	}
-	return nil
+	return savePlot(plt, cfg.OutputPathList, data)
 }
 
 func (all *allAggregatedData) drawXYWithErrorPoints(cfg dbtesterpb.ConfigAnalyzeMachinePlot, triplets ...triplet) error {
 	// frame now contains
 	// KEYS-DB-TAG-X, MIN-LATENCY-MS-DB-TAG-Y, AVG-LATENCY-MS-DB-TAG-Y, MAX-LATENCY-MS-DB-TAG-Y, ...
-	plt, err := plot.New()
+	plt, err := newPlot()
 	if err != nil {
 		return err
 	}
@@ -139,6 +211,7 @@ func (all *allAggregatedData) drawXYWithErrorPoints(cfg dbtesterpb.ConfigAnalyze
 	plt.Y.Label.Text = cfg.YAxis
 	plt.Legend.Top = true
 
+	data := &plotData{}
 	var ps []plot.Plotter
 	for i, triplet := range triplets {
 		{
@@ -150,10 +223,11 @@ func (all *allAggregatedData) drawXYWithErrorPoints(cfg dbtesterpb.ConfigAnalyze
 			if err != nil {
 				return err
 			}
-			l.Color = dbtesterpb.GetRGBII(all.headerToDatabaseID[triplet.avgCol.Header()], i)
+			l.Color = lineColor(dbtesterpb.GetRGBII(all.headerToDatabaseID[triplet.avgCol.Header()], i), i)
 			l.Dashes = plotutil.Dashes(i)
 			ps = append(ps, l)
 			plt.Legend.Add(all.headerToDatabaseDescription[triplet.avgCol.Header()]+" MIN", l)
+			data.add(all.headerToDatabaseDescription[triplet.avgCol.Header()]+" MIN", pt)
 		}
 		{
 			pt, err := pointsXY(triplet.x, triplet.avgCol)
@@ -164,10 +238,11 @@ func (all *allAggregatedData) drawXYWithErrorPoints(cfg dbtesterpb.ConfigAnalyze
 			if err != nil {
 				return err
 			}
-			l.Color = dbtesterpb.GetRGBI(all.headerToDatabaseID[triplet.avgCol.Header()], i)
+			l.Color = lineColor(dbtesterpb.GetRGBI(all.headerToDatabaseID[triplet.avgCol.Header()], i), i)
 			l.Dashes = plotutil.Dashes(i)
 			ps = append(ps, l)
 			plt.Legend.Add(all.headerToDatabaseDescription[triplet.avgCol.Header()], l)
+			data.add(all.headerToDatabaseDescription[triplet.avgCol.Header()], pt)
 		}
 		{
 			pt, err := pointsXY(triplet.x, triplet.maxCol)
@@ -178,20 +253,16 @@ func (all *allAggregatedData) drawXYWithErrorPoints(cfg dbtesterpb.ConfigAnalyze
 			if err != nil {
 				return err
 			}
-			l.Color = dbtesterpb.GetRGBIII(all.headerToDatabaseID[triplet.avgCol.Header()], i)
+			l.Color = lineColor(dbtesterpb.GetRGBIII(all.headerToDatabaseID[triplet.avgCol.Header()], i), i)
 			l.Dashes = plotutil.Dashes(i)
 			ps = append(ps, l)
 			plt.Legend.Add(all.headerToDatabaseDescription[triplet.avgCol.Header()]+" MAX", l)
+			data.add(all.headerToDatabaseDescription[triplet.avgCol.Header()]+" MAX", pt)
 		}
 	}
 	plt.Add(ps...)
 
-	for _, outputPath := range cfg.OutputPathList {
-		if err = plt.Save(plotWidth, plotHeight, outputPath); err != nil {
-			return err
-		}
-	}
-	return nil
+	return savePlot(plt, cfg.OutputPathList, data)
 }
 
 func points(col dataframe.Column) (plotter.XYs, error) {
@@ -216,6 +287,13 @@ func points(col dataframe.Column) (plotter.XYs, error) {
 	return pts, nil
 }
 
+// shiftX adds the offset to x of all points.
+func shiftX(pts plotter.XYs, offset float64) {
+	for i := range pts {
+		pts[i].X += offset
+	}
+}
+
 func pointsXY(colX, colY dataframe.Column) (plotter.XYs, error) {
 	bv, ok := colX.BackNonNil()
 	if !ok {
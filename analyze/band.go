@@ -0,0 +1,126 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/gyuho/dataframe"
+	"gonum.org/v1/plot/plotter"
+)
+
+// readRepetitionColumns reads the column from aggregated results
+// of other repetitions.
+func readRepetitionColumns(fpaths []string, column string) ([]dataframe.Column, error) {
+	cols := make([]dataframe.Column, 0, len(fpaths))
+	for _, fpath := range fpaths {
+		fr, err := dataframe.NewFromCSV(nil, fpath)
+		if err != nil {
+			return nil, err
+		}
+		col, err := fr.Column(column)
+		if err != nil {
+			return nil, fmt.Errorf("%q in %s (%v)", column, fpath, err)
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+// confidenceBand returns the polygon of the band around the mean of
+// repetitions per second, over the seconds in all repetitions.
+// 'stddev' band is ±1 sample standard deviation, and 'ci95' band is
+// 95% confidence interval of the mean (Student's t with n-1 degrees
+// of freedom, since repetitions are few).
+func confidenceBand(kind string, cols []dataframe.Column) (plotter.XYs, error) {
+	if len(cols) < 2 {
+		return nil, nil
+	}
+	n := cols[0].Count()
+	for _, col := range cols[1:] {
+		if col.Count() < n {
+			n = col.Count()
+		}
+	}
+
+	k := float64(len(cols))
+	lower := make(plotter.XYs, n)
+	upper := make(plotter.XYs, n)
+	for i := 0; i < n; i++ {
+		vs := make([]float64, len(cols))
+		var mean float64
+		for j, col := range cols {
+			v, err := col.Value(i)
+			if err != nil {
+				return nil, err
+			}
+			vs[j], _ = v.Float64()
+			mean += vs[j]
+		}
+		mean /= k
+
+		var sq float64
+		for _, v := range vs {
+			sq += (v - mean) * (v - mean)
+		}
+		half := math.Sqrt(sq / (k - 1))
+		switch kind {
+		case "stddev":
+		case "ci95":
+			half = tQuantile975(len(cols)-1) * half / math.Sqrt(k)
+		default:
+			return nil, fmt.Errorf("unknown band %q", kind)
+		}
+
+		lower[i].X, lower[i].Y = float64(i), mean-half
+		upper[i].X, upper[i].Y = float64(i), mean+half
+	}
+
+	band := make(plotter.XYs, 0, 2*n)
+	band = append(band, lower...)
+	for i := n - 1; i >= 0; i-- {
+		band = append(band, upper[i])
+	}
+	return band, nil
+}
+
+// t975 is the 97.5th percentile of Student's t distribution,
+// indexed by degrees of freedom (1 to 30).
+var t975 = [...]float64{
+	0,
+	12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+	2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+	2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+}
+
+// tQuantile975 returns the 97.5th percentile of Student's t distribution
+// with 'df' degrees of freedom, for the two-sided 95% interval. Beyond the
+// table, it uses the Cornish-Fisher expansion around the normal quantile.
+func tQuantile975(df int) float64 {
+	if df < len(t975) {
+		return t975[df]
+	}
+	const z = 1.959964
+	d := float64(df)
+	return z + (z*z*z+z)/(4*d) + (5*math.Pow(z, 5)+16*z*z*z+3*z)/(96*d*d)
+}
+
+// translucent returns the color with alpha, to fill bands.
+func translucent(c color.Color) color.Color {
+	r, g, b, _ := c.RGBA()
+	return color.NRGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: 60}
+}
@@ -0,0 +1,70 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gyuho/dataframe"
+)
+
+func TestConfidenceBand(t *testing.T) {
+	var cols []dataframe.Column
+	for _, vs := range [][]string{{"1", "10", "7"}, {"3", "10"}, {"5", "10"}} {
+		col := dataframe.NewColumn("AVG-THROUGHPUT")
+		for _, v := range vs {
+			col.PushBack(dataframe.NewStringValue(v))
+		}
+		cols = append(cols, col)
+	}
+
+	band, err := confidenceBand("stddev", cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// mean 3 and stddev 2 at second 0, mean 10 and stddev 0 at second 1
+	exp := []float64{1, 10, 10, 5}
+	if len(band) != len(exp) {
+		t.Fatalf("expected %d points, got %v", len(exp), band)
+	}
+	for i := range exp {
+		if math.Abs(band[i].Y-exp[i]) > 1e-9 {
+			t.Fatalf("#%d: expected %f, got %f", i, exp[i], band[i].Y)
+		}
+	}
+
+	band, err = confidenceBand("ci95", cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if half := band[3].Y - 3; math.Abs(half-4.303*2/math.Sqrt(3)) > 1e-9 {
+		t.Fatalf("unexpected 95%% confidence interval %f", half)
+	}
+
+	if band, err = confidenceBand("stddev", cols[:1]); err != nil || band != nil {
+		t.Fatalf("expected no band for single run, got %v (%v)", band, err)
+	}
+}
+
+func TestTQuantile975(t *testing.T) {
+	// table and expansion should agree around 30 degrees of freedom
+	if v := tQuantile975(31); v < 2.035 || v > 2.042 {
+		t.Fatalf("unexpected t quantile %f for 31 degrees of freedom", v)
+	}
+	if v := tQuantile975(1000); math.Abs(v-1.962) > 1e-3 {
+		t.Fatalf("unexpected t quantile %f for 1000 degrees of freedom", v)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/gyuho/dataframe"
+)
+
+const (
+	// clientBoundCPUPercent is the client CPU usage per core over which
+	// the client is likely the bottleneck, rather than the database.
+	clientBoundCPUPercent = 90.0
+	// diskBoundRatio is the ratio of disk write throughput to the
+	// device spec over which the disk is likely the bottleneck.
+	diskBoundRatio = 0.8
+
+	// sectorBytes is the size of sectors in /proc/diskstats.
+	sectorBytes = 512
+)
+
+// bottleneckHint is a heuristic guess of what limited a run,
+// to be checked before comparing results of databases.
+type bottleneckHint struct {
+	kind   string // "client-bound" or "disk-bound"
+	reason string
+}
+
+func (h bottleneckHint) String() string { return h.kind + " (" + h.reason + ")" }
+
+// bottleneckHints returns the hints of a run from the maximum client
+// CPU usage of all cores divided by the client cores (0 if unknown), and
+// average disk write MB/s of each server against the device spec in run
+// metadata. Servers without device spec are skipped.
+func bottleneckHints(clientMaxCPU float64, clientCores int64, serverDiskWriteMBs []float64, servers []dbtester.Hardware) []bottleneckHint {
+	var hints []bottleneckHint
+	if cpu := perCoreCPUPercent(clientMaxCPU, clientCores); cpu > clientBoundCPUPercent {
+		hints = append(hints, bottleneckHint{
+			kind:   "client-bound",
+			reason: fmt.Sprintf("client max CPU %.2f %% per core > %.0f %%", cpu, clientBoundCPUPercent),
+		})
+	}
+	for i, mbs := range serverDiskWriteMBs {
+		if i >= len(servers) || servers[i].DiskWriteMBPerSecond <= 0 {
+			continue
+		}
+		spec := servers[i].DiskWriteMBPerSecond
+		if mbs >= spec*diskBoundRatio {
+			hints = append(hints, bottleneckHint{
+				kind:   "disk-bound",
+				reason: fmt.Sprintf("server %d avg disk write %.2f MB/s of %.2f MB/s spec", i+1, mbs, spec),
+			})
+		}
+	}
+	return hints
+}
+
+// serverDiskWriteMBs returns the average disk write MB/s of each server,
+// from 'SECTORS-WRITTEN-DELTA-*' columns of aggregated data.
+func serverDiskWriteMBs(aggregated dataframe.Frame) ([]float64, error) {
+	var mbs []float64
+	for _, col := range aggregated.Columns() {
+		hdr := col.Header()
+		if !strings.HasPrefix(hdr, "SECTORS-WRITTEN-DELTA-") {
+			continue
+		}
+		idx, err := strconv.Atoi(strings.TrimPrefix(hdr, "SECTORS-WRITTEN-DELTA-"))
+		if err != nil || idx < 1 {
+			return nil, fmt.Errorf("unexpected column %q", hdr)
+		}
+		var sum float64
+		cnt := col.Count()
+		for j := 0; j < cnt; j++ {
+			vv, err := col.Value(j)
+			if err != nil {
+				return nil, err
+			}
+			fv, _ := vv.Float64()
+			sum += fv
+		}
+		for len(mbs) < idx {
+			mbs = append(mbs, 0)
+		}
+		if cnt > 0 {
+			mbs[idx-1] = sum / float64(cnt) * sectorBytes / 1000000
+		}
+	}
+	return mbs, nil
+}
+
+// bottleneckRow returns the summary row of bottleneck hints of each
+// database, and the hint lines to print under the summary.
+func bottleneckRow(databaseIDs []string, mdPaths map[string]string, clientMaxCPUs map[string]float64, diskWriteMBs map[string][]float64) ([]string, []string) {
+	row := []string{"BOTTLENECK-HINTS"}
+	var lines []string
+	for _, databaseID := range databaseIDs {
+		var (
+			servers     []dbtester.Hardware
+			clientCores int64
+		)
+		if fpath := mdPaths[databaseID]; fpath != "" {
+			md, err := dbtester.ReadRunMetadata(fpath)
+			if err != nil {
+				plog.Warningf("cannot read run metadata %q to detect client-bound and disk-bound (%v)", fpath, err)
+			} else {
+				servers, clientCores = md.ServerHardware, md.ClientHardware.CPUCores
+			}
+		}
+		hints := bottleneckHints(clientMaxCPUs[databaseID], clientCores, diskWriteMBs[databaseID], servers)
+		if len(hints) == 0 {
+			row = append(row, "-")
+			continue
+		}
+		kinds := make([]string, 0, len(hints))
+		for _, h := range hints {
+			if len(kinds) == 0 || kinds[len(kinds)-1] != h.kind {
+				kinds = append(kinds, h.kind)
+			}
+			lines = append(lines, databaseID+": "+h.String())
+			plog.Warningf("%s: %s", databaseID, h)
+		}
+		row = append(row, strings.Join(kinds, ", "))
+	}
+	return row, lines
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester"
+	"github.com/gyuho/dataframe"
+)
+
+func TestBottleneckHints(t *testing.T) {
+	servers := []dbtester.Hardware{
+		{DiskWriteMBPerSecond: 100},
+		{DiskWriteMBPerSecond: 100},
+		{},
+	}
+	tests := []struct {
+		cpu   float64
+		cores int64
+		mbs   []float64
+		kinds []string
+	}{
+		{50, 0, []float64{10, 20, 500}, nil},
+		{95, 0, []float64{10, 20, 500}, []string{"client-bound"}},
+		{380, 4, []float64{10, 20, 500}, []string{"client-bound"}},
+		{200, 4, []float64{10, 20, 500}, nil},
+		{50, 0, []float64{10, 85, 500}, []string{"disk-bound"}},
+		{91, 0, []float64{90, 85, 0}, []string{"client-bound", "disk-bound", "disk-bound"}},
+	}
+	for i, tt := range tests {
+		var kinds []string
+		for _, h := range bottleneckHints(tt.cpu, tt.cores, tt.mbs, servers) {
+			kinds = append(kinds, h.kind)
+		}
+		if !reflect.DeepEqual(kinds, tt.kinds) {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.kinds, kinds)
+		}
+	}
+}
+
+func TestServerDiskWriteMBs(t *testing.T) {
+	fr := dataframe.New()
+	for _, c := range []struct {
+		hdr string
+		vs  []string
+	}{
+		{"SECTORS-WRITTEN-1", []string{"1", "2"}},
+		{"SECTORS-WRITTEN-DELTA-1", []string{"100000", "300000"}},
+		{"SECTORS-WRITTEN-DELTA-2", []string{"0", "20000"}},
+	} {
+		col := dataframe.NewColumn(c.hdr)
+		for _, v := range c.vs {
+			col.PushBack(dataframe.NewStringValue(v))
+		}
+		if err := fr.AddColumn(col); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mbs, err := serverDiskWriteMBs(fr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(mbs, []float64{102.4, 5.12}) {
+		t.Fatalf("unexpected %v", mbs)
+	}
+}
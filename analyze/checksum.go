@@ -0,0 +1,115 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/checksum"
+)
+
+// verifyInputChecksums verifies the input files of the database against
+// their checksums, if any, so that files truncated by interrupted copies
+// or downloads fail the analysis, instead of being analyzed.
+func verifyInputChecksums(testdata dbtesterpb.ConfigAnalyzeMachineInitial) error {
+	fpaths := []string{
+		testdata.ClientSystemMetricsInterpolatedPath,
+		testdata.ClientLatencyThroughputTimeseriesPath,
+		testdata.ClientLatencyDistributionAllPath,
+		testdata.ClientLatencyDistributionPercentilePath,
+		testdata.ClientLatencyDistributionSummaryPath,
+		testdata.ClientLatencyByKeyNumberPath,
+		testdata.ServerDiskSpaceUsageSummaryPath,
+		testdata.RunMetadataPath,
+		testdata.ClientLatencyHistogramLogPath,
+		testdata.ClientConnectionEventsPath,
+		testdata.NemesisEventsPath,
+	}
+	fpaths = append(fpaths, testdata.ServerSystemMetricsInterpolatedPathList...)
+	fpaths = append(fpaths, testdata.ServerSystemMetricsPathList...)
+	fpaths = append(fpaths, testdata.RepetitionAllAggregatedPathList...)
+
+	verified := 0
+	for _, fpath := range fpaths {
+		if fpath == "" {
+			continue
+		}
+		switch err := checksum.Verify(fpath); err {
+		case nil:
+			verified++
+		case checksum.ErrNoChecksum:
+		default:
+			return err
+		}
+	}
+	if verified > 0 {
+		plog.Printf("verified checksums of %d input files", verified)
+	}
+	return nil
+}
+
+// writeOutputChecksums writes the checksums of the summary, README,
+// aggregated data of each database, and all files in the plot directory.
+func writeOutputChecksums(cfg *dbtester.Config) error {
+	fpaths := map[string]struct{}{}
+	add := func(fpath string) {
+		if fpath == "" || strings.HasSuffix(fpath, checksum.Suffix) {
+			return
+		}
+		if _, err := os.Stat(fpath); err == nil {
+			fpaths[fpath] = struct{}{}
+		}
+	}
+	add(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV)
+	add(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathTXT)
+	add(changeExtToTxt(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathTXT))
+	add(cfg.ConfigAnalyzeMachineREADME.OutputPath)
+	for _, testdata := range cfg.DatabaseIDToConfigAnalyzeMachineInitial {
+		add(testdata.AllAggregatedOutputPath)
+		add(testdata.ServerMemoryByKeyNumberPath)
+		add(testdata.ServerReadBytesDeltaByKeyNumberPath)
+		add(testdata.ServerWriteBytesDeltaByKeyNumberPath)
+	}
+	if cfg.AnalyzePlotPathPrefix != "" {
+		fis, err := ioutil.ReadDir(cfg.AnalyzePlotPathPrefix)
+		if err != nil {
+			return err
+		}
+		for _, fi := range fis {
+			if fi.Mode().IsRegular() {
+				add(filepath.Join(cfg.AnalyzePlotPathPrefix, fi.Name()))
+			}
+		}
+	}
+
+	var sorted []string
+	for fpath := range fpaths {
+		sorted = append(sorted, fpath)
+	}
+	sort.Strings(sorted)
+	for _, fpath := range sorted {
+		if err := checksum.Write(fpath); err != nil {
+			return err
+		}
+	}
+	plog.Printf("wrote checksums of %d output files", len(sorted))
+	return nil
+}
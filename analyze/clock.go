@@ -0,0 +1,96 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/table"
+)
+
+// applyClockOffsets corrects the timestamps of server system metrics by
+// the clock offset of each agent in run metadata, so that server rows are
+// joined with the client rows of the same second. The corrected files are
+// saved in 'dir', and the paths are updated to them. It is no-op if the
+// test data has no run metadata, or all offsets are less than half a second.
+func applyClockOffsets(testdata *dbtesterpb.ConfigAnalyzeMachineInitial, dir string) error {
+	if testdata.RunMetadataPath == "" {
+		return nil
+	}
+	if _, err := os.Stat(testdata.RunMetadataPath); err != nil {
+		return nil
+	}
+	md, err := dbtester.ReadRunMetadata(testdata.RunMetadataPath)
+	if err != nil {
+		return err
+	}
+	offsets := md.ClockOffsetSeconds()
+	if len(offsets) == 0 {
+		return nil
+	}
+	if len(offsets) != len(testdata.ServerSystemMetricsInterpolatedPathList) {
+		return fmt.Errorf("%q has clock offsets of %d servers, expected %d", testdata.RunMetadataPath, len(offsets), len(testdata.ServerSystemMetricsInterpolatedPathList))
+	}
+
+	for i, offset := range offsets {
+		if offset == 0 {
+			continue
+		}
+		fpath := &testdata.ServerSystemMetricsInterpolatedPathList[i]
+		plog.Printf("correcting %q by clock offset %d sec", *fpath, offset)
+
+		tb, err := table.ReadCSV(*fpath)
+		if err != nil {
+			return err
+		}
+		idx, err := tb.ColumnIndex("UNIX-SECOND")
+		if err != nil {
+			return err
+		}
+		for _, row := range tb.Rows {
+			sec, err := strconv.ParseInt(row[idx], 10, 64)
+			if err != nil {
+				return fmt.Errorf("%q: %v", *fpath, err)
+			}
+			row[idx] = strconv.FormatInt(sec-offset, 10)
+		}
+
+		f, err := ioutil.TempFile(dir, filepath.Base(*fpath))
+		if err != nil {
+			return err
+		}
+		f.Close()
+		if err = tb.WriteCSV(f.Name()); err != nil {
+			return err
+		}
+		*fpath = f.Name()
+	}
+	return nil
+}
+
+func hasRunMetadata(cfg *dbtester.Config) bool {
+	for _, testdata := range cfg.DatabaseIDToConfigAnalyzeMachineInitial {
+		if testdata.RunMetadataPath != "" {
+			return true
+		}
+	}
+	return false
+}
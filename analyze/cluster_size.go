@@ -0,0 +1,127 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// modes to normalize server totals by the cluster size
+const (
+	normalizePerNode = "per-node"
+)
+
+// perNodeColumns are the summary rows divided by the cluster size,
+// of the values summed over all servers (or the cluster throughput).
+var perNodeColumns = []string{
+	"AVG-THROUGHPUT-PER-NODE",
+	"SERVER-NETWORK-RX-BYTES-PER-NODE",
+	"SERVER-NETWORK-TX-BYTES-PER-NODE",
+	"SERVER-READS-COMPLETED-DELTA-PER-NODE",
+	"SERVER-WRITES-COMPLETED-DELTA-PER-NODE",
+	"SERVER-SECTORS-WRITTEN-DELTA-PER-NODE",
+}
+
+// clusterSizeNormalizer divides throughput and server totals by the
+// number of servers of each database. Empty mode disables normalization.
+type clusterSizeNormalizer struct {
+	mode string
+}
+
+func newClusterSizeNormalizer(mode string) (clusterSizeNormalizer, error) {
+	switch mode {
+	case "", normalizePerNode:
+		return clusterSizeNormalizer{mode: mode}, nil
+	default:
+		return clusterSizeNormalizer{}, fmt.Errorf("unknown cluster size normalization %q (must be %q)", mode, normalizePerNode)
+	}
+}
+
+func (n clusterSizeNormalizer) enabled() bool { return n.mode != "" }
+
+// rows returns the summary rows of per-node values of each database,
+// from the totals keyed by 'perNodeColumns'.
+func (n clusterSizeNormalizer) rows(databaseIDs []string, sizes map[string]int, totals map[string]map[string]float64) [][]string {
+	rows := make([][]string, 0, len(perNodeColumns))
+	for _, col := range perNodeColumns {
+		row := []string{col}
+		for _, databaseID := range databaseIDs {
+			v, ok := totals[databaseID][col]
+			if !ok || sizes[databaseID] == 0 {
+				row = append(row, "-")
+				continue
+			}
+			row = append(row, fmt.Sprintf("%.2f", v/float64(sizes[databaseID])))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// clusterSize returns the number of servers of the database, from its
+// server system metrics (which the analysis averages over), or agent
+// endpoints if none.
+func clusterSize(testgroup dbtesterpb.ConfigClientMachineAgentControl, testdata dbtesterpb.ConfigAnalyzeMachineInitial) int {
+	if n := len(testdata.ServerSystemMetricsInterpolatedPathList); n > 0 {
+		return n
+	}
+	return len(testgroup.AgentEndpoints)
+}
+
+// heterogeneousClusterSizes returns true if the databases have different
+// numbers of servers, so that server totals do not compare as is.
+func heterogeneousClusterSizes(databaseIDs []string, sizes map[string]int) bool {
+	for _, databaseID := range databaseIDs {
+		if sizes[databaseID] != sizes[databaseIDs[0]] {
+			return true
+		}
+	}
+	return false
+}
+
+// clusterSizeRow returns the summary row of the number of servers.
+func clusterSizeRow(databaseIDs []string, sizes map[string]int) []string {
+	row := []string{"SERVER-NUM"}
+	for _, databaseID := range databaseIDs {
+		row = append(row, fmt.Sprintf("%d", sizes[databaseID]))
+	}
+	return row
+}
+
+// clusterSizeLegend labels the database with its cluster size in plots
+// (e.g. "etcd v3.3 (3 nodes)").
+func clusterSizeLegend(desc string, size int) string {
+	if size == 1 {
+		return desc + " (1 node)"
+	}
+	return fmt.Sprintf("%s (%d nodes)", desc, size)
+}
+
+// clusterSizeNote is noted in the summary of databases with different
+// cluster sizes.
+func clusterSizeNote(databaseIDs []string, sizes map[string]int, normalized bool) string {
+	ss := make([]string, 0, len(databaseIDs))
+	for _, databaseID := range databaseIDs {
+		ss = append(ss, fmt.Sprintf("%s %d", databaseID, sizes[databaseID]))
+	}
+	note := fmt.Sprintf("NOTE: databases have different cluster sizes (%s). SERVER-TOTAL-* and SERVER-AVG-*-SUM are summed over all servers of each cluster, while SERVER-MAX-* are averaged over servers.", strings.Join(ss, ", "))
+	if normalized {
+		return note + " *-PER-NODE are divided by the cluster size."
+	}
+	return note + " Set 'normalize_cluster_size' to compare per node."
+}
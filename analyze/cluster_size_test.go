@@ -0,0 +1,89 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestClusterSizeNormalizer(t *testing.T) {
+	if _, err := newClusterSizeNormalizer("per-core"); err == nil {
+		t.Fatal("expected error for unknown mode")
+	}
+	n, err := newClusterSizeNormalizer(normalizePerNode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !n.enabled() {
+		t.Fatal("expected enabled")
+	}
+
+	ids := []string{"etcd__v3_3", "zookeeper__r3_5_3_beta"}
+	sizes := map[string]int{"etcd__v3_3": 3, "zookeeper__r3_5_3_beta": 5}
+	if !heterogeneousClusterSizes(ids, sizes) {
+		t.Fatal("expected heterogeneous cluster sizes")
+	}
+	if heterogeneousClusterSizes(ids, map[string]int{"etcd__v3_3": 3, "zookeeper__r3_5_3_beta": 3}) {
+		t.Fatal("expected same cluster sizes")
+	}
+	if row := clusterSizeRow(ids, sizes); !reflect.DeepEqual(row, []string{"SERVER-NUM", "3", "5"}) {
+		t.Fatalf("unexpected row %v", row)
+	}
+
+	rows := n.rows(ids, sizes, map[string]map[string]float64{
+		"etcd__v3_3": {
+			"AVG-THROUGHPUT-PER-NODE":          30000,
+			"SERVER-NETWORK-RX-BYTES-PER-NODE": 900,
+		},
+		"zookeeper__r3_5_3_beta": {
+			"AVG-THROUGHPUT-PER-NODE":          25000,
+			"SERVER-NETWORK-RX-BYTES-PER-NODE": 1000,
+		},
+	})
+	if len(rows) != len(perNodeColumns) {
+		t.Fatalf("expected %d rows, got %d", len(perNodeColumns), len(rows))
+	}
+	for i, expected := range [][]string{
+		{"AVG-THROUGHPUT-PER-NODE", "10000.00", "5000.00"},
+		{"SERVER-NETWORK-RX-BYTES-PER-NODE", "300.00", "200.00"},
+		{"SERVER-NETWORK-TX-BYTES-PER-NODE", "-", "-"},
+	} {
+		if !reflect.DeepEqual(rows[i], expected) {
+			t.Fatalf("#%d: expected %v, got %v", i, expected, rows[i])
+		}
+	}
+
+	if s := clusterSizeLegend("etcd v3.3", 3); s != "etcd v3.3 (3 nodes)" {
+		t.Fatalf("unexpected legend %q", s)
+	}
+	if note := clusterSizeNote(ids, sizes, false); !strings.Contains(note, "etcd__v3_3 3, zookeeper__r3_5_3_beta 5") || !strings.Contains(note, "normalize_cluster_size") {
+		t.Fatalf("unexpected note %q", note)
+	}
+}
+
+func TestClusterSize(t *testing.T) {
+	testgroup := dbtesterpb.ConfigClientMachineAgentControl{AgentEndpoints: []string{"a", "b", "c"}}
+	if n := clusterSize(testgroup, dbtesterpb.ConfigAnalyzeMachineInitial{}); n != 3 {
+		t.Fatalf("expected 3 from agent endpoints, got %d", n)
+	}
+	testdata := dbtesterpb.ConfigAnalyzeMachineInitial{ServerSystemMetricsInterpolatedPathList: []string{"1.csv", "2.csv", "3.csv", "4.csv", "5.csv"}}
+	if n := clusterSize(testgroup, testdata); n != 5 {
+		t.Fatalf("expected 5 from server system metrics, got %d", n)
+	}
+}
@@ -18,6 +18,8 @@ import (
 	"bytes"
 	"encoding/csv"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"reflect"
 	"sort"
@@ -40,12 +42,31 @@ var Command = &cobra.Command{
 }
 
 var configPath string
+var resultsRoot string
+var runID string
+var runTags []string
+var skipBadRows bool
+var windowFrom string
+var windowTo string
+var plotThemeName string
+var includeSaturated bool
 
 func init() {
 	Command.PersistentFlags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
+	Command.PersistentFlags().StringVar(&resultsRoot, "results-root", "", "Root directory of results in the standard layout, to discover test data paths instead of the configuration.")
+	Command.PersistentFlags().StringVar(&runID, "run-id", "", "Run ID to analyze in '--results-root' (optional if the root has only one run).")
+	Command.PersistentFlags().StringArrayVar(&runTags, "tag", nil, "'key=value' (or 'key') tag of runs to analyze in '--results-root', repeated to require all tags (e.g. '--tag purpose=nightly').")
+	Command.PersistentFlags().BoolVar(&skipBadRows, "skip-bad-rows", false, "'true' to drop unparsable rows in test data (with a count and samples logged), instead of failing the analysis.")
+	Command.PersistentFlags().StringVar(&windowFrom, "from", "", "Start of time window to analyze, in seconds since the start of the run, Unix seconds, or RFC3339 (e.g. '60' to exclude the ramp-up).")
+	Command.PersistentFlags().StringVar(&windowTo, "to", "", "End of time window to analyze, in seconds since the start of the run, Unix seconds, or RFC3339.")
+	Command.PersistentFlags().StringVar(&plotThemeName, "theme", "default", "Plot theme: 'default', 'dark', 'print', or 'presentation'.")
+	Command.PersistentFlags().BoolVar(&includeSaturated, "include-saturated", false, "'true' to include the seconds where the client was saturated in latency percentiles (they are still marked in CLIENT-SATURATED column).")
 }
 
 func commandFunc(cmd *cobra.Command, args []string) error {
+	if err := setPlotTheme(plotThemeName); err != nil {
+		return err
+	}
 	return do(configPath)
 }
 
@@ -55,6 +76,7 @@ type allAggregatedData struct {
 	headerToDatabaseID          map[string]string
 	headerToDatabaseDescription map[string]string
 	allDatabaseIDList           []string
+	timeAxis                    timeAxis
 }
 
 func do(configPath string) error {
@@ -62,6 +84,65 @@ func do(configPath string) error {
 	if err != nil {
 		return err
 	}
+	if resultsRoot != "" {
+		if err = cfg.UseResultLayout(resultsRoot, runID, runTags); err != nil {
+			return err
+		}
+	}
+
+	units, err := newOutputUnits(cfg.ConfigAnalyzeMachineAllAggregatedOutput)
+	if err != nil {
+		return err
+	}
+	normalizer, err := newThroughputNormalizer(cfg.ConfigAnalyzeMachineAllAggregatedOutput.NormalizeThroughput)
+	if err != nil {
+		return err
+	}
+	ta, err := newTimeAxis(cfg.ConfigAnalyzeMachineAllAggregatedOutput)
+	if err != nil {
+		return err
+	}
+	derivedColumns, err := parseDerivedColumns(cfg.ConfigAnalyzeMachineAllAggregatedOutput.DerivedColumns)
+	if err != nil {
+		return err
+	}
+	derivedColumnToDatabaseIDToValue := make(map[string]map[string]string, len(derivedColumns))
+	clusterNormalizer, err := newClusterSizeNormalizer(cfg.ConfigAnalyzeMachineAllAggregatedOutput.NormalizeClusterSize)
+	if err != nil {
+		return err
+	}
+	// number of servers, labeled in plots if databases have different sizes
+	databaseIDToClusterSize := make(map[string]int)
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		databaseIDToClusterSize[databaseID] = clusterSize(cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID], cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID])
+	}
+	heterogeneous := heterogeneousClusterSizes(cfg.AllDatabaseIDList, databaseIDToClusterSize)
+
+	saturationCPU := cfg.ConfigAnalyzeMachineAllAggregatedOutput.ClientSaturationCPUPercent
+	if saturationCPU == 0 {
+		saturationCPU = defaultClientSaturationCPUPercent
+	}
+	// number of client-saturated seconds, of databases with offered load
+	databaseIDToSaturatedN := make(map[string]int)
+	// databases with latency percentiles of the whole run, not of the time window
+	databaseIDToWholeRunPercentiles := make(map[string]bool)
+	// client CPU cores in run metadata, 0 if unknown
+	databaseIDToClientCores := make(map[string]int64)
+
+	suspect := newSuspectThresholds(cfg.ConfigAnalyzeMachineAllAggregatedOutput)
+	databaseIDToSuspectFlags := make(map[string][]suspectFlag)
+
+	var tmpDir, skipDir string
+	if skipBadRows || windowFrom != "" || windowTo != "" || hasRunMetadata(cfg) || saturationCPU > 0 {
+		tmpDir, err = ioutil.TempDir(os.TempDir(), "dbtester-analyze")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmpDir)
+	}
+	if skipBadRows {
+		skipDir = tmpDir
+	}
 
 	all := &allAggregatedData{
 		title:                       cfg.TestTitle,
@@ -69,11 +150,64 @@ func do(configPath string) error {
 		headerToDatabaseID:          make(map[string]string),
 		headerToDatabaseDescription: make(map[string]string),
 		allDatabaseIDList:           cfg.AllDatabaseIDList,
+		timeAxis:                    ta,
 	}
 	for _, databaseID := range cfg.AllDatabaseIDList {
 		testgroup := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 		testdata := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
 
+		plog.Printf("validating test data for %s", databaseID)
+		if err = verifyInputChecksums(testdata); err != nil {
+			return err
+		}
+		if err = validateTestData(&testdata, skipDir); err != nil {
+			return err
+		}
+		var md *dbtester.RunMetadata
+		if testdata.RunMetadataPath != "" {
+			if _, err = os.Stat(testdata.RunMetadataPath); err == nil {
+				m, merr := dbtester.ReadRunMetadata(testdata.RunMetadataPath)
+				if merr != nil {
+					return merr
+				}
+				md = &m
+			}
+		}
+		restartsExpected := len(testgroup.NemesisSchedule) > 0 || testgroup.ConfigRollingRestart != nil
+		if databaseIDToSuspectFlags[databaseID], err = suspectFlags(suspect, testdata.ServerSystemMetricsPathList, md, restartsExpected); err != nil {
+			return err
+		}
+		for _, f := range databaseIDToSuspectFlags[databaseID] {
+			plog.Warningf("%s: suspect run, %s", databaseID, f)
+		}
+		if err = applyClockOffsets(&testdata, tmpDir); err != nil {
+			return err
+		}
+		if windowFrom != "" || windowTo != "" {
+			if databaseIDToWholeRunPercentiles[databaseID], err = applyTimeWindow(&testdata, tmpDir, windowFrom, windowTo); err != nil {
+				return err
+			}
+		}
+		if md != nil {
+			databaseIDToClientCores[databaseID] = md.ClientHardware.CPUCores
+		}
+		var saturated map[int64]bool
+		if target := offeredLoad(testgroup.ConfigClientMachineBenchmarkOptions); target > 0 && saturationCPU > 0 {
+			plog.Printf("detecting client saturation for %s (offered load %d requests/s)", databaseID, target)
+			cores := databaseIDToClientCores[databaseID]
+			if cores <= 0 {
+				plog.Warningf("%s: unknown client CPU cores, comparing client CPU usage of all cores against %.2f %%", databaseID, saturationCPU)
+			}
+			if saturated, err = detectClientSaturation(&testdata, tmpDir, target, saturationCPU, cores, includeSaturated); err != nil {
+				return err
+			}
+			if len(saturated) > 0 {
+				plog.Warningf("%s: client did not achieve %d requests/s with %.2f %%+ CPU usage per core for %d second(s)", databaseID, target, saturationCPU, len(saturated))
+			}
+			databaseIDToSaturatedN[databaseID] = len(saturated)
+		}
+		cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID] = testdata
+
 		plog.Printf("reading system metrics data for %s", databaseID)
 		ad, err := readSystemMetricsAll(testdata.ServerSystemMetricsInterpolatedPathList...)
 		if err != nil {
@@ -82,6 +216,7 @@ func do(configPath string) error {
 		ad.databaseTag = testgroup.DatabaseTag
 		ad.legend = testgroup.DatabaseDescription
 		ad.allAggregatedOutputPath = testdata.AllAggregatedOutputPath
+		ad.timeAxis = ta
 
 		if err = ad.aggSystemMetrics(); err != nil {
 			return err
@@ -89,9 +224,26 @@ func do(configPath string) error {
 		if err = ad.importBenchMetrics(testdata.ClientLatencyThroughputTimeseriesPath); err != nil {
 			return err
 		}
+		if saturated != nil {
+			if err = ad.markClientSaturated(saturated); err != nil {
+				return err
+			}
+		}
 		if err = ad.aggregateAll(testdata.ServerMemoryByKeyNumberPath, testdata.ServerReadBytesDeltaByKeyNumberPath, testdata.ServerWriteBytesDeltaByKeyNumberPath, testgroup.ConfigClientMachineBenchmarkOptions.RequestNumber); err != nil {
 			return err
 		}
+		if len(derivedColumns) > 0 {
+			avgs, err := ad.addDerivedColumns(derivedColumns, hardwareValues(md))
+			if err != nil {
+				return fmt.Errorf("%s: %v", databaseID, err)
+			}
+			for col, v := range avgs {
+				if derivedColumnToDatabaseIDToValue["AVG-"+col] == nil {
+					derivedColumnToDatabaseIDToValue["AVG-"+col] = make(map[string]string)
+				}
+				derivedColumnToDatabaseIDToValue["AVG-"+col][databaseID] = fmt.Sprintf("%.4f", v)
+			}
+		}
 		if err = ad.save(); err != nil {
 			return err
 		}
@@ -99,7 +251,15 @@ func do(configPath string) error {
 		all.data = append(all.data, ad)
 		for _, hd := range ad.aggregated.Headers() {
 			all.headerToDatabaseID[makeHeader(hd, testgroup.DatabaseTag)] = databaseID
-			all.headerToDatabaseDescription[makeHeader(hd, testgroup.DatabaseTag)] = testgroup.DatabaseDescription
+			desc := testgroup.DatabaseDescription
+			if heterogeneous {
+				desc = clusterSizeLegend(desc, databaseIDToClusterSize[databaseID])
+			}
+			if op := operationTypeOf(hd); op != "" {
+				// plotted as a distinct series
+				desc += " " + op
+			}
+			all.headerToDatabaseDescription[makeHeader(hd, testgroup.DatabaseTag)] = desc
 		}
 	}
 
@@ -131,8 +291,16 @@ func do(configPath string) error {
 	row28WritesCompletedDeltaSum := []string{"SERVER-AVG-WRITES-COMPLETED-DELTA-SUM"}
 	row29SectorsWrittenDeltaSum := []string{"SERVER-AVG-SECTORS-WRITTEN-DELTA-SUM"}
 
+	// footer of raw numbers, so that spreadsheets can use them without parsing
+	footerServerMaxMemory := []string{"SERVER-MAX-MEMORY-BYTES"}
+
+	// average disk write MB/s of each server, to hint disk-bound runs
+	databaseIDToServerDiskWriteMBs := make(map[string][]float64)
+	// server totals and throughput, to divide by the cluster size
+	databaseIDToClusterTotals := make(map[string]map[string]float64)
+
 	// iterate each database's all data
-	for _, ad := range all.data {
+	for i, ad := range all.data {
 		// per database
 		var (
 			readsCompletedDeltaSum   float64
@@ -239,11 +407,25 @@ func do(configPath string) error {
 		row27SectorsReadDeltaSum = append(row27SectorsReadDeltaSum, humanize.Comma(int64(sectorsReadDeltaSum)))
 		row28WritesCompletedDeltaSum = append(row28WritesCompletedDeltaSum, humanize.Comma(int64(writesCompletedDeltaSum)))
 		row29SectorsWrittenDeltaSum = append(row29SectorsWrittenDeltaSum, humanize.Comma(int64(sectorsWrittenDeltaSum)))
+		databaseIDToClusterTotals[cfg.AllDatabaseIDList[i]] = map[string]float64{
+			"SERVER-NETWORK-RX-BYTES-PER-NODE":       receiveBytesNumDeltaSum,
+			"SERVER-NETWORK-TX-BYTES-PER-NODE":       transmitBytesNumDeltaSum,
+			"SERVER-READS-COMPLETED-DELTA-PER-NODE":  readsCompletedDeltaSum,
+			"SERVER-WRITES-COMPLETED-DELTA-PER-NODE": writesCompletedDeltaSum,
+			"SERVER-SECTORS-WRITTEN-DELTA-PER-NODE":  sectorsWrittenDeltaSum,
+		}
 
 		sort.Float64s(maxAvgVMRSSMBs)
 		mv := maxAvgVMRSSMBs[len(maxAvgVMRSSMBs)-1]
 		mb := uint64(mv * 1000000)
-		row22ServerMaxMemoryUsage = append(row22ServerMaxMemoryUsage, humanize.Bytes(mb))
+		row22ServerMaxMemoryUsage = append(row22ServerMaxMemoryUsage, units.formatMemory(mb))
+		footerServerMaxMemory = append(footerServerMaxMemory, fmt.Sprintf("%d", mb))
+
+		mbs, err := serverDiskWriteMBs(ad.aggregated)
+		if err != nil {
+			return err
+		}
+		databaseIDToServerDiskWriteMBs[cfg.AllDatabaseIDList[i]] = mbs
 	}
 
 	row01TotalSeconds := []string{"TOTAL-SECONDS"} // TOTAL-SECONDS
@@ -271,7 +453,33 @@ func do(configPath string) error {
 	row25ClientErrorCount := []string{"CLIENT-ERROR-COUNT"}                             // ERROR:
 	row30AvgDiskSpaceUsage := []string{"SERVER-AVG-DISK-SPACE-USAGE"}                   // DISK-SPACE-USAGE
 
+	footerTotalRequests := []string{"TOTAL-REQUESTS"}            // sum of AVG-THROUGHPUT
+	footerMeanThroughput := []string{"MEAN-THROUGHPUT"}          // mean of AVG-THROUGHPUT
+	footerClientMaxMemory := []string{"CLIENT-MAX-MEMORY-BYTES"} // VMRSS-NUM
+
 	databaseIDToErrs := make(map[string][]string)
+	var clientBottlenecks []string
+
+	// SLO-COMPLIANCE-UNDER-* columns, sorted by threshold
+	var sloColumns []string
+	sloColumnToDatabaseIDToValue := make(map[string]map[string]string)
+	// per-operation-type columns (e.g. READ-REQUESTS-PER-SECOND), in "mixed" type benchmark
+	var opColumns []string
+	opColumnToDatabaseIDToValue := make(map[string]map[string]string)
+	// LEASE-EXPIRY-* columns of key expiry accuracy, in "lease-expiry" type benchmark
+	var expiryColumns []string
+	expiryColumnToDatabaseIDToValue := make(map[string]map[string]string)
+	// REQUESTS-PER-SECOND and run metadata paths, to normalize throughput
+	databaseIDToThroughput := make(map[string]float64)
+	databaseIDToRunMetadataPath := make(map[string]string)
+	// writes per second and server numbers, to estimate cost
+	databaseIDToWriteThroughput := make(map[string]float64)
+	databaseIDToServerN := make(map[string]int)
+	// client max CPU usage, to hint client-bound runs
+	databaseIDToClientMaxCPU := make(map[string]float64)
+	// direct or proxied, to compare the overhead of proxies
+	rowTopology := []string{"TOPOLOGY"}
+	proxied := false
 	for i, databaseID := range cfg.AllDatabaseIDList {
 		testgroup := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 		testdata := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
@@ -281,6 +489,12 @@ func do(configPath string) error {
 			return fmt.Errorf("analyze config has different order; expected %q, got %q", row00Header[i+1], tag)
 		}
 		row02TotalRequestNumber = append(row02TotalRequestNumber, humanize.Comma(testgroup.ConfigClientMachineBenchmarkOptions.RequestNumber))
+		databaseIDToRunMetadataPath[databaseID] = testdata.RunMetadataPath
+		databaseIDToServerN[databaseID] = databaseIDToClusterSize[databaseID]
+		rowTopology = append(rowTopology, dbtesterpb.Topology(testgroup))
+		if len(testgroup.ProxyEndpoints) > 0 {
+			proxied = true
+		}
 
 		{
 			fr, err := dataframe.NewFromCSV(nil, testdata.ClientSystemMetricsInterpolatedPath)
@@ -353,7 +567,16 @@ func do(configPath string) error {
 			row20ClientTransmitBytesSum = append(row20ClientTransmitBytesSum, humanize.Bytes(uint64(transmitBytesNumDeltaSum)))
 			row20ClientTransmitBytesSumRaw = append(row20ClientTransmitBytesSumRaw, fmt.Sprintf("%.2f", transmitBytesNumDeltaSum))
 			row23ClientMaxCPU = append(row23ClientMaxCPU, fmt.Sprintf("%.2f %%", maxAvgCPU))
-			row24ClientMaxMemory = append(row24ClientMaxMemory, humanize.Bytes(maxVMRSSNum))
+			databaseIDToClientMaxCPU[databaseID] = maxAvgCPU
+			limit := cfg.ConfigAnalyzeMachineAllAggregatedOutput.ClientMaxCPUPercent
+			if perCore := perCoreCPUPercent(maxAvgCPU, databaseIDToClientCores[databaseID]); cpuAtOrAbove(perCore, limit) {
+				es := fmt.Sprintf("client max CPU usage %.2f %% per core reached %.2f %% (client may have been the bottleneck)", perCore, limit)
+				plog.Warningf("%s: %s", databaseID, es)
+				databaseIDToErrs[databaseID] = append(databaseIDToErrs[databaseID], es)
+				clientBottlenecks = append(clientBottlenecks, databaseID)
+			}
+			row24ClientMaxMemory = append(row24ClientMaxMemory, units.formatMemory(maxVMRSSNum))
+			footerClientMaxMemory = append(footerClientMaxMemory, fmt.Sprintf("%d", maxVMRSSNum))
 		}
 		{
 			f, err := openToRead(testdata.ClientLatencyDistributionSummaryPath)
@@ -379,6 +602,45 @@ func do(configPath string) error {
 
 			var totalErrCnt int64
 			for _, row := range rows {
+				if strings.HasPrefix(row[0], dbtester.SLOComplianceColumnPrefix) {
+					if _, ok := sloColumnToDatabaseIDToValue[row[0]]; !ok {
+						sloColumns = append(sloColumns, row[0])
+						sloColumnToDatabaseIDToValue[row[0]] = make(map[string]string)
+					}
+					sloColumnToDatabaseIDToValue[row[0]][databaseID] = fmt.Sprintf("%s %%", row[1])
+				}
+				if strings.HasPrefix(row[0], dbtester.LeaseExpirySummaryPrefix) {
+					if _, ok := expiryColumnToDatabaseIDToValue[row[0]]; !ok {
+						expiryColumns = append(expiryColumns, row[0])
+						expiryColumnToDatabaseIDToValue[row[0]] = make(map[string]string)
+					}
+					v := row[1]
+					switch {
+					case strings.HasSuffix(row[0], "-MS"):
+						v += " ms"
+					case strings.HasSuffix(row[0], "-PERCENT"):
+						v += " %"
+					}
+					expiryColumnToDatabaseIDToValue[row[0]][databaseID] = v
+				}
+				if operationTypeOf(row[0]) != "" {
+					if _, ok := opColumnToDatabaseIDToValue[row[0]]; !ok {
+						opColumns = append(opColumns, row[0])
+						opColumnToDatabaseIDToValue[row[0]] = make(map[string]string)
+					}
+					v := fmt.Sprintf("%s ms", row[1])
+					if strings.HasSuffix(row[0], "REQUESTS-PER-SECOND") {
+						fv, err := strconv.ParseFloat(row[1], 64)
+						if err != nil {
+							return err
+						}
+						v = units.formatThroughput(int64(fv))
+						if operationTypeOf(row[0]) == "write" {
+							databaseIDToWriteThroughput[databaseID] = fv
+						}
+					}
+					opColumnToDatabaseIDToValue[row[0]][databaseID] = v
+				}
 				switch row[0] {
 				case "TOTAL-SECONDS":
 					row01TotalSeconds = append(row01TotalSeconds, fmt.Sprintf("%s sec", row[1]))
@@ -388,7 +650,11 @@ func do(configPath string) error {
 						return err
 					}
 					avg := int64(fv)
-					row04AverageThroughput = append(row04AverageThroughput, fmt.Sprintf("%s req/sec", humanize.Comma(avg)))
+					row04AverageThroughput = append(row04AverageThroughput, units.formatThroughput(avg))
+					databaseIDToThroughput[databaseID] = fv
+					if testgroup.ConfigClientMachineBenchmarkOptions.Type == "write" {
+						databaseIDToWriteThroughput[databaseID] = fv
+					}
 				case "SLOWEST-LATENCY-MS":
 					row08SlowestLatency = append(row08SlowestLatency, fmt.Sprintf("%s ms", row[1]))
 				case "FASTEST-LATENCY-MS":
@@ -427,12 +693,14 @@ func do(configPath string) error {
 			}
 			var min int64
 			var max int64
+			var sum float64
 			for i := 0; i < col.Count(); i++ {
 				val, err := col.Value(i)
 				if err != nil {
 					return err
 				}
 				fv, _ := val.Float64()
+				sum += fv
 
 				if i == 0 {
 					min = int64(fv)
@@ -444,8 +712,14 @@ func do(configPath string) error {
 					min = int64(fv)
 				}
 			}
-			row03MaxThroughput = append(row03MaxThroughput, fmt.Sprintf("%s req/sec", humanize.Comma(max)))
-			row05MinThroughput = append(row05MinThroughput, fmt.Sprintf("%s req/sec", humanize.Comma(min)))
+			row03MaxThroughput = append(row03MaxThroughput, units.formatThroughput(max))
+			row05MinThroughput = append(row05MinThroughput, units.formatThroughput(min))
+			footerTotalRequests = append(footerTotalRequests, fmt.Sprintf("%.0f", sum))
+			mean := 0.0
+			if col.Count() > 0 {
+				mean = sum / float64(col.Count())
+			}
+			footerMeanThroughput = append(footerMeanThroughput, fmt.Sprintf("%.2f", mean))
 		}
 		{
 			fr, err := dataframe.NewFromCSV(nil, testdata.ServerDiskSpaceUsageSummaryPath)
@@ -490,35 +764,91 @@ func do(configPath string) error {
 				return err
 			}
 
+			// percentiles are not recomputed for the time window without histogram log
+			label := ""
+			if databaseIDToWholeRunPercentiles[databaseID] {
+				label = " (whole run)"
+			}
 			for ri, row := range rows {
 				if ri == 0 {
 					continue // skip header
 				}
 				switch row[0] {
 				case "p10":
-					row09p10 = append(row09p10, fmt.Sprintf("%s ms", row[1]))
+					row09p10 = append(row09p10, fmt.Sprintf("%s ms%s", row[1], label))
 				case "p25":
-					row10p25 = append(row10p25, fmt.Sprintf("%s ms", row[1]))
+					row10p25 = append(row10p25, fmt.Sprintf("%s ms%s", row[1], label))
 				case "p50":
-					row11p50 = append(row11p50, fmt.Sprintf("%s ms", row[1]))
+					row11p50 = append(row11p50, fmt.Sprintf("%s ms%s", row[1], label))
 				case "p75":
-					row12p75 = append(row12p75, fmt.Sprintf("%s ms", row[1]))
+					row12p75 = append(row12p75, fmt.Sprintf("%s ms%s", row[1], label))
 				case "p90":
-					row13p90 = append(row13p90, fmt.Sprintf("%s ms", row[1]))
+					row13p90 = append(row13p90, fmt.Sprintf("%s ms%s", row[1], label))
 				case "p95":
-					row14p95 = append(row14p95, fmt.Sprintf("%s ms", row[1]))
+					row14p95 = append(row14p95, fmt.Sprintf("%s ms%s", row[1], label))
 				case "p99":
-					row15p99 = append(row15p99, fmt.Sprintf("%s ms", row[1]))
+					row15p99 = append(row15p99, fmt.Sprintf("%s ms%s", row[1], label))
 				case "p99.9":
-					row16p999 = append(row16p999, fmt.Sprintf("%s ms", row[1]))
+					row16p999 = append(row16p999, fmt.Sprintf("%s ms%s", row[1], label))
 				}
 			}
 		}
 	}
 
+	var topologyRows [][]string
+	if proxied {
+		topologyRows = append(topologyRows, rowTopology)
+	}
+	var clusterSizeRows [][]string
+	if heterogeneous || clusterNormalizer.enabled() {
+		clusterSizeRows = append(clusterSizeRows, clusterSizeRow(cfg.AllDatabaseIDList, databaseIDToClusterSize))
+	}
+	if clusterNormalizer.enabled() {
+		for databaseID, v := range databaseIDToThroughput {
+			if databaseIDToClusterTotals[databaseID] != nil {
+				databaseIDToClusterTotals[databaseID]["AVG-THROUGHPUT-PER-NODE"] = v
+			}
+		}
+		clusterSizeRows = append(clusterSizeRows, clusterNormalizer.rows(cfg.AllDatabaseIDList, databaseIDToClusterSize, databaseIDToClusterTotals)...)
+	}
+	var saturationRows [][]string
+	if len(databaseIDToSaturatedN) > 0 {
+		row := []string{"CLIENT-SATURATED-SECONDS"}
+		for _, databaseID := range cfg.AllDatabaseIDList {
+			v := "-"
+			if n, ok := databaseIDToSaturatedN[databaseID]; ok {
+				v = fmt.Sprintf("%d", n)
+			}
+			row = append(row, v)
+		}
+		saturationRows = append(saturationRows, row)
+	}
+	sortSLOColumns(sloColumns)
+	sloRows := comparedRows(sloColumns, cfg.AllDatabaseIDList, sloColumnToDatabaseIDToValue)
+	expiryRows := comparedRows(expiryColumns, cfg.AllDatabaseIDList, expiryColumnToDatabaseIDToValue)
+	derivedSummaryColumns := make([]string, len(derivedColumns))
+	for i, dc := range derivedColumns {
+		derivedSummaryColumns[i] = "AVG-" + dc.column
+	}
+	derivedRows := comparedRows(derivedSummaryColumns, cfg.AllDatabaseIDList, derivedColumnToDatabaseIDToValue)
+	sortOperationColumns(opColumns)
+	opRows := comparedRows(opColumns, cfg.AllDatabaseIDList, opColumnToDatabaseIDToValue)
+	var hardwareRows [][]string
+	if normalizer.enabled() {
+		hardwareRows, err = normalizer.rows(cfg.AllDatabaseIDList, databaseIDToRunMetadataPath, databaseIDToThroughput)
+		if err != nil {
+			return err
+		}
+		plog.Warning(normalizationCaveat)
+	}
+	rowSuspect, suspectLines := suspectRow(cfg.AllDatabaseIDList, databaseIDToSuspectFlags)
+	rowBottleneckHints, bottleneckLines := bottleneckRow(cfg.AllDatabaseIDList, databaseIDToRunMetadataPath, databaseIDToClientMaxCPU, databaseIDToServerDiskWriteMBs)
+	costEstimateRows := costRows(cfg.AllDatabaseIDList, cfg.DatabaseIDToConfigAnalyzeMachineInitial, databaseIDToServerN, databaseIDToThroughput, databaseIDToWriteThroughput)
+
 	plog.Printf("saving summary data to %q", cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV)
 	aggRowsForSummaryCSV := [][]string{
 		row00Header,
+		rowSuspect,
 		row01TotalSeconds,
 		row02TotalRequestNumber,
 		row03MaxThroughput,
@@ -537,7 +867,18 @@ func do(configPath string) error {
 		row14p95,
 		row15p99,
 		row16p999,
-
+	}
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, saturationRows...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, topologyRows...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, clusterSizeRows...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, sloRows...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, expiryRows...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, opRows...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, hardwareRows...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, derivedRows...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, costEstimateRows...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, rowBottleneckHints)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, [][]string{
 		row17ServerReceiveBytesSum,
 		row17ServerReceiveBytesSumRaw,
 		row18ServerTransmitBytesSum,
@@ -559,7 +900,17 @@ func do(configPath string) error {
 		row28WritesCompletedDeltaSum,
 		row29SectorsWrittenDeltaSum,
 		row30AvgDiskSpaceUsage,
-	}
+	}...)
+
+	// footer with per-database totals, delimited by its own header row
+	footerHeader := append([]string{"TOTALS"}, row00Header[1:]...)
+	aggRowsForSummaryCSV = append(aggRowsForSummaryCSV, [][]string{
+		footerHeader,
+		footerTotalRequests,
+		footerMeanThroughput,
+		footerServerMaxMemory,
+		footerClientMaxMemory,
+	}...)
 	file, err := openToOverwrite(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV)
 	if err != nil {
 		return err
@@ -577,6 +928,7 @@ func do(configPath string) error {
 	plog.Printf("saving summary data to %q", cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathTXT)
 	aggRowsForSummaryTXT := [][]string{
 		row00Header,
+		rowSuspect,
 		row01TotalSeconds,
 		row02TotalRequestNumber,
 		row03MaxThroughput,
@@ -595,7 +947,18 @@ func do(configPath string) error {
 		row14p95,
 		row15p99,
 		row16p999,
-
+	}
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, saturationRows...)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, topologyRows...)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, clusterSizeRows...)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, sloRows...)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, expiryRows...)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, opRows...)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, hardwareRows...)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, derivedRows...)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, costEstimateRows...)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, rowBottleneckHints)
+	aggRowsForSummaryTXT = append(aggRowsForSummaryTXT, [][]string{
 		row17ServerReceiveBytesSum,
 		row18ServerTransmitBytesSum,
 		row19ClientReceiveBytesSum,
@@ -613,7 +976,7 @@ func do(configPath string) error {
 		row28WritesCompletedDeltaSum,
 		row29SectorsWrittenDeltaSum,
 		row30AvgDiskSpaceUsage,
-	}
+	}...)
 	buf := new(bytes.Buffer)
 	tw := tablewriter.NewWriter(buf)
 	tw.SetHeader(aggRowsForSummaryTXT[0])
@@ -632,6 +995,35 @@ func do(configPath string) error {
 		errs = databaseID + " " + "errors:\n" + strings.Join(es, "\n") + "\n"
 	}
 	stxt := buf.String()
+	if len(cfg.AllDatabaseIDList) > 1 {
+		diff, err := newConfigDiff(cfg.AllDatabaseIDList, cfg.DatabaseIDToConfigClientMachineAgentControl, databaseIDToRunMetadataPath)
+		if err != nil {
+			return err
+		}
+		diffPath := configDiffPath(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV)
+		plog.Printf("saving config diff to %q", diffPath)
+		if err = diff.writeCSV(diffPath); err != nil {
+			return err
+		}
+		if dt := diff.table(); dt != "" {
+			stxt = dt + "\n" + stxt
+		}
+	}
+	if len(suspectLines) > 0 {
+		stxt = "SUSPECT RUN (results may be invalid):\n" + strings.Join(suspectLines, "\n") + "\n\n" + stxt
+	}
+	if normalizer.enabled() {
+		stxt += "\n" + normalizationCaveat + "\n"
+	}
+	if heterogeneous {
+		stxt += "\n" + clusterSizeNote(cfg.AllDatabaseIDList, databaseIDToClusterSize, clusterNormalizer.enabled()) + "\n"
+	}
+	if len(bottleneckLines) > 0 {
+		stxt += "\nBOTTLENECK HINTS (results may not compare databases):\n" + strings.Join(bottleneckLines, "\n") + "\n"
+	}
+	if sc := serverConfigTable(cfg.AllDatabaseIDList, databaseIDToRunMetadataPath); sc != "" {
+		stxt += "\n" + sc
+	}
 	if errs != "" {
 		stxt += "\n" + "\n" + errs
 	}
@@ -686,6 +1078,7 @@ func do(configPath string) error {
 		}
 	}
 	// KEYS, MIN-VMRSS-MB, AVG-VMRSS-MB, MAX-VMRSS-MB
+	// (named after the configured memory unit)
 	plog.Info("combining all server memory usage by keys")
 	allMemoryFrame := dataframe.New()
 	for _, databaseID := range cfg.AllDatabaseIDList {
@@ -708,7 +1101,10 @@ func do(configPath string) error {
 		if err != nil {
 			return err
 		}
-		colMemMin.UpdateHeader(makeHeader("MIN-VMRSS-MB", testdata.DatabaseTag))
+		colMemMin.UpdateHeader(makeHeader(units.memoryColumn("MIN-VMRSS-MB"), testdata.DatabaseTag))
+		if colMemMin, err = units.convertColumn("MIN-VMRSS-MB", colMemMin); err != nil {
+			return err
+		}
 		if err = allMemoryFrame.AddColumn(colMemMin); err != nil {
 			return err
 		}
@@ -717,7 +1113,10 @@ func do(configPath string) error {
 		if err != nil {
 			return err
 		}
-		colMem.UpdateHeader(makeHeader("AVG-VMRSS-MB", testdata.DatabaseTag))
+		colMem.UpdateHeader(makeHeader(units.memoryColumn("AVG-VMRSS-MB"), testdata.DatabaseTag))
+		if colMem, err = units.convertColumn("AVG-VMRSS-MB", colMem); err != nil {
+			return err
+		}
 		if err = allMemoryFrame.AddColumn(colMem); err != nil {
 			return err
 		}
@@ -726,7 +1125,10 @@ func do(configPath string) error {
 		if err != nil {
 			return err
 		}
-		colMemMax.UpdateHeader(makeHeader("MAX-VMRSS-MB", testdata.DatabaseTag))
+		colMemMax.UpdateHeader(makeHeader(units.memoryColumn("MAX-VMRSS-MB"), testdata.DatabaseTag))
+		if colMemMax, err = units.convertColumn("MAX-VMRSS-MB", colMemMax); err != nil {
+			return err
+		}
 		if err = allMemoryFrame.AddColumn(colMemMax); err != nil {
 			return err
 		}
@@ -889,11 +1291,11 @@ func do(configPath string) error {
 		allMemoryFrameCfg := dbtesterpb.ConfigAnalyzeMachinePlot{
 			Column:         "AVG-VMRSS-MB",
 			XAxis:          "Cumulative Number of Keys",
-			YAxis:          "Memory(MB) by Keys",
+			YAxis:          fmt.Sprintf("Memory(%s) by Keys", units.memoryUnit()),
 			OutputPathList: make([]string, len(cfg.AnalyzePlotList[0].OutputPathList)),
 		}
-		allMemoryFrameCfg.OutputPathList[0] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), "AVG-VMRSS-MB-BY-KEY.svg")
-		allMemoryFrameCfg.OutputPathList[1] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), "AVG-VMRSS-MB-BY-KEY.png")
+		allMemoryFrameCfg.OutputPathList[0] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), units.memoryColumn("AVG-VMRSS-MB")+"-BY-KEY.svg")
+		allMemoryFrameCfg.OutputPathList[1] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), units.memoryColumn("AVG-VMRSS-MB")+"-BY-KEY.png")
 		plog.Printf("plotting %v", allMemoryFrameCfg.OutputPathList)
 		var pairs []pair
 		allCols := allMemoryFrame.Columns()
@@ -915,7 +1317,7 @@ func do(configPath string) error {
 				return err
 			}
 		}
-		csvPath := filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), "AVG-VMRSS-MB-BY-KEY.csv")
+		csvPath := filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), units.memoryColumn("AVG-VMRSS-MB")+"-BY-KEY.csv")
 		if err := newCSV.CSV(csvPath); err != nil {
 			return err
 		}
@@ -925,11 +1327,11 @@ func do(configPath string) error {
 		allMemoryFrameCfg := dbtesterpb.ConfigAnalyzeMachinePlot{
 			Column:         "AVG-VMRSS-MB",
 			XAxis:          "Cumulative Number of Keys",
-			YAxis:          "Memory(MB) by Keys",
+			YAxis:          fmt.Sprintf("Memory(%s) by Keys", units.memoryUnit()),
 			OutputPathList: make([]string, len(cfg.AnalyzePlotList[0].OutputPathList)),
 		}
-		allMemoryFrameCfg.OutputPathList[0] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), "AVG-VMRSS-MB-BY-KEY-ERROR-POINTS.svg")
-		allMemoryFrameCfg.OutputPathList[1] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), "AVG-VMRSS-MB-BY-KEY-ERROR-POINTS.png")
+		allMemoryFrameCfg.OutputPathList[0] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), units.memoryColumn("AVG-VMRSS-MB")+"-BY-KEY-ERROR-POINTS.svg")
+		allMemoryFrameCfg.OutputPathList[1] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), units.memoryColumn("AVG-VMRSS-MB")+"-BY-KEY-ERROR-POINTS.png")
 		plog.Printf("plotting %v", allMemoryFrameCfg.OutputPathList)
 		var triplets []triplet
 		allCols := allMemoryFrame.Columns()
@@ -959,7 +1361,7 @@ func do(configPath string) error {
 				return err
 			}
 		}
-		csvPath := filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), "AVG-VMRSS-MB-BY-KEY-ERROR-POINTS.csv")
+		csvPath := filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), units.memoryColumn("AVG-VMRSS-MB")+"-BY-KEY-ERROR-POINTS.csv")
 		if err := newCSV.CSV(csvPath); err != nil {
 			return err
 		}
@@ -1023,9 +1425,14 @@ func do(configPath string) error {
 		var clientNumColumns []dataframe.Column
 		var pairs []pair
 		var dataColumns []dataframe.Column
+		var opDataColumns []dataframe.Column
 		for i, ad := range all.data {
 			databaseID := all.allDatabaseIDList[i]
 			tag := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag
+			start, err := firstUnixSecond(ad.aggregated)
+			if err != nil {
+				return err
+			}
 
 			avgCol, err := ad.aggregated.Column("CONTROL-CLIENT-NUM")
 			if err != nil {
@@ -1039,21 +1446,71 @@ func do(configPath string) error {
 				return err
 			}
 			col.UpdateHeader(makeHeader(plotConfig.Column, tag))
-			pairs = append(pairs, pair{y: col})
+			if col, err = units.convertColumn(plotConfig.Column, col); err != nil {
+				return err
+			}
+			p := pair{y: col, start: start}
+			if plotConfig.ConnectionEvents {
+				if p.events, err = readConnectionEventSeconds(cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].ClientConnectionEventsPath, start); err != nil {
+					return err
+				}
+			}
+			if plotConfig.NemesisEvents {
+				if p.nemesis, err = readNemesisEventSeconds(cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].NemesisEventsPath, start); err != nil {
+					return err
+				}
+			}
+			if reps := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID].RepetitionAllAggregatedPathList; plotConfig.Band != "" && len(reps) > 0 {
+				repCols, err := readRepetitionColumns(reps, plotConfig.Column)
+				if err != nil {
+					return err
+				}
+				cols := []dataframe.Column{col}
+				for _, rc := range repCols {
+					if rc, err = units.convertColumn(plotConfig.Column, rc); err != nil {
+						return err
+					}
+					cols = append(cols, rc)
+				}
+				if p.band, err = confidenceBand(plotConfig.Band, cols); err != nil {
+					return err
+				}
+			}
+			pairs = append(pairs, p)
 			dataColumns = append(dataColumns, col)
+
+			// distinct series per operation type, if any
+			for _, op := range dbtester.OperationTypes {
+				opColumn := dbtester.OperationColumn(op, plotConfig.Column)
+				opCol, err := ad.aggregated.Column(opColumn)
+				if err != nil {
+					continue
+				}
+				opCol.UpdateHeader(makeHeader(opColumn, tag))
+				if opCol, err = units.convertColumn(plotConfig.Column, opCol); err != nil {
+					return err
+				}
+				pairs = append(pairs, pair{y: opCol, start: start})
+				opDataColumns = append(opDataColumns, opCol)
+			}
 		}
+		unit := units.columnUnit(plotConfig.Column)
+		plotConfig.YAxis = axisLabel(plotConfig.YAxis, unit)
 		if err = all.draw(plotConfig, pairs...); err != nil {
 			return err
 		}
 
 		plog.Printf("saving data for %q of all database", plotConfig.Column)
-		nf1, err := dataframe.NewFromColumns(nil, dataColumns...)
+		nf1, err := dataframe.NewFromColumns(nil, append(dataColumns, opDataColumns...)...)
 		if err != nil {
 			return err
 		}
 		if err = nf1.CSV(plotConfig.OutputPathCSV); err != nil {
 			return err
 		}
+		if err = saveUnits(plotConfig.OutputPathCSV, nf1.Headers(), func(string) string { return unit }); err != nil {
+			return err
+		}
 
 		plog.Printf("saving data for %q of all database (by client number)", plotConfig.Column)
 		nf2 := dataframe.New()
@@ -1139,7 +1596,16 @@ func do(configPath string) error {
 		}
 	}
 
-	return cfg.WriteREADME(stxt)
+	if err = cfg.WriteREADME(stxt); err != nil {
+		return err
+	}
+	if err = writeOutputChecksums(cfg); err != nil {
+		return err
+	}
+	if len(clientBottlenecks) > 0 {
+		return fmt.Errorf("client reached %.2f %% CPU usage per core for %q", cfg.ConfigAnalyzeMachineAllAggregatedOutput.ClientMaxCPUPercent, clientBottlenecks)
+	}
+	return nil
 }
 
 func changeExtToTxt(fpath string) string {
@@ -0,0 +1,80 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dbtester"
+)
+
+// comparedRows returns the rows of the compared summary, one row for
+// each column with the values of databases in 'databaseIDs' order,
+// and "-" for databases without the column.
+func comparedRows(columns []string, databaseIDs []string, columnToDatabaseIDToValue map[string]map[string]string) [][]string {
+	var rows [][]string
+	for _, col := range columns {
+		row := []string{col}
+		for _, databaseID := range databaseIDs {
+			v, ok := columnToDatabaseIDToValue[col][databaseID]
+			if !ok {
+				v = "-"
+			}
+			row = append(row, v)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// sortSLOColumns sorts SLO-COMPLIANCE-UNDER-* columns by threshold,
+// so that rows do not depend on which database is compared first.
+func sortSLOColumns(cols []string) {
+	threshold := func(col string) int64 {
+		v, _ := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(col, dbtester.SLOComplianceColumnPrefix), "MS"), 10, 64)
+		return v
+	}
+	sort.SliceStable(cols, func(i, j int) bool {
+		ti, tj := threshold(cols[i]), threshold(cols[j])
+		if ti != tj {
+			return ti < tj
+		}
+		return cols[i] < cols[j]
+	})
+}
+
+// sortOperationColumns sorts per-operation-type summary columns in the
+// order of operation types, and then of dbtester.OperationSummaryColumns.
+func sortOperationColumns(cols []string) {
+	rank := func(col string) int {
+		for i, op := range dbtester.OperationTypes {
+			for j, c := range dbtester.OperationSummaryColumns {
+				if col == dbtester.OperationColumn(op, c) {
+					return i*len(dbtester.OperationSummaryColumns) + j
+				}
+			}
+		}
+		return len(dbtester.OperationTypes) * len(dbtester.OperationSummaryColumns)
+	}
+	sort.SliceStable(cols, func(i, j int) bool {
+		ri, rj := rank(cols[i]), rank(cols[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return cols[i] < cols[j]
+	})
+}
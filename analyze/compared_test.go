@@ -0,0 +1,66 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files in testdata")
+
+func TestComparedRowsGolden(t *testing.T) {
+	// columns in the order of first appearance, where the second database
+	// has SLO thresholds and operation types that the first does not
+	sloColumns := []string{"SLO-COMPLIANCE-UNDER-100MS", "SLO-COMPLIANCE-UNDER-5MS", "SLO-COMPLIANCE-UNDER-10MS"}
+	opColumns := []string{"WRITE-REQUESTS-PER-SECOND", "WRITE-SLOWEST-LATENCY-MS", "READ-AVERAGE-LATENCY-MS", "READ-REQUESTS-PER-SECOND"}
+	databaseIDs := []string{"etcd__tip", "zookeeper__r3_5_3_beta"}
+	values := map[string]map[string]string{
+		"SLO-COMPLIANCE-UNDER-100MS": {"etcd__tip": "99.9 %", "zookeeper__r3_5_3_beta": "98.0 %"},
+		"SLO-COMPLIANCE-UNDER-5MS":   {"zookeeper__r3_5_3_beta": "50.0 %"},
+		"SLO-COMPLIANCE-UNDER-10MS":  {"etcd__tip": "90.0 %", "zookeeper__r3_5_3_beta": "80.0 %"},
+		"WRITE-REQUESTS-PER-SECOND":  {"etcd__tip": "1,000 req/sec", "zookeeper__r3_5_3_beta": "900 req/sec"},
+		"WRITE-SLOWEST-LATENCY-MS":   {"etcd__tip": "100 ms"},
+		"READ-AVERAGE-LATENCY-MS":    {"zookeeper__r3_5_3_beta": "2 ms"},
+		"READ-REQUESTS-PER-SECOND":   {"zookeeper__r3_5_3_beta": "5,000 req/sec"},
+	}
+
+	sortSLOColumns(sloColumns)
+	sortOperationColumns(opColumns)
+	rows := append(comparedRows(sloColumns, databaseIDs, values), comparedRows(opColumns, databaseIDs, values)...)
+
+	buf := new(bytes.Buffer)
+	wr := csv.NewWriter(buf)
+	if err := wr.WriteAll(rows); err != nil {
+		t.Fatal(err)
+	}
+	golden := filepath.Join("testdata", "compared-rows.golden")
+	if *updateGolden {
+		if err := ioutil.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exp, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != string(exp) {
+		t.Fatalf("rows differ from %q (run with '-update' to update)\nexpected:\n%s\ngot:\n%s", golden, exp, buf.String())
+	}
+}
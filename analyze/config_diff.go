@@ -0,0 +1,226 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/olekukonko/tablewriter"
+	"gopkg.in/yaml.v2"
+)
+
+// configDiffSkipKeys are the config keys that always differ between
+// databases or runs (addresses, names), and do not explain results.
+var configDiffSkipKeys = map[string]bool{
+	"database_id":          true,
+	"database_description": true,
+	"database_tag":         true,
+	"peer_ips":             true,
+	"peer_ips_string":      true,
+	"agent_endpoints":      true,
+	"database_endpoints":   true,
+	"proxy_endpoints":      true,
+}
+
+// configDiff is the config keys whose values differ between databases,
+// with the value of each database ("-" if not set).
+type configDiff struct {
+	databaseIDs []string
+	keys        []string
+	values      map[string]map[string]string
+}
+
+// newConfigDiff compares the test configs of databases and the versions
+// and environment variables in their run metadata. Database flags are
+// keyed by "flag." regardless of the database (e.g. "flag.quota_size_bytes"),
+// so that the same flags of two versions are compared.
+func newConfigDiff(databaseIDs []string, controls map[string]dbtesterpb.ConfigClientMachineAgentControl, mdPaths map[string]string) (*configDiff, error) {
+	all := make(map[string]map[string]string)
+	for _, databaseID := range databaseIDs {
+		control := controls[databaseID]
+		vs, err := flattenConfig(databaseID, &control)
+		if err != nil {
+			return nil, err
+		}
+		if fpath := mdPaths[databaseID]; fpath != "" {
+			md, err := dbtester.ReadRunMetadata(fpath)
+			if err != nil {
+				plog.Warningf("cannot read run metadata %q for config diff (%v)", fpath, err)
+			} else {
+				for k, v := range runMetadataValues(md) {
+					vs[k] = v
+				}
+			}
+		}
+		all[databaseID] = vs
+	}
+
+	keys := make(map[string]bool)
+	for _, vs := range all {
+		for k := range vs {
+			keys[k] = true
+		}
+	}
+	d := &configDiff{databaseIDs: databaseIDs, values: make(map[string]map[string]string)}
+	for k := range keys {
+		differ := false
+		vals := make(map[string]string)
+		for i, databaseID := range databaseIDs {
+			v, ok := all[databaseID][k]
+			if !ok {
+				v = "-"
+			}
+			vals[databaseID] = v
+			if i > 0 && v != vals[databaseIDs[0]] {
+				differ = true
+			}
+		}
+		if differ {
+			d.keys = append(d.keys, k)
+			d.values[k] = vals
+		}
+	}
+	sort.Strings(d.keys)
+	return d, nil
+}
+
+// flattenConfig returns the config values keyed by their YAML paths
+// (e.g. "benchmark_options.value_size_bytes"), without unset values.
+func flattenConfig(databaseID string, control *dbtesterpb.ConfigClientMachineAgentControl) (map[string]string, error) {
+	b, err := yaml.Marshal(control)
+	if err != nil {
+		return nil, err
+	}
+	var m map[interface{}]interface{}
+	if err = yaml.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	vs := make(map[string]string)
+	for k, v := range m {
+		key := fmt.Sprint(k)
+		if configDiffSkipKeys[key] {
+			continue
+		}
+		if key == databaseID {
+			key = "flag"
+		}
+		flattenValue(key, v, vs)
+	}
+	return vs, nil
+}
+
+func flattenValue(key string, v interface{}, vs map[string]string) {
+	switch tv := v.(type) {
+	case nil:
+	case map[interface{}]interface{}:
+		for k, sv := range tv {
+			flattenValue(key+"."+fmt.Sprint(k), sv, vs)
+		}
+	case []interface{}:
+		var ss []string
+		for i, sv := range tv {
+			switch sv.(type) {
+			case map[interface{}]interface{}, []interface{}:
+				flattenValue(fmt.Sprintf("%s.%d", key, i), sv, vs)
+			default:
+				ss = append(ss, fmt.Sprint(sv))
+			}
+		}
+		if len(ss) > 0 {
+			vs[key] = strings.Join(ss, ",")
+		}
+	default:
+		vs[key] = fmt.Sprint(tv)
+	}
+}
+
+// runMetadataValues returns the versions and environment variables
+// of the database in run metadata, keyed by "run.".
+func runMetadataValues(md dbtester.RunMetadata) map[string]string {
+	vs := make(map[string]string)
+	for k, v := range map[string]string{
+		"release_version":   md.ReleaseVersion,
+		"source_repository": md.SourceRepository,
+		"source_revision":   md.SourceRevision,
+		"source_commit":     md.SourceCommit,
+		"topology":          md.Topology,
+		"database_env":      strings.Join(md.DatabaseEnv, ","),
+	} {
+		if v != "" {
+			vs["run."+k] = v
+		}
+	}
+	return vs
+}
+
+// table returns the text table of differing keys, or an empty string
+// if all databases have the same configs.
+func (d *configDiff) table() string {
+	if len(d.keys) == 0 {
+		return ""
+	}
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(append([]string{"KEY"}, d.databaseIDs...))
+	tw.AppendBulk(d.rows())
+	tw.SetAutoFormatHeaders(false)
+	tw.Render()
+	return "CONFIG DIFF (what differs between databases):\n" + buf.String()
+}
+
+func (d *configDiff) rows() [][]string {
+	rows := make([][]string, 0, len(d.keys))
+	for _, k := range d.keys {
+		row := []string{k}
+		for _, databaseID := range d.databaseIDs {
+			row = append(row, d.values[k][databaseID])
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// writeCSV saves the differing keys with a header row of database IDs,
+// to be read by other tools.
+func (d *configDiff) writeCSV(fpath string) error {
+	f, err := openToOverwrite(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	if err = wr.Write(append([]string{"KEY"}, d.databaseIDs...)); err != nil {
+		return err
+	}
+	if err = wr.WriteAll(d.rows()); err != nil {
+		return err
+	}
+	wr.Flush()
+	return wr.Error()
+}
+
+// configDiffPath returns the path of the config diff CSV next to
+// the summary CSV (e.g. "all-aggregated-config-diff.csv").
+func configDiffPath(summaryPath string) string {
+	return strings.TrimSuffix(summaryPath, filepath.Ext(summaryPath)) + "-config-diff.csv"
+}
@@ -0,0 +1,103 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestConfigDiff(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "config-diff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	v32Path := filepath.Join(dir, "etcd-v3.2.yaml")
+	if err = ioutil.WriteFile(v32Path, []byte("release_version: v3.2.16\ntopology: direct\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	v33Path := filepath.Join(dir, "etcd-v3.3.yaml")
+	if err = ioutil.WriteFile(v33Path, []byte("release_version: v3.3.1\ntopology: direct\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	controls := map[string]dbtesterpb.ConfigClientMachineAgentControl{
+		"etcd__v3_2": {
+			DatabaseID:        "etcd__v3_2",
+			DatabaseEndpoints: []string{"10.0.0.1:2379"},
+			Flag_Etcd_V3_2:    &dbtesterpb.Flag_Etcd_V3_2{SnapshotCount: 100000, QuotaSizeBytes: 8000000000},
+			ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+				Type:           "write",
+				ValueSizeBytes: 256,
+			},
+		},
+		"etcd__v3_3": {
+			DatabaseID:        "etcd__v3_3",
+			DatabaseEndpoints: []string{"10.0.0.2:2379"},
+			Flag_Etcd_V3_3:    &dbtesterpb.Flag_Etcd_V3_3{SnapshotCount: 100000, QuotaSizeBytes: 2000000000},
+			ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+				Type:           "write",
+				ValueSizeBytes: 1024,
+			},
+		},
+	}
+	ids := []string{"etcd__v3_2", "etcd__v3_3"}
+	d, err := newConfigDiff(ids, controls, map[string]string{"etcd__v3_2": v32Path, "etcd__v3_3": v33Path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{
+		{"benchmark_options.value_size_bytes", "256", "1024"},
+		{"flag.quota_size_bytes", "8000000000", "2000000000"},
+		{"run.release_version", "v3.2.16", "v3.3.1"},
+	}
+	if rows := d.rows(); !reflect.DeepEqual(rows, expected) {
+		t.Fatalf("expected %v, got %v", expected, rows)
+	}
+	if txt := d.table(); !strings.HasPrefix(txt, "CONFIG DIFF") || !strings.Contains(txt, "flag.quota_size_bytes") {
+		t.Fatalf("unexpected table\n%s", txt)
+	}
+
+	fpath := configDiffPath(filepath.Join(dir, "all-aggregated.csv"))
+	if filepath.Base(fpath) != "all-aggregated-config-diff.csv" {
+		t.Fatalf("unexpected path %q", fpath)
+	}
+	if err = d.writeCSV(fpath); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(b), "KEY,etcd__v3_2,etcd__v3_3\nbenchmark_options.value_size_bytes,256,1024\n") {
+		t.Fatalf("unexpected CSV\n%s", b)
+	}
+
+	same, err := newConfigDiff(ids[:1], controls, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if txt := same.table(); txt != "" {
+		t.Fatalf("expected no table for one database, got %q", txt)
+	}
+}
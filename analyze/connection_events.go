@@ -0,0 +1,125 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"encoding/csv"
+	"fmt"
+	"image/color"
+	"os"
+	"sort"
+	"strconv"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// connectionEvent is a client connection event in
+// 'client_connection_events_path'.
+type connectionEvent struct {
+	unixNano int64
+	event    string
+	endpoint string
+	detail   string
+}
+
+// readConnectionEvents reads the connection events of clients.
+func readConnectionEvents(fpath string) ([]connectionEvent, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 || len(rows[0]) < 4 || rows[0][0] != "UNIX-NANOSECOND" {
+		return nil, fmt.Errorf("%q is not connection events", fpath)
+	}
+	events := make([]connectionEvent, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		ns, err := strconv.ParseInt(row[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q has invalid time %q (%v)", fpath, row[0], err)
+		}
+		events = append(events, connectionEvent{unixNano: ns, event: row[1], endpoint: row[2], detail: row[3]})
+	}
+	return events, nil
+}
+
+// connectionEventSeconds returns the seconds since 'start' (Unix second)
+// with connection events, once per second. Re-resolves without endpoint
+// changes and idle connections closed by servers are not overlaid.
+func connectionEventSeconds(events []connectionEvent, start int64) []float64 {
+	seen := make(map[int64]bool)
+	var xs []float64
+	for _, ev := range events {
+		if (ev.event == "re-resolve" && ev.detail == "unchanged") || ev.event == "idle-close" {
+			continue
+		}
+		sec := ev.unixNano/1e9 - start
+		if seen[sec] {
+			continue
+		}
+		seen[sec] = true
+		xs = append(xs, float64(sec))
+	}
+	sort.Float64s(xs)
+	return xs
+}
+
+// readConnectionEventSeconds returns the seconds with connection events
+// in the file, or none if the file is not set.
+func readConnectionEventSeconds(fpath string, start int64) ([]float64, error) {
+	if fpath == "" {
+		return nil, nil
+	}
+	events, err := readConnectionEvents(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return connectionEventSeconds(events, start), nil
+}
+
+// connectionEventDashes is the line style of connection events, to tell
+// them apart from the solid lines of nemesis events.
+var connectionEventDashes = []vg.Length{vg.Points(2), vg.Points(2)}
+
+// eventLines returns the vertical lines from 'ymin' to 'ymax' at the
+// seconds within the x range of the series points.
+func eventLines(xs []float64, pts plotter.XYs, ymin, ymax float64, c color.Color, dashes []vg.Length) ([]plot.Plotter, error) {
+	if len(pts) == 0 {
+		return nil, nil
+	}
+	xmin, xmax := pts[0].X, pts[len(pts)-1].X
+	var ls []plot.Plotter
+	for _, x := range xs {
+		if x < xmin || x > xmax {
+			continue
+		}
+		l, err := plotter.NewLine(plotter.XYs{{X: x, Y: ymin}, {X: x, Y: ymax}})
+		if err != nil {
+			return nil, err
+		}
+		l.Color = c
+		l.Width = vg.Points(0.75)
+		l.Dashes = dashes
+		ls = append(ls, l)
+	}
+	return ls, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"image/color"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestConnectionEvents(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "connection-events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "client-connection-events.csv")
+	data := `UNIX-NANOSECOND,EVENT,ENDPOINT,DETAIL,COUNT
+99500000000,dial,a:2379,,100
+103100000000,disconnect,a:2379,EOF,1
+103900000000,dial,b:2379,,1
+105000000000,idle-close,b:2379,EOF,1
+110000000000,re-resolve,_etcd-client._tcp.example.com,unchanged,1
+120000000000,re-resolve,_etcd-client._tcp.example.com,changed to c:2379,1
+`
+	if err = ioutil.WriteFile(fpath, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if xs, err := readConnectionEventSeconds("", 100); err != nil || xs != nil {
+		t.Fatalf("expected no events without file, got %v (%v)", xs, err)
+	}
+	xs, err := readConnectionEventSeconds(fpath, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []float64{-1, 3, 20}; !reflect.DeepEqual(xs, expected) {
+		t.Fatalf("expected %v, got %v", expected, xs)
+	}
+
+	pts := plotter.XYs{{X: 0, Y: 1}, {X: 10, Y: 5}}
+	ls, err := eventLines(xs, pts, 0, 5, color.Black, connectionEventDashes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ls) != 1 {
+		t.Fatalf("expected 1 line within the series, got %d", len(ls))
+	}
+	if l := ls[0].(*plotter.Line); l.XYs[0].X != 3 || l.XYs[1].Y != 5 {
+		t.Fatalf("unexpected line %v", l.XYs)
+	}
+
+	if err = ioutil.WriteFile(fpath, []byte("SECOND,VALUE\n1,2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = readConnectionEvents(fpath); err == nil {
+		t.Fatal("expected error on unexpected header")
+	}
+}
+
+func TestNemesisEvents(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "nemesis-events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "nemesis-events.csv")
+	data := `UNIX-NANOSECOND,UNIX-SECOND,OPERATION,TARGET-INDEX,RECOVER,ERROR
+105000000000,105,kill,1,false,
+105500000000,105,kill,2,false,
+115000000000,115,kill,1,true,
+`
+	if err = ioutil.WriteFile(fpath, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	xs, err := readNemesisEventSeconds(fpath, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []float64{5, 15}; !reflect.DeepEqual(xs, expected) {
+		t.Fatalf("expected %v, got %v", expected, xs)
+	}
+
+	pts := plotter.XYs{{X: 0, Y: 1}, {X: 10, Y: 5}}
+	ls, err := eventLines(xs, pts, 0, 5, color.Black, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ls) != 1 || ls[0].(*plotter.Line).Dashes != nil {
+		t.Fatalf("expected 1 solid line within the series, got %v", ls)
+	}
+
+	if err = ioutil.WriteFile(fpath, []byte("UNIX-NANOSECOND,EVENT,ENDPOINT,DETAIL\n1,dial,a,\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = readNemesisEventSeconds(fpath, 100); err == nil {
+		t.Fatal("expected error on connection events")
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// costSustainedQPS is the throughput to estimate the cost of sustaining.
+const costSustainedQPS = 10000
+
+// clusterCostPerHour returns the price of all server machines
+// and the client machine per hour. It returns false if no price is set.
+func clusterCostPerHour(amc dbtesterpb.ConfigAnalyzeMachineInitial, serverN int) (float64, bool) {
+	if amc.ServerMachinePricePerHour == 0 && amc.ClientMachinePricePerHour == 0 {
+		return 0, false
+	}
+	return float64(serverN)*amc.ServerMachinePricePerHour + amc.ClientMachinePricePerHour, true
+}
+
+// costPerMillion returns the cost of one million requests at the throughput.
+func costPerMillion(perHour, requestsPerSecond float64) (float64, bool) {
+	if requestsPerSecond <= 0 {
+		return 0, false
+	}
+	return perHour / (requestsPerSecond * 3600) * 1000000, true
+}
+
+// costPerSustained returns the cost per hour to sustain 'qps', assuming
+// whole clusters of the same size are added until the throughput is met.
+func costPerSustained(perHour, requestsPerSecond, qps float64) (float64, bool) {
+	if requestsPerSecond <= 0 {
+		return 0, false
+	}
+	return perHour * math.Ceil(qps/requestsPerSecond), true
+}
+
+// costRows returns the summary rows of cost estimation of each database,
+// or nil if no database has machine prices. Writes per second of
+// databases without writes should be zero.
+func costRows(databaseIDs []string, amcs map[string]dbtesterpb.ConfigAnalyzeMachineInitial, serverNs map[string]int, throughputs, writeThroughputs map[string]float64) [][]string {
+	rowPerHour := []string{"CLUSTER-COST-PER-HOUR"}
+	rowPerMillionWrites := []string{"COST-PER-MILLION-WRITES"}
+	rowPerSustained := []string{fmt.Sprintf("COST-PER-HOUR-AT-%dK-QPS", costSustainedQPS/1000)}
+	priced := false
+	for _, databaseID := range databaseIDs {
+		perHour, ok := clusterCostPerHour(amcs[databaseID], serverNs[databaseID])
+		if !ok {
+			rowPerHour = append(rowPerHour, "-")
+			rowPerMillionWrites = append(rowPerMillionWrites, "-")
+			rowPerSustained = append(rowPerSustained, "-")
+			continue
+		}
+		priced = true
+		rowPerHour = append(rowPerHour, fmt.Sprintf("%.4f", perHour))
+		if v, ok := costPerMillion(perHour, writeThroughputs[databaseID]); ok {
+			rowPerMillionWrites = append(rowPerMillionWrites, fmt.Sprintf("%.4f", v))
+		} else {
+			rowPerMillionWrites = append(rowPerMillionWrites, "-")
+		}
+		if v, ok := costPerSustained(perHour, throughputs[databaseID], costSustainedQPS); ok {
+			rowPerSustained = append(rowPerSustained, fmt.Sprintf("%.4f", v))
+		} else {
+			rowPerSustained = append(rowPerSustained, "-")
+		}
+	}
+	if !priced {
+		return nil
+	}
+	return [][]string{rowPerHour, rowPerMillionWrites, rowPerSustained}
+}
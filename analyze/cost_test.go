@@ -0,0 +1,47 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestCostRows(t *testing.T) {
+	ids := []string{"etcd__tip", "zookeeper__r3_5_3_beta", "consul__v1_0_2"}
+	amcs := map[string]dbtesterpb.ConfigAnalyzeMachineInitial{
+		"etcd__tip":              {ServerMachinePricePerHour: 0.5, ClientMachinePricePerHour: 0.5},
+		"zookeeper__r3_5_3_beta": {ServerMachinePricePerHour: 0.5, ClientMachinePricePerHour: 0.5},
+	}
+	serverNs := map[string]int{"etcd__tip": 3, "zookeeper__r3_5_3_beta": 3, "consul__v1_0_2": 3}
+	throughputs := map[string]float64{"etcd__tip": 20000, "zookeeper__r3_5_3_beta": 3000, "consul__v1_0_2": 10000}
+	writeThroughputs := map[string]float64{"etcd__tip": 20000}
+
+	rows := costRows(ids, amcs, serverNs, throughputs, writeThroughputs)
+	exp := [][]string{
+		{"CLUSTER-COST-PER-HOUR", "2.0000", "2.0000", "-"},
+		{"COST-PER-MILLION-WRITES", "0.0278", "-", "-"},
+		{"COST-PER-HOUR-AT-10K-QPS", "2.0000", "8.0000", "-"},
+	}
+	if !reflect.DeepEqual(rows, exp) {
+		t.Fatalf("expected %q, got %q", exp, rows)
+	}
+
+	if rows = costRows(ids, nil, serverNs, throughputs, writeThroughputs); rows != nil {
+		t.Fatalf("expected no rows without prices, got %q", rows)
+	}
+}
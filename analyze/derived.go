@@ -0,0 +1,327 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/gyuho/dataframe"
+)
+
+// derivedColumn is a column computed from other columns of each second,
+// defined in 'derived_columns' as 'name = expression'.
+type derivedColumn struct {
+	// column is the name of the column (e.g. "THROUGHPUT-PER-CORE").
+	column string
+	expr   *derivedExpr
+}
+
+// derivedExpr is a node of arithmetic expression, either a number,
+// a variable, or a binary (or unary minus) operation.
+type derivedExpr struct {
+	op          byte // 0 for number and variable
+	num         float64
+	variable    string
+	left, right *derivedExpr
+}
+
+// derivedVariableToColumn returns the column name of the variable
+// (e.g. "avg_throughput" to "AVG-THROUGHPUT").
+func derivedVariableToColumn(v string) string {
+	return strings.ToUpper(strings.Replace(v, "_", "-", -1))
+}
+
+// hardwareVariables are the variables of the server hardware
+// in run metadata, available to all derived columns.
+var hardwareVariables = []string{"cores", "cpu_mhz", "servers"}
+
+// parseDerivedColumns parses 'name = expression' definitions.
+func parseDerivedColumns(defs []string) ([]derivedColumn, error) {
+	dcs := make([]derivedColumn, 0, len(defs))
+	seen := make(map[string]bool, len(defs))
+	for _, def := range defs {
+		kv := strings.SplitN(def, "=", 2)
+		name := strings.TrimSpace(kv[0])
+		if len(kv) != 2 || !isDerivedVariable(name) {
+			return nil, fmt.Errorf("derived column %q is not 'name = expression'", def)
+		}
+		col := derivedVariableToColumn(name)
+		if seen[col] {
+			return nil, fmt.Errorf("derived column %q is duplicate", name)
+		}
+		seen[col] = true
+		p := &derivedParser{s: kv[1]}
+		expr, err := p.parse()
+		if err != nil {
+			return nil, fmt.Errorf("derived column %q: %v", name, err)
+		}
+		dcs = append(dcs, derivedColumn{column: col, expr: expr})
+	}
+	return dcs, nil
+}
+
+func isDerivedVariable(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r == '_':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// derivedParser parses arithmetic expressions of '+', '-', '*', '/',
+// and parentheses, with the usual precedence.
+type derivedParser struct {
+	s   string
+	pos int
+}
+
+func (p *derivedParser) parse() (*derivedExpr, error) {
+	e, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpaces(); p.pos < len(p.s) {
+		return nil, fmt.Errorf("unexpected %q at %d", p.s[p.pos:], p.pos)
+	}
+	return e, nil
+}
+
+func (p *derivedParser) skipSpaces() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// peek returns the next non-space byte, or 0 at the end.
+func (p *derivedParser) peek() byte {
+	p.skipSpaces()
+	if p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+	return 0
+}
+
+func (p *derivedParser) parseSum() (*derivedExpr, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for op := p.peek(); op == '+' || op == '-'; op = p.peek() {
+		p.pos++
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = &derivedExpr{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *derivedParser) parseProduct() (*derivedExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for op := p.peek(); op == '*' || op == '/'; op = p.peek() {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &derivedExpr{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *derivedParser) parseUnary() (*derivedExpr, error) {
+	if p.peek() == '-' {
+		p.pos++
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &derivedExpr{op: '-', left: &derivedExpr{}, right: e}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *derivedParser) parsePrimary() (*derivedExpr, error) {
+	c := p.peek()
+	switch {
+	case c == 0:
+		return nil, fmt.Errorf("unexpected end of expression")
+	case c == '(':
+		p.pos++
+		e, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, fmt.Errorf("missing ')' at %d", p.pos)
+		}
+		p.pos++
+		return e, nil
+	case (c >= '0' && c <= '9') || c == '.':
+		start := p.pos
+		for p.pos < len(p.s) && ((p.s[p.pos] >= '0' && p.s[p.pos] <= '9') || p.s[p.pos] == '.') {
+			p.pos++
+		}
+		v, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", p.s[start:p.pos])
+		}
+		return &derivedExpr{num: v}, nil
+	case (c >= 'a' && c <= 'z') || c == '_':
+		start := p.pos
+		for p.pos < len(p.s) && isDerivedVariable(p.s[start:p.pos+1]) {
+			p.pos++
+		}
+		return &derivedExpr{variable: p.s[start:p.pos]}, nil
+	default:
+		return nil, fmt.Errorf("unexpected %q at %d", c, p.pos)
+	}
+}
+
+// variables returns all variables of the expression.
+func (e *derivedExpr) variables() []string {
+	if e == nil {
+		return nil
+	}
+	if e.variable != "" {
+		return []string{e.variable}
+	}
+	return append(e.left.variables(), e.right.variables()...)
+}
+
+// eval evaluates the expression with the values of variables.
+// Division by zero returns infinity or NaN.
+func (e *derivedExpr) eval(values map[string]float64) float64 {
+	switch e.op {
+	case '+':
+		return e.left.eval(values) + e.right.eval(values)
+	case '-':
+		return e.left.eval(values) - e.right.eval(values)
+	case '*':
+		return e.left.eval(values) * e.right.eval(values)
+	case '/':
+		return e.left.eval(values) / e.right.eval(values)
+	}
+	if e.variable != "" {
+		return values[e.variable]
+	}
+	return e.num
+}
+
+// hardwareValues returns the values of hardware variables from
+// the run metadata, or nil if the run metadata has no servers.
+func hardwareValues(md *dbtester.RunMetadata) map[string]float64 {
+	if md == nil || len(md.ServerHardware) == 0 {
+		return nil
+	}
+	var cores, mhz float64
+	for _, hw := range md.ServerHardware {
+		cores += float64(hw.CPUCores)
+		mhz += hw.CPUMHz
+	}
+	n := float64(len(md.ServerHardware))
+	return map[string]float64{"cores": cores / n, "cpu_mhz": mhz / n, "servers": n}
+}
+
+// addDerivedColumns adds the derived columns to the aggregated data,
+// computed in order so that later columns can refer to earlier ones,
+// and returns the average of each column. Non-finite values (e.g.
+// division by zero CPU) are saved as 0, and excluded from averages.
+func (data *analyzeData) addDerivedColumns(dcs []derivedColumn, hardware map[string]float64) (map[string]float64, error) {
+	avgs := make(map[string]float64, len(dcs))
+	for _, dc := range dcs {
+		var cols []dataframe.Column
+		vars := dc.expr.variables()
+		for _, v := range vars {
+			if isHardwareVariable(v) {
+				if hardware == nil {
+					return nil, fmt.Errorf("derived column %q: %q requires server hardware in run metadata", dc.column, v)
+				}
+				cols = append(cols, nil)
+				continue
+			}
+			col, err := data.aggregated.Column(derivedVariableToColumn(v))
+			if err != nil {
+				return nil, fmt.Errorf("derived column %q: %q is neither a column nor one of %q", dc.column, v, hardwareVariables)
+			}
+			cols = append(cols, col)
+		}
+
+		uc, err := data.aggregated.Column("UNIX-SECOND")
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]float64, len(vars))
+		for k, v := range hardware {
+			values[k] = v
+		}
+		derived := dataframe.NewColumn(dc.column)
+		var sum float64
+		var n int
+		for i := 0; i < uc.Count(); i++ {
+			for j, col := range cols {
+				if col == nil {
+					continue
+				}
+				vv, err := col.Value(i)
+				if err != nil {
+					return nil, err
+				}
+				values[vars[j]], _ = vv.Float64()
+			}
+			v := dc.expr.eval(values)
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				v = 0
+			} else {
+				sum += v
+				n++
+			}
+			derived.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", v)))
+		}
+		if err = data.aggregated.AddColumn(derived); err != nil {
+			return nil, fmt.Errorf("derived column %q: %v", dc.column, err)
+		}
+		// same as other aggregated columns, looked up by its name
+		derived.UpdateHeader(makeHeader(dc.column, data.databaseTag))
+		if n > 0 {
+			avgs[dc.column] = sum / float64(n)
+		}
+	}
+	return avgs, nil
+}
+
+func isHardwareVariable(v string) bool {
+	for _, hv := range hardwareVariables {
+		if v == hv {
+			return true
+		}
+	}
+	return false
+}
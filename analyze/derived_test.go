@@ -0,0 +1,122 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester"
+	"github.com/gyuho/dataframe"
+)
+
+func TestParseDerivedColumns(t *testing.T) {
+	dcs, err := parseDerivedColumns([]string{
+		"throughput_per_core = avg_throughput / (avg_cpu / 100 * cores)",
+		"x = -2 * (1 + 0.5) - -1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dcs[0].column != "THROUGHPUT-PER-CORE" {
+		t.Fatalf("unexpected column %q", dcs[0].column)
+	}
+	if vars := dcs[0].expr.variables(); !reflect.DeepEqual(vars, []string{"avg_throughput", "avg_cpu", "cores"}) {
+		t.Fatalf("unexpected variables %q", vars)
+	}
+	v := dcs[0].expr.eval(map[string]float64{"avg_throughput": 1000, "avg_cpu": 250, "cores": 4})
+	if v != 100 {
+		t.Fatalf("expected 100, got %f", v)
+	}
+	if v = dcs[1].expr.eval(nil); v != -2 {
+		t.Fatalf("expected -2, got %f", v)
+	}
+
+	for _, bad := range [][]string{
+		{"no expression"},
+		{"Upper = 1"},
+		{"a = 1 +"},
+		{"a = (1 + 2"},
+		{"a = 1 $ 2"},
+		{"a = 1", "a = 2"},
+	} {
+		if _, err = parseDerivedColumns(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestAddDerivedColumns(t *testing.T) {
+	fr := dataframe.New()
+	for hd, vs := range map[string][]string{
+		"UNIX-SECOND":    {"1", "2", "3"},
+		"AVG-THROUGHPUT": {"1000", "2000", "0"},
+		"AVG-CPU":        {"100", "400", "0"},
+	} {
+		col := dataframe.NewColumn(hd)
+		for _, v := range vs {
+			col.PushBack(dataframe.NewStringValue(v))
+		}
+		if err := fr.AddColumn(col); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data := &analyzeData{databaseTag: "etcd-v3.3", aggregated: fr}
+
+	dcs, err := parseDerivedColumns([]string{
+		"per_core = avg_throughput / (avg_cpu / 100 * cores)",
+		"per_server = per_core * cores / servers",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = data.addDerivedColumns(dcs, nil); err == nil {
+		t.Fatal("expected error without server hardware")
+	}
+
+	md := &dbtester.RunMetadata{ServerHardware: []dbtester.Hardware{{CPUCores: 2}, {CPUCores: 6}}}
+	avgs, err := data.addDerivedColumns(dcs, hardwareValues(md))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// division by zero CPU in the last second is excluded, but saved as 0,
+	// which later columns refer to
+	if math.Abs(avgs["PER-CORE"]-187.5) > 1e-9 || math.Abs(avgs["PER-SERVER"]-250) > 1e-9 {
+		t.Fatalf("unexpected averages %v", avgs)
+	}
+	col, err := fr.Column("PER-CORE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if col.Header() != "PER-CORE-etcd-v3.3" {
+		t.Fatalf("unexpected header %q", col.Header())
+	}
+	v, err := col.Value(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := v.String(); s != "0.0000" {
+		t.Fatalf("expected 0.0000, got %q", s)
+	}
+
+	bad, err := parseDerivedColumns([]string{"y = unknown_column * 2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = data.addDerivedColumns(bad, nil); err == nil {
+		t.Fatal("expected error of unknown column")
+	}
+}
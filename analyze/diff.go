@@ -0,0 +1,209 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"github.com/gyuho/dataframe"
+	"github.com/spf13/cobra"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/plotutil"
+)
+
+// DiffCommand implements 'analyze diff' command.
+var DiffCommand = &cobra.Command{
+	Use:   "diff [flags] BASE_CSV TARGET_CSV",
+	Short: "Overlays the same metric from two runs with a shaded difference band, for regression review.",
+	RunE:  diffCommandFunc,
+}
+
+var (
+	diffColumn      string
+	diffBaseLabel   string
+	diffTargetLabel string
+	diffTitle       string
+	diffOutput      string
+)
+
+// diffBandColor is the fill color of the band between two runs.
+var diffBandColor = color.NRGBA{R: 128, G: 128, B: 128, A: 80}
+
+func init() {
+	DiffCommand.Flags().StringVar(&diffColumn, "column", "AVG-LATENCY-MS", "Column to compare (e.g. 'AVG-THROUGHPUT' in client latency-throughput timeseries, or any column in aggregated results).")
+	DiffCommand.Flags().StringVar(&diffBaseLabel, "base-label", "base", "Legend of the base run (e.g. 'etcd v3.0').")
+	DiffCommand.Flags().StringVar(&diffTargetLabel, "target-label", "target", "Legend of the target run (e.g. 'etcd v3.1').")
+	DiffCommand.Flags().StringVar(&diffTitle, "title", "", "Plot title (default to the column).")
+	DiffCommand.Flags().StringVar(&diffOutput, "output", "", "Comma-separated plot file paths (e.g. 'diff.svg,diff.png').")
+	Command.AddCommand(DiffCommand)
+}
+
+func diffCommandFunc(cmd *cobra.Command, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("expected BASE_CSV and TARGET_CSV, got %q", args)
+	}
+	if diffOutput == "" {
+		return fmt.Errorf("'--output' is required")
+	}
+	if err := setPlotTheme(plotThemeName); err != nil {
+		return err
+	}
+	base, err := readDiffPoints(args[0], diffColumn)
+	if err != nil {
+		return err
+	}
+	target, err := readDiffPoints(args[1], diffColumn)
+	if err != nil {
+		return err
+	}
+
+	s := summarizeDiff(base, target)
+	plog.Printf("%s: %s average %.4f, %s average %.4f (%+.2f%%) over %d seconds", diffColumn, diffBaseLabel, s.baseAvg, diffTargetLabel, s.targetAvg, s.deltaPercent, s.n)
+
+	title := diffTitle
+	if title == "" {
+		title = diffColumn
+	}
+	plt, err := plotDiff(title, diffColumn, base, target)
+	if err != nil {
+		return err
+	}
+	var outputPaths []string
+	for _, outputPath := range strings.Split(diffOutput, ",") {
+		if outputPath = strings.TrimSpace(outputPath); outputPath != "" {
+			outputPaths = append(outputPaths, outputPath)
+		}
+	}
+	data := &plotData{}
+	data.add(diffBaseLabel, base)
+	data.add(diffTargetLabel, target)
+	if err = savePlot(plt, outputPaths, data); err != nil {
+		return err
+	}
+	plog.Printf("saved %q", outputPaths)
+	return nil
+}
+
+// readDiffPoints reads the column, where X is the row index
+// (seconds since the start of the run, in timeseries).
+// Empty or non-numeric values are read as zero.
+func readDiffPoints(fpath, column string) (plotter.XYs, error) {
+	fr, err := dataframe.NewFromCSV(nil, fpath)
+	if err != nil {
+		return nil, err
+	}
+	col, err := fr.Column(column)
+	if err != nil {
+		return nil, fmt.Errorf("%q in %s (%v)", column, fpath, err)
+	}
+	pts := make(plotter.XYs, col.Count())
+	for i := range pts {
+		v, err := col.Value(i)
+		if err != nil {
+			return nil, err
+		}
+		y, _ := v.Float64()
+		pts[i].X = float64(i)
+		pts[i].Y = y
+	}
+	if len(pts) == 0 {
+		return nil, fmt.Errorf("%q in %s is empty", column, fpath)
+	}
+	return pts, nil
+}
+
+type diffSummary struct {
+	n            int
+	baseAvg      float64
+	targetAvg    float64
+	deltaPercent float64
+}
+
+// summarizeDiff compares averages over the seconds in both runs.
+func summarizeDiff(base, target plotter.XYs) diffSummary {
+	n := len(base)
+	if len(target) < n {
+		n = len(target)
+	}
+	s := diffSummary{n: n}
+	if n == 0 {
+		return s
+	}
+	for i := 0; i < n; i++ {
+		s.baseAvg += base[i].Y
+		s.targetAvg += target[i].Y
+	}
+	s.baseAvg /= float64(n)
+	s.targetAvg /= float64(n)
+	if s.baseAvg != 0 {
+		s.deltaPercent = (s.targetAvg - s.baseAvg) / s.baseAvg * 100
+	}
+	return s
+}
+
+// diffBand returns the polygon between two lines over
+// the seconds in both runs.
+func diffBand(base, target plotter.XYs) plotter.XYs {
+	n := len(base)
+	if len(target) < n {
+		n = len(target)
+	}
+	band := make(plotter.XYs, 0, 2*n)
+	band = append(band, base[:n]...)
+	for i := n - 1; i >= 0; i-- {
+		band = append(band, target[i])
+	}
+	return band
+}
+
+func plotDiff(title, yAxis string, base, target plotter.XYs) (*plot.Plot, error) {
+	plt, err := newPlot()
+	if err != nil {
+		return nil, err
+	}
+	plt.Title.Text = title
+	plt.X.Label.Text = "Second"
+	plt.Y.Label.Text = yAxis
+	plt.Legend.Top = true
+
+	if band := diffBand(base, target); len(band) > 2 {
+		pg, err := plotter.NewPolygon(band)
+		if err != nil {
+			return nil, err
+		}
+		pg.Color = diffBandColor
+		pg.LineStyle.Color = diffBandColor
+		plt.Add(pg)
+		plt.Legend.Add("difference", pg)
+	}
+
+	for i, l := range []struct {
+		label string
+		pts   plotter.XYs
+	}{{diffBaseLabel, base}, {diffTargetLabel, target}} {
+		ln, err := plotter.NewLine(l.pts)
+		if err != nil {
+			return nil, err
+		}
+		ln.Color = lineColor(plotutil.Color(i), i)
+		ln.Dashes = plotutil.Dashes(i)
+		plt.Add(ln)
+		plt.Legend.Add(l.label, ln)
+	}
+	return plt, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestDiff(t *testing.T) {
+	base := plotter.XYs{{X: 0, Y: 10}, {X: 1, Y: 20}, {X: 2, Y: 30}}
+	target := plotter.XYs{{X: 0, Y: 12}, {X: 1, Y: 24}}
+
+	s := summarizeDiff(base, target)
+	if s.n != 2 || s.baseAvg != 15 || s.targetAvg != 18 {
+		t.Fatalf("unexpected summary %+v", s)
+	}
+	if math.Abs(s.deltaPercent-20) > 1e-9 {
+		t.Fatalf("expected +20%%, got %f", s.deltaPercent)
+	}
+
+	band := diffBand(base, target)
+	exp := plotter.XYs{{X: 0, Y: 10}, {X: 1, Y: 20}, {X: 1, Y: 24}, {X: 0, Y: 12}}
+	if len(band) != len(exp) {
+		t.Fatalf("expected %v, got %v", exp, band)
+	}
+	for i := range exp {
+		if band[i] != exp[i] {
+			t.Fatalf("#%d: expected %v, got %v", i, exp[i], band[i])
+		}
+	}
+
+	if _, err := plotDiff("test", "AVG-LATENCY-MS", base, target); err != nil {
+		t.Fatal(err)
+	}
+}
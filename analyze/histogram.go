@@ -0,0 +1,240 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"github.com/coreos/dbtester/pkg/table"
+	"github.com/spf13/cobra"
+)
+
+// HistogramCommand implements 'analyze histogram' command.
+var HistogramCommand = &cobra.Command{
+	Use:   "histogram [flags] LATENCY_HISTOGRAM_LOG...",
+	Short: "Re-buckets and merges latency histogram logs, and exports percentile timelines.",
+	RunE:  histogramCommandFunc,
+}
+
+var (
+	histogramInterval    time.Duration
+	histogramTag         string
+	histogramPercentiles string
+	histogramOutput      string
+	histogramOutputLog   string
+)
+
+func init() {
+	HistogramCommand.Flags().DurationVar(&histogramInterval, "interval", time.Second, "Interval to re-bucket histograms into (multiple of the recorded interval).")
+	HistogramCommand.Flags().StringVar(&histogramTag, "tag", "", "Tag of histograms to read (e.g. 'read' or 'write' in mixed benchmark), or empty for all requests.")
+	HistogramCommand.Flags().StringVar(&histogramPercentiles, "percentiles", "50,90,99,99.9,99.99,100", "Comma-separated percentiles to export.")
+	HistogramCommand.Flags().StringVar(&histogramOutput, "output", "", "CSV file path to save percentile timeline.")
+	HistogramCommand.Flags().StringVar(&histogramOutputLog, "output-log", "", "File path to save re-bucketed histograms in HdrHistogram log format (optional).")
+	Command.AddCommand(HistogramCommand)
+}
+
+func histogramCommandFunc(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no latency histogram log given")
+	}
+	if histogramOutput == "" && histogramOutputLog == "" {
+		return fmt.Errorf("'--output' or '--output-log' is required")
+	}
+	pcts, err := parsePercentiles(histogramPercentiles)
+	if err != nil {
+		return err
+	}
+
+	// histograms of all logs (e.g. from multiple clients) are merged
+	var entries []hdrhistogram.LogEntry
+	for _, fpath := range args {
+		es, err := readHistogramLog(fpath)
+		if err != nil {
+			return err
+		}
+		plog.Printf("read %d histograms from %q", len(es), fpath)
+		entries = append(entries, es...)
+	}
+
+	merged, err := rebucketHistograms(entries, histogramTag, histogramInterval)
+	if err != nil {
+		return err
+	}
+	if len(merged) == 0 {
+		return fmt.Errorf("no histogram with tag %q", histogramTag)
+	}
+
+	if histogramOutput != "" {
+		if err = percentileTimeline(merged, pcts).WriteCSV(histogramOutput); err != nil {
+			return err
+		}
+		plog.Printf("saved percentile timeline to %q", histogramOutput)
+	}
+	if histogramOutputLog != "" {
+		if err = writeHistogramLog(histogramOutputLog, merged); err != nil {
+			return err
+		}
+		plog.Printf("saved %d histograms to %q", len(merged), histogramOutputLog)
+	}
+	return nil
+}
+
+func parsePercentiles(s string) ([]float64, error) {
+	var pcts []float64
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		p, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return nil, err
+		}
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("percentile %v out of range [0, 100]", p)
+		}
+		pcts = append(pcts, p)
+	}
+	if len(pcts) == 0 {
+		return nil, fmt.Errorf("no percentile given")
+	}
+	return pcts, nil
+}
+
+func readHistogramLog(fpath string) ([]hdrhistogram.LogEntry, error) {
+	f, err := os.OpenFile(fpath, os.O_RDONLY, 0444)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	es, err := hdrhistogram.ReadLog(f)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %v", fpath, err)
+	}
+	return es, nil
+}
+
+func writeHistogramLog(fpath string, es []hdrhistogram.LogEntry) error {
+	f, err := os.OpenFile(fpath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0777)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	lw, err := hdrhistogram.NewLogWriter(f, es[0].Start, float64(time.Millisecond/time.Microsecond))
+	if err != nil {
+		return err
+	}
+	for _, e := range es {
+		if err = lw.Write(e); err != nil {
+			return err
+		}
+	}
+	return f.Sync()
+}
+
+// rebucketHistograms merges all histograms with the tag into intervals
+// of 'interval', aligned to Unix time, in order of start time.
+func rebucketHistograms(entries []hdrhistogram.LogEntry, tag string, interval time.Duration) ([]hdrhistogram.LogEntry, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid interval %v", interval)
+	}
+	buckets := make(map[int64]*hdrhistogram.Histogram)
+	for _, e := range entries {
+		if e.Tag != tag {
+			continue
+		}
+		if e.Length > interval {
+			return nil, fmt.Errorf("interval %v is shorter than recorded interval %v", interval, e.Length)
+		}
+		start := e.Start.UnixNano() / int64(interval) * int64(interval)
+		h, ok := buckets[start]
+		if !ok {
+			var err error
+			h, err = hdrhistogram.New(e.Histogram.LowestDiscernibleValue(), e.Histogram.HighestTrackableValue(), e.Histogram.SignificantFigures())
+			if err != nil {
+				return nil, err
+			}
+			buckets[start] = h
+		}
+		if err := h.Merge(e.Histogram); err != nil {
+			return nil, err
+		}
+	}
+
+	merged := make([]hdrhistogram.LogEntry, 0, len(buckets))
+	for start, h := range buckets {
+		merged = append(merged, hdrhistogram.LogEntry{Tag: tag, Start: time.Unix(0, start), Length: interval, Histogram: h})
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Start.Before(merged[j].Start) })
+	return merged, nil
+}
+
+// mergeHistograms merges all histograms to keep into one,
+// or returns nil if there is none.
+func mergeHistograms(entries []hdrhistogram.LogEntry, keep func(hdrhistogram.LogEntry) bool) (*hdrhistogram.Histogram, error) {
+	var h *hdrhistogram.Histogram
+	for _, e := range entries {
+		if !keep(e) {
+			continue
+		}
+		if h == nil {
+			var err error
+			h, err = hdrhistogram.New(e.Histogram.LowestDiscernibleValue(), e.Histogram.HighestTrackableValue(), e.Histogram.SignificantFigures())
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := h.Merge(e.Histogram); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// percentileColumn returns the timeline column name of a percentile.
+func percentileColumn(p float64) string {
+	return fmt.Sprintf("P%s-LATENCY-MS", strconv.FormatFloat(p, 'f', -1, 64))
+}
+
+// percentileTimeline returns the latency percentiles of each interval,
+// in milliseconds, from histograms recorded in microseconds.
+func percentileTimeline(entries []hdrhistogram.LogEntry, pcts []float64) *table.Table {
+	header := []string{"UNIX-SECOND", "INTERVAL-SECONDS", "REQUESTS", "AVG-LATENCY-MS"}
+	for _, p := range pcts {
+		header = append(header, percentileColumn(p))
+	}
+	tb := table.New(header...)
+	for _, e := range entries {
+		row := []string{
+			fmt.Sprintf("%d", e.Start.Unix()),
+			fmt.Sprintf("%g", e.Length.Seconds()),
+			fmt.Sprintf("%d", e.Histogram.TotalCount()),
+			fmt.Sprintf("%.3f", e.Histogram.Mean()/1000),
+		}
+		for _, p := range pcts {
+			row = append(row, fmt.Sprintf("%.3f", float64(e.Histogram.ValueAtQuantile(p))/1000))
+		}
+		tb.Rows = append(tb.Rows, row)
+	}
+	return tb
+}
@@ -0,0 +1,75 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+)
+
+func TestRebucketHistograms(t *testing.T) {
+	var entries []hdrhistogram.LogEntry
+	// two clients, each with 4 one-second histograms
+	for client := int64(1); client <= 2; client++ {
+		for sec := int64(0); sec < 4; sec++ {
+			h, _ := hdrhistogram.New(1, 3600*1000*1000, 3)
+			h.RecordValues(client*1000, 10)
+			entries = append(entries, hdrhistogram.LogEntry{Start: time.Unix(1500000000+sec, 0), Length: time.Second, Histogram: h})
+		}
+	}
+	h, _ := hdrhistogram.New(1, 3600*1000*1000, 3)
+	h.RecordValue(5000)
+	entries = append(entries, hdrhistogram.LogEntry{Tag: "read", Start: time.Unix(1500000000, 0), Length: time.Second, Histogram: h})
+
+	merged, err := rebucketHistograms(entries, "", 2*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tb := percentileTimeline(merged, []float64{50, 100})
+	expHeader := []string{"UNIX-SECOND", "INTERVAL-SECONDS", "REQUESTS", "AVG-LATENCY-MS", "P50-LATENCY-MS", "P100-LATENCY-MS"}
+	if !reflect.DeepEqual(tb.Header, expHeader) {
+		t.Fatalf("expected %q, got %q", expHeader, tb.Header)
+	}
+	expRows := [][]string{
+		{"1500000000", "2", "40", "1.500", "1.000", "2.000"},
+		{"1500000002", "2", "40", "1.500", "1.000", "2.000"},
+	}
+	if !reflect.DeepEqual(tb.Rows, expRows) {
+		t.Fatalf("expected %q, got %q", expRows, tb.Rows)
+	}
+
+	if _, err = rebucketHistograms(entries, "", 500*time.Millisecond); err == nil {
+		t.Fatal("expected error for interval shorter than recorded")
+	}
+}
+
+func TestParsePercentiles(t *testing.T) {
+	pcts, err := parsePercentiles("50, 99.9,100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(pcts, []float64{50, 99.9, 100}) {
+		t.Fatalf("unexpected %v", pcts)
+	}
+	if percentileColumn(99.9) != "P99.9-LATENCY-MS" {
+		t.Fatalf("unexpected %q", percentileColumn(99.9))
+	}
+	if _, err = parsePercentiles("101"); err == nil {
+		t.Fatal("expected error")
+	}
+}
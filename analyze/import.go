@@ -0,0 +1,441 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// ImportCommand implements 'analyze import' command.
+var ImportCommand = &cobra.Command{
+	Use:   "import [flags] INPUT",
+	Short: "Converts the output of other benchmark tools into dbtester client results, to analyze and plot with this pipeline.",
+	RunE:  importCommandFunc,
+}
+
+var (
+	importFormat    string
+	importOutputDir string
+	importClients   int64
+	importStart     int64
+)
+
+func init() {
+	ImportCommand.Flags().StringVar(&importFormat, "format", "", "Format of the input: 'etcd-benchmark' for the output of etcd 'benchmark' tool (with '--sample' for per-second samples), or 'zk-smoketest' for the output of 'zk-latencies.py'.")
+	ImportCommand.Flags().StringVar(&importOutputDir, "output-dir", "", "Directory to save client latency throughput timeseries, summary, and percentiles.")
+	ImportCommand.Flags().Int64Var(&importClients, "clients", 1, "Number of clients of the benchmark, for CONTROL-CLIENT-NUM column.")
+	ImportCommand.Flags().Int64Var(&importStart, "start", 0, "Unix second the benchmark started, for inputs without per-second samples (0 to infer from the modification time of the input).")
+	Command.AddCommand(ImportCommand)
+}
+
+func importCommandFunc(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected INPUT, got %q", args)
+	}
+	if importOutputDir == "" {
+		return fmt.Errorf("'--output-dir' is required")
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var run *importedRun
+	switch importFormat {
+	case "etcd-benchmark":
+		run, err = parseEtcdBenchmark(f)
+	case "zk-smoketest":
+		run, err = parseZKSmoketest(f)
+	default:
+		return fmt.Errorf("unknown format %q", importFormat)
+	}
+	if err != nil {
+		return fmt.Errorf("%q: %v", args[0], err)
+	}
+
+	if len(run.timeseries) == 0 {
+		start := importStart
+		if start == 0 {
+			fi, err := f.Stat()
+			if err != nil {
+				return err
+			}
+			start = fi.ModTime().Unix() - int64(run.totalSeconds+0.5)
+		}
+		plog.Warningf("%q has no per-second samples, timeseries is filled with the average of each phase from %d", args[0], start)
+		run.fillTimeseries(start)
+	}
+	if !run.mergeLatencyExtremes() {
+		plog.Warningf("%q has no request latencies, fastest and slowest latencies are of the average of each phase", args[0])
+	}
+
+	if err = os.MkdirAll(importOutputDir, 0777); err != nil {
+		return err
+	}
+	for _, out := range []struct {
+		name  string
+		write func(string) error
+	}{
+		{"client-latency-throughput-timeseries.csv", func(fpath string) error { return run.writeTimeseries(fpath, importClients) }},
+		{"client-latency-distribution-summary.csv", run.writeSummary},
+		{"client-latency-distribution-percentile.csv", run.writePercentiles},
+	} {
+		fpath := filepath.Join(importOutputDir, out.name)
+		if err = out.write(fpath); err != nil {
+			return err
+		}
+		plog.Printf("saved %q", fpath)
+	}
+	return nil
+}
+
+// importedRun is the results of a benchmark run by other tools.
+type importedRun struct {
+	totalSeconds float64
+	rps          float64
+	slowestMs    float64
+	fastestMs    float64
+	averageMs    float64
+	stddevMs     float64
+	errors       map[string]int64
+
+	// percentiles are latencies by percentile label (e.g. "p99"), if any.
+	percentiles []importedPercentile
+	// timeseries are per-second samples, if any.
+	timeseries []importedSecond
+	// phases are the sequential phases of the run (e.g. each operation
+	// type in zk-smoketest), to fill timeseries without samples.
+	phases []importedPhase
+	// filled is true if timeseries are filled from phases.
+	filled bool
+}
+
+type importedPercentile struct {
+	label string
+	ms    float64
+}
+
+type importedSecond struct {
+	unixSecond int64
+	minMs      float64
+	avgMs      float64
+	maxMs      float64
+	throughput int64
+}
+
+type importedPhase struct {
+	seconds    float64
+	throughput float64
+	avgMs      float64
+}
+
+// fillTimeseries fills per-second samples with the average throughput
+// and latency of each phase, from 'start' Unix second.
+func (r *importedRun) fillTimeseries(start int64) {
+	elapsed := 0.0
+	for _, ph := range r.phases {
+		from, to := start+int64(elapsed), start+int64(elapsed+ph.seconds+0.5)
+		if to == from {
+			to++
+		}
+		for sec := from; sec < to; sec++ {
+			if n := len(r.timeseries); n > 0 && r.timeseries[n-1].unixSecond >= sec {
+				continue
+			}
+			r.timeseries = append(r.timeseries, importedSecond{
+				unixSecond: sec,
+				minMs:      ph.avgMs,
+				avgMs:      ph.avgMs,
+				maxMs:      ph.avgMs,
+				throughput: int64(ph.throughput + 0.5),
+			})
+		}
+		elapsed += ph.seconds
+	}
+	r.filled = true
+}
+
+func (r *importedRun) writeTimeseries(fpath string, clients int64) error {
+	rows := [][]string{{"UNIX-SECOND", "CONTROL-CLIENT-NUM", "MIN-LATENCY-MS", "AVG-LATENCY-MS", "MAX-LATENCY-MS", "AVG-THROUGHPUT"}}
+	for _, s := range r.timeseries {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", s.unixSecond),
+			fmt.Sprintf("%d", clients),
+			fmt.Sprintf("%f", s.minMs),
+			fmt.Sprintf("%f", s.avgMs),
+			fmt.Sprintf("%f", s.maxMs),
+			fmt.Sprintf("%d", s.throughput),
+		})
+	}
+	return writeCSVRows(fpath, rows)
+}
+
+// mergeLatencyExtremes sets the fastest and slowest latencies to the
+// extremes of all requests, from the summary of the tool and the minimum
+// and maximum of per-second samples. It returns false if there are only
+// per-phase averages, without any request latency.
+func (r *importedRun) mergeLatencyExtremes() bool {
+	fromRequests := r.fastestMs > 0 || r.slowestMs > 0
+	for _, s := range r.timeseries {
+		if r.fastestMs == 0 || s.minMs < r.fastestMs {
+			r.fastestMs = s.minMs
+		}
+		if s.maxMs > r.slowestMs {
+			r.slowestMs = s.maxMs
+		}
+	}
+	return fromRequests || !r.filled
+}
+
+// writeSummary saves the summary in 'name,value' rows,
+// as 'client-latency-distribution-summary.csv'.
+func (r *importedRun) writeSummary(fpath string) error {
+	rows := [][]string{
+		{"TOTAL-SECONDS", fmt.Sprintf("%4.4f", r.totalSeconds)},
+		{"REQUESTS-PER-SECOND", fmt.Sprintf("%4.4f", r.rps)},
+		{"SLOWEST-LATENCY-MS", fmt.Sprintf("%4.4f", r.slowestMs)},
+		{"FASTEST-LATENCY-MS", fmt.Sprintf("%4.4f", r.fastestMs)},
+		{"AVERAGE-LATENCY-MS", fmt.Sprintf("%4.4f", r.averageMs)},
+		{"STDDEV-LATENCY-MS", fmt.Sprintf("%4.4f", r.stddevMs)},
+	}
+	if len(r.errors) == 0 {
+		rows = append(rows, []string{"ERROR", "0"})
+	}
+	var errs []string
+	for e := range r.errors {
+		errs = append(errs, e)
+	}
+	sort.Strings(errs)
+	for _, e := range errs {
+		rows = append(rows, []string{fmt.Sprintf("ERROR: %q", e), fmt.Sprintf("%d", r.errors[e])})
+	}
+	return writeCSVRows(fpath, rows)
+}
+
+// writePercentiles saves the latency percentiles, which has only
+// the header if the input has no percentile.
+func (r *importedRun) writePercentiles(fpath string) error {
+	rows := [][]string{{"LATENCY-PERCENTILE", "LATENCY-MS"}}
+	for _, p := range r.percentiles {
+		rows = append(rows, []string{p.label, fmt.Sprintf("%f", p.ms)})
+	}
+	return writeCSVRows(fpath, rows)
+}
+
+func writeCSVRows(fpath string, rows [][]string) error {
+	f, err := openToOverwrite(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	wr := csv.NewWriter(f)
+	if err = wr.WriteAll(rows); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+var (
+	etcdBenchmarkSummaryRegex    = regexp.MustCompile(`^\s*(Total|Slowest|Fastest|Average|Stddev|Requests/sec):\s+([0-9.]+)`)
+	etcdBenchmarkPercentileRegex = regexp.MustCompile(`^\s*([0-9.]+)% in ([0-9.]+) secs`)
+	etcdBenchmarkErrorRegex      = regexp.MustCompile(`^\s*\[(\d+)\]\s+(.+)$`)
+)
+
+// parseEtcdBenchmark parses the output of etcd 'benchmark' tool, where
+// latencies are in seconds, and per-second samples are in CSV
+// (e.g. 'UNIX-SECOND,MIN-LATENCY-MS,...' with '--sample').
+func parseEtcdBenchmark(r io.Reader) (*importedRun, error) {
+	run := &importedRun{errors: make(map[string]int64)}
+	section := ""
+	var samples []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		switch {
+		case strings.HasPrefix(line, "Summary:"):
+			section = "summary"
+			continue
+		case strings.HasPrefix(line, "Response time histogram:"):
+			section = "histogram"
+			continue
+		case strings.HasPrefix(line, "Latency distribution:"):
+			section = "latency"
+			continue
+		case strings.HasPrefix(line, "Error distribution:"):
+			section = "error"
+			continue
+		case strings.HasPrefix(line, "Sample in one second"):
+			section = "sample"
+			continue
+		}
+
+		switch section {
+		case "summary":
+			m := etcdBenchmarkSummaryRegex.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			v, err := strconv.ParseFloat(m[2], 64)
+			if err != nil {
+				return nil, err
+			}
+			switch m[1] {
+			case "Total":
+				run.totalSeconds = v
+			case "Slowest":
+				run.slowestMs = 1000 * v
+			case "Fastest":
+				run.fastestMs = 1000 * v
+			case "Average":
+				run.averageMs = 1000 * v
+			case "Stddev":
+				run.stddevMs = 1000 * v
+			case "Requests/sec":
+				run.rps = v
+			}
+		case "latency":
+			m := etcdBenchmarkPercentileRegex.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			v, err := strconv.ParseFloat(m[2], 64)
+			if err != nil {
+				return nil, err
+			}
+			run.percentiles = append(run.percentiles, importedPercentile{label: "p" + m[1], ms: 1000 * v})
+		case "error":
+			m := etcdBenchmarkErrorRegex.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			n, err := strconv.ParseInt(m[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			run.errors[strings.TrimSpace(m[2])] += n
+		case "sample":
+			if strings.TrimSpace(line) != "" {
+				samples = append(samples, line)
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if run.totalSeconds == 0 {
+		return nil, fmt.Errorf("no summary found (not an output of etcd benchmark?)")
+	}
+	run.phases = []importedPhase{{seconds: run.totalSeconds, throughput: run.rps, avgMs: run.averageMs}}
+
+	if len(samples) > 0 {
+		ts, err := parseEtcdBenchmarkSamples(strings.Join(samples, "\n"))
+		if err != nil {
+			return nil, err
+		}
+		run.timeseries = ts
+	}
+	return run, nil
+}
+
+// parseEtcdBenchmarkSamples parses per-second samples,
+// where latencies are Go durations (e.g. '1.5ms').
+func parseEtcdBenchmarkSamples(s string) ([]importedSecond, error) {
+	rows, err := csv.NewReader(strings.NewReader(s)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var ts []importedSecond
+	for i, row := range rows {
+		if i == 0 && row[0] == "UNIX-SECOND" {
+			continue
+		}
+		if len(row) < 5 {
+			return nil, fmt.Errorf("sample %q has %d fields, expected 5", strings.Join(row, ","), len(row))
+		}
+		var sec importedSecond
+		if sec.unixSecond, err = strconv.ParseInt(row[0], 10, 64); err != nil {
+			return nil, err
+		}
+		for j, p := range []*float64{&sec.minMs, &sec.avgMs, &sec.maxMs} {
+			d, err := time.ParseDuration(row[j+1])
+			if err != nil {
+				return nil, err
+			}
+			*p = float64(d) / float64(time.Millisecond)
+		}
+		if sec.throughput, err = strconv.ParseInt(row[4], 10, 64); err != nil {
+			return nil, err
+		}
+		ts = append(ts, sec)
+	}
+	return ts, nil
+}
+
+// zkSmoketestRegex matches each operation of 'zk-latencies.py'
+// (e.g. 'created   10000 permanent znodes  in   1234 ms (0.123400 ms/op 8103.727715/sec)').
+var zkSmoketestRegex = regexp.MustCompile(`^\s*(\w+)\s+(\d+)\s+.*znodes\s+in\s+(\d+) ms \(([0-9.]+) ms/op ([0-9.]+)/sec\)`)
+
+// parseZKSmoketest parses the output of 'zk-latencies.py' in zk-smoketest,
+// where each operation type runs in sequence, and only the time per
+// operation is reported. Without request latencies, the fastest and
+// slowest latencies are left to the per-second samples filled from
+// the operation types.
+func parseZKSmoketest(r io.Reader) (*importedRun, error) {
+	run := &importedRun{errors: make(map[string]int64)}
+	var totalOps float64
+	var totalMs float64
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		m := zkSmoketestRegex.FindStringSubmatch(sc.Text())
+		if m == nil {
+			continue
+		}
+		var vs [4]float64
+		for i := range vs {
+			v, err := strconv.ParseFloat(m[i+2], 64)
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = v
+		}
+		n, elapsedMs, msPerOp, opsPerSec := vs[0], vs[1], vs[2], vs[3]
+		run.phases = append(run.phases, importedPhase{seconds: elapsedMs / 1000, throughput: opsPerSec, avgMs: msPerOp})
+		totalOps += n
+		totalMs += elapsedMs
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(run.phases) == 0 || totalMs == 0 {
+		return nil, fmt.Errorf("no operation found (not an output of zk-latencies.py?)")
+	}
+	run.totalSeconds = totalMs / 1000
+	run.rps = totalOps / run.totalSeconds
+	run.averageMs = totalMs / totalOps
+	return run, nil
+}
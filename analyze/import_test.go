@@ -0,0 +1,112 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testEtcdBenchmarkOutput = `
+Summary:
+  Total:	2.5000 secs.
+  Slowest:	0.0200 secs.
+  Fastest:	0.0010 secs.
+  Average:	0.0050 secs.
+  Stddev:	0.0020 secs.
+  Requests/sec:	4000.0000
+
+Response time histogram:
+  0.0010 [1]	|
+  0.0200 [9999]	|∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎∎
+
+Latency distribution:
+  10% in 0.0020 secs.
+  99.9% in 0.0190 secs.
+
+Error distribution:
+  [3]	context deadline exceeded
+
+Sample in one second (unix latency throughput):
+UNIX-SECOND,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS,AVG-THROUGHPUT
+1500000000,1ms,5.5ms,20ms,4000
+1500000001,1.2ms,4.5ms,19ms,4100
+`
+
+func TestParseEtcdBenchmark(t *testing.T) {
+	run, err := parseEtcdBenchmark(strings.NewReader(testEtcdBenchmarkOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.totalSeconds != 2.5 || run.rps != 4000 || run.slowestMs != 20 || run.fastestMs != 1 || run.averageMs != 5 || run.stddevMs != 2 {
+		t.Fatalf("unexpected summary %+v", run)
+	}
+	if !reflect.DeepEqual(run.percentiles, []importedPercentile{{"p10", 2}, {"p99.9", 19}}) {
+		t.Fatalf("unexpected percentiles %+v", run.percentiles)
+	}
+	if !reflect.DeepEqual(run.errors, map[string]int64{"context deadline exceeded": 3}) {
+		t.Fatalf("unexpected errors %+v", run.errors)
+	}
+	expected := []importedSecond{
+		{unixSecond: 1500000000, minMs: 1, avgMs: 5.5, maxMs: 20, throughput: 4000},
+		{unixSecond: 1500000001, minMs: 1.2, avgMs: 4.5, maxMs: 19, throughput: 4100},
+	}
+	if !reflect.DeepEqual(run.timeseries, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, run.timeseries)
+	}
+	if !run.mergeLatencyExtremes() || run.fastestMs != 1 || run.slowestMs != 20 {
+		t.Fatalf("unexpected fastest %f and slowest %f", run.fastestMs, run.slowestMs)
+	}
+}
+
+const testZKSmoketestOutput = `Connecting to localhost:2181
+session id: 0x15c1b2e2c4e0000
+created     2000 permanent znodes  in   1000 ms (0.500000 ms/op 2000.000000/sec)
+get         2000           znodes  in    500 ms (0.250000 ms/op 4000.000000/sec)
+deleted     2000 permanent znodes  in   1500 ms (0.750000 ms/op 1333.333333/sec)
+`
+
+func TestParseZKSmoketest(t *testing.T) {
+	run, err := parseZKSmoketest(strings.NewReader(testZKSmoketestOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.totalSeconds != 3 || run.rps != 2000 || run.averageMs != 0.5 {
+		t.Fatalf("unexpected summary %+v", run)
+	}
+	run.fillTimeseries(100)
+	if run.mergeLatencyExtremes() || run.fastestMs != 0.25 || run.slowestMs != 0.75 {
+		t.Fatalf("unexpected fastest %f and slowest %f from phases", run.fastestMs, run.slowestMs)
+	}
+	var secs []int64
+	var tps []int64
+	for _, s := range run.timeseries {
+		secs = append(secs, s.unixSecond)
+		tps = append(tps, s.throughput)
+	}
+	if !reflect.DeepEqual(secs, []int64{100, 101, 102}) {
+		t.Fatalf("unexpected seconds %v", secs)
+	}
+	if !reflect.DeepEqual(tps, []int64{2000, 4000, 1333}) {
+		t.Fatalf("unexpected throughputs %v", tps)
+	}
+}
+
+func TestParseZKSmoketestInvalid(t *testing.T) {
+	if _, err := parseZKSmoketest(strings.NewReader("hello")); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// readNemesisEventSeconds returns the seconds since 'start' (Unix second)
+// with nemesis events in 'nemesis_events_path', once per second, or none
+// if the file is not set.
+func readNemesisEventSeconds(fpath string, start int64) ([]float64, error) {
+	if fpath == "" {
+		return nil, nil
+	}
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 || len(rows[0]) < 3 || rows[0][0] != "UNIX-NANOSECOND" || rows[0][2] != "OPERATION" {
+		return nil, fmt.Errorf("%q is not nemesis events", fpath)
+	}
+	seen := make(map[int64]bool)
+	var xs []float64
+	for _, row := range rows[1:] {
+		ns, err := strconv.ParseInt(row[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q has invalid time %q (%v)", fpath, row[0], err)
+		}
+		sec := ns/1e9 - start
+		if seen[sec] {
+			continue
+		}
+		seen[sec] = true
+		xs = append(xs, float64(sec))
+	}
+	sort.Float64s(xs)
+	return xs, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coreos/dbtester"
+)
+
+// modes to normalize throughput by the server hardware
+const (
+	normalizeCPUCores     = "cpu-cores"
+	normalizeCPUFrequency = "cpu-frequency"
+)
+
+// normalizationCaveat is noted in the summary with normalized throughput.
+const normalizationCaveat = "NOTE: normalized throughput is only a rough comparison of runs on different hardware. It assumes throughput scales linearly with CPU, and ignores CPU architecture, memory, disk, and network."
+
+// throughputNormalizer divides throughput by the server hardware
+// recorded in run metadata. Empty mode disables normalization.
+type throughputNormalizer struct {
+	mode string
+}
+
+func newThroughputNormalizer(mode string) (throughputNormalizer, error) {
+	switch mode {
+	case "", normalizeCPUCores, normalizeCPUFrequency:
+		return throughputNormalizer{mode: mode}, nil
+	default:
+		return throughputNormalizer{}, fmt.Errorf("unknown throughput normalization %q (must be %q or %q)", mode, normalizeCPUCores, normalizeCPUFrequency)
+	}
+}
+
+func (n throughputNormalizer) enabled() bool { return n.mode != "" }
+
+// column returns the summary row name of normalized throughput.
+func (n throughputNormalizer) column() string {
+	if n.mode == normalizeCPUFrequency {
+		return "AVG-THROUGHPUT-PER-CPU-GHZ"
+	}
+	return "AVG-THROUGHPUT-PER-CPU-CORE"
+}
+
+// divisor returns the mean CPU cores or GHz of the servers.
+func (n throughputNormalizer) divisor(servers []dbtester.Hardware) (float64, error) {
+	if len(servers) == 0 {
+		return 0, fmt.Errorf("no server hardware in run metadata")
+	}
+	var sum float64
+	for i, hw := range servers {
+		v := float64(hw.CPUCores)
+		if n.mode == normalizeCPUFrequency {
+			v = hw.CPUMHz / 1000
+		}
+		if v <= 0 {
+			return 0, fmt.Errorf("server %d has no %s in run metadata", i+1, n.mode)
+		}
+		sum += v
+	}
+	return sum / float64(len(servers)), nil
+}
+
+// rows returns the summary rows of the server hardware and normalized
+// throughput of each database, from the run metadata at 'mdPaths'.
+func (n throughputNormalizer) rows(databaseIDs []string, mdPaths map[string]string, throughputs map[string]float64) ([][]string, error) {
+	rowModel := []string{"SERVER-CPU-MODEL"}
+	rowCores := []string{"SERVER-CPU-CORES"}
+	rowMHz := []string{"SERVER-CPU-MHZ"}
+	rowNormalized := []string{n.column()}
+	for _, databaseID := range databaseIDs {
+		if mdPaths[databaseID] == "" {
+			return nil, fmt.Errorf("%q has no 'run_metadata_path' to normalize throughput", databaseID)
+		}
+		md, err := dbtester.ReadRunMetadata(mdPaths[databaseID])
+		if err != nil {
+			return nil, err
+		}
+		div, err := n.divisor(md.ServerHardware)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %v", mdPaths[databaseID], err)
+		}
+
+		var models []string
+		seen := make(map[string]struct{})
+		var cores int64
+		var mhz float64
+		for _, hw := range md.ServerHardware {
+			if _, ok := seen[hw.CPUModel]; !ok && hw.CPUModel != "" {
+				seen[hw.CPUModel] = struct{}{}
+				models = append(models, hw.CPUModel)
+			}
+			cores += hw.CPUCores
+			mhz += hw.CPUMHz
+		}
+		rowModel = append(rowModel, strings.Join(models, " / "))
+		rowCores = append(rowCores, fmt.Sprintf("%.1f", float64(cores)/float64(len(md.ServerHardware))))
+		rowMHz = append(rowMHz, fmt.Sprintf("%.0f", mhz/float64(len(md.ServerHardware))))
+		rowNormalized = append(rowNormalized, fmt.Sprintf("%.2f", throughputs[databaseID]/div))
+	}
+	return [][]string{rowModel, rowCores, rowMHz, rowNormalized}, nil
+}
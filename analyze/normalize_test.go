@@ -0,0 +1,82 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestThroughputNormalizerRows(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "normalize-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	mdOld := filepath.Join(dir, "old.yaml")
+	if err = ioutil.WriteFile(mdOld, []byte(`server_hardware:
+- {cpu_model: old, cpu_cores: 4, cpu_mhz: 2000}
+- {cpu_model: old, cpu_cores: 4, cpu_mhz: 2000}
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	mdNew := filepath.Join(dir, "new.yaml")
+	if err = ioutil.WriteFile(mdNew, []byte(`server_hardware:
+- {cpu_model: new, cpu_cores: 16, cpu_mhz: 3000}
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ids := []string{"etcd__v3_2", "etcd__tip"}
+	paths := map[string]string{"etcd__v3_2": mdOld, "etcd__tip": mdNew}
+	throughputs := map[string]float64{"etcd__v3_2": 10000, "etcd__tip": 30000}
+
+	n, err := newThroughputNormalizer(normalizeCPUCores)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := n.rows(ids, paths, throughputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := [][]string{
+		{"SERVER-CPU-MODEL", "old", "new"},
+		{"SERVER-CPU-CORES", "4.0", "16.0"},
+		{"SERVER-CPU-MHZ", "2000", "3000"},
+		{"AVG-THROUGHPUT-PER-CPU-CORE", "2500.00", "1875.00"},
+	}
+	if !reflect.DeepEqual(rows, exp) {
+		t.Fatalf("expected %q, got %q", exp, rows)
+	}
+
+	n, _ = newThroughputNormalizer(normalizeCPUFrequency)
+	rows, err = n.rows(ids, paths, throughputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := []string{"AVG-THROUGHPUT-PER-CPU-GHZ", "5000.00", "10000.00"}; !reflect.DeepEqual(rows[3], exp) {
+		t.Fatalf("expected %q, got %q", exp, rows[3])
+	}
+
+	if _, err = newThroughputNormalizer("memory"); err == nil {
+		t.Fatal("expected error for unknown mode")
+	}
+	if _, err = n.rows(ids, map[string]string{"etcd__v3_2": mdOld}, throughputs); err == nil {
+		t.Fatal("expected error for missing run metadata")
+	}
+}
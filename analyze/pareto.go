@@ -0,0 +1,258 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"github.com/coreos/dbtester/pkg/table"
+	"github.com/spf13/cobra"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/plotutil"
+)
+
+// ParetoCommand implements 'analyze pareto' command.
+var ParetoCommand = &cobra.Command{
+	Use:   "pareto [flags] LABEL=LATENCY_HISTOGRAM_LOG...",
+	Short: "Plots throughput against latency percentile of runs, or of windows in runs, with the Pareto frontier of each database.",
+	RunE:  paretoCommandFunc,
+}
+
+var (
+	paretoWindow     time.Duration
+	paretoPercentile float64
+	paretoTag        string
+	paretoTitle      string
+	paretoOutput     string
+	paretoOutputCSV  string
+)
+
+func init() {
+	ParetoCommand.Flags().DurationVar(&paretoWindow, "window", 0, "Window to plot as a point (e.g. '1m'), or 0 to plot each run as a point.")
+	ParetoCommand.Flags().Float64Var(&paretoPercentile, "percentile", 99, "Latency percentile of each point.")
+	ParetoCommand.Flags().StringVar(&paretoTag, "tag", "", "Tag of histograms to read (e.g. 'read' or 'write' in mixed benchmark), or empty for all requests.")
+	ParetoCommand.Flags().StringVar(&paretoTitle, "title", "", "Plot title (default to the percentile).")
+	ParetoCommand.Flags().StringVar(&paretoOutput, "output", "", "Comma-separated plot file paths (e.g. 'pareto.svg,pareto.png').")
+	ParetoCommand.Flags().StringVar(&paretoOutputCSV, "output-csv", "", "CSV file path to save the points (optional).")
+	Command.AddCommand(ParetoCommand)
+}
+
+func paretoCommandFunc(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no latency histogram log given")
+	}
+	if paretoOutput == "" {
+		return fmt.Errorf("'--output' is required")
+	}
+	if paretoPercentile <= 0 || paretoPercentile > 100 {
+		return fmt.Errorf("invalid percentile %v", paretoPercentile)
+	}
+	if err := setPlotTheme(plotThemeName); err != nil {
+		return err
+	}
+
+	// the same label is given for runs of a database at different load levels
+	var labels []string
+	labelToPoints := make(map[string]plotter.XYs)
+	for _, arg := range args {
+		ss := strings.SplitN(arg, "=", 2)
+		if len(ss) != 2 || ss[0] == "" || ss[1] == "" {
+			return fmt.Errorf("expected LABEL=LATENCY_HISTOGRAM_LOG, got %q", arg)
+		}
+		entries, err := readHistogramLog(ss[1])
+		if err != nil {
+			return err
+		}
+		pts, err := paretoPoints(entries, paretoTag, paretoWindow, paretoPercentile)
+		if err != nil {
+			return fmt.Errorf("%q: %v", ss[1], err)
+		}
+		plog.Printf("read %d point(s) of %q from %q", len(pts), ss[0], ss[1])
+		if _, ok := labelToPoints[ss[0]]; !ok {
+			labels = append(labels, ss[0])
+		}
+		labelToPoints[ss[0]] = append(labelToPoints[ss[0]], pts...)
+	}
+
+	yAxis := fmt.Sprintf("P%g Latency(millisecond)", paretoPercentile)
+	title := paretoTitle
+	if title == "" {
+		title = "Throughput vs. " + yAxis
+	}
+	plt, data, err := plotPareto(title, yAxis, labels, labelToPoints)
+	if err != nil {
+		return err
+	}
+	var outputPaths []string
+	for _, outputPath := range strings.Split(paretoOutput, ",") {
+		if outputPath = strings.TrimSpace(outputPath); outputPath != "" {
+			outputPaths = append(outputPaths, outputPath)
+		}
+	}
+	if err = savePlot(plt, outputPaths, data); err != nil {
+		return err
+	}
+	plog.Printf("saved %q", outputPaths)
+
+	if paretoOutputCSV != "" {
+		if err = paretoTable(labels, labelToPoints, percentileColumn(paretoPercentile)).WriteCSV(paretoOutputCSV); err != nil {
+			return err
+		}
+		plog.Printf("saved points to %q", paretoOutputCSV)
+	}
+	return nil
+}
+
+// paretoPoints returns the points of throughput (X, requests per second)
+// and latency percentile (Y, milliseconds) of the histograms with the tag:
+// one point for all histograms if the window is zero, or one point per
+// window otherwise. Windows at the start and end are dropped if there are
+// more, since they only cover part of the window.
+func paretoPoints(entries []hdrhistogram.LogEntry, tag string, window time.Duration, pct float64) (plotter.XYs, error) {
+	if window > 0 {
+		merged, err := rebucketHistograms(entries, tag, window)
+		if err != nil {
+			return nil, err
+		}
+		if len(merged) > 2 {
+			merged = merged[1 : len(merged)-1]
+		}
+		var pts plotter.XYs
+		for _, e := range merged {
+			if e.Histogram.TotalCount() == 0 {
+				continue
+			}
+			pts = append(pts, struct{ X, Y float64 }{
+				X: float64(e.Histogram.TotalCount()) / window.Seconds(),
+				Y: float64(e.Histogram.ValueAtQuantile(pct)) / 1000,
+			})
+		}
+		if len(pts) == 0 {
+			return nil, fmt.Errorf("no request with tag %q", tag)
+		}
+		return pts, nil
+	}
+
+	var start, end time.Time
+	h, err := mergeHistograms(entries, func(e hdrhistogram.LogEntry) bool {
+		if e.Tag != tag {
+			return false
+		}
+		if start.IsZero() || e.Start.Before(start) {
+			start = e.Start
+		}
+		if t := e.Start.Add(e.Length); t.After(end) {
+			end = t
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if h == nil || h.TotalCount() == 0 || !end.After(start) {
+		return nil, fmt.Errorf("no request with tag %q", tag)
+	}
+	return plotter.XYs{{
+		X: float64(h.TotalCount()) / end.Sub(start).Seconds(),
+		Y: float64(h.ValueAtQuantile(pct)) / 1000,
+	}}, nil
+}
+
+// paretoFrontier returns the points not dominated by any other point,
+// with higher or equal throughput and lower or equal latency, in order
+// of throughput.
+func paretoFrontier(pts plotter.XYs) plotter.XYs {
+	sorted := make(plotter.XYs, len(pts))
+	copy(sorted, pts)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].X != sorted[j].X {
+			return sorted[i].X > sorted[j].X
+		}
+		return sorted[i].Y < sorted[j].Y
+	})
+	var frontier plotter.XYs
+	for _, p := range sorted {
+		if len(frontier) == 0 || p.Y < frontier[len(frontier)-1].Y {
+			frontier = append(frontier, p)
+		}
+	}
+	for i, j := 0, len(frontier)-1; i < j; i, j = i+1, j-1 {
+		frontier[i], frontier[j] = frontier[j], frontier[i]
+	}
+	return frontier
+}
+
+func plotPareto(title, yAxis string, labels []string, labelToPoints map[string]plotter.XYs) (*plot.Plot, *plotData, error) {
+	plt, err := newPlot()
+	if err != nil {
+		return nil, nil, err
+	}
+	plt.Title.Text = title
+	plt.X.Label.Text = "Throughput(requests per second)"
+	plt.Y.Label.Text = yAxis
+	plt.Legend.Top = true
+
+	data := &plotData{}
+	for i, label := range labels {
+		pts := labelToPoints[label]
+		sc, err := plotter.NewScatter(pts)
+		if err != nil {
+			return nil, nil, err
+		}
+		sc.Color = lineColor(plotutil.Color(i), i)
+		sc.Shape = plotutil.Shape(i)
+		plt.Add(sc)
+		data.add(label, pts)
+
+		frontier := paretoFrontier(pts)
+		l, err := plotter.NewLine(frontier)
+		if err != nil {
+			return nil, nil, err
+		}
+		l.Color = sc.Color
+		l.Dashes = plotutil.Dashes(i)
+		plt.Add(l)
+		plt.Legend.Add(label, sc, l)
+		data.add(label+" frontier", frontier)
+	}
+	return plt, data, nil
+}
+
+// paretoTable returns the points of all labels, with ON-FRONTIER
+// set to true for the points on the Pareto frontier of the label.
+func paretoTable(labels []string, labelToPoints map[string]plotter.XYs, latencyColumn string) *table.Table {
+	tb := table.New("LABEL", "REQUESTS-PER-SECOND", latencyColumn, "ON-FRONTIER")
+	for _, label := range labels {
+		pts := labelToPoints[label]
+		onFrontier := make(map[struct{ X, Y float64 }]bool)
+		for _, p := range paretoFrontier(pts) {
+			onFrontier[p] = true
+		}
+		for _, p := range pts {
+			tb.Rows = append(tb.Rows, []string{
+				label,
+				fmt.Sprintf("%.4f", p.X),
+				fmt.Sprintf("%.3f", p.Y),
+				fmt.Sprintf("%t", onFrontier[p]),
+			})
+		}
+	}
+	return tb
+}
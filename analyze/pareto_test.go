@@ -0,0 +1,97 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestParetoFrontier(t *testing.T) {
+	pts := plotter.XYs{
+		{X: 1000, Y: 2},
+		{X: 2000, Y: 3},
+		// dominated by 2000 requests/s at 3 ms
+		{X: 1500, Y: 4},
+		{X: 3000, Y: 10},
+		// same throughput with higher latency
+		{X: 3000, Y: 12},
+		{X: 500, Y: 2},
+	}
+	exp := plotter.XYs{{X: 1000, Y: 2}, {X: 2000, Y: 3}, {X: 3000, Y: 10}}
+	if got := paretoFrontier(pts); !reflect.DeepEqual(got, exp) {
+		t.Fatalf("expected %v, got %v", exp, got)
+	}
+	if pts[0].X != 1000 || pts[5].X != 500 {
+		t.Fatalf("expected points unchanged, got %v", pts)
+	}
+
+	tb := paretoTable([]string{"etcd"}, map[string]plotter.XYs{"etcd": pts}, "P99-LATENCY-MS")
+	var on []string
+	for _, row := range tb.Rows {
+		on = append(on, row[3])
+	}
+	if expOn := []string{"true", "true", "false", "true", "false", "false"}; !reflect.DeepEqual(on, expOn) {
+		t.Fatalf("expected %v, got %v", expOn, on)
+	}
+}
+
+func TestParetoPoints(t *testing.T) {
+	// 100 requests per second at 1 ms for 3 minutes, then at 5 ms
+	// for 1 minute, starting 30 seconds into a minute
+	var entries []hdrhistogram.LogEntry
+	for sec := int64(0); sec < 240; sec++ {
+		h, _ := hdrhistogram.New(1, 3600*1000*1000, 3)
+		v := int64(1000)
+		if sec >= 180 {
+			v = 5000
+		}
+		h.RecordValues(v, 100)
+		entries = append(entries, hdrhistogram.LogEntry{Start: time.Unix(1500000030+sec, 0), Length: time.Second, Histogram: h})
+	}
+
+	pts, err := paretoPoints(entries, "", 0, 99)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pts) != 1 || pts[0].X != 100 || pts[0].Y < 4.99 || pts[0].Y > 5.01 {
+		t.Fatalf("unexpected run point %v", pts)
+	}
+
+	// partial windows at the start and end are dropped
+	pts, err = paretoPoints(entries, "", time.Minute, 99)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pts) != 3 {
+		t.Fatalf("expected 3 windows, got %v", pts)
+	}
+	for i, p := range pts {
+		if p.X != 100 {
+			t.Fatalf("#%d: expected 100 requests/s, got %v", i, p.X)
+		}
+	}
+	if pts[0].Y > 1.01 || pts[2].Y < 4.99 {
+		t.Fatalf("unexpected window latencies %v", pts)
+	}
+
+	if _, err = paretoPoints(entries, "read", 0, 99); err == nil {
+		t.Fatal("expected error for missing tag")
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"github.com/coreos/dbtester/pkg/table"
+	"github.com/gyuho/dataframe"
+)
+
+// defaultClientSaturationCPUPercent is the default client CPU usage,
+// at or above which the client is considered saturated.
+const defaultClientSaturationCPUPercent = 90.0
+
+// saturationThroughputRatio is the fraction of the offered load,
+// below which the offered load is considered not achieved.
+const saturationThroughputRatio = 0.9
+
+// clientSaturatedColumn marks the seconds where the client was saturated
+// with 1, and others with 0.
+const clientSaturatedColumn = "CLIENT-SATURATED"
+
+// summaryPercentiles are the latency percentiles saved by the client.
+var summaryPercentiles = []float64{10, 25, 50, 75, 90, 95, 99, 99.9}
+
+// offeredLoad returns the requests per second the client was configured
+// to issue, or 0 if the rate was not limited or changed during the run.
+func offeredLoad(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) int64 {
+	if opts == nil {
+		return 0
+	}
+	if opts.ThroughputCeiling != nil || len(opts.BatchSizes) > 0 {
+		return 0
+	}
+	if len(opts.TenantGroups) > 0 {
+		var sum int64
+		for _, g := range opts.TenantGroups {
+			if g.RateLimitRequestsPerSecond <= 0 {
+				return 0
+			}
+			sum += g.RateLimitRequestsPerSecond
+		}
+		return sum
+	}
+	return opts.RateLimitRequestsPerSecond
+}
+
+// saturatedSeconds returns the Unix seconds where the throughput was below
+// the offered load, while the client CPU usage per core was at or above
+// 'cpuPercent'. Then the client, not the database, may have limited the
+// throughput, and latencies of those seconds include the time requests
+// waited in the client.
+func saturatedSeconds(timeseries, clientSys *table.Table, target int64, cpuPercent float64, cores int64) (map[int64]bool, error) {
+	cpu, err := secondValues(clientSys, "CPU-NUM", false)
+	if err != nil {
+		return nil, err
+	}
+	for sec, v := range cpu {
+		cpu[sec] = perCoreCPUPercent(v, cores)
+	}
+	// duplicate timestamps are added up, as in 'importBenchMetrics'
+	throughput, err := secondValues(timeseries, "AVG-THROUGHPUT", true)
+	if err != nil {
+		return nil, err
+	}
+
+	saturated := make(map[int64]bool)
+	for sec, thr := range throughput {
+		if thr >= float64(target)*saturationThroughputRatio {
+			continue
+		}
+		if v, ok := cpu[sec]; ok && cpuAtOrAbove(v, cpuPercent) {
+			saturated[sec] = true
+		}
+	}
+	return saturated, nil
+}
+
+// cpuAtOrAbove returns true if the client CPU usage per core reached
+// the threshold, which is also per core (100 when all cores are busy).
+func cpuAtOrAbove(perCore, threshold float64) bool {
+	return threshold > 0 && perCore >= threshold
+}
+
+// perCoreCPUPercent returns the CPU usage of all cores (e.g. 400 % when
+// 4 cores are busy) per core, or the usage as is if cores are unknown.
+func perCoreCPUPercent(v float64, cores int64) float64 {
+	if cores <= 0 {
+		return v
+	}
+	return v / float64(cores)
+}
+
+// secondValues returns the values of the column by Unix second.
+// Values of duplicate timestamps are added up if 'add' is true,
+// or the last one is kept.
+func secondValues(tb *table.Table, column string, add bool) (map[int64]float64, error) {
+	secs, err := tb.Column("UNIX-SECOND")
+	if err != nil {
+		return nil, err
+	}
+	vs, err := tb.Column(column)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[int64]float64, len(secs))
+	for i := range secs {
+		sec, err := strconv.ParseInt(secs[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		fv, err := strconv.ParseFloat(vs[i], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s at %d: %v", column, sec, err)
+		}
+		if add {
+			m[sec] += fv
+		} else {
+			m[sec] = fv
+		}
+	}
+	return m, nil
+}
+
+// detectClientSaturation returns the seconds where the client was saturated.
+// Unless 'include' is true, latency percentiles are recomputed without
+// those seconds from the latency histogram log, saved in 'dir', and the
+// percentile path is updated to them.
+func detectClientSaturation(testdata *dbtesterpb.ConfigAnalyzeMachineInitial, dir string, target int64, cpuPercent float64, cores int64, include bool) (map[int64]bool, error) {
+	timeseries, err := table.ReadCSV(testdata.ClientLatencyThroughputTimeseriesPath)
+	if err != nil {
+		return nil, err
+	}
+	clientSys, err := table.ReadCSV(testdata.ClientSystemMetricsInterpolatedPath)
+	if err != nil {
+		return nil, err
+	}
+	saturated, err := saturatedSeconds(timeseries, clientSys, target, cpuPercent, cores)
+	if err != nil {
+		return nil, err
+	}
+	if len(saturated) == 0 || include {
+		return saturated, nil
+	}
+
+	if _, err = os.Stat(testdata.ClientLatencyHistogramLogPath); testdata.ClientLatencyHistogramLogPath == "" || err != nil {
+		plog.Warningf("%s: no latency histogram log to exclude %d client-saturated second(s) from latency percentiles", testdata.DatabaseID, len(saturated))
+		return saturated, nil
+	}
+	entries, err := readHistogramLog(testdata.ClientLatencyHistogramLogPath)
+	if err != nil {
+		return nil, err
+	}
+	tb, err := percentilesExcluding(entries, saturated)
+	if err != nil {
+		return nil, err
+	}
+	f, err := ioutil.TempFile(dir, filepath.Base(testdata.ClientLatencyDistributionPercentilePath))
+	if err != nil {
+		return nil, err
+	}
+	f.Close()
+	if err = tb.WriteCSV(f.Name()); err != nil {
+		return nil, err
+	}
+	plog.Printf("%s: excluded %d client-saturated second(s) from latency percentiles", testdata.DatabaseID, len(saturated))
+	testdata.ClientLatencyDistributionPercentilePath = f.Name()
+	return saturated, nil
+}
+
+// percentilesExcluding returns the latency percentiles of all requests,
+// except the ones in the excluded seconds, in the format of the client.
+func percentilesExcluding(entries []hdrhistogram.LogEntry, excluded map[int64]bool) (*table.Table, error) {
+	merged, err := rebucketHistograms(entries, "", time.Second)
+	if err != nil {
+		return nil, err
+	}
+	h, err := mergeHistograms(merged, func(e hdrhistogram.LogEntry) bool { return !excluded[e.Start.Unix()] })
+	if err != nil {
+		return nil, err
+	}
+	if h == nil || h.TotalCount() == 0 {
+		return nil, fmt.Errorf("no request left after excluding %d second(s)", len(excluded))
+	}
+
+	tb := table.New("LATENCY-PERCENTILE", "LATENCY-MS")
+	for _, p := range summaryPercentiles {
+		pct := "p" + strings.TrimSuffix(fmt.Sprintf("%.1f", p), ".0")
+		tb.Rows = append(tb.Rows, []string{pct, fmt.Sprintf("%f", float64(h.ValueAtQuantile(p))/1000)})
+	}
+	return tb, nil
+}
+
+// markClientSaturated adds the column of client-saturated seconds
+// to the benchmark metrics.
+func (data *analyzeData) markClientSaturated(saturated map[int64]bool) error {
+	col := dataframe.NewColumn(clientSaturatedColumn)
+	n := data.benchMetrics.lastUnixSecond - data.benchMetrics.frontUnixSecond + 1
+	for i := int64(0); i < n; i++ {
+		v := 0
+		if saturated[data.benchMetrics.frontUnixSecond+i] {
+			v = 1
+		}
+		col.PushBack(dataframe.NewStringValue(v))
+	}
+	return data.benchMetrics.frame.AddColumn(col)
+}
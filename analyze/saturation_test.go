@@ -0,0 +1,138 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"github.com/coreos/dbtester/pkg/table"
+)
+
+func TestOfferedLoad(t *testing.T) {
+	tests := []struct {
+		opts   *dbtesterpb.ConfigClientMachineBenchmarkOptions
+		target int64
+	}{
+		{&dbtesterpb.ConfigClientMachineBenchmarkOptions{RateLimitRequestsPerSecond: 1000}, 1000},
+		{&dbtesterpb.ConfigClientMachineBenchmarkOptions{}, 0},
+		{&dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			TenantGroups: []*dbtesterpb.ConfigClientMachineTenantGroup{{RateLimitRequestsPerSecond: 800}, {RateLimitRequestsPerSecond: 200}},
+		}, 1000},
+		{&dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			TenantGroups: []*dbtesterpb.ConfigClientMachineTenantGroup{{RateLimitRequestsPerSecond: 800}, {}},
+		}, 0},
+		{&dbtesterpb.ConfigClientMachineBenchmarkOptions{RateLimitRequestsPerSecond: 1000, BatchSizes: []int64{10}}, 0},
+	}
+	for i, tt := range tests {
+		if target := offeredLoad(tt.opts); target != tt.target {
+			t.Fatalf("#%d: expected %d, got %d", i, tt.target, target)
+		}
+	}
+}
+
+func TestSaturatedSeconds(t *testing.T) {
+	timeseries := table.New("UNIX-SECOND", "AVG-THROUGHPUT")
+	timeseries.Rows = [][]string{
+		{"100", "1000"},
+		{"101", "500"}, // short, client busy
+		{"102", "500"}, // short, client idle
+		{"103", "400"}, // duplicate timestamps are added up
+		{"103", "500"},
+		{"104", "300"}, // short, no client metrics
+	}
+	clientSys := table.New("UNIX-SECOND", "CPU-NUM")
+	clientSys.Rows = [][]string{
+		{"100", "396"},
+		{"101", "380"},
+		{"102", "200"}, // half of 4 cores busy
+		{"103", "380"},
+	}
+	saturated, err := saturatedSeconds(timeseries, clientSys, 1000, 90, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := map[int64]bool{101: true}; !reflect.DeepEqual(saturated, exp) {
+		t.Fatalf("expected %v, got %v", exp, saturated)
+	}
+
+	// all cores add up without client cores
+	if saturated, err = saturatedSeconds(timeseries, clientSys, 1000, 90, 0); err != nil {
+		t.Fatal(err)
+	}
+	if exp := map[int64]bool{101: true, 102: true}; !reflect.DeepEqual(saturated, exp) {
+		t.Fatalf("expected %v, got %v", exp, saturated)
+	}
+}
+
+func TestCPUAtOrAbove(t *testing.T) {
+	tests := []struct {
+		allCores  float64
+		cores     int64
+		threshold float64
+		expected  bool
+	}{
+		{380, 4, 90, true},
+		{300, 4, 90, false},
+		{85, 0, 90, false},
+		{95, 0, 90, true},
+		{400, 4, 0, false},
+	}
+	for i, tt := range tests {
+		if v := cpuAtOrAbove(perCoreCPUPercent(tt.allCores, tt.cores), tt.threshold); v != tt.expected {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.expected, v)
+		}
+	}
+}
+
+func TestPercentilesExcluding(t *testing.T) {
+	var entries []hdrhistogram.LogEntry
+	for sec := int64(0); sec < 4; sec++ {
+		h, _ := hdrhistogram.New(1, 3600*1000*1000, 3)
+		v := int64(1000)
+		if sec == 2 {
+			// saturated second, with requests waiting in the client
+			v = 100000
+		}
+		h.RecordValues(v, 10)
+		entries = append(entries, hdrhistogram.LogEntry{Start: time.Unix(1500000000+sec, 0), Length: time.Second, Histogram: h})
+	}
+
+	tb, err := percentilesExcluding(entries, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tb.Header, []string{"LATENCY-PERCENTILE", "LATENCY-MS"}) {
+		t.Fatalf("unexpected header %q", tb.Header)
+	}
+	if len(tb.Rows) != len(summaryPercentiles) || tb.Rows[7][0] != "p99.9" || tb.Rows[7][1] != "100.031000" {
+		t.Fatalf("unexpected rows %q", tb.Rows)
+	}
+
+	tb, err = percentilesExcluding(entries, map[int64]bool{1500000002: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tb.Rows[0][0] != "p10" || tb.Rows[7][1] != "1.000000" {
+		t.Fatalf("unexpected rows %q", tb.Rows)
+	}
+
+	if _, err = percentilesExcluding(entries[:1], map[int64]bool{1500000000: true}); err == nil {
+		t.Fatal("expected error with no request left")
+	}
+}
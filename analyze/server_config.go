@@ -0,0 +1,81 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/olekukonko/tablewriter"
+)
+
+// serverConfigTable returns the table of command lines and config files
+// of database servers in run metadata, followed by the content of config
+// files (printed once if all servers of the database share it). It returns
+// an empty string if no run metadata has server configs.
+func serverConfigTable(databaseIDs []string, mdPaths map[string]string) string {
+	var rows [][]string
+	configs := new(bytes.Buffer)
+	for _, databaseID := range databaseIDs {
+		fpath := mdPaths[databaseID]
+		if fpath == "" {
+			continue
+		}
+		md, err := dbtester.ReadRunMetadata(fpath)
+		if err != nil {
+			plog.Warningf("cannot read run metadata %q for server configs (%v)", fpath, err)
+			continue
+		}
+
+		var contents []string
+		servers := make(map[string][]string)
+		for i, sc := range md.ServerConfigs {
+			server := fmt.Sprintf("%d", i+1)
+			cmd := sc.CommandLine
+			if sc.ProxyCommandLine != "" {
+				cmd += " (proxy: " + sc.ProxyCommandLine + ")"
+			}
+			configPath := sc.ConfigPath
+			if configPath == "" {
+				configPath = "-"
+			}
+			rows = append(rows, []string{databaseID, server, cmd, configPath})
+
+			if sc.Config == "" {
+				continue
+			}
+			if _, ok := servers[sc.Config]; !ok {
+				contents = append(contents, sc.Config)
+			}
+			servers[sc.Config] = append(servers[sc.Config], server)
+		}
+		for _, c := range contents {
+			fmt.Fprintf(configs, "\n%s config file (server %s):\n%s\n", databaseID, strings.Join(servers[c], ", "), strings.TrimRight(c, "\n"))
+		}
+	}
+	if len(rows) == 0 {
+		return ""
+	}
+
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader([]string{"DATABASE", "SERVER", "COMMAND-LINE", "CONFIG-FILE"})
+	tw.AppendBulk(rows)
+	tw.SetAutoFormatHeaders(false)
+	tw.Render()
+	return "SERVER CONFIGURATION:\n" + buf.String() + configs.String()
+}
@@ -0,0 +1,68 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestServerConfigTable(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "server-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	zkPath := filepath.Join(dir, "zookeeper.yaml")
+	zk := `server_configs:
+- command_line: java -Xms50G zookeeper.config
+  config_path: /home/gyuho/zookeeper/zookeeper.config
+  config: |
+    tickTime=2000
+- command_line: java -Xms50G zookeeper.config
+  config_path: /home/gyuho/zookeeper/zookeeper.config
+  config: |
+    tickTime=2000
+`
+	if err = ioutil.WriteFile(zkPath, []byte(zk), 0644); err != nil {
+		t.Fatal(err)
+	}
+	etcdPath := filepath.Join(dir, "etcd.yaml")
+	if err = ioutil.WriteFile(etcdPath, []byte("server_configs:\n- command_line: etcd --snapshot-count 100000\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if txt := serverConfigTable([]string{"etcd__v3_3"}, map[string]string{}); txt != "" {
+		t.Fatalf("expected no table without run metadata, got %q", txt)
+	}
+	txt := serverConfigTable([]string{"etcd__v3_3", "zookeeper__r3_5_3_beta"}, map[string]string{
+		"etcd__v3_3":             etcdPath,
+		"zookeeper__r3_5_3_beta": zkPath,
+	})
+	for _, s := range []string{
+		"SERVER CONFIGURATION",
+		"--snapshot-count",
+		"/home/gyuho/zookeeper/zookeeper.config",
+		"zookeeper__r3_5_3_beta config file (server 1, 2):\ntickTime=2000\n",
+	} {
+		if !strings.Contains(txt, s) {
+			t.Fatalf("expected %q in\n%s", s, txt)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/csvmmap"
+)
+
+const (
+	defaultSuspectMonitoringGapSeconds = 5
+	defaultSuspectCPUStealPercent      = 5.0
+	defaultSuspectClockDriftMs         = 500.0
+)
+
+// suspectThresholds are the thresholds to flag a run as suspect,
+// where negative values disable the check.
+type suspectThresholds struct {
+	monitoringGapSeconds int64
+	cpuStealPercent      float64
+	clockDriftMs         float64
+}
+
+func newSuspectThresholds(cfg dbtesterpb.ConfigAnalyzeMachineAllAggregatedOutput) suspectThresholds {
+	th := suspectThresholds{
+		monitoringGapSeconds: cfg.SuspectMonitoringGapSeconds,
+		cpuStealPercent:      cfg.SuspectCPUStealPercent,
+		clockDriftMs:         cfg.SuspectClockDriftMs,
+	}
+	if th.monitoringGapSeconds == 0 {
+		th.monitoringGapSeconds = defaultSuspectMonitoringGapSeconds
+	}
+	if th.cpuStealPercent == 0 {
+		th.cpuStealPercent = defaultSuspectCPUStealPercent
+	}
+	if th.clockDriftMs == 0 {
+		th.clockDriftMs = defaultSuspectClockDriftMs
+	}
+	return th
+}
+
+// suspectFlag is a reason that the results of a run may be invalid,
+// regardless of the database under test.
+type suspectFlag struct {
+	kind   string // "failed", "monitoring-gap", "server-restart", "cpu-steal", or "clock-drift"
+	reason string
+}
+
+func (f suspectFlag) String() string { return f.kind + " (" + f.reason + ")" }
+
+// suspectFlags returns the flags of a run from the raw system metrics and
+// host metrics of each server, and the failure and clock offsets in run
+// metadata (optional).
+// Restarts are not flagged if 'restartsExpected' is true (e.g. faults
+// injected by nemesis schedule, or rolling restarts).
+func suspectFlags(th suspectThresholds, serverMetricsPaths []string, md *dbtester.RunMetadata, restartsExpected bool) ([]suspectFlag, error) {
+	var flags []suspectFlag
+	if md != nil && md.Failure != "" {
+		flags = append(flags, suspectFlag{kind: "failed", reason: md.Failure})
+	}
+	maxGap := th.monitoringGapSeconds
+	if md != nil && md.MonitorIntervalMs > 1000 {
+		// samples are expected to be apart by the monitor interval
+		maxGap += (md.MonitorIntervalMs+999)/1000 - 1
+	}
+	for i, fpath := range serverMetricsPaths {
+		// only the needed columns of (possibly large) metrics are read
+		cols, err := readColumns(fpath, "UNIX-SECOND", "PID")
+		if err != nil {
+			return nil, err
+		}
+		if th.monitoringGapSeconds >= 0 {
+			gaps, longest, at, err := monitoringGaps(cols[0], maxGap)
+			if err != nil {
+				return nil, fmt.Errorf("%q: %v", fpath, err)
+			}
+			if gaps > 0 {
+				flags = append(flags, suspectFlag{
+					kind:   "monitoring-gap",
+					reason: fmt.Sprintf("server %d has %d gap(s) in metrics, longest %d sec after unix second %d", i+1, gaps, longest, at),
+				})
+			}
+		}
+		if !restartsExpected {
+			if n := processRestarts(cols[1]); n > 0 {
+				flags = append(flags, suspectFlag{
+					kind:   "server-restart",
+					reason: fmt.Sprintf("server %d database PID changed %d time(s)", i+1, n),
+				})
+			}
+		}
+		if th.cpuStealPercent >= 0 {
+			hostPath := strings.TrimSuffix(fpath, ".csv") + "-host.csv"
+			if _, err := os.Stat(hostPath); err != nil {
+				continue
+			}
+			host, err := readColumns(hostPath, "CPU-STEAL-PERCENT")
+			if err != nil {
+				return nil, err
+			}
+			avg, max, err := cpuSteal(host[0])
+			if err != nil {
+				return nil, fmt.Errorf("%q: %v", hostPath, err)
+			}
+			if avg >= th.cpuStealPercent {
+				flags = append(flags, suspectFlag{
+					kind:   "cpu-steal",
+					reason: fmt.Sprintf("server %d avg CPU steal %.2f %% (max %.2f %%) >= %.2f %%", i+1, avg, max, th.cpuStealPercent),
+				})
+			}
+		}
+	}
+
+	if md != nil && th.clockDriftMs >= 0 {
+		for i, sc := range md.ServerClockOffsets {
+			if drift := clockDriftMs(sc.Samples); drift >= th.clockDriftMs {
+				flags = append(flags, suspectFlag{
+					kind:   "clock-drift",
+					reason: fmt.Sprintf("server %d clock drifted %.2f ms during the run", i+1, drift),
+				})
+			}
+		}
+	}
+	return flags, nil
+}
+
+// readColumns returns the values of the named columns in the CSV file.
+func readColumns(fpath string, names ...string) ([][]string, error) {
+	rd, err := csvmmap.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer rd.Close()
+	return rd.Columns(names...)
+}
+
+// monitoringGaps returns the number of gaps longer than 'maxSeconds'
+// between consecutive samples, the longest gap, and when it started.
+func monitoringGaps(secs []string, maxSeconds int64) (gaps int, longest, at int64, err error) {
+	var prev int64
+	for i, v := range secs {
+		sec, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid UNIX-SECOND %q (%v)", v, err)
+		}
+		if i > 0 {
+			if gap := sec - prev; gap > maxSeconds {
+				gaps++
+				if gap > longest {
+					longest, at = gap, prev
+				}
+			}
+		}
+		prev = sec
+	}
+	return gaps, longest, at, nil
+}
+
+// processRestarts returns the number of times the PID of the database
+// process changed in system metrics.
+func processRestarts(pids []string) int {
+	n := 0
+	for i := 1; i < len(pids); i++ {
+		if pids[i] != pids[i-1] && pids[i] != "" && pids[i-1] != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// cpuSteal returns the average and maximum CPU steal percent in host
+// metrics, skipping the samples that agents failed to read.
+func cpuSteal(vs []string) (avg, max float64, err error) {
+	var sum float64
+	n := 0
+	for _, v := range vs {
+		if v == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid CPU-STEAL-PERCENT %q (%v)", v, err)
+		}
+		sum += f
+		if f > max {
+			max = f
+		}
+		n++
+	}
+	if n > 0 {
+		avg = sum / float64(n)
+	}
+	return avg, max, nil
+}
+
+// clockDriftMs returns how much the clock offset of an agent changed
+// during the run. Offsets themselves are corrected in analysis.
+func clockDriftMs(samples []dbtester.ClockOffsetSample) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+	min, max := samples[0].OffsetMs, samples[0].OffsetMs
+	for _, s := range samples[1:] {
+		if s.OffsetMs < min {
+			min = s.OffsetMs
+		}
+		if s.OffsetMs > max {
+			max = s.OffsetMs
+		}
+	}
+	return max - min
+}
+
+// suspectRow returns the summary row of suspect flags of each database,
+// and the flag lines to print above the summary.
+func suspectRow(databaseIDs []string, flags map[string][]suspectFlag) ([]string, []string) {
+	row := []string{"SUSPECT-RUN"}
+	var lines []string
+	for _, databaseID := range databaseIDs {
+		fs := flags[databaseID]
+		if len(fs) == 0 {
+			row = append(row, "-")
+			continue
+		}
+		var kinds []string
+		seen := make(map[string]bool)
+		for _, f := range fs {
+			if !seen[f.kind] {
+				seen[f.kind] = true
+				kinds = append(kinds, f.kind)
+			}
+			lines = append(lines, databaseID+": "+f.String())
+		}
+		row = append(row, strings.Join(kinds, ", "))
+	}
+	return row, lines
+}
@@ -0,0 +1,111 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestSuspectFlags(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "suspect")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		// 12-second gap, and restarted
+		"1-server-system-metrics.csv": "UNIX-SECOND,PID\n100,7\n101,7\n113,9\n114,9\n",
+		// no gaps, with high steal time
+		"2-server-system-metrics.csv":      "UNIX-SECOND,PID\n100,8\n101,8\n102,8\n",
+		"2-server-system-metrics-host.csv": "UNIX-SECOND,CPU-STEAL-PERCENT\n100,\n101,4.00\n102,10.00\n",
+		// no host metrics
+		"3-server-system-metrics.csv": "UNIX-SECOND,PID\n100,5\n104,5\n",
+	}
+	var paths []string
+	for _, name := range []string{"1-server-system-metrics.csv", "2-server-system-metrics.csv", "3-server-system-metrics.csv"} {
+		paths = append(paths, filepath.Join(dir, name))
+	}
+	for name, txt := range files {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte(txt), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	md := &dbtester.RunMetadata{
+		ServerClockOffsets: []dbtester.ServerClockOffsets{
+			{Samples: []dbtester.ClockOffsetSample{{OffsetMs: 10}, {OffsetMs: 30}}},
+			{Samples: []dbtester.ClockOffsetSample{{OffsetMs: -400}, {OffsetMs: 300}, {OffsetMs: 0}}},
+		},
+	}
+	th := suspectThresholds{monitoringGapSeconds: 5, cpuStealPercent: 5, clockDriftMs: 500}
+
+	tests := []struct {
+		th               suspectThresholds
+		restartsExpected bool
+		reasons          []string
+	}{
+		{
+			th, false,
+			[]string{
+				"monitoring-gap (server 1 has 1 gap(s) in metrics, longest 12 sec after unix second 101)",
+				"server-restart (server 1 database PID changed 1 time(s))",
+				"cpu-steal (server 2 avg CPU steal 7.00 % (max 10.00 %) >= 5.00 %)",
+				"clock-drift (server 2 clock drifted 700.00 ms during the run)",
+			},
+		},
+		{
+			suspectThresholds{monitoringGapSeconds: -1, cpuStealPercent: 8, clockDriftMs: -1}, true,
+			nil,
+		},
+	}
+	for i, tt := range tests {
+		flags, err := suspectFlags(tt.th, paths, md, tt.restartsExpected)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var reasons []string
+		for _, f := range flags {
+			reasons = append(reasons, f.String())
+		}
+		if !reflect.DeepEqual(reasons, tt.reasons) {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.reasons, reasons)
+		}
+	}
+
+	row, lines := suspectRow([]string{"etcd__v3_3", "consul__v1_0_2"}, map[string][]suspectFlag{
+		"consul__v1_0_2": {{kind: "cpu-steal", reason: "a"}, {kind: "cpu-steal", reason: "b"}, {kind: "clock-drift", reason: "c"}},
+	})
+	if !reflect.DeepEqual(row, []string{"SUSPECT-RUN", "-", "cpu-steal, clock-drift"}) {
+		t.Fatalf("unexpected row %q", row)
+	}
+	if len(lines) != 3 || lines[0] != "consul__v1_0_2: cpu-steal (a)" {
+		t.Fatalf("unexpected lines %q", lines)
+	}
+}
+
+func TestNewSuspectThresholds(t *testing.T) {
+	th := newSuspectThresholds(dbtesterpb.ConfigAnalyzeMachineAllAggregatedOutput{SuspectCPUStealPercent: -1, SuspectClockDriftMs: 100})
+	exp := suspectThresholds{monitoringGapSeconds: defaultSuspectMonitoringGapSeconds, cpuStealPercent: -1, clockDriftMs: 100}
+	if th != exp {
+		t.Fatalf("expected %+v, got %+v", exp, th)
+	}
+}
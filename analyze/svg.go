@@ -0,0 +1,112 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+)
+
+// plotData is the source data of a plot, embedded in SVG outputs
+// so that exact values can be recovered from published figures.
+type plotData struct {
+	Title  string       `json:"title"`
+	XAxis  string       `json:"x_axis"`
+	YAxis  string       `json:"y_axis"`
+	Series []plotSeries `json:"series"`
+}
+
+type plotSeries struct {
+	Label  string       `json:"label"`
+	Points [][2]float64 `json:"points"`
+}
+
+// add adds the series, dropping NaN and infinite points (e.g. empty
+// intervals), which cannot be encoded in JSON.
+func (d *plotData) add(label string, pts plotter.XYs) {
+	s := plotSeries{Label: label, Points: make([][2]float64, 0, len(pts))}
+	for i := range pts {
+		if !isFinite(pts[i].X) || !isFinite(pts[i].Y) {
+			continue
+		}
+		s.Points = append(s.Points, [2]float64{pts[i].X, pts[i].Y})
+	}
+	d.Series = append(d.Series, s)
+}
+
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// savePlot saves the plot to all output paths, and embeds the data
+// in SVG outputs.
+func savePlot(plt *plot.Plot, outputPaths []string, data *plotData) error {
+	data.Title = plt.Title.Text
+	data.XAxis = plt.X.Label.Text
+	data.YAxis = plt.Y.Label.Text
+	for _, outputPath := range outputPaths {
+		if err := plt.Save(plotWidth, plotHeight, outputPath); err != nil {
+			return err
+		}
+		if strings.ToLower(filepath.Ext(outputPath)) != ".svg" {
+			continue
+		}
+		if err := embedSVGData(outputPath, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// svgDataID is the ID of SVG metadata element with the plot data in JSON.
+const svgDataID = "dbtester-data"
+
+// embedSVGData inserts the data as metadata right after the root element.
+func embedSVGData(fpath string, data *plotData) error {
+	svg, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return err
+	}
+	idx := bytes.Index(svg, []byte("<svg"))
+	if idx == -1 {
+		return fmt.Errorf("no <svg> element in %q", fpath)
+	}
+	end := bytes.IndexByte(svg[idx:], '>')
+	if end == -1 {
+		return fmt.Errorf("unterminated <svg> element in %q", fpath)
+	}
+	end += idx + 1
+
+	js, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	// "]]>" cannot appear in CDATA section
+	js = bytes.Replace(js, []byte("]]>"), []byte("]]]]><![CDATA[>"), -1)
+
+	var buf bytes.Buffer
+	buf.Write(svg[:end])
+	fmt.Fprintf(&buf, "\n<metadata id=%q><![CDATA[%s]]></metadata>", svgDataID, js)
+	buf.Write(svg[end:])
+	return ioutil.WriteFile(fpath, buf.Bytes(), 0644)
+}
@@ -0,0 +1,121 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestSavePlotEmbedsSVGData(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "svgdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	plt, err := newPlot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	plt.Title.Text = "test"
+	pts := plotter.XYs{{X: 0, Y: 1.25}, {X: 1, Y: 2.5}}
+	l, err := plotter.NewLine(pts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plt.Add(l)
+
+	data := &plotData{}
+	data.add("etcd ]]> v3.3", pts)
+	svgPath, pngPath := filepath.Join(dir, "test.svg"), filepath.Join(dir, "test.png")
+	if err = savePlot(plt, []string{svgPath, pngPath}, data); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readSVGData(svgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Fatalf("expected %+v, got %+v", data, got)
+	}
+	if got.Title != "test" {
+		t.Fatalf("expected title 'test', got %q", got.Title)
+	}
+	if _, err = readSVGData(pngPath); err == nil {
+		t.Fatal("expected no data embedded in PNG")
+	}
+}
+
+func TestEmbedSVGDataNonFinite(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "svgdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	svgPath := filepath.Join(dir, "test.svg")
+	if err = ioutil.WriteFile(svgPath, []byte("<svg></svg>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	data := &plotData{}
+	data.add("etcd", plotter.XYs{{X: 0, Y: 1}, {X: 1, Y: math.NaN()}, {X: math.Inf(1), Y: 2}, {X: 3, Y: math.Inf(-1)}, {X: 4, Y: 5}})
+	if err = embedSVGData(svgPath, data); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readSVGData(svgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := [][2]float64{{0, 1}, {4, 5}}
+	if !reflect.DeepEqual(got.Series[0].Points, exp) {
+		t.Fatalf("expected %v, got %v", exp, got.Series[0].Points)
+	}
+}
+
+// readSVGData reads the data embedded by embedSVGData.
+func readSVGData(fpath string) (*plotData, error) {
+	svg, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	start := fmt.Sprintf("<metadata id=%q><![CDATA[", svgDataID)
+	idx := bytes.Index(svg, []byte(start))
+	if idx == -1 {
+		return nil, fmt.Errorf("no data embedded in %q", fpath)
+	}
+	svg = svg[idx+len(start):]
+	end := bytes.Index(svg, []byte("]]></metadata>"))
+	if end == -1 {
+		return nil, fmt.Errorf("unterminated data in %q", fpath)
+	}
+	js := bytes.Replace(svg[:end], []byte("]]]]><![CDATA[>"), []byte("]]>"), -1)
+
+	var data plotData
+	if err = json.Unmarshal(js, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
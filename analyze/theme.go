@@ -0,0 +1,177 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"image/color"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// plotTheme controls fonts, background, grid, and palette of all plots.
+// Zero values keep the defaults.
+type plotTheme struct {
+	font       string
+	titleSize  vg.Length
+	labelSize  vg.Length
+	tickSize   vg.Length
+	lineWidth  vg.Length
+	background color.Color
+	foreground color.Color
+	// grid is the color of grid lines, nil for no grid
+	grid color.Color
+	// palette replaces colors of databases in the order of lines, if not empty
+	palette []color.Color
+}
+
+var plotThemes = map[string]plotTheme{
+	"default": {},
+	"dark": {
+		background: color.RGBA{R: 30, G: 30, B: 30, A: 255},
+		foreground: color.RGBA{R: 220, G: 220, B: 220, A: 255},
+		grid:       color.RGBA{R: 70, G: 70, B: 70, A: 255},
+		palette: []color.Color{
+			color.RGBA{R: 102, G: 178, B: 255, A: 255},
+			color.RGBA{R: 255, G: 179, B: 71, A: 255},
+			color.RGBA{R: 127, G: 219, B: 127, A: 255},
+			color.RGBA{R: 255, G: 105, B: 140, A: 255},
+			color.RGBA{R: 200, G: 160, B: 255, A: 255},
+			color.RGBA{R: 255, G: 240, B: 120, A: 255},
+		},
+	},
+	"print": {
+		font:       "Times-Roman",
+		background: color.White,
+		foreground: color.Black,
+		grid:       color.Gray{Y: 220},
+		palette: []color.Color{
+			color.Gray{Y: 0},
+			color.Gray{Y: 90},
+			color.Gray{Y: 150},
+			color.Gray{Y: 190},
+		},
+	},
+	"presentation": {
+		titleSize: vg.Points(28),
+		labelSize: vg.Points(22),
+		tickSize:  vg.Points(18),
+		lineWidth: vg.Points(3),
+		grid:      color.Gray{Y: 230},
+	},
+}
+
+// theme is the plot theme of the invocation.
+var theme plotTheme
+
+// setPlotTheme selects the named theme for all plots.
+func setPlotTheme(name string) error {
+	if name == "" {
+		name = "default"
+	}
+	th, ok := plotThemes[name]
+	if !ok {
+		var names []string
+		for k := range plotThemes {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown plot theme %q (available %q)", name, names)
+	}
+	theme = th
+	plotter.DefaultLineStyle.Width = defaultLineWidth
+	if th.lineWidth != 0 {
+		plotter.DefaultLineStyle.Width = th.lineWidth
+	}
+	return nil
+}
+
+// newPlot returns a plot with the theme applied.
+func newPlot() (*plot.Plot, error) {
+	plt, err := plot.New()
+	if err != nil {
+		return nil, err
+	}
+	th := theme
+
+	if th.font != "" {
+		for _, ts := range plotTextStyles(plt) {
+			f, err := vg.MakeFont(th.font, ts.Font.Size)
+			if err != nil {
+				return nil, err
+			}
+			ts.Font = f
+		}
+	}
+	for _, sz := range []struct {
+		size   vg.Length
+		styles []*draw.TextStyle
+	}{
+		{th.titleSize, []*draw.TextStyle{&plt.Title.TextStyle}},
+		{th.labelSize, []*draw.TextStyle{&plt.X.Label.TextStyle, &plt.Y.Label.TextStyle, &plt.Legend.TextStyle}},
+		{th.tickSize, []*draw.TextStyle{&plt.X.Tick.Label, &plt.Y.Tick.Label}},
+	} {
+		if sz.size == 0 {
+			continue
+		}
+		for _, ts := range sz.styles {
+			ts.Font.Size = sz.size
+		}
+	}
+
+	if th.background != nil {
+		plt.BackgroundColor = th.background
+	}
+	if th.foreground != nil {
+		for _, ts := range plotTextStyles(plt) {
+			ts.Color = th.foreground
+		}
+		for _, ax := range []*plot.Axis{&plt.X, &plt.Y} {
+			ax.LineStyle.Color = th.foreground
+			ax.Tick.LineStyle.Color = th.foreground
+		}
+	}
+	if th.grid != nil {
+		g := plotter.NewGrid()
+		g.Vertical.Color = th.grid
+		g.Horizontal.Color = th.grid
+		plt.Add(g)
+	}
+	return plt, nil
+}
+
+func plotTextStyles(plt *plot.Plot) []*draw.TextStyle {
+	return []*draw.TextStyle{
+		&plt.Title.TextStyle,
+		&plt.X.Label.TextStyle,
+		&plt.Y.Label.TextStyle,
+		&plt.X.Tick.Label,
+		&plt.Y.Tick.Label,
+		&plt.Legend.TextStyle,
+	}
+}
+
+// lineColor returns the color of i-th line, from the theme palette
+// if any, or the color of the database.
+func lineColor(c color.Color, i int) color.Color {
+	if len(theme.palette) == 0 {
+		return c
+	}
+	return theme.palette[i%len(theme.palette)]
+}
@@ -0,0 +1,71 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"image/color"
+	"testing"
+
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestPlotTheme(t *testing.T) {
+	defer setPlotTheme("default")
+
+	if err := setPlotTheme("unknown"); err == nil {
+		t.Fatal("expected error for unknown theme")
+	}
+
+	if err := setPlotTheme("dark"); err != nil {
+		t.Fatal(err)
+	}
+	plt, err := newPlot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plt.BackgroundColor != theme.background || plt.Title.Color != theme.foreground {
+		t.Fatalf("dark theme is not applied")
+	}
+	if c := lineColor(color.White, 7); c != theme.palette[7%len(theme.palette)] {
+		t.Fatalf("expected palette color, got %v", c)
+	}
+
+	if err = setPlotTheme("print"); err != nil {
+		t.Fatal(err)
+	}
+	if plt, err = newPlot(); err != nil {
+		t.Fatal(err)
+	}
+	if name := plt.X.Label.Font.Name(); name != "Times-Roman" {
+		t.Fatalf("expected Times-Roman, got %q", name)
+	}
+
+	if err = setPlotTheme("presentation"); err != nil {
+		t.Fatal(err)
+	}
+	if plotter.DefaultLineStyle.Width != theme.lineWidth {
+		t.Fatalf("expected line width %v, got %v", theme.lineWidth, plotter.DefaultLineStyle.Width)
+	}
+
+	if err = setPlotTheme(""); err != nil {
+		t.Fatal(err)
+	}
+	if c := lineColor(color.White, 0); c != color.White {
+		t.Fatalf("expected database color in default theme, got %v", c)
+	}
+	if plotter.DefaultLineStyle.Width != defaultLineWidth {
+		t.Fatalf("line width changed to %v", plotter.DefaultLineStyle.Width)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/gyuho/dataframe"
+	"gonum.org/v1/plot"
+)
+
+const (
+	timeAxisSeconds   = "seconds"
+	timeAxisElapsed   = "elapsed"
+	timeAxisWallClock = "wall-clock"
+
+	defaultTimeFormat = "15:04:05"
+)
+
+// timeAxis defines the time format of timeseries in plots and tables.
+// Zero value keeps seconds since the start.
+type timeAxis struct {
+	mode   string
+	loc    *time.Location
+	layout string
+}
+
+func newTimeAxis(cfg dbtesterpb.ConfigAnalyzeMachineAllAggregatedOutput) (timeAxis, error) {
+	ta := timeAxis{mode: cfg.TimeAxis, loc: time.UTC, layout: cfg.TimeFormat}
+	switch ta.mode {
+	case "":
+		ta.mode = timeAxisSeconds
+	case timeAxisSeconds, timeAxisElapsed, timeAxisWallClock:
+	default:
+		return timeAxis{}, fmt.Errorf("unknown time axis %q (expected %q, %q, or %q)", ta.mode, timeAxisSeconds, timeAxisElapsed, timeAxisWallClock)
+	}
+	if cfg.TimeZone != "" {
+		loc, err := time.LoadLocation(cfg.TimeZone)
+		if err != nil {
+			return timeAxis{}, fmt.Errorf("unknown time zone %q (%v)", cfg.TimeZone, err)
+		}
+		ta.loc = loc
+	}
+	if ta.layout == "" {
+		ta.layout = defaultTimeFormat
+	}
+	return ta, nil
+}
+
+// enabled returns true if time is formatted other than in seconds.
+func (ta timeAxis) enabled() bool {
+	return ta.mode == timeAxisElapsed || ta.mode == timeAxisWallClock
+}
+
+// format formats the time at 'sec' seconds since the start Unix second.
+func (ta timeAxis) format(start, sec int64) string {
+	if ta.mode == timeAxisWallClock {
+		return time.Unix(start+sec, 0).In(ta.loc).Format(ta.layout)
+	}
+	return formatElapsed(sec)
+}
+
+// label returns the x-axis label, or the given label in seconds.
+func (ta timeAxis) label(xAxis string) string {
+	switch ta.mode {
+	case timeAxisElapsed:
+		return "Elapsed Time (HH:MM:SS)"
+	case timeAxisWallClock:
+		return fmt.Sprintf("Time (%s)", ta.loc)
+	default:
+		return xAxis
+	}
+}
+
+// formatElapsed formats seconds in HH:MM:SS.
+func formatElapsed(sec int64) string {
+	sign := ""
+	if sec < 0 {
+		sign, sec = "-", -sec
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, sec/3600, sec%3600/60, sec%60)
+}
+
+// tickStepSeconds are the intervals of time ticks, to keep ticks
+// at round minutes and hours.
+var tickStepSeconds = []int64{1, 2, 5, 10, 15, 30, 60, 120, 300, 600, 900, 1800, 3600, 7200, 10800, 21600, 43200, 86400}
+
+const maxTimeTicks = 8
+
+// timeTicker ticks the x-axis in seconds since the start Unix second,
+// with labels in elapsed or wall-clock time.
+type timeTicker struct {
+	ta    timeAxis
+	start int64
+}
+
+func (tt timeTicker) Ticks(min, max float64) []plot.Tick {
+	step := tickStepSeconds[len(tickStepSeconds)-1]
+	for _, s := range tickStepSeconds {
+		if (max-min)/float64(s) <= maxTimeTicks {
+			step = s
+			break
+		}
+	}
+
+	// align wall-clock ticks to round local time
+	var offset int64
+	if tt.ta.mode == timeAxisWallClock {
+		_, zoneOffset := time.Unix(tt.start, 0).In(tt.ta.loc).Zone()
+		offset = tt.start + int64(zoneOffset)
+	}
+	first := int64(math.Ceil(min))
+	if r := ((first+offset)%step + step) % step; r != 0 {
+		first += step - r
+	}
+
+	var ticks []plot.Tick
+	for sec := first; float64(sec) <= max; sec += step {
+		ticks = append(ticks, plot.Tick{Value: float64(sec), Label: tt.ta.format(tt.start, sec)})
+	}
+	return ticks
+}
+
+// apply labels the x-axis of the timeseries plot, which starts
+// at the Unix second.
+func (ta timeAxis) apply(plt *plot.Plot, start int64) {
+	plt.X.Label.Text = ta.label(plt.X.Label.Text)
+	if ta.enabled() {
+		plt.X.Tick.Marker = timeTicker{ta: ta, start: start}
+	}
+}
+
+// firstUnixSecond returns the Unix second of the first row.
+func firstUnixSecond(fr dataframe.Frame) (int64, error) {
+	col, err := fr.Column("UNIX-SECOND")
+	if err != nil {
+		return 0, err
+	}
+	v, err := col.Value(0)
+	if err != nil {
+		return 0, err
+	}
+	s, _ := v.String()
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// withTimeColumn returns the aggregated timeseries with "TIME" column
+// after "SECOND" column.
+func (ta timeAxis) withTimeColumn(fr dataframe.Frame, tag string) (dataframe.Frame, error) {
+	start, err := firstUnixSecond(fr)
+	if err != nil {
+		return nil, err
+	}
+	col, err := fr.Column("SECOND")
+	if err != nil {
+		return nil, err
+	}
+	timeCol := dataframe.NewColumn(makeHeader("TIME", tag))
+	for i := 0; i < col.Count(); i++ {
+		v, err := col.Value(i)
+		if err != nil {
+			return nil, err
+		}
+		sec, _ := v.Int64()
+		timeCol.PushBack(dataframe.NewStringValue(ta.format(start, sec)))
+	}
+
+	var cols []dataframe.Column
+	for _, c := range fr.Columns() {
+		cols = append(cols, c)
+		if c == col {
+			cols = append(cols, timeCol)
+		}
+	}
+	return dataframe.NewFromColumns(nil, cols...)
+}
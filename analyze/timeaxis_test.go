@@ -0,0 +1,95 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/gyuho/dataframe"
+)
+
+func TestTimeAxis(t *testing.T) {
+	if _, err := newTimeAxis(dbtesterpb.ConfigAnalyzeMachineAllAggregatedOutput{TimeAxis: "minutes"}); err == nil {
+		t.Fatal("expected error for unknown time axis")
+	}
+	ta, err := newTimeAxis(dbtesterpb.ConfigAnalyzeMachineAllAggregatedOutput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ta.enabled() || ta.label("Second") != "Second" {
+		t.Fatalf("expected seconds by default, got %+v", ta)
+	}
+
+	if s := formatElapsed(3725); s != "01:02:05" {
+		t.Fatalf("expected 01:02:05, got %q", s)
+	}
+
+	// 2017-03-01 09:59:50 in UTC+09:30
+	start := time.Date(2017, 3, 1, 0, 29, 50, 0, time.UTC).Unix()
+	ta = timeAxis{mode: timeAxisWallClock, loc: time.FixedZone("ACST", 34200), layout: "15:04"}
+	var labels []string
+	for _, tk := range (timeTicker{ta: ta, start: start}).Ticks(0, 1810) {
+		labels = append(labels, tk.Label)
+	}
+	if exp := []string{"10:00", "10:05", "10:10", "10:15", "10:20", "10:25", "10:30"}; !reflect.DeepEqual(labels, exp) {
+		t.Fatalf("expected %q, got %q", exp, labels)
+	}
+
+	ta.mode = timeAxisElapsed
+	labels = nil
+	for _, tk := range (timeTicker{ta: ta, start: start}).Ticks(0, 150) {
+		labels = append(labels, tk.Label)
+	}
+	if exp := []string{"00:00:00", "00:00:30", "00:01:00", "00:01:30", "00:02:00", "00:02:30"}; !reflect.DeepEqual(labels, exp) {
+		t.Fatalf("expected %q, got %q", exp, labels)
+	}
+}
+
+func TestWithTimeColumn(t *testing.T) {
+	fr := dataframe.New()
+	uc := dataframe.NewColumn("UNIX-SECOND")
+	sc := dataframe.NewColumn("SECOND")
+	lc := dataframe.NewColumn("AVG-LATENCY-MS")
+	for i := 0; i < 2; i++ {
+		uc.PushBack(dataframe.NewStringValue(1488326400 + i))
+		sc.PushBack(dataframe.NewStringValue(i))
+		lc.PushBack(dataframe.NewStringValue("1.5"))
+	}
+	for _, c := range []dataframe.Column{uc, sc, lc} {
+		if err := fr.AddColumn(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ta := timeAxis{mode: timeAxisWallClock, loc: time.UTC, layout: defaultTimeFormat}
+	nf, err := ta.withTimeColumn(fr, "etcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := []string{"UNIX-SECOND", "SECOND", "TIME-etcd", "AVG-LATENCY-MS"}; !reflect.DeepEqual(nf.Headers(), exp) {
+		t.Fatalf("expected %q, got %q", exp, nf.Headers())
+	}
+	col, err := nf.Column("TIME-etcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, _ := col.Value(1)
+	if s, _ := v.String(); s != "00:00:01" {
+		t.Fatalf("expected 00:00:01, got %q", s)
+	}
+}
@@ -0,0 +1,481 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/table"
+	"github.com/spf13/cobra"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/plotutil"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// TrendCommand implements 'analyze trend' command.
+var TrendCommand = &cobra.Command{
+	Use:   "trend [flags]",
+	Short: "Plots metrics of runs in '--results-root' over calendar time per database and release, and flags significant shifts from the preceding runs.",
+	RunE:  trendCommandFunc,
+}
+
+var (
+	trendMetrics          []string
+	trendOutputDir        string
+	trendDays             int
+	trendBaselineDays     int
+	trendMinBaseline      int
+	trendZThreshold       float64
+	trendMinChangePercent float64
+	trendGroupBy          string
+)
+
+func init() {
+	TrendCommand.Flags().StringSliceVar(&trendMetrics, "metric", []string{"REQUESTS-PER-SECOND", "AVERAGE-LATENCY-MS", "p99"}, "Metrics to plot: row names of the client latency summary (e.g. 'AVERAGE-LATENCY-MS'), or latency percentiles (e.g. 'p99').")
+	TrendCommand.Flags().StringVar(&trendOutputDir, "output-dir", "", "Directory to save the plots and 'trend.csv'.")
+	TrendCommand.Flags().IntVar(&trendDays, "days", 0, "Days of runs to report, back from the latest run (e.g. '7' for a weekly report), or 0 for all runs. Earlier runs are still used as baseline.")
+	TrendCommand.Flags().IntVar(&trendBaselineDays, "baseline-days", 7, "Days of preceding runs of the same database and release to compare each run with.")
+	TrendCommand.Flags().IntVar(&trendMinBaseline, "min-baseline", 3, "Minimum number of runs in the baseline to flag a shift.")
+	TrendCommand.Flags().Float64Var(&trendZThreshold, "z-threshold", 3, "Minimum absolute z-score against the baseline to flag a shift.")
+	TrendCommand.Flags().Float64Var(&trendMinChangePercent, "min-change-percent", 5, "Minimum change from the baseline mean in percent to flag a shift, so that tiny changes of stable metrics are not flagged.")
+	TrendCommand.Flags().StringVar(&trendGroupBy, "group-by", "", "Key of run tags to plot and compare runs in separate series per value (e.g. 'env'), or empty to not group.")
+	Command.AddCommand(TrendCommand)
+}
+
+// default file names in the standard result layout
+const (
+	trendRunMetadataName = "run-metadata.yaml"
+	trendSummaryName     = "client-latency-distribution-summary.csv"
+	trendPercentileName  = "client-latency-distribution-percentile.csv"
+)
+
+func trendCommandFunc(cmd *cobra.Command, args []string) error {
+	if resultsRoot == "" {
+		return fmt.Errorf("'--results-root' is required")
+	}
+	if trendOutputDir == "" {
+		return fmt.Errorf("'--output-dir' is required")
+	}
+	if len(trendMetrics) == 0 {
+		return fmt.Errorf("no metric given")
+	}
+	if err := setPlotTheme(plotThemeName); err != nil {
+		return err
+	}
+
+	pts, err := readTrendPoints(resultsRoot, runTags, trendGroupBy, trendMetrics)
+	if err != nil {
+		return err
+	}
+	if len(pts) == 0 {
+		return fmt.Errorf("no run with metrics found in %q (tags %q)", resultsRoot, runTags)
+	}
+	plog.Printf("read %d point(s) from %q", len(pts), resultsRoot)
+
+	th := trendThresholds{
+		baseline:         time.Duration(trendBaselineDays) * 24 * time.Hour,
+		minBaseline:      trendMinBaseline,
+		zScore:           trendZThreshold,
+		minChangePercent: trendMinChangePercent,
+	}
+	th.detect(pts)
+	pts = trendReportPoints(pts, time.Duration(trendDays)*24*time.Hour)
+
+	if err = os.MkdirAll(trendOutputDir, 0755); err != nil {
+		return err
+	}
+	for _, metric := range trendMetrics {
+		plt, data, err := plotTrend(metric, pts)
+		if err != nil {
+			return err
+		}
+		if plt == nil {
+			plog.Warningf("no run has %q", metric)
+			continue
+		}
+		base := filepath.Join(trendOutputDir, strings.Replace(metric, ".", "_", -1))
+		outputPaths := []string{base + ".svg", base + ".png"}
+		if err = savePlot(plt, outputPaths, data); err != nil {
+			return err
+		}
+		plog.Printf("saved %q", outputPaths)
+	}
+
+	csvPath := filepath.Join(trendOutputDir, "trend.csv")
+	if err = trendTable(pts).WriteCSV(csvPath); err != nil {
+		return err
+	}
+	plog.Printf("saved points to %q", csvPath)
+
+	shifts := 0
+	for _, p := range pts {
+		if p.shift == "" {
+			continue
+		}
+		shifts++
+		plog.Warningf("%s of %q shifted %s in run %q (version %q): %.4f from baseline mean %.4f of %d run(s) (z-score %.2f)", p.metric, p.series(), p.shift, p.runID, p.version, p.value, p.baselineMean, p.baselineN, p.zScore)
+	}
+	plog.Printf("found %d significant shift(s)", shifts)
+	return nil
+}
+
+// trendPoint is a metric of a database in a run.
+type trendPoint struct {
+	time     time.Time
+	runID    string
+	database string
+	// version is the release version, or the source commit if built
+	// from source.
+	version string
+	// release is the release version, or empty if built from source.
+	// Baselines only have runs of the same release, and release changes
+	// are marked in plots.
+	release string
+	// group is 'key=value' of the '--group-by' tag of the run,
+	// "no key" if the run does not have the tag, or empty if not grouped.
+	group  string
+	metric string
+	value  float64
+
+	// baselineN is the number of runs in the baseline, and the other
+	// baseline fields are set only if baselineN > 0.
+	baselineN    int
+	baselineMean float64
+	zScore       float64
+	// shift is "up" or "down" if the value is significantly different
+	// from the baseline, or empty.
+	shift string
+}
+
+// series returns the name of the series of the point in plots, which is
+// the database and the group, if any.
+func (p trendPoint) series() string {
+	if p.group == "" {
+		return p.database
+	}
+	return fmt.Sprintf("%s (%s)", p.database, p.group)
+}
+
+// readTrendPoints returns the metrics of all databases in all runs with
+// the tags under the root directory, sorted by time. The time is the
+// start of the run in run metadata. Points are grouped by the value of
+// the 'groupBy' tag in run metadata, if not empty. Databases without run
+// metadata or client results are skipped.
+func readTrendPoints(root string, tags []string, groupBy string, metrics []string) ([]trendPoint, error) {
+	runs, err := dbtester.DiscoverRuns(root)
+	if err != nil {
+		return nil, err
+	}
+	var pts []trendPoint
+	for _, run := range runs {
+		if !dbtester.MatchTags(run.Tags, tags) {
+			continue
+		}
+		for _, tag := range run.DatabaseTags {
+			dir := filepath.Join(root, dbtesterpb.ClientResultDir(run.ID, tag))
+			md, err := dbtester.ReadRunMetadata(filepath.Join(dir, trendRunMetadataName))
+			if err != nil {
+				if os.IsNotExist(err) {
+					plog.Warningf("skipping %q in run %q (no run metadata)", tag, run.ID)
+					continue
+				}
+				return nil, err
+			}
+			started, err := time.Parse(time.RFC3339, md.StartedAt)
+			if err != nil {
+				return nil, fmt.Errorf("invalid start time of %q in run %q (%v)", tag, run.ID, err)
+			}
+			values, err := readTrendValues(dir)
+			if err != nil {
+				return nil, fmt.Errorf("%q in run %q: %v", tag, run.ID, err)
+			}
+			database := md.DatabaseID
+			if database == "" {
+				database = tag
+			}
+			version := md.ReleaseVersion
+			if version == "" {
+				version = md.SourceCommit
+				if len(version) > 12 {
+					version = version[:12]
+				}
+			}
+			var group string
+			if groupBy != "" {
+				if v, ok := md.Tags[groupBy]; ok {
+					group = groupBy + "=" + v
+				} else {
+					group = "no " + groupBy
+				}
+			}
+			for _, metric := range metrics {
+				v, ok := values[metric]
+				if !ok {
+					continue
+				}
+				pts = append(pts, trendPoint{
+					time:     started,
+					runID:    run.ID,
+					database: database,
+					version:  version,
+					release:  md.ReleaseVersion,
+					group:    group,
+					metric:   metric,
+					value:    v,
+				})
+			}
+		}
+	}
+	sort.SliceStable(pts, func(i, j int) bool { return pts[i].time.Before(pts[j].time) })
+	return pts, nil
+}
+
+// readTrendValues returns the summary values and latency percentiles
+// in the client result directory, by row name.
+func readTrendValues(dir string) (map[string]float64, error) {
+	values, err := readSummaryValues(filepath.Join(dir, trendSummaryName))
+	if err != nil {
+		return nil, err
+	}
+	tb, err := table.ReadCSV(filepath.Join(dir, trendPercentileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return values, nil
+		}
+		return nil, err
+	}
+	for _, row := range tb.Rows {
+		if len(row) < 2 {
+			continue
+		}
+		if fv, err := strconv.ParseFloat(row[1], 64); err == nil {
+			values[row[0]] = fv
+		}
+	}
+	return values, nil
+}
+
+// trendThresholds is the thresholds to flag a shift.
+type trendThresholds struct {
+	baseline         time.Duration
+	minBaseline      int
+	zScore           float64
+	minChangePercent float64
+}
+
+// detect compares each point with the points of the same database, release,
+// group, and metric in the preceding baseline duration, and sets the shift
+// if both the z-score and the change are over the thresholds. Points already
+// flagged are left out of later baselines, so that a shift does not hide the
+// next one. A constant baseline has infinite z-score for any change. The
+// points must be sorted by time.
+func (th trendThresholds) detect(pts []trendPoint) {
+	for i := range pts {
+		p := &pts[i]
+		var baseline []float64
+		for j := i - 1; j >= 0; j-- {
+			q := pts[j]
+			if p.time.Sub(q.time) > th.baseline {
+				break
+			}
+			if q.shift != "" || q.database != p.database || q.release != p.release || q.group != p.group || q.metric != p.metric {
+				continue
+			}
+			if q.time.Before(p.time) {
+				baseline = append(baseline, q.value)
+			}
+		}
+		p.baselineN = len(baseline)
+		if p.baselineN == 0 {
+			continue
+		}
+		mean, sd := meanStddev(baseline)
+		p.baselineMean = mean
+		switch {
+		case sd > 0:
+			p.zScore = (p.value - mean) / sd
+		case p.value > mean:
+			p.zScore = math.Inf(1)
+		case p.value < mean:
+			p.zScore = math.Inf(-1)
+		}
+		if p.baselineN < th.minBaseline || math.Abs(p.zScore) < th.zScore {
+			continue
+		}
+		if mean != 0 && math.Abs(p.value-mean)/math.Abs(mean)*100 < th.minChangePercent {
+			continue
+		}
+		if p.value > mean {
+			p.shift = "up"
+		} else {
+			p.shift = "down"
+		}
+	}
+}
+
+// meanStddev returns the mean and sample standard deviation.
+func meanStddev(vs []float64) (mean, sd float64) {
+	for _, v := range vs {
+		mean += v
+	}
+	mean /= float64(len(vs))
+	if len(vs) < 2 {
+		return mean, 0
+	}
+	for _, v := range vs {
+		sd += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(sd / float64(len(vs)-1))
+}
+
+// trendReportPoints returns the points within the duration back from
+// the latest point, or all points if the duration is zero.
+func trendReportPoints(pts []trendPoint, d time.Duration) []trendPoint {
+	if d <= 0 || len(pts) == 0 {
+		return pts
+	}
+	from := pts[len(pts)-1].time.Add(-d)
+	for i := range pts {
+		if !pts[i].time.Before(from) {
+			return pts[i:]
+		}
+	}
+	return nil
+}
+
+// plotTrend plots the metric of each database (and group) over time,
+// with the shifts and release changes marked. It returns nil plot if no
+// point has the metric.
+func plotTrend(metric string, pts []trendPoint) (*plot.Plot, *plotData, error) {
+	var databases []string
+	databaseToPoints := make(map[string]plotter.XYs)
+	databaseToRelease := make(map[string]string)
+	var shifts plotter.XYs
+	var releases plotter.XYLabels
+	for _, p := range pts {
+		if p.metric != metric {
+			continue
+		}
+		series := p.series()
+		xy := struct{ X, Y float64 }{X: float64(p.time.Unix()), Y: p.value}
+		if _, ok := databaseToPoints[series]; !ok {
+			databases = append(databases, series)
+		} else if databaseToRelease[series] != p.release {
+			releases.XYs = append(releases.XYs, xy)
+			releases.Labels = append(releases.Labels, p.version)
+		}
+		databaseToRelease[series] = p.release
+		databaseToPoints[series] = append(databaseToPoints[series], xy)
+		if p.shift != "" {
+			shifts = append(shifts, xy)
+		}
+	}
+	if len(databases) == 0 {
+		return nil, nil, nil
+	}
+	sort.Strings(databases)
+
+	plt, err := newPlot()
+	if err != nil {
+		return nil, nil, err
+	}
+	plt.Title.Text = metric + " over time"
+	plt.X.Label.Text = "Run start time(UTC)"
+	plt.X.Tick.Marker = plot.TimeTicks{Format: "2006-01-02"}
+	plt.Y.Label.Text = metric
+	plt.Legend.Top = true
+
+	data := &plotData{}
+	for i, database := range databases {
+		l, sc, err := plotter.NewLinePoints(databaseToPoints[database])
+		if err != nil {
+			return nil, nil, err
+		}
+		l.Color = lineColor(plotutil.Color(i), i)
+		l.Dashes = plotutil.Dashes(i)
+		sc.Color = l.Color
+		sc.Shape = plotutil.Shape(i)
+		plt.Add(l, sc)
+		plt.Legend.Add(database, l, sc)
+		data.add(database, databaseToPoints[database])
+	}
+	if len(shifts) > 0 {
+		sc, err := plotter.NewScatter(shifts)
+		if err != nil {
+			return nil, nil, err
+		}
+		sc.Shape = draw.RingGlyph{}
+		sc.Radius = vg.Points(7)
+		sc.Color = lineColor(plotutil.Color(len(databases)), len(databases))
+		plt.Add(sc)
+		plt.Legend.Add("significant shift", sc)
+		data.add("significant shift", shifts)
+	}
+	if len(releases.XYs) > 0 {
+		sc, err := plotter.NewScatter(releases.XYs)
+		if err != nil {
+			return nil, nil, err
+		}
+		sc.Shape = draw.TriangleGlyph{}
+		sc.Radius = vg.Points(5)
+		sc.Color = lineColor(plotutil.Color(len(databases)+1), len(databases)+1)
+		lb, err := plotter.NewLabels(releases)
+		if err != nil {
+			return nil, nil, err
+		}
+		lb.XOffset, lb.YOffset = vg.Points(6), vg.Points(6)
+		plt.Add(sc, lb)
+		plt.Legend.Add("release change", sc)
+		data.add("release change", releases.XYs)
+	}
+	return plt, data, nil
+}
+
+// trendTable returns all points with the baseline and shift.
+func trendTable(pts []trendPoint) *table.Table {
+	tb := table.New("TIME", "RUN-ID", "DATABASE", "VERSION", "GROUP", "METRIC", "VALUE", "BASELINE-RUNS", "BASELINE-MEAN", "Z-SCORE", "SHIFT")
+	for _, p := range pts {
+		mean, z := "", ""
+		if p.baselineN > 0 {
+			mean = fmt.Sprintf("%.4f", p.baselineMean)
+			z = fmt.Sprintf("%.2f", p.zScore)
+		}
+		tb.Rows = append(tb.Rows, []string{
+			p.time.UTC().Format(time.RFC3339),
+			p.runID,
+			p.database,
+			p.version,
+			p.group,
+			p.metric,
+			fmt.Sprintf("%.4f", p.value),
+			fmt.Sprintf("%d", p.baselineN),
+			mean,
+			z,
+			p.shift,
+		})
+	}
+	return tb
+}
@@ -0,0 +1,180 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReadTrendPoints(t *testing.T) {
+	root, err := ioutil.TempDir("", "trend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	write := func(runID, tag, started, purpose string, rps float64) {
+		dir := filepath.Join(root, runID, tag, "client")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		files := map[string]string{
+			trendRunMetadataName: fmt.Sprintf("database_id: %s_v3\ndatabase_tag: %s\nstarted_at: %q\nrelease_version: v3.2.0\ntags:\n  purpose: %s\n", tag, tag, started, purpose),
+			trendSummaryName:     fmt.Sprintf("TOTAL-SECONDS,10.0000s\nREQUESTS-PER-SECOND,%.4f\nAVERAGE-LATENCY-MS,2.5000\n", rps),
+			trendPercentileName:  "LATENCY-PERCENTILE,LATENCY-MS\np50,2.000000\np99,9.000000\n",
+		}
+		for name, s := range files {
+			if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(s), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	// run IDs are not in time order
+	write("b", "etcd", "2017-06-01T00:00:00Z", "nightly", 1000)
+	write("a", "etcd", "2017-06-02T00:00:00Z", "nightly", 2000)
+	write("c", "etcd", "2017-06-03T00:00:00Z", "manual", 3000)
+
+	pts, err := readTrendPoints(root, []string{"purpose=nightly"}, "", []string{"REQUESTS-PER-SECOND", "p99", "unknown"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, p := range pts {
+		got = append(got, fmt.Sprintf("%s %s %s %s %s %g", p.time.Format("01-02"), p.runID, p.database, p.version, p.metric, p.value))
+	}
+	exp := []string{
+		"06-01 b etcd_v3 v3.2.0 REQUESTS-PER-SECOND 1000",
+		"06-01 b etcd_v3 v3.2.0 p99 9",
+		"06-02 a etcd_v3 v3.2.0 REQUESTS-PER-SECOND 2000",
+		"06-02 a etcd_v3 v3.2.0 p99 9",
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Fatalf("expected %q, got %q", exp, got)
+	}
+
+	// grouped by the tag, including runs without the tag
+	write("d", "etcd", "2017-06-04T00:00:00Z", "", 4000)
+	if err = ioutil.WriteFile(filepath.Join(root, "d", "etcd", "client", trendRunMetadataName), []byte("database_id: etcd_v3\nstarted_at: \"2017-06-04T00:00:00Z\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if pts, err = readTrendPoints(root, nil, "purpose", []string{"REQUESTS-PER-SECOND"}); err != nil {
+		t.Fatal(err)
+	}
+	got = nil
+	for _, p := range pts {
+		got = append(got, fmt.Sprintf("%s %s", p.runID, p.series()))
+	}
+	exp = []string{
+		"b etcd_v3 (purpose=nightly)",
+		"a etcd_v3 (purpose=nightly)",
+		"c etcd_v3 (purpose=manual)",
+		"d etcd_v3 (no purpose)",
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Fatalf("expected %q, got %q", exp, got)
+	}
+}
+
+func TestTrendThresholds(t *testing.T) {
+	day := 24 * time.Hour
+	start := time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC)
+	var pts []trendPoint
+	add := func(days int, database string, v float64) {
+		pts = append(pts, trendPoint{time: start.Add(time.Duration(days) * day), database: database, metric: "REQUESTS-PER-SECOND", value: v})
+	}
+	add(0, "etcd", 1000)
+	add(1, "etcd", 1010)
+	add(2, "etcd", 990)
+	add(2, "zookeeper", 100)
+	// within the noise of the baseline
+	add(3, "etcd", 1005)
+	// drop
+	add(4, "etcd", 700)
+	// recovered, but the drop is in the baseline
+	add(5, "etcd", 1000)
+	// baseline of 3 days has only one run
+	add(8, "etcd", 2000)
+	add(10, "zookeeper", 100)
+
+	th := trendThresholds{baseline: 3 * day, minBaseline: 3, zScore: 3, minChangePercent: 5}
+	th.detect(pts)
+	var shifts []string
+	for _, p := range pts {
+		shifts = append(shifts, p.shift)
+	}
+	if exp := []string{"", "", "", "", "", "down", "", "", ""}; !reflect.DeepEqual(shifts, exp) {
+		t.Fatalf("expected shifts %q, got %q", exp, shifts)
+	}
+	if pts[3].baselineN != 0 || pts[5].baselineN != 3 || pts[7].baselineN != 1 {
+		t.Fatalf("unexpected baseline sizes %d, %d, %d", pts[3].baselineN, pts[5].baselineN, pts[7].baselineN)
+	}
+	// the first run is 4 days before
+	if math.Abs(pts[5].baselineMean-3005.0/3) > 1e-9 {
+		t.Fatalf("expected baseline mean %v, got %v", 3005.0/3, pts[5].baselineMean)
+	}
+
+	// constant baseline, with changes under and over the minimum change
+	pts = nil
+	for i := 0; i < 3; i++ {
+		add(i, "etcd", 1000)
+	}
+	add(3, "etcd", 1010)
+	add(4, "etcd", 1100)
+	th.detect(pts)
+	if pts[3].shift != "" || !math.IsInf(pts[3].zScore, 1) || pts[4].shift != "up" {
+		t.Fatalf("unexpected shifts %+v", pts[3:])
+	}
+
+	if got := trendReportPoints(pts, 2*day); len(got) != 3 || !got[0].time.Equal(start.Add(2*day)) {
+		t.Fatalf("unexpected report points %+v", got)
+	}
+
+	// baselines of the same release, without flagged points
+	pts = nil
+	for i := 0; i < 3; i++ {
+		add(i, "etcd", 1000)
+		pts[len(pts)-1].release = "v3.2.0"
+	}
+	add(3, "etcd", 700)
+	pts[3].release = "v3.2.0"
+	add(4, "etcd", 700)
+	pts[4].release = "v3.3.0"
+	th.baseline = 7 * day
+	th.detect(pts)
+	if pts[3].shift != "down" || pts[4].baselineN != 0 {
+		t.Fatalf("unexpected shifts %+v", pts[3:])
+	}
+	add(5, "etcd", 1000)
+	pts[5].release = "v3.2.0"
+	th.detect(pts)
+	if pts[5].baselineN != 3 || pts[5].shift != "" {
+		t.Fatalf("expected the flagged point out of the baseline, got %+v", pts[5])
+	}
+
+	plt, data, err := plotTrend("REQUESTS-PER-SECOND", pts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plt == nil || len(data.Series) != 3 || data.Series[2].Label != "release change" || len(data.Series[2].Points) != 2 {
+		t.Fatalf("expected 2 release changes, got %+v", data)
+	}
+}
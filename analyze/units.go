@@ -0,0 +1,188 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	humanize "github.com/dustin/go-humanize"
+	"github.com/gyuho/dataframe"
+)
+
+// memoryUnitToBytes maps memory unit to its size in bytes.
+var memoryUnitToBytes = map[string]float64{
+	"B":   1,
+	"KB":  1000,
+	"MB":  1000 * 1000,
+	"GB":  1000 * 1000 * 1000,
+	"KiB": 1 << 10,
+	"MiB": 1 << 20,
+	"GiB": 1 << 30,
+}
+
+// throughputUnitToOps maps throughput unit to its number of operations per second.
+var throughputUnitToOps = map[string]float64{
+	"ops/s":  1,
+	"kops/s": 1000,
+}
+
+// outputUnits defines the units of the summary and plots.
+// Empty unit keeps the default format.
+type outputUnits struct {
+	memory     string
+	throughput string
+}
+
+func newOutputUnits(cfg dbtesterpb.ConfigAnalyzeMachineAllAggregatedOutput) (outputUnits, error) {
+	if _, ok := memoryUnitToBytes[cfg.MemoryUnit]; cfg.MemoryUnit != "" && !ok {
+		return outputUnits{}, fmt.Errorf("unknown memory unit %q", cfg.MemoryUnit)
+	}
+	if _, ok := throughputUnitToOps[cfg.ThroughputUnit]; cfg.ThroughputUnit != "" && !ok {
+		return outputUnits{}, fmt.Errorf("unknown throughput unit %q", cfg.ThroughputUnit)
+	}
+	return outputUnits{memory: cfg.MemoryUnit, throughput: cfg.ThroughputUnit}, nil
+}
+
+// memoryUnit returns the unit of converted memory columns.
+func (u outputUnits) memoryUnit() string {
+	if u.memory == "" {
+		return "MB"
+	}
+	return u.memory
+}
+
+// memoryColumn returns the name of the memory column in the configured
+// unit (e.g. 'AVG-VMRSS-MB' to 'AVG-VMRSS-MiB'), so that headers and
+// file names do not claim megabytes for converted values.
+func (u outputUnits) memoryColumn(column string) string {
+	return strings.TrimSuffix(column, "MB") + u.memoryUnit()
+}
+
+// formatMemory formats memory in bytes.
+func (u outputUnits) formatMemory(bytes uint64) string {
+	if u.memory == "" {
+		return humanize.Bytes(bytes)
+	}
+	return fmt.Sprintf("%.2f %s", float64(bytes)/memoryUnitToBytes[u.memory], u.memory)
+}
+
+// formatThroughput formats throughput in requests per second.
+func (u outputUnits) formatThroughput(ops int64) string {
+	switch u.throughput {
+	case "":
+		return fmt.Sprintf("%s req/sec", humanize.Comma(ops))
+	case "ops/s":
+		return fmt.Sprintf("%s ops/s", humanize.Comma(ops))
+	default:
+		return fmt.Sprintf("%.2f %s", float64(ops)/throughputUnitToOps[u.throughput], u.throughput)
+	}
+}
+
+// convertColumn returns a copy of the aggregated column, with values
+// converted to the configured unit if the column is in memory (e.g.
+// 'AVG-VMRSS-MB') or throughput (e.g. 'AVG-THROUGHPUT'). Headers are kept,
+// so that the column can still be looked up by the configured name.
+func (u outputUnits) convertColumn(column string, col dataframe.Column) (dataframe.Column, error) {
+	var scale float64
+	switch {
+	case u.memory != "" && strings.HasSuffix(column, "VMRSS-MB"):
+		scale = memoryUnitToBytes["MB"] / memoryUnitToBytes[u.memory]
+	case u.throughput != "" && column == "AVG-THROUGHPUT":
+		scale = 1 / throughputUnitToOps[u.throughput]
+	default:
+		return col, nil
+	}
+	converted := col.Copy()
+	for i := 0; i < converted.Count(); i++ {
+		v, err := converted.Value(i)
+		if err != nil {
+			return nil, err
+		}
+		fv, ok := v.Float64()
+		if !ok {
+			continue
+		}
+		if err = converted.Set(i, dataframe.NewStringValue(fmt.Sprintf("%.4f", fv*scale))); err != nil {
+			return nil, err
+		}
+	}
+	return converted, nil
+}
+
+// columnUnit returns the unit of the column after conversion.
+func (u outputUnits) columnUnit(column string) string {
+	switch {
+	case u.memory != "" && strings.HasSuffix(column, "VMRSS-MB"):
+		return u.memory
+	case u.throughput != "" && column == "AVG-THROUGHPUT":
+		return u.throughput
+	default:
+		return dbtester.ColumnUnit(column)
+	}
+}
+
+// unitAliases maps the unit to its other names in axis labels.
+var unitAliases = map[string][]string{
+	"ms":    {"millisecond", "milliseconds"},
+	"s":     {"second", "seconds"},
+	"ops/s": {"requests/second", "req/sec"},
+}
+
+// isUnitName returns true if the name is any unit or its alias.
+func isUnitName(name string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, m := range []map[string]float64{memoryUnitToBytes, throughputUnitToOps} {
+		for u := range m {
+			if strings.ToLower(u) == name {
+				return true
+			}
+		}
+	}
+	for u, aliases := range unitAliases {
+		if strings.ToLower(u) == name {
+			return true
+		}
+		for _, a := range aliases {
+			if a == name {
+				return true
+			}
+		}
+	}
+	return name == "%" || name == "bytes"
+}
+
+// axisLabel returns the axis label with the unit, replacing the unit
+// in parentheses that is different (e.g. "Memory(MB)" to "Memory(MiB)"),
+// or appending the unit if the label has none.
+func axisLabel(label, unit string) string {
+	if unit == "" {
+		return label
+	}
+	lower := strings.ToLower(label)
+	for _, name := range append([]string{unit}, unitAliases[unit]...) {
+		if strings.Contains(lower, strings.ToLower(name)) {
+			return label
+		}
+	}
+	if i := strings.Index(label, "("); i >= 0 {
+		if j := strings.Index(label[i:], ")"); j > 0 && isUnitName(label[i+1:i+j]) {
+			return label[:i+1] + unit + label[i+j:]
+		}
+	}
+	return fmt.Sprintf("%s (%s)", label, unit)
+}
@@ -0,0 +1,91 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/gyuho/dataframe"
+)
+
+func TestOutputUnits(t *testing.T) {
+	u, err := newOutputUnits(dbtesterpb.ConfigAnalyzeMachineAllAggregatedOutput{MemoryUnit: "MiB", ThroughputUnit: "kops/s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := u.formatMemory(3 << 20); s != "3.00 MiB" {
+		t.Fatalf("expected 3.00 MiB, got %q", s)
+	}
+	if s := u.formatThroughput(12500); s != "12.50 kops/s" {
+		t.Fatalf("expected 12.50 kops/s, got %q", s)
+	}
+	if s := (outputUnits{}).formatThroughput(12500); s != "12,500 req/sec" {
+		t.Fatalf("expected 12,500 req/sec, got %q", s)
+	}
+
+	col := dataframe.NewColumn("AVG-VMRSS-MB-etcd")
+	col.PushBack(dataframe.NewStringValue("1048.576"))
+	converted, err := u.convertColumn("AVG-VMRSS-MB", col)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, _ := converted.Value(0)
+	if s, _ := v.String(); s != "1000.0000" {
+		t.Fatalf("expected 1000.0000, got %q", s)
+	}
+	v, _ = col.Value(0)
+	if s, _ := v.String(); s != "1048.576" {
+		t.Fatalf("original column must not change, got %q", s)
+	}
+
+	if _, err = newOutputUnits(dbtesterpb.ConfigAnalyzeMachineAllAggregatedOutput{MemoryUnit: "mb"}); err == nil {
+		t.Fatal("expected error for unknown memory unit")
+	}
+}
+
+func TestAxisLabel(t *testing.T) {
+	tests := []struct {
+		label string
+		unit  string
+		exp   string
+	}{
+		{"Latency(millisecond)", "ms", "Latency(millisecond)"},
+		{"Memory(MB)", "MiB", "Memory(MiB)"},
+		{"Throughput(Requests/Second)", "kops/s", "Throughput(kops/s)"},
+		{"Average CPU(%)", "%", "Average CPU(%)"},
+		{"Sectors Read (Delta per Second)", "", "Sectors Read (Delta per Second)"},
+		{"Latency", "ms", "Latency (ms)"},
+	}
+	for i, tt := range tests {
+		if s := axisLabel(tt.label, tt.unit); s != tt.exp {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.exp, s)
+		}
+	}
+
+	u := outputUnits{memory: "GiB"}
+	if s := u.columnUnit("AVG-VMRSS-MB"); s != "GiB" {
+		t.Fatalf("expected GiB, got %q", s)
+	}
+	if s := u.columnUnit("AVG-LATENCY-MS"); s != "ms" {
+		t.Fatalf("expected ms, got %q", s)
+	}
+	if s := u.memoryColumn("AVG-VMRSS-MB"); s != "AVG-VMRSS-GiB" {
+		t.Fatalf("expected AVG-VMRSS-GiB, got %q", s)
+	}
+	if s := (outputUnits{}).memoryColumn("AVG-VMRSS-MB"); s != "AVG-VMRSS-MB" {
+		t.Fatalf("expected AVG-VMRSS-MB, got %q", s)
+	}
+}
@@ -17,6 +17,9 @@ package analyze
 import (
 	"fmt"
 	"os"
+	"strings"
+
+	"github.com/coreos/dbtester"
 )
 
 func minFloat64(a, b float64) float64 {
@@ -37,6 +40,23 @@ func makeHeader(column string, tag string) string {
 	return fmt.Sprintf("%s-%s", column, tag)
 }
 
+// operationTypeOf returns the operation type of the per-operation-type
+// column (e.g. "read" for 'READ-AVG-LATENCY-MS-etcd-v3.3'), or empty
+// if the column is not per operation type.
+func operationTypeOf(column string) string {
+	for _, op := range dbtester.OperationTypes {
+		for _, cs := range [][]string{dbtester.OperationTimeseriesColumns, dbtester.OperationSummaryColumns} {
+			for _, c := range cs {
+				oc := dbtester.OperationColumn(op, c)
+				if column == oc || strings.HasPrefix(column, oc+"-") {
+					return op
+				}
+			}
+		}
+	}
+	return ""
+}
+
 func openToRead(fpath string) (*os.File, error) {
 	f, err := os.OpenFile(fpath, os.O_RDONLY, 0444)
 	if err != nil {
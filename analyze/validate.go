@@ -0,0 +1,264 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// csvSchema defines the columns that analyze reads from a CSV file.
+type csvSchema struct {
+	// columns must exist in the header, with numeric values.
+	columns []string
+	// horizontal is true if the first field of each row is the header
+	// (e.g. 'client-latency-distribution-summary.csv').
+	horizontal bool
+}
+
+var (
+	serverSystemMetricsSchema = csvSchema{columns: sysMetricsColumnsToRead}
+	clientSystemMetricsSchema = csvSchema{columns: []string{
+		"UNIX-SECOND",
+		"CPU-NUM",
+		"VMRSS-NUM",
+		"RECEIVE-BYTES-NUM-DELTA",
+		"TRANSMIT-BYTES-NUM-DELTA",
+	}}
+	clientLatencyThroughputTimeseriesSchema = csvSchema{columns: []string{
+		"UNIX-SECOND",
+		"CONTROL-CLIENT-NUM",
+		"MIN-LATENCY-MS",
+		"AVG-LATENCY-MS",
+		"MAX-LATENCY-MS",
+		"AVG-THROUGHPUT",
+	}}
+	clientLatencyDistributionSummarySchema = csvSchema{horizontal: true, columns: []string{
+		"TOTAL-SECONDS",
+		"REQUESTS-PER-SECOND",
+		"SLOWEST-LATENCY-MS",
+		"FASTEST-LATENCY-MS",
+		"AVERAGE-LATENCY-MS",
+	}}
+	clientLatencyDistributionPercentileSchema = csvSchema{columns: []string{"LATENCY-MS"}}
+	clientLatencyByKeyNumberSchema            = csvSchema{columns: []string{
+		"KEYS",
+		"MIN-LATENCY-MS",
+		"AVG-LATENCY-MS",
+		"MAX-LATENCY-MS",
+	}}
+	serverDiskSpaceUsageSummarySchema = csvSchema{columns: []string{dbtester.DiskSpaceUsageSummaryColumns[3]}}
+)
+
+// validateTestData validates all input files of the database upfront,
+// so that malformed files fail with the file name and line number.
+// If 'skipDir' is not empty, malformed rows are dropped instead, and
+// the paths are updated to the files without them, saved in 'skipDir'.
+func validateTestData(testdata *dbtesterpb.ConfigAnalyzeMachineInitial, skipDir string) error {
+	files := []struct {
+		fpath  *string
+		schema csvSchema
+	}{
+		{&testdata.ClientSystemMetricsInterpolatedPath, clientSystemMetricsSchema},
+		{&testdata.ClientLatencyThroughputTimeseriesPath, clientLatencyThroughputTimeseriesSchema},
+		{&testdata.ClientLatencyDistributionSummaryPath, clientLatencyDistributionSummarySchema},
+		{&testdata.ClientLatencyDistributionPercentilePath, clientLatencyDistributionPercentileSchema},
+		{&testdata.ClientLatencyByKeyNumberPath, clientLatencyByKeyNumberSchema},
+		{&testdata.ServerDiskSpaceUsageSummaryPath, serverDiskSpaceUsageSummarySchema},
+	}
+	for i := range testdata.ServerSystemMetricsInterpolatedPathList {
+		files = append(files, struct {
+			fpath  *string
+			schema csvSchema
+		}{&testdata.ServerSystemMetricsInterpolatedPathList[i], serverSystemMetricsSchema})
+	}
+	for _, f := range files {
+		if skipDir == "" {
+			if _, err := checkCSV(*f.fpath, f.schema, nil); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// rows are streamed to the cleaned file, which is
+		// kept only if any row was dropped
+		cleaned, err := ioutil.TempFile(skipDir, filepath.Base(*f.fpath))
+		if err != nil {
+			return err
+		}
+		wr := csv.NewWriter(cleaned)
+		bad, err := checkCSV(*f.fpath, f.schema, wr)
+		if cerr := cleaned.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil || len(bad) == 0 {
+			os.Remove(cleaned.Name())
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		samples := bad
+		if len(samples) > 3 {
+			samples = samples[:3]
+		}
+		plog.Warningf("skipped %d bad rows in %q (e.g. %s)", len(bad), *f.fpath, strings.Join(samples, "; "))
+		*f.fpath = cleaned.Name()
+	}
+	return nil
+}
+
+// checkCSV reads the file row by row, and returns an error with the file name
+// and line number if the file is missing any column or has a malformed row.
+// If 'w' is not nil, malformed rows are described in 'bad' instead, and
+// the other rows are written to 'w', so the file is never held in memory.
+func checkCSV(fpath string, schema csvSchema, w *csv.Writer) (bad []string, err error) {
+	f, err := openToRead(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rd := csv.NewReader(f)
+	rd.FieldsPerRecord = -1 // check field counts below, with line numbers
+	write := func(row []string) error {
+		if w == nil {
+			return nil
+		}
+		return w.Write(row)
+	}
+
+	var check func(line int, row []string) error
+	if schema.horizontal {
+		check = func(line int, row []string) error { return checkHorizontalRow(fpath, line, row, schema) }
+	} else {
+		header, err := rd.Read()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%s: empty file, expected columns %q", fpath, schema.columns)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fpath, err)
+		}
+		headerToIdx := make(map[string]int, len(header))
+		for i, h := range header {
+			headerToIdx[h] = i
+		}
+		var missing []string
+		for _, c := range schema.columns {
+			if _, ok := headerToIdx[c]; !ok {
+				missing = append(missing, c)
+			}
+		}
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("%s:1: missing columns %q (expected %q, found %q)", fpath, missing, schema.columns, header)
+		}
+		if err = write(header); err != nil {
+			return nil, err
+		}
+		check = func(line int, row []string) error {
+			if len(row) != len(header) {
+				return fmt.Errorf("%s:%d: expected %d fields (%q), found %d", fpath, line, len(header), header, len(row))
+			}
+			for _, c := range schema.columns {
+				v := row[headerToIdx[c]]
+				if _, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err != nil {
+					return fmt.Errorf("%s:%d: column %q has non-numeric value %q", fpath, line, c, v)
+				}
+			}
+			return nil
+		}
+	}
+
+	found := make(map[string]bool)
+	line := 1
+	if !schema.horizontal {
+		line = 2
+	}
+	for ; ; line++ {
+		row, err := rd.Read()
+		if err == io.EOF {
+			break
+		}
+		if err == nil {
+			err = check(line, row)
+		} else {
+			err = fmt.Errorf("%s: %v", fpath, err)
+		}
+		if err != nil {
+			if w == nil {
+				return nil, err
+			}
+			bad = append(bad, err.Error())
+			continue
+		}
+		if schema.horizontal {
+			found[row[0]] = true
+		}
+		if err = write(row); err != nil {
+			return nil, err
+		}
+	}
+	if w != nil {
+		w.Flush()
+		if err = w.Error(); err != nil {
+			return nil, err
+		}
+	}
+
+	if schema.horizontal {
+		var missing []string
+		for _, c := range schema.columns {
+			if !found[c] {
+				missing = append(missing, c)
+			}
+		}
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("%s: missing rows %q (expected %q)", fpath, missing, schema.columns)
+		}
+	}
+	return bad, nil
+}
+
+// checkHorizontalRow checks a row of header and value
+// (e.g. 'REQUESTS-PER-SECOND,1000').
+func checkHorizontalRow(fpath string, line int, row []string, schema csvSchema) error {
+	if len(row) < 2 {
+		return fmt.Errorf("%s:%d: expected 2 fields (header and value), found %d", fpath, line, len(row))
+	}
+	required := strings.HasPrefix(row[0], "ERROR")
+	for _, c := range schema.columns {
+		if row[0] == c {
+			required = true
+			break
+		}
+	}
+	if !required {
+		return nil
+	}
+	if _, err := strconv.ParseFloat(strings.TrimSpace(row[1]), 64); err != nil {
+		return fmt.Errorf("%s:%d: row %q has non-numeric value %q", fpath, line, row[0], row[1])
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckCSV(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "analyze-validate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		data   string
+		schema csvSchema
+		errStr string
+	}{
+		{
+			data:   "UNIX-SECOND,CONTROL-CLIENT-NUM,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS,AVG-THROUGHPUT\n1,1,0.1,0.2,0.3,100\n",
+			schema: clientLatencyThroughputTimeseriesSchema,
+		},
+		{
+			data:   "UNIX-SECOND,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS\n1,0.1,0.2,0.3\n",
+			schema: clientLatencyThroughputTimeseriesSchema,
+			errStr: `:1: missing columns ["CONTROL-CLIENT-NUM" "AVG-THROUGHPUT"]`,
+		},
+		{
+			data:   "KEYS,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS\n1000,0.1,0.2,0.3\n2000,0.1,0.2\n",
+			schema: clientLatencyByKeyNumberSchema,
+			errStr: ":3: expected 4 fields",
+		},
+		{
+			data:   "KEYS,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS\n1000,0.1,abc,0.3\n",
+			schema: clientLatencyByKeyNumberSchema,
+			errStr: `:2: column "AVG-LATENCY-MS" has non-numeric value "abc"`,
+		},
+		{
+			data:   "TOTAL-SECONDS,10\nREQUESTS-PER-SECOND,100\nSLOWEST-LATENCY-MS,3\nFASTEST-LATENCY-MS,1\nAVERAGE-LATENCY-MS,2\nERROR,0\n",
+			schema: clientLatencyDistributionSummarySchema,
+		},
+		{
+			data:   "TOTAL-SECONDS,10\nREQUESTS-PER-SECOND\n",
+			schema: clientLatencyDistributionSummarySchema,
+			errStr: ":2: expected 2 fields",
+		},
+		{
+			data:   "TOTAL-SECONDS,10\n",
+			schema: clientLatencyDistributionSummarySchema,
+			errStr: `missing rows ["REQUESTS-PER-SECOND"`,
+		},
+	}
+	for i, tt := range tests {
+		fpath := filepath.Join(dir, "test.csv")
+		if err = ioutil.WriteFile(fpath, []byte(tt.data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		_, err = checkCSV(fpath, tt.schema, nil)
+		if tt.errStr == "" {
+			if err != nil {
+				t.Errorf("#%d: unexpected error %v", i, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.errStr) || !strings.HasPrefix(err.Error(), fpath) {
+			t.Errorf("#%d: expected error with %q, got %v", i, tt.errStr, err)
+		}
+	}
+}
+
+func TestCheckCSVSkipBadRows(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "analyze-validate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "test.csv")
+	data := "KEYS,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS\n1000,0.1,0.2,0.3\n2000,0.1,abc,0.3\n3000,0.1\n4000,0.1,0.2,0.3\n"
+	if err = ioutil.WriteFile(fpath, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	bad, err := checkCSV(fpath, clientLatencyByKeyNumberSchema, csv.NewWriter(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "KEYS,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS\n1000,0.1,0.2,0.3\n4000,0.1,0.2,0.3\n"; buf.String() != exp {
+		t.Fatalf("expected rows %q, got %q", exp, buf.String())
+	}
+	if len(bad) != 2 || !strings.Contains(bad[0], ":3: ") || !strings.Contains(bad[1], ":4: ") {
+		t.Fatalf("unexpected bad rows %q", bad)
+	}
+
+	// missing columns cannot be skipped
+	if err = ioutil.WriteFile(fpath, []byte("KEYS,MIN-LATENCY-MS\n1000,0.1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = checkCSV(fpath, clientLatencyByKeyNumberSchema, csv.NewWriter(ioutil.Discard)); err == nil {
+		t.Fatal("expected error for missing columns")
+	}
+}
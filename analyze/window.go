@@ -0,0 +1,252 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"github.com/coreos/dbtester/pkg/table"
+)
+
+// absoluteSecondThreshold is the smallest bound to be read as Unix seconds.
+// Smaller numbers are seconds relative to the start of the run.
+const absoluteSecondThreshold = 1000000000
+
+// parseWindowBound parses the bound of a time window, in seconds
+// relative to 'start', Unix seconds, or RFC3339 timestamp.
+func parseWindowBound(s string, start int64) (int64, error) {
+	if iv, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if iv < absoluteSecondThreshold {
+			return start + iv, nil
+		}
+		return iv, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q (expected relative seconds, Unix seconds, or RFC3339)", s)
+	}
+	return t.Unix(), nil
+}
+
+// applyTimeWindow keeps only the rows within the time window
+// in the timeseries of the test data, so that the ramp-up and the drain
+// can be excluded. The windowed files are saved in 'dir', and the paths
+// are updated to them. The latency and throughput rows of the summary are
+// recomputed from the windowed timeseries, and the percentiles from the
+// latency histogram log of the window. Without the histogram log,
+// percentiles are of the whole run, and it returns true.
+func applyTimeWindow(testdata *dbtesterpb.ConfigAnalyzeMachineInitial, dir, from, to string) (wholeRunPercentiles bool, err error) {
+	tb, err := table.ReadCSV(testdata.ClientLatencyThroughputTimeseriesPath)
+	if err != nil {
+		return false, err
+	}
+	secs, err := tb.Column("UNIX-SECOND")
+	if err != nil {
+		return false, err
+	}
+	if len(secs) == 0 {
+		return false, fmt.Errorf("%q has no data", testdata.ClientLatencyThroughputTimeseriesPath)
+	}
+	start, err := strconv.ParseInt(secs[0], 10, 64)
+	if err != nil {
+		return false, err
+	}
+
+	fromSec, toSec := int64(0), int64(1<<62)
+	if from != "" {
+		if fromSec, err = parseWindowBound(from, start); err != nil {
+			return false, err
+		}
+	}
+	if to != "" {
+		if toSec, err = parseWindowBound(to, start); err != nil {
+			return false, err
+		}
+	}
+	if fromSec > toSec {
+		return false, fmt.Errorf("invalid time window [%d, %d]", fromSec, toSec)
+	}
+	plog.Printf("analyzing time window [%s, %s] (run started at %d)", from, to, start)
+
+	fpaths := []*string{
+		&testdata.ClientSystemMetricsInterpolatedPath,
+		&testdata.ClientLatencyThroughputTimeseriesPath,
+	}
+	for i := range testdata.ServerSystemMetricsInterpolatedPathList {
+		fpaths = append(fpaths, &testdata.ServerSystemMetricsInterpolatedPathList[i])
+	}
+	for _, fpath := range fpaths {
+		tb, err := table.ReadCSV(*fpath)
+		if err != nil {
+			return false, err
+		}
+		windowed := tb.Filter(func(r table.Row) bool {
+			sec, err := strconv.ParseInt(r.Get("UNIX-SECOND"), 10, 64)
+			return err == nil && fromSec <= sec && sec <= toSec
+		})
+		if len(windowed.Rows) == 0 {
+			return false, fmt.Errorf("%q has no data in time window [%d, %d]", *fpath, fromSec, toSec)
+		}
+
+		f, err := ioutil.TempFile(dir, filepath.Base(*fpath))
+		if err != nil {
+			return false, err
+		}
+		f.Close()
+		if err = windowed.WriteCSV(f.Name()); err != nil {
+			return false, err
+		}
+		*fpath = f.Name()
+
+		if fpath == &testdata.ClientLatencyThroughputTimeseriesPath && testdata.ClientLatencyDistributionSummaryPath != "" {
+			if testdata.ClientLatencyDistributionSummaryPath, err = windowSummary(testdata.ClientLatencyDistributionSummaryPath, windowed, dir); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	if _, err = os.Stat(testdata.ClientLatencyHistogramLogPath); testdata.ClientLatencyHistogramLogPath == "" || err != nil {
+		plog.Warningf("%s: no latency histogram log to compute latency percentiles in time window, so they are of the whole run", testdata.DatabaseID)
+		return true, nil
+	}
+	entries, err := readHistogramLog(testdata.ClientLatencyHistogramLogPath)
+	if err != nil {
+		return false, err
+	}
+	var windowed []hdrhistogram.LogEntry
+	for _, e := range entries {
+		if sec := e.Start.Unix(); fromSec <= sec && sec <= toSec {
+			windowed = append(windowed, e)
+		}
+	}
+	if len(windowed) == 0 {
+		return false, fmt.Errorf("%q has no data in time window [%d, %d]", testdata.ClientLatencyHistogramLogPath, fromSec, toSec)
+	}
+	pt, err := percentilesExcluding(windowed, nil)
+	if err != nil {
+		return false, err
+	}
+
+	// later steps (e.g. excluding client-saturated seconds) only read the window
+	hf, err := ioutil.TempFile(dir, filepath.Base(testdata.ClientLatencyHistogramLogPath))
+	if err != nil {
+		return false, err
+	}
+	hf.Close()
+	if err = writeHistogramLog(hf.Name(), windowed); err != nil {
+		return false, err
+	}
+	testdata.ClientLatencyHistogramLogPath = hf.Name()
+
+	pf, err := ioutil.TempFile(dir, filepath.Base(testdata.ClientLatencyDistributionPercentilePath))
+	if err != nil {
+		return false, err
+	}
+	pf.Close()
+	if err = pt.WriteCSV(pf.Name()); err != nil {
+		return false, err
+	}
+	testdata.ClientLatencyDistributionPercentilePath = pf.Name()
+	return false, nil
+}
+
+// windowSummary rewrites the summary rows of total seconds, throughput and
+// latencies with the values of the windowed timeseries, and returns the
+// path of the rewritten summary in 'dir'. The standard deviation cannot be
+// recomputed from per-second averages, so its row is dropped.
+func windowSummary(fpath string, windowed *table.Table, dir string) (string, error) {
+	cols := make(map[string][]float64)
+	for _, name := range []string{"MIN-LATENCY-MS", "AVG-LATENCY-MS", "MAX-LATENCY-MS", "AVG-THROUGHPUT"} {
+		vs, err := windowed.Column(name)
+		if err != nil {
+			return "", err
+		}
+		fs := make([]float64, len(vs))
+		for i, v := range vs {
+			if fs[i], err = strconv.ParseFloat(v, 64); err != nil {
+				return "", fmt.Errorf("invalid %s %q (%v)", name, v, err)
+			}
+		}
+		cols[name] = fs
+	}
+
+	seconds := len(windowed.Rows)
+	var requests, latencySum, fastest, slowest float64
+	for i, thr := range cols["AVG-THROUGHPUT"] {
+		if thr == 0 {
+			continue // no request completed in this second
+		}
+		if minLat := cols["MIN-LATENCY-MS"][i]; requests == 0 || minLat < fastest {
+			fastest = minLat
+		}
+		if maxLat := cols["MAX-LATENCY-MS"][i]; maxLat > slowest {
+			slowest = maxLat
+		}
+		requests += thr
+		latencySum += cols["AVG-LATENCY-MS"][i] * thr
+	}
+	var average float64
+	if requests > 0 {
+		average = latencySum / requests
+	}
+	recomputed := map[string]float64{
+		"TOTAL-SECONDS":       float64(seconds),
+		"REQUESTS-PER-SECOND": requests / float64(seconds),
+		"SLOWEST-LATENCY-MS":  slowest,
+		"FASTEST-LATENCY-MS":  fastest,
+		"AVERAGE-LATENCY-MS":  average,
+	}
+
+	f, err := openToRead(fpath)
+	if err != nil {
+		return "", err
+	}
+	rd := csv.NewReader(f)
+	rd.FieldsPerRecord = -1
+	rows, err := rd.ReadAll()
+	f.Close()
+	if err != nil {
+		return "", fmt.Errorf("%q: %v", fpath, err)
+	}
+	kept := rows[:0]
+	for _, row := range rows {
+		if len(row) < 2 || row[0] == "STDDEV-LATENCY-MS" {
+			continue
+		}
+		if v, ok := recomputed[row[0]]; ok {
+			row[1] = fmt.Sprintf("%4.4f", v)
+		}
+		kept = append(kept, row)
+	}
+
+	wf, err := ioutil.TempFile(dir, filepath.Base(fpath))
+	if err != nil {
+		return "", err
+	}
+	wr := csv.NewWriter(wf)
+	if err = wr.WriteAll(kept); err != nil {
+		wf.Close()
+		return "", err
+	}
+	return wf.Name(), wf.Close()
+}
@@ -0,0 +1,184 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"github.com/coreos/dbtester/pkg/table"
+)
+
+func TestParseWindowBound(t *testing.T) {
+	tests := []struct {
+		s   string
+		sec int64
+	}{
+		{"60", 1500000060},
+		{"1500000100", 1500000100},
+		{"2017-07-14T02:41:40Z", 1500000100},
+	}
+	for i, tt := range tests {
+		sec, err := parseWindowBound(tt.s, 1500000000)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if sec != tt.sec {
+			t.Fatalf("#%d: expected %d, got %d", i, tt.sec, sec)
+		}
+	}
+	if _, err := parseWindowBound("1m", 0); err == nil {
+		t.Fatal("expected error for invalid bound")
+	}
+}
+
+func TestApplyTimeWindow(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "analyze-window")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := "UNIX-SECOND,AVG-THROUGHPUT\n100,1\n101,2\n102,3\n103,4\n"
+	testdata := dbtesterpb.ConfigAnalyzeMachineInitial{
+		ClientSystemMetricsInterpolatedPath:     filepath.Join(dir, "client-system-metrics.csv"),
+		ClientLatencyThroughputTimeseriesPath:   filepath.Join(dir, "timeseries.csv"),
+		ServerSystemMetricsInterpolatedPathList: []string{filepath.Join(dir, "server-1.csv")},
+	}
+	for _, fpath := range []string{testdata.ClientSystemMetricsInterpolatedPath, testdata.ClientLatencyThroughputTimeseriesPath, testdata.ServerSystemMetricsInterpolatedPathList[0]} {
+		if err = ioutil.WriteFile(fpath, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wholeRun, err := applyTimeWindow(&testdata, dir, "1", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !wholeRun {
+		t.Fatal("expected whole-run percentiles without latency histogram log")
+	}
+	for _, fpath := range []string{testdata.ClientSystemMetricsInterpolatedPath, testdata.ClientLatencyThroughputTimeseriesPath, testdata.ServerSystemMetricsInterpolatedPathList[0]} {
+		tb, err := table.ReadCSV(fpath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		secs, err := tb.Column("UNIX-SECOND")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(secs) != 2 || secs[0] != "101" || secs[1] != "102" {
+			t.Fatalf("%q: unexpected seconds %q", fpath, secs)
+		}
+	}
+}
+
+func TestApplyTimeWindowSummary(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "analyze-window")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testdata := dbtesterpb.ConfigAnalyzeMachineInitial{
+		ClientSystemMetricsInterpolatedPath:   filepath.Join(dir, "client-system-metrics.csv"),
+		ClientLatencyThroughputTimeseriesPath: filepath.Join(dir, "timeseries.csv"),
+		ClientLatencyDistributionSummaryPath:  filepath.Join(dir, "summary.csv"),
+	}
+	timeseries := "UNIX-SECOND,CONTROL-CLIENT-NUM,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS,AVG-THROUGHPUT\n" +
+		"100,1,5,50,500,10\n101,1,1,2,4,100\n102,1,2,5,8,300\n103,1,0,0,0,0\n104,1,7,70,700,10\n"
+	summary := "TOTAL-SECONDS,5.0000\nREQUESTS-PER-SECOND,84.0000\nSLOWEST-LATENCY-MS,700.0000\nFASTEST-LATENCY-MS,1.0000\nAVERAGE-LATENCY-MS,9.0000\nSTDDEV-LATENCY-MS,3.0000\nERROR,0\n"
+	for _, fpath := range []string{testdata.ClientSystemMetricsInterpolatedPath, testdata.ClientLatencyThroughputTimeseriesPath} {
+		if err = ioutil.WriteFile(fpath, []byte(timeseries), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = ioutil.WriteFile(testdata.ClientLatencyDistributionSummaryPath, []byte(summary), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = applyTimeWindow(&testdata, dir, "1", "3"); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(testdata.ClientLatencyDistributionSummaryPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := "TOTAL-SECONDS,3.0000\nREQUESTS-PER-SECOND,133.3333\nSLOWEST-LATENCY-MS,8.0000\nFASTEST-LATENCY-MS,1.0000\nAVERAGE-LATENCY-MS,4.2500\nERROR,0\n"
+	if string(b) != exp {
+		t.Fatalf("expected summary %q, got %q", exp, string(b))
+	}
+}
+
+func TestApplyTimeWindowPercentiles(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "analyze-window")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testdata := dbtesterpb.ConfigAnalyzeMachineInitial{
+		ClientSystemMetricsInterpolatedPath:     filepath.Join(dir, "client-system-metrics.csv"),
+		ClientLatencyThroughputTimeseriesPath:   filepath.Join(dir, "timeseries.csv"),
+		ClientLatencyDistributionPercentilePath: filepath.Join(dir, "percentile.csv"),
+		ClientLatencyHistogramLogPath:           filepath.Join(dir, "latency.hlog"),
+	}
+	data := "UNIX-SECOND,AVG-THROUGHPUT\n100,1\n101,1\n102,1\n"
+	for _, fpath := range []string{testdata.ClientSystemMetricsInterpolatedPath, testdata.ClientLatencyThroughputTimeseriesPath} {
+		if err = ioutil.WriteFile(fpath, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 1 second latency only in the ramp-up, 1ms after
+	var entries []hdrhistogram.LogEntry
+	for sec, us := range map[int64]int64{100: 1000000, 101: 1000, 102: 1000} {
+		h, _ := hdrhistogram.New(1, 3600*1000*1000, 3)
+		h.RecordValues(us, 10)
+		entries = append(entries, hdrhistogram.LogEntry{Start: time.Unix(sec, 0), Length: time.Second, Histogram: h})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Start.Before(entries[j].Start) })
+	if err = writeHistogramLog(testdata.ClientLatencyHistogramLogPath, entries); err != nil {
+		t.Fatal(err)
+	}
+
+	wholeRun, err := applyTimeWindow(&testdata, dir, "1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wholeRun {
+		t.Fatal("expected percentiles of the time window")
+	}
+	tb, err := table.ReadCSV(testdata.ClientLatencyDistributionPercentilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range tb.Rows {
+		if ms, _ := strconv.ParseFloat(row[1], 64); ms > 2 {
+			t.Fatalf("expected ramp-up excluded from %s, got %s ms", row[0], row[1])
+		}
+	}
+	es, err := readHistogramLog(testdata.ClientLatencyHistogramLogPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(es) != 2 {
+		t.Fatalf("expected 2 histograms in time window, got %d", len(es))
+	}
+}
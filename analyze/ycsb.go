@@ -0,0 +1,344 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"github.com/coreos/dbtester/pkg/table"
+	"github.com/spf13/cobra"
+)
+
+// YCSBCommand implements 'analyze ycsb' command.
+var YCSBCommand = &cobra.Command{
+	Use:   "ycsb",
+	Short: "Exports the results of each database in YCSB summary output format, to compare with published YCSB numbers.",
+	RunE:  ycsbCommandFunc,
+}
+
+var ycsbOutputDir string
+
+func init() {
+	YCSBCommand.Flags().StringVar(&ycsbOutputDir, "output-dir", "", "Directory to save '<database_tag>-ycsb.txt' of each database.")
+	Command.AddCommand(YCSBCommand)
+}
+
+func ycsbCommandFunc(cmd *cobra.Command, args []string) error {
+	if ycsbOutputDir == "" {
+		return fmt.Errorf("'--output-dir' is required")
+	}
+	cfg, err := dbtester.ReadConfig(configPath, true)
+	if err != nil {
+		return err
+	}
+	if resultsRoot != "" {
+		if err = cfg.UseResultLayout(resultsRoot, runID, runTags); err != nil {
+			return err
+		}
+	}
+	if err = os.MkdirAll(ycsbOutputDir, 0777); err != nil {
+		return err
+	}
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		testgroup := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+		testdata := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
+		res, err := readYCSBResult(testdata, testgroup.ConfigClientMachineBenchmarkOptions)
+		if err != nil {
+			return fmt.Errorf("%s: %v", databaseID, err)
+		}
+		fpath := filepath.Join(ycsbOutputDir, testgroup.DatabaseTag+"-ycsb.txt")
+		f, err := openToOverwrite(fpath)
+		if err != nil {
+			return err
+		}
+		err = res.write(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+		plog.Printf("saved YCSB output of %s to %q", databaseID, fpath)
+	}
+	return nil
+}
+
+// ycsbOperation is the result of an operation type, in YCSB terms.
+type ycsbOperation struct {
+	// name is the YCSB operation (e.g. "INSERT", "READ").
+	name       string
+	operations int64
+	errors     int64
+
+	avgUs float64
+	minUs float64
+	maxUs float64
+	// p95Us and p99Us are negative if not known.
+	p95Us float64
+	p99Us float64
+}
+
+// ycsbResult is the summary of a run, in YCSB terms.
+type ycsbResult struct {
+	runTimeMs  float64
+	throughput float64
+	operations []ycsbOperation
+}
+
+// ycsbOperationName returns the YCSB operation of the benchmark type,
+// or of the operation type in "mixed" type benchmark. Writes of new keys
+// are inserts, and writes in "mixed" type benchmark overwrite keys.
+func ycsbOperationName(benchmarkType string) string {
+	switch benchmarkType {
+	case "write":
+		return "INSERT"
+	case "read", "read-oneshot":
+		return "READ"
+	default:
+		return strings.ToUpper(benchmarkType)
+	}
+}
+
+// write writes the result in the format of YCSB summary output, where
+// latencies are in microseconds (e.g. '[READ], AverageLatency(us), 410.5').
+func (r ycsbResult) write(w io.Writer) error {
+	lines := [][]string{
+		{"[OVERALL]", "RunTime(ms)", formatYCSBFloat(r.runTimeMs)},
+		{"[OVERALL]", "Throughput(ops/sec)", formatYCSBFloat(r.throughput)},
+	}
+	for _, op := range r.operations {
+		name := "[" + op.name + "]"
+		lines = append(lines,
+			[]string{name, "Operations", fmt.Sprintf("%d", op.operations)},
+			[]string{name, "AverageLatency(us)", formatYCSBFloat(op.avgUs)},
+			[]string{name, "MinLatency(us)", fmt.Sprintf("%.0f", op.minUs)},
+			[]string{name, "MaxLatency(us)", fmt.Sprintf("%.0f", op.maxUs)},
+		)
+		if op.p95Us >= 0 {
+			lines = append(lines, []string{name, "95thPercentileLatency(us)", fmt.Sprintf("%.0f", op.p95Us)})
+		}
+		if op.p99Us >= 0 {
+			lines = append(lines, []string{name, "99thPercentileLatency(us)", fmt.Sprintf("%.0f", op.p99Us)})
+		}
+		lines = append(lines, []string{name, "Return=OK", fmt.Sprintf("%d", op.operations)})
+		if op.errors > 0 {
+			lines = append(lines, []string{name, "Return=ERROR", fmt.Sprintf("%d", op.errors)})
+		}
+	}
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(w, strings.Join(line, ", ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatYCSBFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// readYCSBResult reads the client results of a database. Percentiles of
+// each operation type in "mixed" type benchmark are read from the latency
+// histogram log, and omitted without it.
+func readYCSBResult(testdata dbtesterpb.ConfigAnalyzeMachineInitial, opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) (ycsbResult, error) {
+	summary, err := readSummaryValues(testdata.ClientLatencyDistributionSummaryPath)
+	if err != nil {
+		return ycsbResult{}, err
+	}
+	timeseries, err := table.ReadCSV(testdata.ClientLatencyThroughputTimeseriesPath)
+	if err != nil {
+		return ycsbResult{}, err
+	}
+	var entries []hdrhistogram.LogEntry
+	if testdata.ClientLatencyHistogramLogPath != "" {
+		if entries, err = readHistogramLog(testdata.ClientLatencyHistogramLogPath); err != nil {
+			return ycsbResult{}, err
+		}
+	}
+
+	res := ycsbResult{
+		runTimeMs:  1000 * summary["TOTAL-SECONDS"],
+		throughput: summary["REQUESTS-PER-SECOND"],
+	}
+	var errN int64
+	for k, v := range summary {
+		if strings.HasPrefix(k, "ERROR:") {
+			errN += int64(v)
+		}
+	}
+
+	benchmarkType := ""
+	if opts != nil {
+		benchmarkType = opts.Type
+	}
+	if benchmarkType != "mixed" {
+		op := ycsbOperation{
+			name:   ycsbOperationName(benchmarkType),
+			errors: errN,
+			avgUs:  1000 * summary["AVERAGE-LATENCY-MS"],
+			minUs:  1000 * summary["FASTEST-LATENCY-MS"],
+			maxUs:  1000 * summary["SLOWEST-LATENCY-MS"],
+			p95Us:  -1,
+			p99Us:  -1,
+		}
+		if op.operations, err = sumColumn(timeseries, "AVG-THROUGHPUT"); err != nil {
+			return ycsbResult{}, err
+		}
+		if entries != nil {
+			op.p95Us, op.p99Us, err = tagPercentiles(entries, "")
+		} else {
+			op.p95Us, op.p99Us, err = readPercentiles(testdata.ClientLatencyDistributionPercentilePath)
+		}
+		if err != nil {
+			return ycsbResult{}, err
+		}
+		res.operations = append(res.operations, op)
+		return res, nil
+	}
+
+	if entries == nil {
+		plog.Warningf("%s: no latency histogram log, percentiles of each operation type are omitted", testdata.DatabaseID)
+	}
+	for _, opType := range dbtester.OperationTypes {
+		if _, ok := summary[dbtester.OperationColumn(opType, "AVERAGE-LATENCY-MS")]; !ok {
+			continue
+		}
+		name := "UPDATE"
+		if opType == "read" {
+			name = "READ"
+		}
+		op := ycsbOperation{
+			name:  name,
+			avgUs: 1000 * summary[dbtester.OperationColumn(opType, "AVERAGE-LATENCY-MS")],
+			maxUs: 1000 * summary[dbtester.OperationColumn(opType, "SLOWEST-LATENCY-MS")],
+			p95Us: -1,
+			p99Us: -1,
+		}
+		if op.operations, err = sumColumn(timeseries, dbtester.OperationColumn(opType, "AVG-THROUGHPUT")); err != nil {
+			return ycsbResult{}, err
+		}
+		// fastest of each second with requests
+		mins, err := timeseries.Column(dbtester.OperationColumn(opType, "MIN-LATENCY-MS"))
+		if err != nil {
+			return ycsbResult{}, err
+		}
+		op.minUs = -1
+		for _, s := range mins {
+			if fv, err := strconv.ParseFloat(s, 64); err == nil && fv > 0 && (op.minUs < 0 || 1000*fv < op.minUs) {
+				op.minUs = 1000 * fv
+			}
+		}
+		if op.minUs < 0 {
+			op.minUs = 0
+		}
+		if entries != nil {
+			if op.p95Us, op.p99Us, err = tagPercentiles(entries, opType); err != nil {
+				return ycsbResult{}, err
+			}
+		}
+		res.operations = append(res.operations, op)
+	}
+	return res, nil
+}
+
+// readSummaryValues reads the numeric values of the client summary,
+// saved as 'name,value' rows.
+func readSummaryValues(fpath string) (map[string]float64, error) {
+	f, err := openToRead(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rd := csv.NewReader(f)
+	rd.FieldsPerRecord = -1
+	rows, err := rd.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]float64)
+	for _, row := range rows {
+		if len(row) < 2 {
+			continue
+		}
+		if fv, err := strconv.ParseFloat(strings.TrimSpace(row[1]), 64); err == nil {
+			m[row[0]] = fv
+		}
+	}
+	return m, nil
+}
+
+// sumColumn returns the sum of the column, as integer.
+func sumColumn(tb *table.Table, column string) (int64, error) {
+	vs, err := tb.Column(column)
+	if err != nil {
+		return 0, err
+	}
+	var sum float64
+	for _, s := range vs {
+		fv, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %v", column, err)
+		}
+		sum += fv
+	}
+	return int64(sum + 0.5), nil
+}
+
+// readPercentiles reads p95 and p99 of the client latency percentiles,
+// in microseconds.
+func readPercentiles(fpath string) (p95Us, p99Us float64, err error) {
+	tb, err := table.ReadCSV(fpath)
+	if err != nil {
+		return 0, 0, err
+	}
+	p95Us, p99Us = -1, -1
+	for _, row := range tb.Rows {
+		if len(row) < 2 {
+			continue
+		}
+		fv, err := strconv.ParseFloat(row[1], 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("%s in %q: %v", row[0], fpath, err)
+		}
+		switch row[0] {
+		case "p95":
+			p95Us = 1000 * fv
+		case "p99":
+			p99Us = 1000 * fv
+		}
+	}
+	return p95Us, p99Us, nil
+}
+
+// tagPercentiles returns p95 and p99 of all histograms with the tag,
+// in microseconds, or negative values if there is no histogram.
+func tagPercentiles(entries []hdrhistogram.LogEntry, tag string) (p95Us, p99Us float64, err error) {
+	h, err := mergeHistograms(entries, func(e hdrhistogram.LogEntry) bool { return e.Tag == tag })
+	if err != nil {
+		return 0, 0, err
+	}
+	if h == nil {
+		return -1, -1, nil
+	}
+	return float64(h.ValueAtQuantile(95)), float64(h.ValueAtQuantile(99)), nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestReadYCSBResult(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "analyze-ycsb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testdata := dbtesterpb.ConfigAnalyzeMachineInitial{
+		ClientLatencyDistributionSummaryPath:    filepath.Join(dir, "summary.csv"),
+		ClientLatencyThroughputTimeseriesPath:   filepath.Join(dir, "timeseries.csv"),
+		ClientLatencyDistributionPercentilePath: filepath.Join(dir, "percentile.csv"),
+	}
+	for fpath, data := range map[string]string{
+		testdata.ClientLatencyDistributionSummaryPath:    "TOTAL-SECONDS,2.5000\nREQUESTS-PER-SECOND,400.0000\nSLOWEST-LATENCY-MS,20.0000\nFASTEST-LATENCY-MS,0.2500\nAVERAGE-LATENCY-MS,1.5000\nSTDDEV-LATENCY-MS,0.1000\n\"ERROR: \"\"timeout\"\"\",3\n",
+		testdata.ClientLatencyThroughputTimeseriesPath:   "UNIX-SECOND,AVG-THROUGHPUT\n100,400\n101,400\n102,197\n",
+		testdata.ClientLatencyDistributionPercentilePath: "LATENCY-PERCENTILE,LATENCY-MS\np50,1.000000\np95,3.500000\np99,10.000000\n",
+	} {
+		if err = ioutil.WriteFile(fpath, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res, err := readYCSBResult(testdata, &dbtesterpb.ConfigClientMachineBenchmarkOptions{Type: "write"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err = res.write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	exp := `[OVERALL], RunTime(ms), 2500
+[OVERALL], Throughput(ops/sec), 400
+[INSERT], Operations, 997
+[INSERT], AverageLatency(us), 1500
+[INSERT], MinLatency(us), 250
+[INSERT], MaxLatency(us), 20000
+[INSERT], 95thPercentileLatency(us), 3500
+[INSERT], 99thPercentileLatency(us), 10000
+[INSERT], Return=OK, 997
+[INSERT], Return=ERROR, 3
+`
+	if buf.String() != exp {
+		t.Fatalf("expected\n%s\ngot\n%s", exp, buf.String())
+	}
+}
+
+func TestYCSBOperationName(t *testing.T) {
+	for typ, name := range map[string]string{
+		"write":        "INSERT",
+		"read":         "READ",
+		"read-oneshot": "READ",
+		"replay":       "REPLAY",
+	} {
+		if v := ycsbOperationName(typ); v != name {
+			t.Fatalf("%q: expected %q, got %q", typ, name, v)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/anonymize"
+)
+
+// newAnonymizer returns the Anonymizer for results of the database,
+// where servers are named in the order of peer IPs, as in agents.
+func (cfg *Config) newAnonymizer(gcfg dbtesterpb.ConfigClientMachineAgentControl) *anonymize.Anonymizer {
+	names := map[string]string{
+		cfg.ConfigClientMachineInitial.GoogleCloudProjectName:       "project",
+		cfg.ConfigClientMachineInitial.GoogleCloudStorageBucketName: "bucket",
+		gcfg.DiscoverySRV: "discovery",
+	}
+	if host, err := os.Hostname(); err == nil {
+		names[host] = "client"
+	}
+	for i, ip := range gcfg.PeerIPs {
+		names[ip] = fmt.Sprintf("server-%d", i+1)
+	}
+	for i, ep := range gcfg.ProxyEndpoints {
+		if host, _, err := net.SplitHostPort(ep); err == nil {
+			ep = host
+		}
+		names[ep] = fmt.Sprintf("proxy-%d", i+1)
+	}
+	return anonymize.New(names)
+}
+
+// anonymizedCopy writes the anonymized copy of 'fpath' in a temporary
+// directory. The returned function removes the copy.
+func (cfg *Config) anonymizedCopy(gcfg dbtesterpb.ConfigClientMachineAgentControl, fpath string) (string, func(), error) {
+	dir, err := ioutil.TempDir("", "dbtester-anonymized")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+	dst := filepath.Join(dir, filepath.Base(fpath))
+	if err = cfg.newAnonymizer(gcfg).File(fpath, dst); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return dst, cleanup, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/coreos/dbtester/pkg/tc"
+)
+
+// bandwidthCommands returns 'tc' arguments to cap the total outgoing
+// bandwidth to the IPs, in one 'htb' class. Other packets are not
+// classified, so not shaped.
+func bandwidthCommands(dev string, mbit float64, ips []string) [][]string {
+	rate := fmt.Sprintf("%gmbit", mbit)
+	cmds := [][]string{
+		{"qdisc", "add", "dev", dev, "root", "handle", "1:", "htb"},
+		{"class", "add", "dev", dev, "parent", "1:", "classid", "1:1", "htb", "rate", rate, "ceil", rate},
+	}
+	for _, ip := range ips {
+		cmds = append(cmds, []string{"filter", "add", "dev", dev, "protocol", "ip", "parent", "1:0", "prio", "1", "u32", "match", "ip", "dst", ip + "/32", "flowid", "1:1"})
+	}
+	return cmds
+}
+
+// LimitClientBandwidth caps the bandwidth from this machine to database
+// endpoints on the network interface, with 'client_bandwidth_mbit_per_second'.
+// It returns the function to remove the limit. It is no-op if not configured.
+func (cfg *Config) LimitClientBandwidth(databaseID, dev string) (func() error, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return nil, fmt.Errorf("%q does not exist", databaseID)
+	}
+	mbit := gcfg.ClientBandwidthMbitPerSecond
+	if mbit == 0 {
+		return func() error { return nil }, nil
+	}
+	if dev == "" {
+		return nil, fmt.Errorf("network interface is required to limit bandwidth")
+	}
+
+	seen := make(map[string]bool)
+	var ips []string
+	for _, ep := range gcfg.DatabaseEndpoints {
+		host, _, err := net.SplitHostPort(ep)
+		if err != nil {
+			return nil, err
+		}
+		addrs, err := net.LookupIP(host)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addrs {
+			if ip := addr.To4(); ip != nil && !seen[ip.String()] {
+				seen[ip.String()] = true
+				ips = append(ips, ip.String())
+			}
+		}
+	}
+
+	// remove rules left by previous runs
+	tc.Reset(dev)
+	plog.Infof("limiting bandwidth to %d database endpoints at %g Mbit/s on %q", len(ips), mbit, dev)
+	for _, args := range bandwidthCommands(dev, mbit, ips) {
+		if err := tc.Run(args); err != nil {
+			return nil, err
+		}
+	}
+	return func() error {
+		plog.Infof("removing bandwidth limit on %q", dev)
+		return tc.Run(tc.DeleteRootArgs(dev))
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestBandwidthCommands(t *testing.T) {
+	cmds := bandwidthCommands("ens4", 2.5, []string{"10.240.0.7", "10.240.0.8"})
+	exp := [][]string{
+		{"qdisc", "add", "dev", "ens4", "root", "handle", "1:", "htb"},
+		{"class", "add", "dev", "ens4", "parent", "1:", "classid", "1:1", "htb", "rate", "2.5mbit", "ceil", "2.5mbit"},
+		{"filter", "add", "dev", "ens4", "protocol", "ip", "parent", "1:0", "prio", "1", "u32", "match", "ip", "dst", "10.240.0.7/32", "flowid", "1:1"},
+		{"filter", "add", "dev", "ens4", "protocol", "ip", "parent", "1:0", "prio", "1", "u32", "match", "ip", "dst", "10.240.0.8/32", "flowid", "1:1"},
+	}
+	if !reflect.DeepEqual(cmds, exp) {
+		t.Fatalf("expected %q, got %q", exp, cmds)
+	}
+}
+
+func TestLimitClientBandwidthNotConfigured(t *testing.T) {
+	cfg := &Config{DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+		"etcd__tip": {DatabaseEndpoints: []string{"10.240.0.7:2379"}},
+	}}
+	unlimit, err := cfg.LimitClientBandwidth("etcd__tip", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = unlimit(); err != nil {
+		t.Fatal(err)
+	}
+}
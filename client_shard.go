@@ -0,0 +1,69 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import "fmt"
+
+// shareOf returns the share of 'n' for the client 'index' of 'shards',
+// and the total share of the clients before it. The remainder goes to
+// the first clients, one each.
+func shareOf(n int64, index, shards int) (share, before int64) {
+	q, r := n/int64(shards), n%int64(shards)
+	i := int64(index)
+	share, before = q, i*q+r
+	if i < r {
+		share, before = q+1, i*q+i
+	}
+	return share, before
+}
+
+// ApplyClientShard splits the requests, the request rate, and the written
+// key range of the database between 'shards' clients stressing it together,
+// and keeps the share of the client 'index' (from 0), so that all clients
+// run the configured workload in total. The workload seed, if set, is
+// offset by the index, so that clients do not send the same random values.
+func (cfg *Config) ApplyClientShard(databaseID string, index, shards int) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+	if shards < 1 || index < 0 || index >= shards {
+		return fmt.Errorf("invalid client shard %d of %d", index, shards)
+	}
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	if shards == 1 {
+		return nil
+	}
+	if len(opts.TenantGroups) > 0 || opts.ThroughputCeiling != nil {
+		return fmt.Errorf("%q cannot split 'tenant_groups' or 'throughput_ceiling' between clients", databaseID)
+	}
+	if opts.RequestNumber < int64(shards) {
+		return fmt.Errorf("%q has fewer requests %d than clients %d", databaseID, opts.RequestNumber, shards)
+	}
+	if opts.RateLimitRequestsPerSecond > 0 && opts.RateLimitRequestsPerSecond < int64(shards) {
+		return fmt.Errorf("%q has lower rate %d than clients %d", databaseID, opts.RateLimitRequestsPerSecond, shards)
+	}
+
+	var before int64
+	opts.RequestNumber, before = shareOf(opts.RequestNumber, index, shards)
+	opts.KeyStartIndex += before
+	if opts.RateLimitRequestsPerSecond > 0 {
+		opts.RateLimitRequestsPerSecond, _ = shareOf(opts.RateLimitRequestsPerSecond, index, shards)
+	}
+	if opts.Seed != 0 {
+		opts.Seed += int64(index)
+	}
+	return nil
+}
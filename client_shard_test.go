@@ -0,0 +1,66 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestApplyClientShard(t *testing.T) {
+	newConfig := func() *Config {
+		return &Config{DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+			"etcd__tip": {ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+				RequestNumber:              10,
+				RateLimitRequestsPerSecond: 5,
+				KeyStartIndex:              100,
+				Seed:                       7,
+			}},
+		}}
+	}
+
+	tests := []struct {
+		requests, rate, keyStart, seed int64
+	}{
+		{4, 2, 100, 7},
+		{3, 2, 104, 8},
+		{3, 1, 107, 9},
+	}
+	var total, rate int64
+	for i, tt := range tests {
+		cfg := newConfig()
+		if err := cfg.ApplyClientShard("etcd__tip", i, len(tests)); err != nil {
+			t.Fatal(err)
+		}
+		opts := cfg.DatabaseIDToConfigClientMachineAgentControl["etcd__tip"].ConfigClientMachineBenchmarkOptions
+		if opts.RequestNumber != tt.requests || opts.RateLimitRequestsPerSecond != tt.rate || opts.KeyStartIndex != tt.keyStart || opts.Seed != tt.seed {
+			t.Fatalf("#%d: expected %+v, got requests %d, rate %d, key start %d, seed %d",
+				i, tt, opts.RequestNumber, opts.RateLimitRequestsPerSecond, opts.KeyStartIndex, opts.Seed)
+		}
+		total += opts.RequestNumber
+		rate += opts.RateLimitRequestsPerSecond
+	}
+	if total != 10 || rate != 5 {
+		t.Fatalf("expected 10 requests at 5 requests/s in total, got %d at %d", total, rate)
+	}
+
+	if err := newConfig().ApplyClientShard("etcd__tip", 0, 11); err == nil {
+		t.Fatal("expected error for more clients than requests")
+	}
+	if err := newConfig().ApplyClientShard("etcd__tip", 3, 3); err == nil {
+		t.Fatal("expected error for out of range index")
+	}
+}
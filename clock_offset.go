@@ -0,0 +1,179 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"google.golang.org/grpc"
+)
+
+// ClockOffsetSample is the clock offset of an agent from the control node.
+type ClockOffsetSample struct {
+	UnixSecond int64 `yaml:"unix_second"`
+	// OffsetMs is positive if the agent clock is ahead of the control node.
+	OffsetMs float64 `yaml:"offset_ms"`
+	// RoundTripMs is the round trip time of the measurement.
+	// The offset error is at most half of it.
+	RoundTripMs float64 `yaml:"round_trip_ms"`
+}
+
+// ServerClockOffsets are the clock offsets of an agent during the run.
+type ServerClockOffsets struct {
+	Endpoint string              `yaml:"endpoint"`
+	Samples  []ClockOffsetSample `yaml:"samples"`
+}
+
+// MeasureClockOffsets measures the clock offset of all agents,
+// in order of agent index.
+func (cfg *Config) MeasureClockOffsets(databaseID string) ([]ClockOffsetSample, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return nil, fmt.Errorf("database id %q does not exist", databaseID)
+	}
+	samples := make([]ClockOffsetSample, len(gcfg.AgentEndpoints))
+	errs := make([]error, len(gcfg.AgentEndpoints))
+	var wg sync.WaitGroup
+	wg.Add(len(gcfg.AgentEndpoints))
+	for i := range gcfg.AgentEndpoints {
+		go func(i int) {
+			defer wg.Done()
+			samples[i], errs[i] = cfg.measureClockOffset(databaseID, i)
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("%v (%q)", err, gcfg.AgentEndpoints[i])
+		}
+	}
+	return samples, nil
+}
+
+// measureClockOffset measures the clock offset of the agent at index 'idx',
+// assuming the same network delay in both directions.
+func (cfg *Config) measureClockOffset(databaseID string, idx int) (ClockOffsetSample, error) {
+	req, err := cfg.ToRequest(databaseID, dbtesterpb.Operation_ClockOffset, idx)
+	if err != nil {
+		return ClockOffsetSample{}, err
+	}
+	ep := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].AgentEndpoints[idx]
+	conn, err := grpc.Dial(ep, grpc.WithInsecure())
+	if err != nil {
+		return ClockOffsetSample{}, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	sent := time.Now()
+	resp, err := dbtesterpb.NewTransporterClient(conn).Transfer(ctx, req)
+	received := time.Now()
+	if err != nil {
+		return ClockOffsetSample{}, err
+	}
+	return clockOffsetSample(sent, received, resp.UnixNanosecond), nil
+}
+
+// clockOffsetSample returns the offset of the agent clock read between
+// 'sent' and 'received', from the midpoint of the round trip.
+func clockOffsetSample(sent, received time.Time, agentUnixNano int64) ClockOffsetSample {
+	rtt := received.Sub(sent)
+	mid := sent.Add(rtt / 2)
+	return ClockOffsetSample{
+		UnixSecond:  sent.Unix(),
+		OffsetMs:    float64(agentUnixNano-mid.UnixNano()) / float64(time.Millisecond),
+		RoundTripMs: float64(rtt) / float64(time.Millisecond),
+	}
+}
+
+// MonitorClockOffsets measures the clock offset of all agents every
+// 'interval' until the context is canceled. All measurements are sent on
+// the returned channel, each in order of agent index.
+func (cfg *Config) MonitorClockOffsets(ctx context.Context, databaseID string, interval time.Duration) <-chan [][]ClockOffsetSample {
+	ch := make(chan [][]ClockOffsetSample, 1)
+	go func() {
+		var rounds [][]ClockOffsetSample
+		defer func() { ch <- rounds }()
+		if interval <= 0 {
+			return
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+			samples, err := cfg.MeasureClockOffsets(databaseID)
+			if err != nil {
+				plog.Warningf("failed to measure clock offsets (%v)", err)
+				continue
+			}
+			rounds = append(rounds, samples)
+		}
+	}()
+	return ch
+}
+
+// AddClockOffsets adds the clock offsets of agents at 'endpoints',
+// measured in order of agent index.
+func (md *RunMetadata) AddClockOffsets(endpoints []string, samples []ClockOffsetSample) {
+	if len(md.ServerClockOffsets) != len(endpoints) {
+		md.ServerClockOffsets = make([]ServerClockOffsets, len(endpoints))
+		for i, ep := range endpoints {
+			md.ServerClockOffsets[i].Endpoint = ep
+		}
+	}
+	for i := range samples {
+		md.ServerClockOffsets[i].Samples = append(md.ServerClockOffsets[i].Samples, samples[i])
+	}
+}
+
+// ClockOffsetSeconds returns the median clock offset of each agent,
+// rounded to seconds, in order of agent index.
+func (md RunMetadata) ClockOffsetSeconds() []int64 {
+	secs := make([]int64, len(md.ServerClockOffsets))
+	for i, sc := range md.ServerClockOffsets {
+		if len(sc.Samples) == 0 {
+			continue
+		}
+		ms := make([]float64, len(sc.Samples))
+		for j := range sc.Samples {
+			ms[j] = sc.Samples[j].OffsetMs
+		}
+		sort.Float64s(ms)
+		median := ms[len(ms)/2]
+		if len(ms)%2 == 0 {
+			median = (ms[len(ms)/2-1] + ms[len(ms)/2]) / 2
+		}
+		secs[i] = int64(roundHalfAway(median / 1000))
+	}
+	return secs
+}
+
+func roundHalfAway(f float64) float64 {
+	if f < 0 {
+		return -roundHalfAway(-f)
+	}
+	return float64(int64(f + 0.5))
+}
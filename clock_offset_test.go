@@ -0,0 +1,45 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClockOffsetSample(t *testing.T) {
+	sent := time.Unix(1500000000, 0)
+	received := sent.Add(20 * time.Millisecond)
+	// agent clock is 1.5 seconds ahead at the midpoint of the round trip
+	agent := sent.Add(10*time.Millisecond + 1500*time.Millisecond).UnixNano()
+
+	s := clockOffsetSample(sent, received, agent)
+	if s.UnixSecond != 1500000000 || s.OffsetMs != 1500 || s.RoundTripMs != 20 {
+		t.Fatalf("unexpected sample %+v", s)
+	}
+}
+
+func TestClockOffsetSeconds(t *testing.T) {
+	var md RunMetadata
+	eps := []string{"a", "b", "c"}
+	md.AddClockOffsets(eps, []ClockOffsetSample{{OffsetMs: 2400}, {OffsetMs: -700}, {OffsetMs: 100}})
+	md.AddClockOffsets(eps, []ClockOffsetSample{{OffsetMs: 2600}, {OffsetMs: -900}, {OffsetMs: 200}})
+	md.AddClockOffsets(eps, []ClockOffsetSample{{OffsetMs: 90000}, {OffsetMs: -800}, {OffsetMs: 300}})
+
+	if secs := md.ClockOffsetSeconds(); !reflect.DeepEqual(secs, []int64{3, -1, 0}) {
+		t.Fatalf("expected [3 -1 0], got %v", secs)
+	}
+}
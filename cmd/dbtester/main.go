@@ -21,6 +21,11 @@
 //	agent       Database 'agent' in remote servers.
 //	analyze     Analyzes test dbtester test results.
 //	control     Controls tests.
+//	keyspace    Exports and imports keyspace snapshots, to run read benchmarks on identical datasets.
+//	kube        Runs load generators as Kubernetes jobs.
+//	provision   Provisions machines and runs tests.
+//	prune       Deletes or archives raw data of old runs in results, keeping summaries and aggregated results.
+//	web         Serves results of historical runs with comparison charts in the browser.
 //
 package main
 
@@ -31,6 +36,11 @@ import (
 	"github.com/coreos/dbtester/agent"
 	"github.com/coreos/dbtester/analyze"
 	"github.com/coreos/dbtester/control"
+	"github.com/coreos/dbtester/keyspace"
+	"github.com/coreos/dbtester/kube"
+	"github.com/coreos/dbtester/provision"
+	"github.com/coreos/dbtester/prune"
+	"github.com/coreos/dbtester/web"
 	"github.com/spf13/cobra"
 )
 
@@ -50,6 +60,11 @@ func init() {
 	rootCommand.AddCommand(agent.Command)
 	rootCommand.AddCommand(analyze.Command)
 	rootCommand.AddCommand(control.Command)
+	rootCommand.AddCommand(keyspace.Command)
+	rootCommand.AddCommand(kube.Command)
+	rootCommand.AddCommand(provision.Command)
+	rootCommand.AddCommand(prune.Command)
+	rootCommand.AddCommand(web.Command)
 }
 
 func main() {
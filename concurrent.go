@@ -0,0 +1,55 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"net"
+)
+
+// CheckDisjointServers returns an error if any two of the databases
+// share a server or an agent, so that the databases can be tested
+// concurrently without competing for server resources.
+func (cfg *Config) CheckDisjointServers(databaseIDs []string) error {
+	ipToDatabaseID := make(map[string]string)
+	for _, databaseID := range databaseIDs {
+		gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+		if !ok {
+			return fmt.Errorf("%q does not exist", databaseID)
+		}
+		for _, ip := range gcfg.PeerIPs {
+			if id, ok := ipToDatabaseID[ip]; ok && id != databaseID {
+				return fmt.Errorf("%q and %q share server %q", id, databaseID, ip)
+			}
+			ipToDatabaseID[ip] = databaseID
+		}
+	}
+
+	// an agent runs one database at a time
+	hostToDatabaseID := make(map[string]string)
+	for _, databaseID := range databaseIDs {
+		for _, ep := range cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].AgentEndpoints {
+			host, _, err := net.SplitHostPort(ep)
+			if err != nil {
+				host = ep
+			}
+			if id, ok := hostToDatabaseID[host]; ok && id != databaseID {
+				return fmt.Errorf("%q and %q share agent %q", id, databaseID, host)
+			}
+			hostToDatabaseID[host] = databaseID
+		}
+	}
+	return nil
+}
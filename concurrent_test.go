@@ -0,0 +1,45 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestCheckDisjointServers(t *testing.T) {
+	cfg := &Config{DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+		"etcd__v3_3":             {PeerIPs: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, AgentEndpoints: []string{"10.0.0.1:3500", "10.0.0.2:3500", "10.0.0.3:3500"}},
+		"zookeeper__r3_5_3_beta": {PeerIPs: []string{"10.0.0.4", "10.0.0.5", "10.0.0.6"}},
+		"consul__v1_0_2":         {PeerIPs: []string{"10.0.0.3", "10.0.0.7", "10.0.0.8"}},
+	}}
+	if err := cfg.CheckDisjointServers([]string{"etcd__v3_3", "zookeeper__r3_5_3_beta"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.CheckDisjointServers([]string{"etcd__v3_3", "zookeeper__r3_5_3_beta", "consul__v1_0_2"}); err == nil {
+		t.Fatal("expected error on shared server")
+	}
+	cfg.DatabaseIDToConfigClientMachineAgentControl["consul__v1_0_2"] = dbtesterpb.ConfigClientMachineAgentControl{
+		PeerIPs:        []string{"10.0.0.7", "10.0.0.8", "10.0.0.9"},
+		AgentEndpoints: []string{"10.0.0.7:3500", "10.0.0.8:3500", "10.0.0.1:3501"},
+	}
+	if err := cfg.CheckDisjointServers([]string{"etcd__v3_3", "consul__v1_0_2"}); err == nil {
+		t.Fatal("expected error on shared agent")
+	}
+	if err := cfg.CheckDisjointServers([]string{"etcd__tip"}); err == nil {
+		t.Fatal("expected error on unknown database")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/coreos/dbtester/dbtesterpb"
@@ -63,11 +64,24 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		return nil, err
 	}
 
+	if len(cfg.AllDatabaseIDList) == 0 {
+		// results are compared in the declared order, or sorted if not declared
+		for id := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+			cfg.AllDatabaseIDList = append(cfg.AllDatabaseIDList, id)
+		}
+		sort.Strings(cfg.AllDatabaseIDList)
+	}
 	for _, id := range cfg.AllDatabaseIDList {
 		if !dbtesterpb.IsValidDatabaseID(id) {
 			return nil, fmt.Errorf("databaseID %q is unknown", id)
 		}
 	}
+	if _, err = ParseTags(cfg.ConfigClientMachineInitial.RunTags); err != nil {
+		return nil, err
+	}
+	if ms := cfg.ConfigClientMachineInitial.MonitorIntervalMs; ms != 0 && (ms < dbtesterpb.MinMonitorIntervalMs || ms > dbtesterpb.MaxMonitorIntervalMs) {
+		return nil, fmt.Errorf("monitor_interval_ms %d is out of range [%d, %d]", ms, dbtesterpb.MinMonitorIntervalMs, dbtesterpb.MaxMonitorIntervalMs)
+	}
 
 	if cfg.ConfigClientMachineInitial.PathPrefix != "" {
 		cfg.ConfigClientMachineInitial.LogPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.LogPath)
@@ -79,15 +93,73 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)
 		cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath)
 		cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath)
+		if cfg.ConfigClientMachineInitial.ServerSystemMetricsPath != "" {
+			cfg.ConfigClientMachineInitial.ServerSystemMetricsPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ServerSystemMetricsPath)
+		}
+		if cfg.ConfigClientMachineInitial.ServerSystemMetricsInterpolatedPath != "" {
+			cfg.ConfigClientMachineInitial.ServerSystemMetricsInterpolatedPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ServerSystemMetricsInterpolatedPath)
+		}
+		if cfg.ConfigClientMachineInitial.NemesisEventsPath != "" {
+			cfg.ConfigClientMachineInitial.NemesisEventsPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.NemesisEventsPath)
+		}
+		if cfg.ConfigClientMachineInitial.RunMetadataPath != "" {
+			cfg.ConfigClientMachineInitial.RunMetadataPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.RunMetadataPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientThroughputCeilingPath != "" {
+			cfg.ConfigClientMachineInitial.ClientThroughputCeilingPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientThroughputCeilingPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath != "" {
+			cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientRequestLogPath != "" {
+			cfg.ConfigClientMachineInitial.ClientRequestLogPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientRequestLogPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientWatchEventsPath != "" {
+			cfg.ConfigClientMachineInitial.ClientWatchEventsPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientWatchEventsPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientLeaseExpiryPath != "" {
+			cfg.ConfigClientMachineInitial.ClientLeaseExpiryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLeaseExpiryPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientBatchWritesPath != "" {
+			cfg.ConfigClientMachineInitial.ClientBatchWritesPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientBatchWritesPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientRollingRestartPath != "" {
+			cfg.ConfigClientMachineInitial.ClientRollingRestartPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientRollingRestartPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientRangeLatencyPath != "" {
+			cfg.ConfigClientMachineInitial.ClientRangeLatencyPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientRangeLatencyPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientSoakRollupPath != "" {
+			cfg.ConfigClientMachineInitial.ClientSoakRollupPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientSoakRollupPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientLeaseExpiryAccuracyPath != "" {
+			cfg.ConfigClientMachineInitial.ClientLeaseExpiryAccuracyPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLeaseExpiryAccuracyPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientRequestTimeoutsPath != "" {
+			cfg.ConfigClientMachineInitial.ClientRequestTimeoutsPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientRequestTimeoutsPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientConnectionEventsPath != "" {
+			cfg.ConfigClientMachineInitial.ClientConnectionEventsPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientConnectionEventsPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientResultDatabasePath != "" {
+			cfg.ConfigClientMachineInitial.ClientResultDatabasePath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientResultDatabasePath)
+		}
 	}
 
+	tagToDatabaseID := make(map[string]string)
 	for databaseID, group := range cfg.DatabaseIDToConfigClientMachineAgentControl {
 		if !dbtesterpb.IsValidDatabaseID(databaseID) {
 			return nil, fmt.Errorf("databaseID %q is unknown", databaseID)
 		}
 
-		group.DatabaseID = databaseID
+		// database to run, without the cluster name
+		group.DatabaseID = dbtesterpb.BaseDatabaseID(databaseID)
 		group.DatabaseTag = MakeTag(group.DatabaseDescription)
+		if id, ok := tagToDatabaseID[group.DatabaseTag]; ok {
+			// clusters of the same database are labeled by tags in results
+			return nil, fmt.Errorf("%q and %q have the same database tag %q (need different 'database_description')", id, databaseID, group.DatabaseTag)
+		}
+		tagToDatabaseID[group.DatabaseTag] = databaseID
 		group.PeerIPsString = strings.Join(group.PeerIPs, "___")
 		group.DatabaseEndpoints = make([]string, len(group.PeerIPs))
 		group.AgentEndpoints = make([]string, len(group.PeerIPs))
@@ -104,6 +176,15 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		amc.DatabaseTag = cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag
 		amc.DatabaseDescription = cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseDescription
 
+		if analyze && hasGlobMeta(amc.PathPrefix) {
+			prefix, err := resolvePathPrefix(amc.PathPrefix, amc.ClientLatencyThroughputTimeseriesPath)
+			if err != nil {
+				return nil, fmt.Errorf("%q: %v", databaseID, err)
+			}
+			plog.Infof("resolved path prefix %q to %q for %q", amc.PathPrefix, prefix, databaseID)
+			amc.PathPrefix = prefix
+		}
+
 		if amc.PathPrefix != "" {
 			amc.ClientSystemMetricsInterpolatedPath = amc.PathPrefix + "-" + amc.ClientSystemMetricsInterpolatedPath
 			amc.ClientLatencyThroughputTimeseriesPath = amc.PathPrefix + "-" + amc.ClientLatencyThroughputTimeseriesPath
@@ -118,19 +199,109 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 			for i := range amc.ServerSystemMetricsInterpolatedPathList {
 				amc.ServerSystemMetricsInterpolatedPathList[i] = amc.PathPrefix + "-" + amc.ServerSystemMetricsInterpolatedPathList[i]
 			}
+			for i := range amc.ServerSystemMetricsPathList {
+				amc.ServerSystemMetricsPathList[i] = amc.PathPrefix + "-" + amc.ServerSystemMetricsPathList[i]
+			}
 			amc.AllAggregatedOutputPath = amc.PathPrefix + "-" + amc.AllAggregatedOutputPath
+			if amc.RunMetadataPath != "" {
+				amc.RunMetadataPath = amc.PathPrefix + "-" + amc.RunMetadataPath
+			}
+			if amc.ClientLatencyHistogramLogPath != "" {
+				amc.ClientLatencyHistogramLogPath = amc.PathPrefix + "-" + amc.ClientLatencyHistogramLogPath
+			}
+			if amc.ClientConnectionEventsPath != "" {
+				amc.ClientConnectionEventsPath = amc.PathPrefix + "-" + amc.ClientConnectionEventsPath
+			}
+			if amc.NemesisEventsPath != "" {
+				amc.NemesisEventsPath = amc.PathPrefix + "-" + amc.NemesisEventsPath
+			}
+		}
+
+		if analyze && amc.PathPrefix != "" && len(amc.ServerSystemMetricsInterpolatedPathList) == 0 {
+			ps, err := inferServerSystemMetricsPaths(amc.PathPrefix)
+			if err != nil {
+				return nil, fmt.Errorf("%q: %v", databaseID, err)
+			}
+			plog.Infof("found %d server system metrics for %q", len(ps), databaseID)
+			amc.ServerSystemMetricsInterpolatedPathList = ps
 		}
 
 		cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID] = amc
 	}
 
 	for databaseID, ctrl := range cfg.DatabaseIDToConfigClientMachineAgentControl {
-		if databaseID != dbtesterpb.DatabaseID_etcd__tip.String() &&
-			databaseID != dbtesterpb.DatabaseID_etcd__v3_2.String() &&
-			databaseID != dbtesterpb.DatabaseID_etcd__v3_3.String() &&
+		if ctrl.DatabaseID != dbtesterpb.DatabaseID_etcd__tip.String() &&
+			ctrl.DatabaseID != dbtesterpb.DatabaseID_etcd__v3_2.String() &&
+			ctrl.DatabaseID != dbtesterpb.DatabaseID_etcd__v3_3.String() &&
 			ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber != ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber {
 			return nil, fmt.Errorf("%q got connected %d != clients %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber, ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber)
 		}
+		if err = ctrl.ConfigWANTopology.Validate(len(ctrl.PeerIPs)); err != nil {
+			return nil, fmt.Errorf("%q: %v", databaseID, err)
+		}
+		if ctrl.ClientBandwidthMbitPerSecond < 0 {
+			return nil, fmt.Errorf("%q got negative client_bandwidth_mbit_per_second %v", databaseID, ctrl.ClientBandwidthMbitPerSecond)
+		}
+		switch ctrl.ConfigClientMachineBenchmarkOptions.KeyOrder {
+		case "", KeyOrderSequential, KeyOrderRandom:
+		default:
+			return nil, fmt.Errorf("%q got unknown key_order %q", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.KeyOrder)
+		}
+		if ctrl.ConfigClientMachineBenchmarkOptions.DeleteKeyPrefix && ctrl.ConfigClientMachineBenchmarkOptions.KeyPrefix == "" && !ctrl.ConfigClientMachineBenchmarkOptions.PhaseKeyPrefix {
+			// would delete all keys
+			return nil, fmt.Errorf("%q got delete_key_prefix without key_prefix or phase_key_prefix", databaseID)
+		}
+		if dd := ctrl.ConfigDiskDelay; dd != nil && (dd.ReadDelayMilliseconds < 0 || dd.WriteDelayMilliseconds < 0 || dd.SizeGigabytes < 0) {
+			return nil, fmt.Errorf("%q got negative disk_delay %+v", databaseID, *dd)
+		}
+		if rr := ctrl.ConfigRollingRestart; rr != nil {
+			if len(ctrl.NemesisSchedule) > 0 {
+				return nil, fmt.Errorf("%q got both rolling_restart and nemesis_schedule", databaseID)
+			}
+			if rr.DelaySeconds < 0 || rr.DownSeconds < 0 {
+				return nil, fmt.Errorf("%q got negative rolling_restart %+v", databaseID, *rr)
+			}
+		}
+		if rn := ctrl.ConfigRandomNemesis; rn != nil {
+			if len(ctrl.NemesisSchedule) > 0 || ctrl.ConfigRollingRestart != nil {
+				return nil, fmt.Errorf("%q got random_nemesis with nemesis_schedule or rolling_restart", databaseID)
+			}
+			if rn.Steps <= 0 || len(rn.Operations) == 0 {
+				return nil, fmt.Errorf("%q got random_nemesis without steps or operations", databaseID)
+			}
+			if rn.MinDelaySeconds < 0 || rn.MaxDelaySeconds < rn.MinDelaySeconds || rn.MinDurationSeconds < 0 || rn.MaxDurationSeconds < rn.MinDurationSeconds {
+				return nil, fmt.Errorf("%q got invalid random_nemesis ranges %+v", databaseID, *rn)
+			}
+		}
+		if _, err = ParseDatabaseEnv(ctrl.DatabaseEnv); err != nil {
+			return nil, fmt.Errorf("%q: %v", databaseID, err)
+		}
+		if eb := ctrl.ConfigClientMachineBenchmarkOptions.ErrorBudget; eb != nil && (eb.MaxErrorPercent < 0 || eb.MaxErrorPercent >= 100 || eb.WindowSeconds <= 0 || eb.MinRequests < 0) {
+			return nil, fmt.Errorf("%q got invalid error_budget %+v", databaseID, *eb)
+		}
+		if soak := ctrl.ConfigClientMachineBenchmarkOptions.Soak; soak != nil && soak.RollupMinutes <= 0 {
+			return nil, fmt.Errorf("%q got non-positive soak rollup_minutes %d", databaseID, soak.RollupMinutes)
+		}
+		if soak := ctrl.ConfigClientMachineBenchmarkOptions.Soak; soak != nil && soak.TruncateRawData && !soakTruncateSupported(ctrl.ConfigClientMachineBenchmarkOptions) {
+			return nil, fmt.Errorf("%q got soak truncate_raw_data, only supported for 'write' and 'read' with fixed client number", databaseID)
+		}
+		collectors := make(map[string]bool)
+		for _, c := range ctrl.Collectors {
+			// collector types can be registered in agents, so only check names
+			if c.Name == "" || strings.ContainsAny(c.Name, `/\`) {
+				return nil, fmt.Errorf("%q got invalid collector name %q", databaseID, c.Name)
+			}
+			if c.Name == "host" {
+				return nil, fmt.Errorf("%q got collector name %q, reserved for host metrics of agents", databaseID, c.Name)
+			}
+			if collectors[c.Name] {
+				return nil, fmt.Errorf("%q got duplicate collector %q", databaseID, c.Name)
+			}
+			collectors[c.Name] = true
+			if len(c.Columns) == 0 {
+				return nil, fmt.Errorf("%q got collector %q without columns", databaseID, c.Name)
+			}
+		}
 	}
 
 	const (
@@ -148,106 +319,93 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		defaultZookeeperMaxClientConnections int64 = 5000
 	)
 
-	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__tip.String()]; ok {
-		if v.AgentPortToConnect == 0 {
-			v.AgentPortToConnect = defaultAgentPort
-		}
-		if v.DatabasePortToConnect == 0 {
-			v.DatabasePortToConnect = defaultEtcdClientPort
-		}
-		if v.Flag_Etcd_Tip.SnapshotCount == 0 {
-			v.Flag_Etcd_Tip.SnapshotCount = defaultEtcdSnapshotCount
-		}
-		if v.Flag_Etcd_Tip.QuotaSizeBytes == 0 {
-			v.Flag_Etcd_Tip.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
-		}
-		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__tip.String()] = v
-	}
-	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_2.String()]; ok {
-		if v.AgentPortToConnect == 0 {
-			v.AgentPortToConnect = defaultAgentPort
-		}
-		if v.DatabasePortToConnect == 0 {
-			v.DatabasePortToConnect = defaultEtcdClientPort
-		}
-		if v.Flag_Etcd_V3_2.SnapshotCount == 0 {
-			v.Flag_Etcd_V3_2.SnapshotCount = defaultEtcdSnapshotCount
-		}
-		if v.Flag_Etcd_V3_2.QuotaSizeBytes == 0 {
-			v.Flag_Etcd_V3_2.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
-		}
-		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_2.String()] = v
-	}
-	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_3.String()]; ok {
-		if v.AgentPortToConnect == 0 {
-			v.AgentPortToConnect = defaultAgentPort
-		}
-		if v.DatabasePortToConnect == 0 {
-			v.DatabasePortToConnect = defaultEtcdClientPort
-		}
-		if v.Flag_Etcd_V3_3.SnapshotCount == 0 {
-			v.Flag_Etcd_V3_3.SnapshotCount = defaultEtcdSnapshotCount
-		}
-		if v.Flag_Etcd_V3_3.QuotaSizeBytes == 0 {
-			v.Flag_Etcd_V3_3.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
-		}
-		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_3.String()] = v
-	}
-
-	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta.String()]; ok {
-		if v.AgentPortToConnect == 0 {
-			v.AgentPortToConnect = defaultAgentPort
-		}
-		if v.DatabasePortToConnect == 0 {
-			v.DatabasePortToConnect = defaultZookeeperClientPort
-		}
-		v.Flag_Zookeeper_R3_5_3Beta.ClientPort = v.DatabasePortToConnect
-		if v.Flag_Zookeeper_R3_5_3Beta.TickTime == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.TickTime = defaultZookeeperTickTime
-		}
-		if v.Flag_Zookeeper_R3_5_3Beta.TickTime == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.TickTime = defaultZookeeperTickTime
-		}
-		if v.Flag_Zookeeper_R3_5_3Beta.InitLimit == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.InitLimit = defaultZookeeperInitLimit
-		}
-		if v.Flag_Zookeeper_R3_5_3Beta.SyncLimit == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.SyncLimit = defaultZookeeperSyncLimit
-		}
-		if v.Flag_Zookeeper_R3_5_3Beta.SnapCount == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.SnapCount = defaultZookeeperSnapCount
-		}
-		if v.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections = defaultZookeeperMaxClientConnections
-		}
-		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta.String()] = v
-	}
-
-	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_consul__v1_0_2.String()]; ok {
-		if v.AgentPortToConnect == 0 {
-			v.AgentPortToConnect = defaultAgentPort
-		}
-		if v.DatabasePortToConnect == 0 {
-			v.DatabasePortToConnect = defaultConsulClientPort
+	for databaseID, v := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+		switch v.DatabaseID {
+		case dbtesterpb.DatabaseID_etcd__tip.String():
+			if v.AgentPortToConnect == 0 {
+				v.AgentPortToConnect = defaultAgentPort
+			}
+			if v.DatabasePortToConnect == 0 {
+				v.DatabasePortToConnect = defaultEtcdClientPort
+			}
+			if v.Flag_Etcd_Tip.SnapshotCount == 0 {
+				v.Flag_Etcd_Tip.SnapshotCount = defaultEtcdSnapshotCount
+			}
+			if v.Flag_Etcd_Tip.QuotaSizeBytes == 0 {
+				v.Flag_Etcd_Tip.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
+			}
+		case dbtesterpb.DatabaseID_etcd__v3_2.String():
+			if v.AgentPortToConnect == 0 {
+				v.AgentPortToConnect = defaultAgentPort
+			}
+			if v.DatabasePortToConnect == 0 {
+				v.DatabasePortToConnect = defaultEtcdClientPort
+			}
+			if v.Flag_Etcd_V3_2.SnapshotCount == 0 {
+				v.Flag_Etcd_V3_2.SnapshotCount = defaultEtcdSnapshotCount
+			}
+			if v.Flag_Etcd_V3_2.QuotaSizeBytes == 0 {
+				v.Flag_Etcd_V3_2.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
+			}
+		case dbtesterpb.DatabaseID_etcd__v3_3.String():
+			if v.AgentPortToConnect == 0 {
+				v.AgentPortToConnect = defaultAgentPort
+			}
+			if v.DatabasePortToConnect == 0 {
+				v.DatabasePortToConnect = defaultEtcdClientPort
+			}
+			if v.Flag_Etcd_V3_3.SnapshotCount == 0 {
+				v.Flag_Etcd_V3_3.SnapshotCount = defaultEtcdSnapshotCount
+			}
+			if v.Flag_Etcd_V3_3.QuotaSizeBytes == 0 {
+				v.Flag_Etcd_V3_3.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
+			}
+		case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta.String():
+			if v.AgentPortToConnect == 0 {
+				v.AgentPortToConnect = defaultAgentPort
+			}
+			if v.DatabasePortToConnect == 0 {
+				v.DatabasePortToConnect = defaultZookeeperClientPort
+			}
+			v.Flag_Zookeeper_R3_5_3Beta.ClientPort = v.DatabasePortToConnect
+			if v.Flag_Zookeeper_R3_5_3Beta.TickTime == 0 {
+				v.Flag_Zookeeper_R3_5_3Beta.TickTime = defaultZookeeperTickTime
+			}
+			if v.Flag_Zookeeper_R3_5_3Beta.TickTime == 0 {
+				v.Flag_Zookeeper_R3_5_3Beta.TickTime = defaultZookeeperTickTime
+			}
+			if v.Flag_Zookeeper_R3_5_3Beta.InitLimit == 0 {
+				v.Flag_Zookeeper_R3_5_3Beta.InitLimit = defaultZookeeperInitLimit
+			}
+			if v.Flag_Zookeeper_R3_5_3Beta.SyncLimit == 0 {
+				v.Flag_Zookeeper_R3_5_3Beta.SyncLimit = defaultZookeeperSyncLimit
+			}
+			if v.Flag_Zookeeper_R3_5_3Beta.SnapCount == 0 {
+				v.Flag_Zookeeper_R3_5_3Beta.SnapCount = defaultZookeeperSnapCount
+			}
+			if v.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections == 0 {
+				v.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections = defaultZookeeperMaxClientConnections
+			}
+		case dbtesterpb.DatabaseID_consul__v1_0_2.String():
+			if v.AgentPortToConnect == 0 {
+				v.AgentPortToConnect = defaultAgentPort
+			}
+			if v.DatabasePortToConnect == 0 {
+				v.DatabasePortToConnect = defaultConsulClientPort
+			}
 		}
-		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_consul__v1_0_2.String()] = v
+		cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = v
 	}
 
 	// need etcd configs since it's backed by etcd
-	if _, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_zetcd__beta.String()]; ok {
-		_, okTip := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__tip.String()]
-		_, ok32 := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_2.String()]
-		_, ok33 := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_3.String()]
-		if !okTip && !ok32 && !ok33 {
-			return nil, fmt.Errorf("got %q config, but no etcd config is given", dbtesterpb.DatabaseID_zetcd__beta.String())
-		}
+	baseIDs := make(map[string]bool)
+	for _, ctrl := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+		baseIDs[ctrl.DatabaseID] = true
 	}
-	if _, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_cetcd__beta.String()]; ok {
-		_, okTip := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__tip.String()]
-		_, ok32 := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_2.String()]
-		_, ok33 := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_3.String()]
-		if !okTip && !ok32 && !ok33 {
-			return nil, fmt.Errorf("got %q config, but no etcd config is given", dbtesterpb.DatabaseID_cetcd__beta.String())
+	hasEtcd := baseIDs[dbtesterpb.DatabaseID_etcd__tip.String()] || baseIDs[dbtesterpb.DatabaseID_etcd__v3_2.String()] || baseIDs[dbtesterpb.DatabaseID_etcd__v3_3.String()]
+	for _, id := range []string{dbtesterpb.DatabaseID_zetcd__beta.String(), dbtesterpb.DatabaseID_cetcd__beta.String()} {
+		if baseIDs[id] && !hasEtcd {
+			return nil, fmt.Errorf("got %q config, but no etcd config is given", id)
 		}
 	}
 
@@ -264,6 +422,11 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		cfg.AnalyzePlotList[i].OutputPathList = make([]string, 2)
 		cfg.AnalyzePlotList[i].OutputPathList[0] = filepath.Join(cfg.AnalyzePlotPathPrefix, cfg.AnalyzePlotList[i].Column+".svg")
 		cfg.AnalyzePlotList[i].OutputPathList[1] = filepath.Join(cfg.AnalyzePlotPathPrefix, cfg.AnalyzePlotList[i].Column+".png")
+		switch cfg.AnalyzePlotList[i].Band {
+		case "", "stddev", "ci95":
+		default:
+			return nil, fmt.Errorf("unknown band %q for %q (expected 'stddev' or 'ci95')", cfg.AnalyzePlotList[i].Band, cfg.AnalyzePlotList[i].Column)
+		}
 	}
 
 	return &cfg, nil
@@ -278,7 +441,7 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 		err = fmt.Errorf("database ID %q is not defined", databaseID)
 		return
 	}
-	did := dbtesterpb.DatabaseID(dbtesterpb.DatabaseID_value[databaseID])
+	did := dbtesterpb.DatabaseID(dbtesterpb.DatabaseID_value[gcfg.DatabaseID])
 
 	req = &dbtesterpb.Request{
 		Operation:           op,
@@ -294,6 +457,18 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 			GoogleCloudStorageBucketName:   cfg.ConfigClientMachineInitial.GoogleCloudStorageBucketName,
 			GoogleCloudStorageSubDirectory: cfg.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory,
 		},
+		ConfigDocker:      gcfg.ConfigDocker,
+		EnablePprof:       gcfg.ConfigProfile != nil,
+		ConfigWANTopology: gcfg.ConfigWANTopology,
+		ConfigDiskDelay:   gcfg.ConfigDiskDelay,
+		Collectors:        gcfg.Collectors,
+		DatabaseEnv:       gcfg.DatabaseEnv,
+		DatabasePort:      gcfg.DatabasePortToConnect,
+	}
+	if gcfg.ConfigProfile != nil {
+		req.ProfileSeconds = gcfg.ConfigProfile.CPUSeconds
+		req.Perf = gcfg.ConfigProfile.Perf
+		req.PerfArgs = gcfg.ConfigProfile.PerfArgs
 	}
 
 	switch req.DatabaseID {
@@ -16,14 +16,19 @@
 package control
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/coreos/dbtester"
 	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/cpuaffinity"
 	"github.com/coreos/dbtester/pkg/ntp"
+	"github.com/coreos/dbtester/pkg/resample"
 
 	"github.com/coreos/etcd/pkg/netutil"
 	"github.com/gyuho/linux-inspect/df"
@@ -39,10 +44,35 @@ var Command = &cobra.Command{
 	RunE:  commandFunc,
 }
 
-var databaseID string
+var databaseIDs string
 var configPath string
 var diskDevice string
 var networkInterface string
+var stressOnly bool
+var cpuList string
+var numaNode int
+var gomaxprocs int
+var controlPort string
+var clockOffsetInterval time.Duration
+var seed int64
+var nemesisSeed int64
+var nemesisReplay string
+var keyOrder string
+var shardIndex int
+var shards int
+var tuiMode bool
+var httpPort string
+var runTags []string
+var databaseEnv []string
+var runID string
+var force bool
+var startAt string
+var concurrent bool
+var concurrentStartDelay time.Duration
+
+// generatedRunID is the unique run ID generated for 'auto',
+// shared by all databases tested back to back
+var generatedRunID string
 
 func init() {
 	dn, err := df.GetDevice("/")
@@ -60,44 +90,232 @@ func init() {
 	}
 
 	ids := dbtesterpb.GetAllDatabaseIDs()
-	Command.PersistentFlags().StringVar(&databaseID, "database-id", ids[0], strings.Join(ids, ", "))
+	Command.PersistentFlags().StringVar(&databaseIDs, "database-id", ids[0], strings.Join(ids, ", ")+" (comma-separated to test back to back, with '"+dbtesterpb.ClusterSeparator+"<cluster>' suffix for multiple clusters of the same database).")
 	Command.PersistentFlags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
 	Command.PersistentFlags().StringVar(&diskDevice, "disk-device", dn, "Disk device to collect disk statistics metrics from.")
 	Command.PersistentFlags().StringVar(&networkInterface, "network-interface", nt, "Network interface to record in/outgoing packets.")
+	Command.PersistentFlags().StringVar(&cpuList, "cpus", "", "CPUs to pin client threads to (e.g. '0-3,8'), to reduce scheduling noise in latency measurements (split between databases with '--concurrent').")
+	Command.PersistentFlags().IntVar(&numaNode, "numa-node", -1, "NUMA node to pin client threads to (ignored if '--cpus' is set).")
+	Command.PersistentFlags().IntVar(&gomaxprocs, "gomaxprocs", 0, "GOMAXPROCS for the client driver (0 to use default).")
+	Command.Flags().StringVar(&controlPort, "control-port", "", "Port to serve gRPC pause and resume requests (e.g. ':3600'), empty to disable.")
+	Command.PersistentFlags().BoolVar(&stressOnly, "stress-only", false, "'true' to only run step 2 (e.g. when run as one of many Kubernetes job pods).")
+	Command.PersistentFlags().StringVar(&runID, "run-id", "", "Run ID to save results in the standard layout, overriding 'run_id' in config ('"+dbtester.AutoRunID+"' to generate a unique run ID).")
+	Command.PersistentFlags().BoolVar(&force, "force", false, "'true' to overwrite existing results of the run, and break its locks.")
+	Command.PersistentFlags().StringArrayVar(&runTags, "tag", nil, "'key=value' tag of the run in addition to 'run_tags' in config (e.g. '--tag env=gce-n1-standard-8 --tag purpose=nightly').")
+	Command.PersistentFlags().StringArrayVar(&databaseEnv, "database-env", nil, "'KEY=VALUE' environment variable of the database process in addition to 'database_env' in config, also recorded as a run tag (e.g. '--database-env GOGC=400').")
+	Command.PersistentFlags().Int64Var(&seed, "seed", 0, "Seed of random values and operations, to rerun the same request sequences (0 to use 'seed' in config, or a random seed).")
+	Command.PersistentFlags().Int64Var(&nemesisSeed, "nemesis-seed", 0, "Seed of 'random_nemesis' faults, to inject the same faults again (0 to use 'seed' in config, or a random seed).")
+	Command.PersistentFlags().StringVar(&nemesisReplay, "nemesis-replay", "", "Run metadata file of a previous run, to replay its 'random_nemesis' faults with the recorded seed.")
+	Command.PersistentFlags().StringVar(&keyOrder, "key-order", "", "Insertion order of written keys, 'sequential' or 'random' (empty to use 'key_order' in config).")
+	Command.PersistentFlags().IntVar(&shardIndex, "shard-index", 0, "Index of this client among '--shards' clients (from 0), to send its share of the requests, rate, and written keys.")
+	Command.PersistentFlags().IntVar(&shards, "shards", 1, "Number of clients stressing the database together, splitting the requests, rate, and written keys (e.g. Kubernetes job pods).")
+	Command.Flags().BoolVar(&tuiMode, "tui", false, "'true' to show live QPS, latency, errors, and server usage in the terminal, with logs written to 'log_path'.")
+	Command.Flags().StringVar(&httpPort, "http-port", "", "Port to serve HTTP status, latest metrics, pause, resume, and abort requests (e.g. ':3700'), empty to disable.")
+	Command.PersistentFlags().StringVar(&startAt, "start-at", "", "Time to start sending requests after connections are dialed, in RFC3339 or Unix seconds, to start load generators on all client machines at once (empty to start right away).")
+	Command.Flags().BoolVar(&concurrent, "concurrent", false, "'true' to test the comma-separated databases at the same time on disjoint servers, one 'control' process per database (requires 'run_id').")
+	Command.Flags().DurationVar(&concurrentStartDelay, "concurrent-start-delay", 2*time.Minute, "Delay for '--concurrent' databases to start before sending requests at the same time (ignored if '--start-at' is set).")
+	Command.PersistentFlags().DurationVar(&clockOffsetInterval, "clock-offset-interval", time.Minute, "Interval to measure clock offsets of agents during tests, in addition to the start (0 to measure only at start).")
 }
 
 func commandFunc(cmd *cobra.Command, args []string) error {
-	if !dbtesterpb.IsValidDatabaseID(databaseID) {
-		return fmt.Errorf("database id %q is unknown", databaseID)
+	ids := strings.Split(databaseIDs, ",")
+	for _, id := range ids {
+		if !dbtesterpb.IsValidDatabaseID(id) {
+			return fmt.Errorf("database id %q is unknown", id)
+		}
+	}
+	if concurrent && len(ids) > 1 {
+		return runConcurrent(cmd, ids)
+	}
+	if err := pinClient(); err != nil {
+		return err
+	}
+	if startAt != "" {
+		at, err := parseStartAt(startAt)
+		if err != nil {
+			return err
+		}
+		dbtester.SetStartBarrier(at)
+	}
+	if tuiMode || httpPort != "" {
+		live = dbtester.NewLiveStats(tuiHistorySeconds)
+		dbtester.SetLiveStats(live)
+		defer func() {
+			dbtester.SetLiveStats(nil)
+			live = nil
+		}()
+	}
+	if httpPort != "" {
+		srv, err := startHTTPServer(httpPort)
+		if err != nil {
+			return err
+		}
+		defer srv.Close()
+	}
+	if tuiMode {
+		ui = startTUI(live)
+		defer func() {
+			ui.stop()
+			ui = nil
+		}()
+	}
+
+	for i, id := range ids {
+		if len(ids) > 1 {
+			plog.Infof("testing %q (%d/%d)", id, i+1, len(ids))
+		}
+		if err := runTest(id, len(ids) > 1); err != nil {
+			return err
+		}
+		if reason := dbtester.ErrorBudgetExceeded(); reason != "" {
+			return fmt.Errorf("%q failed: %s", id, reason)
+		}
+		if dbtester.LoadAborted() {
+			return fmt.Errorf("aborted while testing %q", id)
+		}
+	}
+
+	plog.Info("all done!")
+	return nil
+}
+
+// parseStartAt parses the start time in RFC3339 or Unix seconds.
+func parseStartAt(s string) (time.Time, error) {
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(sec, 0), nil
 	}
+	at, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid '--start-at' %q (%v)", s, err)
+	}
+	return at, nil
+}
 
+// runTest runs the steps of the database. Configuration is read for each
+// database, when testing multiple databases back to back in one run.
+func runTest(databaseID string, multi bool) error {
 	cfg, err := dbtester.ReadConfig(configPath, false)
 	if err != nil {
 		return err
 	}
+	if runID != "" {
+		cfg.ConfigClientMachineInitial.RunID = runID
+	}
+	if cfg.ConfigClientMachineInitial.RunID == dbtester.AutoRunID {
+		if generatedRunID == "" {
+			generatedRunID = dbtester.NewRunID(time.Now())
+			plog.Infof("generated run ID %q", generatedRunID)
+		}
+		cfg.ConfigClientMachineInitial.RunID = generatedRunID
+	}
+	if multi && cfg.ConfigClientMachineInitial.RunID == "" {
+		// results of all databases are written to the same paths
+		return fmt.Errorf("testing multiple databases requires 'run_id' in %q", configPath)
+	}
+	cfg.ConfigClientMachineInitial.RunTags = append(cfg.ConfigClientMachineInitial.RunTags, runTags...)
+	if _, err = dbtester.ParseTags(cfg.ConfigClientMachineInitial.RunTags); err != nil {
+		return err
+	}
+	if err = cfg.CheckResultDatabase(); err != nil {
+		return err
+	}
+	unlock, err := cfg.LockResultLayout(databaseID, force)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err = cfg.ApplyResultLayout(databaseID); err != nil {
+		return err
+	}
+	if err = ui.redirectLogs(cfg.ConfigClientMachineInitial.LogPath); err != nil {
+		return err
+	}
+	setPhase(databaseID, "preparing")
+
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 	if !ok {
 		return fmt.Errorf("%q is not found", databaseID)
 	}
+	if len(databaseEnv) > 0 {
+		if err = cfg.ApplyDatabaseEnv(databaseID, databaseEnv); err != nil {
+			return err
+		}
+		gcfg.DatabaseEnv = cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseEnv
+	}
+	if stressOnly {
+		// databases are started and stopped by another control node
+		gcfg.ConfigClientMachineBenchmarkSteps = &dbtesterpb.ConfigClientMachineBenchmarkSteps{Step2StressDatabase: true}
+	}
+	if seed != 0 {
+		gcfg.ConfigClientMachineBenchmarkOptions.Seed = seed
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions.Seed == 0 {
+		// record the random seed in run metadata to rerun
+		gcfg.ConfigClientMachineBenchmarkOptions.Seed = time.Now().UnixNano()
+	}
+	if shards > 1 {
+		if err = cfg.ApplyClientShard(databaseID, shardIndex, shards); err != nil {
+			return err
+		}
+		plog.Infof("client shard %d of %d [requests: %d | rate: %d | key start index: %d]", shardIndex, shards,
+			gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond, gcfg.ConfigClientMachineBenchmarkOptions.KeyStartIndex)
+	}
+	plog.Infof("workload seed %d", gcfg.ConfigClientMachineBenchmarkOptions.Seed)
+	if nemesisReplay != "" {
+		if err = cfg.ReplayNemesis(databaseID, nemesisReplay); err != nil {
+			return err
+		}
+		gcfg.ConfigRandomNemesis = cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].ConfigRandomNemesis
+	}
+	if rn := gcfg.ConfigRandomNemesis; rn != nil {
+		if nemesisSeed != 0 {
+			rn.Seed = nemesisSeed
+		}
+		if rn.Seed == 0 {
+			// record the random seed in run metadata to replay
+			rn.Seed = time.Now().UnixNano()
+		}
+		plog.Infof("nemesis seed %d", rn.Seed)
+	}
+	switch keyOrder {
+	case "":
+	case dbtester.KeyOrderSequential, dbtester.KeyOrderRandom:
+		gcfg.ConfigClientMachineBenchmarkOptions.KeyOrder = keyOrder
+	default:
+		return fmt.Errorf("unknown --key-order %q", keyOrder)
+	}
+	keyPrefix, err := cfg.ApplyPhaseKeyPrefix(databaseID, time.Now())
+	if err != nil {
+		return err
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions.PhaseKeyPrefix {
+		plog.Infof("writing keys with prefix %q", keyPrefix)
+	}
 
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase {
 		switch gcfg.ConfigClientMachineBenchmarkOptions.Type {
 		case "write":
 		case "read":
 		case "read-oneshot":
+		case "mixed":
+		case "replay":
+		case "watch-compaction":
+		case "session-expiry":
+		case "lease-expiry":
 		default:
 			return fmt.Errorf("%q is not supported", gcfg.ConfigClientMachineBenchmarkOptions.Type)
 		}
 	}
 
 	pid := int64(os.Getpid())
-	plog.Infof("starting collecting system metrics at %q [disk device: %q | network interface: %q | PID: %d]", cfg.ConfigClientMachineInitial.ClientSystemMetricsPath, diskDevice, networkInterface, pid)
+	interval := dbtesterpb.MonitorInterval(&cfg.ConfigClientMachineInitial)
+	plog.Infof("starting collecting system metrics at %q [disk device: %q | network interface: %q | PID: %d | interval: %v]", cfg.ConfigClientMachineInitial.ClientSystemMetricsPath, diskDevice, networkInterface, pid, interval)
 	if err = os.RemoveAll(cfg.ConfigClientMachineInitial.ClientSystemMetricsPath); err != nil {
 		return err
 	}
 	tcfg := &top.Config{
 		Exec:           top.DefaultExecPath,
-		IntervalSecond: 1,
+		IntervalSecond: interval.Seconds(),
 		PID:            pid,
 	}
 	var metricsCSV *inspect.CSV
@@ -117,7 +335,7 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	go func() {
 		for {
 			select {
-			case <-time.After(time.Second):
+			case <-time.After(interval):
 				if err := metricsCSV.Add(); err != nil {
 					plog.Errorf("inspect.CSV.Add error (%v)", err)
 					continue
@@ -132,9 +350,9 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 					plog.Infof("CSV saved at %q", metricsCSV.FilePath)
 				}
 
-				interpolated, err := metricsCSV.Interpolate()
+				interpolated, err := resample.Interpolate(metricsCSV, interval)
 				if err != nil {
-					plog.Fatalf("inspect.CSV.Interpolate(%q) failed with %v", metricsCSV.FilePath, err)
+					plog.Fatalf("resample.Interpolate(%q) failed with %v", metricsCSV.FilePath, err)
 				}
 				interpolated.FilePath = cfg.ConfigClientMachineInitial.ClientSystemMetricsInterpolatedPath
 				if err := interpolated.Save(); err != nil {
@@ -154,29 +372,168 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	plog.Infof("npt update output: %q", no)
 	plog.Infof("npt update error: %v", nerr)
 
-	println()
+	logBreak()
+	md := cfg.NewRunMetadata(databaseID)
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase {
+		release := gcfg.ConfigRelease != nil && gcfg.ConfigRelease.Version != ""
+		switch {
+		case release && gcfg.ConfigSource != nil:
+			return fmt.Errorf("both release and source are defined for %q", databaseID)
+		case release:
+			plog.Infof("step 1: installing release %s...", gcfg.ConfigRelease.Version)
+			if err = cfg.InstallRelease(databaseID); err != nil {
+				return err
+			}
+		case gcfg.ConfigSource != nil:
+			plog.Infof("step 1: building %q at %q...", gcfg.ConfigSource.Repository, gcfg.ConfigSource.Revision)
+			if md.SourceCommit, err = cfg.InstallFromSource(databaseID); err != nil {
+				return err
+			}
+		}
 		plog.Info("step 1: starting databases...")
-		if _, err = cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Start); err != nil {
+		setPhase(databaseID, "starting")
+		var resps map[int]dbtesterpb.Response
+		if resps, err = cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Start); err != nil {
+			return err
+		}
+		md.SetServerHardware(resps)
+		md.SetServerConfigs(resps)
+	}
+	if !stressOnly {
+		samples, merr := cfg.MeasureClockOffsets(databaseID)
+		if merr != nil {
+			plog.Warningf("failed to measure clock offsets (%v)", merr)
+		} else {
+			md.AddClockOffsets(gcfg.AgentEndpoints, samples)
+		}
+	}
+	if err = cfg.SaveRunMetadata(md); err != nil {
+		return err
+	}
+	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase && !stressOnly {
+		// after step 1, which resets the data directory
+		n, ierr := cfg.ImportKeyspaceSnapshot(databaseID)
+		if ierr != nil {
+			return fmt.Errorf("failed to import keyspace snapshot (%v)", ierr)
+		}
+		if n > 0 {
+			plog.Infof("imported %d keys of keyspace snapshot %q", n, gcfg.ConfigClientMachineBenchmarkOptions.KeyspaceSnapshotPath)
+		}
+	}
+
+	// stream server-side system metrics to this node, if configured
+	var streamc <-chan struct{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if cfg.ConfigClientMachineInitial.ServerSystemMetricsPath != "" && !stressOnly {
+		plog.Info("streaming system metrics from agents...")
+		streamc, err = cfg.StreamSystemMetrics(ctx, databaseID)
+		if err != nil {
 			return err
 		}
 	}
 
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase {
-		println()
+		if controlPort != "" {
+			srv, err := startControlServer(controlPort)
+			if err != nil {
+				return err
+			}
+			defer srv.Stop()
+		}
+
+		logBreak()
 		time.Sleep(5 * time.Second)
-		println()
+		logBreak()
 		plog.Info("step 2: starting tests...")
-		if err = cfg.Stress(databaseID); err != nil {
+		if live != nil {
+			live.SetRequestNumber(gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
+		}
+		setPhase(databaseID, "stressing")
+		var nemesisc <-chan []dbtester.NemesisEvent
+		nctx, ncancel := context.WithCancel(context.Background())
+		if len(gcfg.NemesisSchedule) > 0 || gcfg.ConfigRollingRestart != nil || gcfg.ConfigRandomNemesis != nil {
+			if gcfg.ConfigRollingRestart != nil {
+				plog.Infof("restarting %d servers one at a time", len(gcfg.AgentEndpoints))
+			} else if gcfg.ConfigRandomNemesis != nil {
+				plog.Infof("injecting %d random faults with seed %d", gcfg.ConfigRandomNemesis.Steps, gcfg.ConfigRandomNemesis.Seed)
+			} else {
+				plog.Infof("running nemesis schedule with %d steps", len(gcfg.NemesisSchedule))
+			}
+			if nemesisc, err = cfg.RunNemesis(nctx, databaseID); err != nil {
+				ncancel()
+				return err
+			}
+		}
+		var profilec <-chan struct{}
+		if gcfg.ConfigProfile != nil {
+			plog.Infof("capturing profiles at %v seconds", gcfg.ConfigProfile.AtSeconds)
+			if profilec, err = cfg.CaptureProfiles(nctx, databaseID); err != nil {
+				ncancel()
+				return err
+			}
+		}
+		var clockc <-chan [][]dbtester.ClockOffsetSample
+		if !stressOnly {
+			clockc = cfg.MonitorClockOffsets(nctx, databaseID, clockOffsetInterval)
+		}
+		unlimit, lerr := cfg.LimitClientBandwidth(databaseID, networkInterface)
+		if lerr != nil {
+			ncancel()
+			return lerr
+		}
+		err = cfg.Stress(databaseID)
+		if lerr = unlimit(); lerr != nil {
+			plog.Warningf("failed to remove bandwidth limit (%v)", lerr)
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.DeleteKeyPrefix {
+			if n, derr := cfg.DeleteKeyPrefix(databaseID); derr != nil {
+				plog.Warningf("failed to delete keys with prefix %q (%v)", keyPrefix, derr)
+			} else {
+				plog.Infof("deleted %d keys with prefix %q", n, keyPrefix)
+			}
+		}
+		ncancel()
+		if nemesisc != nil {
+			events := <-nemesisc
+			if gcfg.ConfigRollingRestart != nil && err == nil {
+				if derr := cfg.SaveRollingRestartDips(events); derr != nil {
+					plog.Warningf("failed to save rolling restart dips (%v)", derr)
+				}
+			}
+		}
+		if profilec != nil {
+			<-profilec
+		}
+		if clockc != nil {
+			if rounds := <-clockc; len(rounds) > 0 {
+				for _, samples := range rounds {
+					md.AddClockOffsets(gcfg.AgentEndpoints, samples)
+				}
+				if serr := cfg.SaveRunMetadata(md); serr != nil {
+					plog.Warningf("failed to save clock offsets (%v)", serr)
+				}
+			}
+		}
+		if reason := dbtester.ErrorBudgetExceeded(); reason != "" {
+			// still stop databases, and collect and upload results
+			plog.Warningf("marking %q failed (%s)", databaseID, reason)
+			md.Failure = reason
+			if serr := cfg.SaveRunMetadata(md); serr != nil {
+				plog.Warningf("failed to save run failure (%v)", serr)
+			}
+		}
+		if err != nil {
 			return err
 		}
 	}
 
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step3StopDatabase {
-		println()
+		logBreak()
 		time.Sleep(5 * time.Second)
-		println()
+		logBreak()
 		plog.Info("step 3: stopping tests...")
+		setPhase(databaseID, "stopping")
 		var idxToResp map[int]dbtesterpb.Response
 		for i := 0; i < 5; i++ {
 			idxToResp, err = cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Stop)
@@ -191,9 +548,9 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 			plog.Infof("stop response: %+v", idxToResp[idx])
 		}
 
-		println()
+		logBreak()
 		time.Sleep(time.Second)
-		println()
+		logBreak()
 		plog.Info("step 3: saving responses...")
 		if err = cfg.SaveDiskSpaceUsageSummary(databaseID, idxToResp); err != nil {
 			return err
@@ -203,11 +560,28 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	close(donec)
 	<-sysdonec
 
+	if streamc != nil {
+		if !gcfg.ConfigClientMachineBenchmarkSteps.Step3StopDatabase {
+			// agents keep collecting metrics until databases stop
+			cancel()
+		}
+		<-streamc
+		plog.Info("finished streaming system metrics from agents")
+	}
+
+	if err = cfg.SaveResultDatabase(databaseID); err != nil {
+		return err
+	}
+	if err = cfg.WriteResultChecksums(); err != nil {
+		return err
+	}
+
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs {
-		println()
+		logBreak()
 		time.Sleep(3 * time.Second)
-		println()
+		logBreak()
 		plog.Info("step 4: uploading logs...")
+		setPhase(databaseID, "uploading")
 		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.LogPath); err != nil {
 			return err
 		}
@@ -235,8 +609,163 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath); err != nil {
 			return err
 		}
+		if gcfg.ConfigProfile != nil {
+			for _, at := range gcfg.ConfigProfile.AtSeconds {
+				for idx := range gcfg.AgentEndpoints {
+					cpuPath, heapPath, perfPath := cfg.ProfilePaths(databaseID, idx, at)
+					for _, p := range []string{cpuPath, heapPath, perfPath} {
+						if _, err = os.Stat(p); err != nil {
+							// not captured, if the stress finished earlier
+							continue
+						}
+						if err = cfg.UploadToGoogle(databaseID, p); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+		if cfg.ConfigClientMachineInitial.RunMetadataPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.RunMetadataPath); err != nil {
+				return err
+			}
+		}
+		if (len(gcfg.NemesisSchedule) > 0 || gcfg.ConfigRollingRestart != nil || gcfg.ConfigRandomNemesis != nil) && cfg.ConfigClientMachineInitial.NemesisEventsPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.NemesisEventsPath); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigRollingRestart != nil && cfg.ConfigClientMachineInitial.ClientRollingRestartPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientRollingRestartPath); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.ThroughputCeiling != nil && cfg.ConfigClientMachineInitial.ClientThroughputCeilingPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientThroughputCeilingPath); err != nil {
+				return err
+			}
+		}
+		if cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath); err != nil {
+				return err
+			}
+		}
+		if cfg.ConfigClientMachineInitial.ClientRequestLogPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientRequestLogPath); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.WatchCompaction != nil && cfg.ConfigClientMachineInitial.ClientWatchEventsPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientWatchEventsPath); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.LeaseExpiry != nil && cfg.ConfigClientMachineInitial.ClientLeaseExpiryPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLeaseExpiryPath); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.LeaseExpiry != nil && cfg.ConfigClientMachineInitial.ClientLeaseExpiryAccuracyPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLeaseExpiryAccuracyPath); err != nil {
+				return err
+			}
+		}
+		if len(gcfg.ConfigClientMachineBenchmarkOptions.BatchSizes) > 0 && cfg.ConfigClientMachineInitial.ClientBatchWritesPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientBatchWritesPath); err != nil {
+				return err
+			}
+		}
+		if len(gcfg.ConfigClientMachineBenchmarkOptions.RangeSizes) > 0 && cfg.ConfigClientMachineInitial.ClientRangeLatencyPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientRangeLatencyPath); err != nil {
+				return err
+			}
+		}
+		if len(gcfg.ConfigClientMachineBenchmarkOptions.RequestTimeoutsMs) > 0 && cfg.ConfigClientMachineInitial.ClientRequestTimeoutsPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientRequestTimeoutsPath); err != nil {
+				return err
+			}
+		}
+		if cfg.ConfigClientMachineInitial.ClientConnectionEventsPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientConnectionEventsPath); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.Soak != nil && cfg.ConfigClientMachineInitial.ClientSoakRollupPath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientSoakRollupPath); err != nil {
+				return err
+			}
+		}
+		if cfg.ConfigClientMachineInitial.ClientResultDatabasePath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientResultDatabasePath); err != nil {
+				return err
+			}
+		}
+		for _, tg := range gcfg.ConfigClientMachineBenchmarkOptions.TenantGroups {
+			tcfg := cfg.TenantGroupConfig(tg.Name)
+			for _, p := range []string{
+				tcfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath,
+				tcfg.ConfigClientMachineInitial.ClientLatencyDistributionAllPath,
+				tcfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath,
+				tcfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath,
+				tcfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath,
+			} {
+				if err = cfg.UploadToGoogle(databaseID, p); err != nil {
+					return err
+				}
+			}
+			if tcfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath != "" {
+				if err = cfg.UploadToGoogle(databaseID, tcfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath); err != nil {
+					return err
+				}
+			}
+		}
 	}
 
-	plog.Info("all done!")
+	plog.Infof("finished testing %q", databaseID)
+	setPhase(databaseID, "done")
+	return nil
+}
+
+// live aggregates results for '--tui' and '--http-port', nil if both are disabled.
+var live *dbtester.LiveStats
+
+// setPhase reports the phase of the run to '--tui' and '--http-port'.
+func setPhase(databaseID, phase string) {
+	switch {
+	case ui != nil:
+		ui.setPhase(databaseID, phase)
+	case live != nil:
+		live.SetPhase(databaseID, phase)
+	}
+}
+
+// pinClient sets GOMAXPROCS and pins all client threads
+// to the CPUs or the NUMA node, if configured.
+func pinClient() error {
+	if gomaxprocs > 0 {
+		prev := runtime.GOMAXPROCS(gomaxprocs)
+		plog.Infof("set GOMAXPROCS %d (previously %d)", gomaxprocs, prev)
+	}
+
+	cpus, err := clientCPUs()
+	if err != nil || len(cpus) == 0 {
+		return err
+	}
+	if err = cpuaffinity.Set(cpus); err != nil {
+		return err
+	}
+	plog.Infof("pinned client threads to CPUs %v", cpus)
 	return nil
 }
+
+// clientCPUs returns the CPUs of '--cpus' or '--numa-node',
+// or nil if client threads are not pinned.
+func clientCPUs() ([]int, error) {
+	switch {
+	case cpuList != "":
+		return cpuaffinity.ParseList(cpuList)
+	case numaNode >= 0:
+		return cpuaffinity.NUMANodeCPUs(numaNode)
+	}
+	return nil, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/pkg/cpuaffinity"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// concurrentSkipFlags are the flags not passed to the 'control' process
+// of each database, set per database or bound to a port.
+var concurrentSkipFlags = map[string]bool{
+	"database-id":            true,
+	"concurrent":             true,
+	"concurrent-start-delay": true,
+	"start-at":               true,
+	"run-id":                 true,
+	"tag":                    true,
+	"database-env":           true,
+	"tui":                    true,
+	"http-port":              true,
+	"control-port":           true,
+	"cpus":                   true,
+	"numa-node":              true,
+}
+
+// runConcurrent tests the databases at the same time on disjoint servers,
+// with one 'control' process per database, so that their benchmarks
+// start sending requests at the same time.
+func runConcurrent(cmd *cobra.Command, ids []string) error {
+	cfg, err := dbtester.ReadConfig(configPath, false)
+	if err != nil {
+		return err
+	}
+	if err = cfg.CheckDisjointServers(ids); err != nil {
+		return fmt.Errorf("cannot test concurrently (%v)", err)
+	}
+	rid := runID
+	if rid == "" {
+		rid = cfg.ConfigClientMachineInitial.RunID
+	}
+	if rid == dbtester.AutoRunID {
+		rid = dbtester.NewRunID(time.Now())
+		plog.Infof("generated run ID %q", rid)
+	}
+	if rid == "" {
+		// results of all databases are written to the same paths
+		return fmt.Errorf("testing multiple databases requires 'run_id' in %q", configPath)
+	}
+
+	at := time.Now().Add(concurrentStartDelay)
+	if startAt != "" {
+		if at, err = parseStartAt(startAt); err != nil {
+			return err
+		}
+	}
+	plog.Infof("testing %q concurrently, sending requests at %s", ids, at.Format(time.RFC3339))
+
+	args := []string{"control", "--run-id", rid, "--start-at", fmt.Sprintf("%d", at.Unix())}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if !concurrentSkipFlags[f.Name] {
+			args = append(args, "--"+f.Name+"="+f.Value.String())
+		}
+	})
+	for _, tag := range runTags {
+		args = append(args, "--tag", tag)
+	}
+	for _, env := range databaseEnv {
+		args = append(args, "--database-env", env)
+	}
+
+	// each process is pinned to its own CPUs, so that
+	// the clients do not compete for the same cores
+	cpus, err := clientCPUs()
+	if err != nil {
+		return err
+	}
+	var cpuSets [][]int
+	if len(cpus) > 0 {
+		if cpuSets, err = cpuaffinity.Split(cpus, len(ids)); err != nil {
+			return fmt.Errorf("cannot pin %d databases to disjoint CPUs (%v)", len(ids), err)
+		}
+	}
+
+	errc := make(chan error, len(ids))
+	for i, id := range ids {
+		cargs := append(append([]string{}, args...), "--database-id", id)
+		if cpuSets != nil {
+			cargs = append(cargs, "--cpus", cpuaffinity.FormatList(cpuSets[i]))
+		}
+		c := exec.Command(os.Args[0], cargs...)
+		c.Stdout, c.Stderr = os.Stdout, os.Stderr
+		plog.Infof("starting %q", strings.Join(c.Args, " "))
+		if err = c.Start(); err != nil {
+			errc <- fmt.Errorf("%q: %v", id, err)
+			continue
+		}
+		go func(id string, c *exec.Cmd) {
+			if err := c.Wait(); err != nil {
+				errc <- fmt.Errorf("%q: %v", id, err)
+				return
+			}
+			errc <- nil
+		}(id, c)
+	}
+
+	var errs []string
+	for range ids {
+		if err := <-errc; err != nil {
+			plog.Warningf("failed testing %v", err)
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed testing concurrently (%s)", strings.Join(errs, ", "))
+	}
+	plog.Info("all done!")
+	return nil
+}
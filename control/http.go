@@ -0,0 +1,157 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/coreos/dbtester"
+)
+
+// httpStatus is the response of 'GET /status'.
+type httpStatus struct {
+	DatabaseID string `json:"database_id"`
+	Phase      string `json:"phase"`
+
+	Requests      int64 `json:"requests"`
+	Errors        int64 `json:"errors"`
+	RequestNumber int64 `json:"request_number"`
+	// ProgressPercent is 0 if the number of requests is unknown.
+	ProgressPercent float64 `json:"progress_percent"`
+
+	Paused  bool `json:"paused"`
+	Aborted bool `json:"aborted"`
+}
+
+// httpMetrics is the response of 'GET /metrics/latest'.
+type httpMetrics struct {
+	DatabaseID string  `json:"database_id"`
+	UnixSecond int64   `json:"unix_second"`
+	Requests   int64   `json:"requests"`
+	Errors     int64   `json:"errors"`
+	P50Ms      float64 `json:"p50_ms"`
+	P99Ms      float64 `json:"p99_ms"`
+
+	ServerN      int     `json:"server_number"`
+	ServerCPU    float64 `json:"server_cpu"`
+	ServerVMRSSB uint64  `json:"server_vmrss_bytes"`
+}
+
+// httpControl is the response of POST requests.
+type httpControl struct {
+	// OK is false if the request has no effect (e.g. pause while paused).
+	OK      bool `json:"ok"`
+	Paused  bool `json:"paused"`
+	Aborted bool `json:"aborted"`
+}
+
+// newHTTPHandler returns the handler of status and control requests
+// on the live stats of runs.
+func newHTTPHandler(live *dbtester.LiveStats) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", func(w http.ResponseWriter, req *http.Request) {
+		if !allowMethod(w, req, http.MethodGet) {
+			return
+		}
+		snap := live.Snapshot(time.Now())
+		st := httpStatus{
+			DatabaseID:    snap.DatabaseID,
+			Phase:         snap.Phase,
+			Requests:      snap.Requests,
+			Errors:        snap.Errors,
+			RequestNumber: snap.RequestNumber,
+			Paused:        dbtester.LoadPaused(),
+			Aborted:       dbtester.LoadAborted(),
+		}
+		if snap.RequestNumber > 0 {
+			st.ProgressPercent = 100 * float64(snap.Requests) / float64(snap.RequestNumber)
+			if st.ProgressPercent > 100 {
+				st.ProgressPercent = 100
+			}
+		}
+		writeJSON(w, http.StatusOK, st)
+	})
+	mux.HandleFunc("/metrics/latest", func(w http.ResponseWriter, req *http.Request) {
+		if !allowMethod(w, req, http.MethodGet) {
+			return
+		}
+		snap := live.Snapshot(time.Now())
+		last, _ := snap.Last()
+		writeJSON(w, http.StatusOK, httpMetrics{
+			DatabaseID:   snap.DatabaseID,
+			UnixSecond:   last.UnixSecond,
+			Requests:     last.Requests,
+			Errors:       last.Errors,
+			P50Ms:        last.P50Ms,
+			P99Ms:        last.P99Ms,
+			ServerN:      snap.ServerN,
+			ServerCPU:    snap.ServerCPU,
+			ServerVMRSSB: snap.ServerVMRSS,
+		})
+	})
+	for path, fn := range map[string]func() bool{
+		"/pause":  dbtester.PauseLoad,
+		"/resume": dbtester.ResumeLoad,
+		"/abort":  dbtester.AbortLoad,
+	} {
+		path, fn := path, fn
+		mux.HandleFunc(path, func(w http.ResponseWriter, req *http.Request) {
+			if !allowMethod(w, req, http.MethodPost) {
+				return
+			}
+			plog.Infof("received HTTP control request %q", path)
+			ok := fn()
+			writeJSON(w, http.StatusOK, httpControl{
+				OK:      ok,
+				Paused:  dbtester.LoadPaused(),
+				Aborted: dbtester.LoadAborted(),
+			})
+		})
+	}
+	return mux
+}
+
+func allowMethod(w http.ResponseWriter, req *http.Request, method string) bool {
+	if req.Method == method {
+		return true
+	}
+	w.Header().Set("Allow", method)
+	http.Error(w, req.Method+" is not allowed", http.StatusMethodNotAllowed)
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		plog.Warningf("failed to write HTTP response (%v)", err)
+	}
+}
+
+// startHTTPServer serves status and control requests over HTTP
+// for all databases of the run.
+func startHTTPServer(port string) (*http.Server, error) {
+	ln, err := net.Listen("tcp", port)
+	if err != nil {
+		return nil, err
+	}
+	srv := &http.Server{Handler: newHTTPHandler(live)}
+	go srv.Serve(ln)
+	plog.Infof("control started with HTTP %s", port)
+	return srv, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coreos/dbtester"
+)
+
+func TestHTTPHandler(t *testing.T) {
+	live := dbtester.NewLiveStats(10)
+	live.SetPhase("etcd__tip", "stressing")
+	live.SetRequestNumber(200)
+	srv := httptest.NewServer(newHTTPHandler(live))
+	defer srv.Close()
+
+	var st httpStatus
+	if code := doJSON(t, http.MethodGet, srv.URL+"/status", &st); code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, code)
+	}
+	if st.DatabaseID != "etcd__tip" || st.Phase != "stressing" || st.RequestNumber != 200 || st.Paused || st.Aborted {
+		t.Fatalf("unexpected status %+v", st)
+	}
+	if code := doJSON(t, http.MethodPost, srv.URL+"/status", nil); code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected %d, got %d", http.StatusMethodNotAllowed, code)
+	}
+
+	var m httpMetrics
+	if code := doJSON(t, http.MethodGet, srv.URL+"/metrics/latest", &m); code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, code)
+	}
+	if m.DatabaseID != "etcd__tip" {
+		t.Fatalf("unexpected metrics %+v", m)
+	}
+
+	if code := doJSON(t, http.MethodGet, srv.URL+"/pause", nil); code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected %d, got %d", http.StatusMethodNotAllowed, code)
+	}
+	var c httpControl
+	doJSON(t, http.MethodPost, srv.URL+"/pause", &c)
+	if !c.OK || !c.Paused {
+		t.Fatalf("expected paused, got %+v", c)
+	}
+	doJSON(t, http.MethodPost, srv.URL+"/pause", &c)
+	if c.OK || !c.Paused {
+		t.Fatalf("expected already paused, got %+v", c)
+	}
+	doJSON(t, http.MethodPost, srv.URL+"/resume", &c)
+	if !c.OK || c.Paused {
+		t.Fatalf("expected resumed, got %+v", c)
+	}
+
+	// abort cannot be undone, so it is tested last
+	doJSON(t, http.MethodPost, srv.URL+"/abort", &c)
+	if !c.OK || !c.Aborted {
+		t.Fatalf("expected aborted, got %+v", c)
+	}
+	doJSON(t, http.MethodGet, srv.URL+"/status", &st)
+	if !st.Aborted {
+		t.Fatalf("expected aborted status, got %+v", st)
+	}
+}
+
+func doJSON(t *testing.T, method, url string, v interface{}) int {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if v != nil && resp.StatusCode == http.StatusOK {
+		if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return resp.StatusCode
+}
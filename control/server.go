@@ -0,0 +1,106 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// implements dbtesterpb.ControllerServer
+type controllerServer struct{}
+
+func (controllerServer) Control(ctx context.Context, req *dbtesterpb.ControlRequest) (*dbtesterpb.ControlResponse, error) {
+	plog.Infof("received gRPC control request %q", req.Operation)
+	switch req.Operation {
+	case dbtesterpb.ControlOperation_Pause:
+		if !dbtester.PauseLoad() {
+			plog.Warningf("load generation is already paused")
+		}
+	case dbtesterpb.ControlOperation_Resume:
+		if !dbtester.ResumeLoad() {
+			plog.Warningf("load generation is not paused")
+		}
+	default:
+		return nil, fmt.Errorf("unknown operation %q", req.Operation)
+	}
+	return &dbtesterpb.ControlResponse{Paused: dbtester.LoadPaused()}, nil
+}
+
+// startControlServer serves pause and resume requests while
+// benchmark is running.
+func startControlServer(port string) (*grpc.Server, error) {
+	ln, err := net.Listen("tcp", port)
+	if err != nil {
+		return nil, err
+	}
+	grpcServer := grpc.NewServer()
+	dbtesterpb.RegisterControllerServer(grpcServer, controllerServer{})
+	go grpcServer.Serve(ln)
+	plog.Infof("control started with gRPC %s", port)
+	return grpcServer, nil
+}
+
+// PauseCommand implements 'control pause' command.
+var PauseCommand = &cobra.Command{
+	Use:   "pause",
+	Short: "Pauses load generation of the running test.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return sendControl(dbtesterpb.ControlOperation_Pause)
+	},
+}
+
+// ResumeCommand implements 'control resume' command.
+var ResumeCommand = &cobra.Command{
+	Use:   "resume",
+	Short: "Resumes load generation of the running test.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return sendControl(dbtesterpb.ControlOperation_Resume)
+	},
+}
+
+var controlEndpoint string
+
+func init() {
+	PauseCommand.Flags().StringVar(&controlEndpoint, "endpoint", "localhost:3600", "gRPC endpoint of the running 'control'.")
+	ResumeCommand.Flags().StringVar(&controlEndpoint, "endpoint", "localhost:3600", "gRPC endpoint of the running 'control'.")
+	Command.AddCommand(PauseCommand)
+	Command.AddCommand(ResumeCommand)
+}
+
+func sendControl(op dbtesterpb.ControlOperation) error {
+	conn, err := grpc.Dial(controlEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	resp, err := dbtesterpb.NewControllerClient(conn).Control(ctx, &dbtesterpb.ControlRequest{Operation: op})
+	cancel()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s %q (paused: %v)\n", op, controlEndpoint, resp.Paused)
+	return nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/pkg/capnslog"
+	humanize "github.com/dustin/go-humanize"
+	"github.com/olekukonko/tablewriter"
+)
+
+// tuiHistorySeconds is the number of seconds in sparklines.
+const tuiHistorySeconds = 60
+
+// tui renders live stats of runs in the terminal, while logs are
+// written to the log file of each database.
+type tui struct {
+	mu     sync.Mutex
+	term   *os.File
+	stderr *os.File
+	live   *dbtester.LiveStats
+	// finished has the last snapshot of databases tested before
+	finished []dbtester.LiveSnapshot
+	logf     *os.File
+
+	stopc chan struct{}
+	donec chan struct{}
+}
+
+// ui is the terminal UI of '--tui', nil if disabled.
+var ui *tui
+
+func startTUI(live *dbtester.LiveStats) *tui {
+	t := &tui{
+		term:   os.Stdout,
+		stderr: os.Stderr,
+		live:   live,
+		stopc:  make(chan struct{}),
+		donec:  make(chan struct{}),
+	}
+	go func() {
+		defer close(t.donec)
+		for {
+			t.render()
+			select {
+			case <-time.After(time.Second):
+			case <-t.stopc:
+				t.render()
+				return
+			}
+		}
+	}()
+	return t
+}
+
+// redirectLogs writes all logs and outputs, other than the UI,
+// to the log file.
+func (t *tui) redirectLogs(fpath string) error {
+	if t == nil || fpath == "" {
+		return nil
+	}
+	f, err := os.OpenFile(fpath, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0777)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	if t.logf != nil {
+		t.logf.Close()
+	}
+	t.logf = f
+	t.mu.Unlock()
+
+	os.Stdout, os.Stderr = f, f
+	capnslog.SetFormatter(capnslog.NewPrettyFormatter(f, false))
+	return nil
+}
+
+// logBreak writes a blank line between steps to stderr, which is the log
+// file with '--tui'. Builtin 'println' would write to the terminal.
+func logBreak() {
+	fmt.Fprintln(os.Stderr)
+}
+
+func (t *tui) setPhase(databaseID, phase string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if cur := t.live.Snapshot(time.Now()); cur.DatabaseID != "" && cur.DatabaseID != databaseID {
+		t.finished = append(t.finished, cur)
+	}
+	t.live.SetPhase(databaseID, phase)
+}
+
+func (t *tui) stop() {
+	if t == nil {
+		return
+	}
+	close(t.stopc)
+	<-t.donec
+
+	os.Stdout, os.Stderr = t.term, t.stderr
+	capnslog.SetFormatter(capnslog.NewDefaultFormatter(os.Stderr))
+	if t.logf != nil {
+		t.logf.Close()
+	}
+}
+
+func (t *tui) render() {
+	t.mu.Lock()
+	rows := append(append([]dbtester.LiveSnapshot(nil), t.finished...), t.live.Snapshot(time.Now()))
+	t.mu.Unlock()
+
+	var buf bytes.Buffer
+	// move cursor to top-left, and clear screen
+	buf.WriteString("\033[H\033[2J")
+	writeTUI(&buf, time.Now(), rows)
+	t.term.Write(buf.Bytes())
+}
+
+// writeTUI writes the table of all databases, and sparklines
+// of the current database, the last in rows.
+func writeTUI(w io.Writer, now time.Time, rows []dbtester.LiveSnapshot) {
+	fmt.Fprintf(w, "dbtester control (%s)\n\n", now.Format("2006-01-02 15:04:05"))
+
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"DATABASE", "PHASE", "QPS", "P50 (MS)", "P99 (MS)", "REQUESTS", "ERRORS", "SERVER CPU", "SERVER MEM"})
+	tw.SetAutoFormatHeaders(false)
+	for _, s := range rows {
+		if s.DatabaseID == "" {
+			continue
+		}
+		qps, p50, p99 := "-", "-", "-"
+		if last, ok := s.Last(); ok {
+			qps = humanize.Comma(last.Requests)
+			p50, p99 = fmt.Sprintf("%.2f", last.P50Ms), fmt.Sprintf("%.2f", last.P99Ms)
+		}
+		cpu, mem := "-", "-"
+		if s.ServerN > 0 {
+			cpu = fmt.Sprintf("%.1f %%", s.ServerCPU)
+			mem = humanize.Bytes(s.ServerVMRSS)
+		}
+		tw.Append([]string{s.DatabaseID, s.Phase, qps, p50, p99, humanize.Comma(s.Requests), humanize.Comma(s.Errors), cpu, mem})
+	}
+	tw.Render()
+
+	cur := rows[len(rows)-1]
+	qps := make([]float64, len(cur.History))
+	p99 := make([]float64, len(cur.History))
+	for i, h := range cur.History {
+		qps[i], p99[i] = float64(h.Requests), h.P99Ms
+	}
+	fmt.Fprintf(w, "\nQPS  %s\nP99  %s\n", sparkline(qps), sparkline(p99))
+}
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline returns the values scaled between minimum and maximum.
+func sparkline(vs []float64) string {
+	if len(vs) == 0 {
+		return ""
+	}
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range vs {
+		min, max = math.Min(min, v), math.Max(max, v)
+	}
+	rs := make([]rune, len(vs))
+	for i, v := range vs {
+		idx := 0
+		if max > min {
+			idx = int((v - min) / (max - min) * float64(len(sparks)-1))
+		}
+		rs[i] = sparks[idx]
+	}
+	return string(rs)
+}
@@ -0,0 +1,47 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester"
+)
+
+func TestSparkline(t *testing.T) {
+	if s := sparkline([]float64{0, 7, 14, 7}); s != "▁▄█▄" {
+		t.Fatalf("unexpected sparkline %q", s)
+	}
+	if s := sparkline([]float64{3, 3}); s != "▁▁" {
+		t.Fatalf("unexpected sparkline %q", s)
+	}
+}
+
+func TestWriteTUI(t *testing.T) {
+	var buf bytes.Buffer
+	writeTUI(&buf, time.Unix(0, 0), []dbtester.LiveSnapshot{
+		{DatabaseID: "etcd__tip", Phase: "done", Requests: 1000, History: []dbtester.LiveSample{{Requests: 500, P50Ms: 1.5, P99Ms: 9}}},
+		{DatabaseID: "zookeeper__r3_5_3_beta", Phase: "starting"},
+	})
+	out := buf.String()
+	for _, s := range []string{"etcd__tip", "1,000", "9.00", "zookeeper__r3_5_3_beta", "starting"} {
+		if !strings.Contains(out, s) {
+			t.Fatalf("expected %q in\n%s", s, out)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseDatabaseEnv parses 'KEY=VALUE' environment variables
+// of the database process. Keys must be unique.
+func ParseDatabaseEnv(env []string) (map[string]string, error) {
+	if len(env) == 0 {
+		return nil, nil
+	}
+	m := make(map[string]string, len(env))
+	for _, s := range env {
+		kv := strings.SplitN(s, "=", 2)
+		if len(kv) != 2 || kv[0] == "" || strings.ContainsAny(kv[0], " \t") {
+			return nil, fmt.Errorf("database_env %q is not 'KEY=VALUE'", s)
+		}
+		if _, ok := m[kv[0]]; ok {
+			return nil, fmt.Errorf("database_env %q is duplicate", kv[0])
+		}
+		m[kv[0]] = kv[1]
+	}
+	return m, nil
+}
+
+// mergeDatabaseEnv returns 'env' with the variables of 'override',
+// replacing the variables of the same keys.
+func mergeDatabaseEnv(env, override []string) []string {
+	overridden := make(map[string]bool, len(override))
+	for _, s := range override {
+		overridden[strings.SplitN(s, "=", 2)[0]] = true
+	}
+	merged := make([]string, 0, len(env)+len(override))
+	for _, s := range env {
+		if !overridden[strings.SplitN(s, "=", 2)[0]] {
+			merged = append(merged, s)
+		}
+	}
+	return append(merged, override...)
+}
+
+// ApplyDatabaseEnv sets the environment variables of the database
+// process in addition to 'database_env', replacing the ones of the
+// same keys (e.g. to run GC tuning experiments with the same config).
+func (cfg *Config) ApplyDatabaseEnv(databaseID string, env []string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+	if _, err := ParseDatabaseEnv(env); err != nil {
+		return err
+	}
+	gcfg.DatabaseEnv = mergeDatabaseEnv(gcfg.DatabaseEnv, env)
+	cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = gcfg
+	return nil
+}
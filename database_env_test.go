@@ -0,0 +1,40 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDatabaseEnv(t *testing.T) {
+	env, err := ParseDatabaseEnv([]string{"GOGC=400", "GODEBUG=gctrace=1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(env, map[string]string{"GOGC": "400", "GODEBUG": "gctrace=1"}) {
+		t.Fatalf("unexpected env %v", env)
+	}
+	for _, bad := range [][]string{{"GOGC"}, {"=1"}, {"GO GC=1"}, {"GOGC=1", "GOGC=2"}} {
+		if _, err = ParseDatabaseEnv(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+
+	merged := mergeDatabaseEnv([]string{"GOGC=100", "GOMAXPROCS=4"}, []string{"GOGC=400"})
+	if !reflect.DeepEqual(merged, []string{"GOMAXPROCS=4", "GOGC=400"}) {
+		t.Fatalf("unexpected merged env %q", merged)
+	}
+}
@@ -2,38 +2,53 @@
 // source: dbtesterpb/config_analyze_machine.proto
 
 /*
-	Package dbtesterpb is a generated protocol buffer package.
+Package dbtesterpb is a generated protocol buffer package.
 
-	It is generated from these files:
-		dbtesterpb/config_analyze_machine.proto
-		dbtesterpb/config_client_machine.proto
-		dbtesterpb/database_id.proto
-		dbtesterpb/flag_cetcd.proto
-		dbtesterpb/flag_consul.proto
-		dbtesterpb/flag_etcd.proto
-		dbtesterpb/flag_zetcd.proto
-		dbtesterpb/flag_zookeeper.proto
-		dbtesterpb/message.proto
+It is generated from these files:
 
-	It has these top-level messages:
-		ConfigAnalyzeMachineInitial
-		ConfigAnalyzeMachineAllAggregatedOutput
-		ConfigAnalyzeMachinePlot
-		ConfigAnalyzeMachineImage
-		ConfigAnalyzeMachineREADME
-		ConfigClientMachineInitial
-		ConfigClientMachineBenchmarkOptions
-		ConfigClientMachineBenchmarkSteps
-		ConfigClientMachineAgentControl
-		Flag_Cetcd_Beta
-		Flag_Consul_V1_0_2
-		Flag_Etcd_Tip
-		Flag_Etcd_V3_2
-		Flag_Etcd_V3_3
-		Flag_Zetcd_Beta
-		Flag_Zookeeper_R3_5_3Beta
-		Request
-		Response
+	dbtesterpb/config_analyze_machine.proto
+	dbtesterpb/config_client_machine.proto
+	dbtesterpb/database_id.proto
+	dbtesterpb/flag_cetcd.proto
+	dbtesterpb/flag_consul.proto
+	dbtesterpb/flag_etcd.proto
+	dbtesterpb/flag_zetcd.proto
+	dbtesterpb/flag_zookeeper.proto
+	dbtesterpb/message.proto
+
+It has these top-level messages:
+
+	ConfigAnalyzeMachineInitial
+	ConfigAnalyzeMachineAllAggregatedOutput
+	ConfigAnalyzeMachinePlot
+	ConfigAnalyzeMachineImage
+	ConfigAnalyzeMachineREADME
+	ConfigClientMachineInitial
+	ConfigClientMachineBenchmarkOptions
+	ConfigClientMachineBenchmarkSteps
+	ConfigClientMachineAgentControl
+	ConfigDocker
+	ConfigClientMachineTenantGroup
+	ConfigNemesisStep
+	ConfigRelease
+	ConfigSource
+	ConfigProfile
+
+ConfigClientMachineThroughputCeiling
+
+	Flag_Cetcd_Beta
+	Flag_Consul_V1_0_2
+	Flag_Etcd_Tip
+	Flag_Etcd_V3_2
+	Flag_Etcd_V3_3
+	Flag_Zetcd_Beta
+	Flag_Zookeeper_R3_5_3Beta
+	Request
+	Response
+	MonitorSample
+	ControlRequest
+	ControlResponse
+	InstallChunk
 */
 package dbtesterpb
 
@@ -73,6 +88,32 @@ type ConfigAnalyzeMachineInitial struct {
 	ServerWriteBytesDeltaByKeyNumberPath    string   `protobuf:"bytes,14,opt,name=ServerWriteBytesDeltaByKeyNumberPath,proto3" json:"ServerWriteBytesDeltaByKeyNumberPath,omitempty" yaml:"server_write_bytes_delta_by_key_number_path"`
 	ServerSystemMetricsInterpolatedPathList []string `protobuf:"bytes,15,rep,name=ServerSystemMetricsInterpolatedPathList" json:"ServerSystemMetricsInterpolatedPathList,omitempty" yaml:"server_system_metrics_interpolated_path_list"`
 	AllAggregatedOutputPath                 string   `protobuf:"bytes,16,opt,name=AllAggregatedOutputPath,proto3" json:"AllAggregatedOutputPath,omitempty" yaml:"all_aggregated_output_path"`
+	// RunMetadataPath is the run metadata saved by 'control',
+	// to read the hardware of the run (optional).
+	RunMetadataPath string `protobuf:"bytes,17,opt,name=RunMetadataPath,proto3" json:"RunMetadataPath,omitempty" yaml:"run_metadata_path"`
+	// ServerMachinePricePerHour and ClientMachinePricePerHour are the prices
+	// of each server and client machine per hour, to estimate the cost of
+	// the database in the summary (optional).
+	ServerMachinePricePerHour float64 `protobuf:"fixed64,18,opt,name=ServerMachinePricePerHour,proto3" json:"ServerMachinePricePerHour,omitempty" yaml:"server_machine_price_per_hour"`
+	ClientMachinePricePerHour float64 `protobuf:"fixed64,19,opt,name=ClientMachinePricePerHour,proto3" json:"ClientMachinePricePerHour,omitempty" yaml:"client_machine_price_per_hour"`
+	// RepetitionAllAggregatedPathList is the list of aggregated results
+	// from other repetitions of the same run, to plot confidence bands.
+	RepetitionAllAggregatedPathList []string `protobuf:"bytes,20,rep,name=RepetitionAllAggregatedPathList" json:"RepetitionAllAggregatedPathList,omitempty" yaml:"repetition_all_aggregated_path_list"`
+	// ClientLatencyHistogramLogPath is the latency histogram log of the
+	// client (optional), to compute percentiles without the seconds where
+	// the client was saturated.
+	ClientLatencyHistogramLogPath string `protobuf:"bytes,21,opt,name=ClientLatencyHistogramLogPath,proto3" json:"ClientLatencyHistogramLogPath,omitempty" yaml:"client_latency_histogram_log_path"`
+	// ServerSystemMetricsPathList is the raw (not interpolated) system
+	// metrics of each server (optional), to flag the run as suspect on
+	// monitoring gaps, unexpected restarts, and CPU steal time in host
+	// metrics saved by agents next to it ('<path without .csv>-host.csv').
+	ServerSystemMetricsPathList []string `protobuf:"bytes,22,rep,name=ServerSystemMetricsPathList" json:"ServerSystemMetricsPathList,omitempty" yaml:"server_system_metrics_path_list"`
+	// ClientConnectionEventsPath is the connection events of clients
+	// (optional), overlaid on plots with 'connection_events'.
+	ClientConnectionEventsPath string `protobuf:"bytes,23,opt,name=ClientConnectionEventsPath,proto3" json:"ClientConnectionEventsPath,omitempty" yaml:"client_connection_events_path"`
+	// NemesisEventsPath is the faults injected and recovered during the run
+	// (optional), overlaid on plots with 'nemesis_events'.
+	NemesisEventsPath string `protobuf:"bytes,24,opt,name=NemesisEventsPath,proto3" json:"NemesisEventsPath,omitempty" yaml:"nemesis_events_path"`
 }
 
 func (m *ConfigAnalyzeMachineInitial) Reset()         { *m = ConfigAnalyzeMachineInitial{} }
@@ -85,6 +126,56 @@ func (*ConfigAnalyzeMachineInitial) Descriptor() ([]byte, []int) {
 type ConfigAnalyzeMachineAllAggregatedOutput struct {
 	AllAggregatedOutputPathCSV string `protobuf:"bytes,1,opt,name=AllAggregatedOutputPathCSV,proto3" json:"AllAggregatedOutputPathCSV,omitempty" yaml:"all_aggregated_output_path_csv"`
 	AllAggregatedOutputPathTXT string `protobuf:"bytes,2,opt,name=AllAggregatedOutputPathTXT,proto3" json:"AllAggregatedOutputPathTXT,omitempty" yaml:"all_aggregated_output_path_txt"`
+	// ClientMaxCPUPercent is the maximum CPU usage per core of the load
+	// generator (100 when all cores are busy), using the client CPU cores
+	// in run metadata. Analysis fails when the client reaches it, since the
+	// client may have been the bottleneck of the comparison.
+	ClientMaxCPUPercent float64 `protobuf:"fixed64,3,opt,name=ClientMaxCPUPercent,proto3" json:"ClientMaxCPUPercent,omitempty" yaml:"client_max_cpu_percent"`
+	// MemoryUnit is the unit of memory in the summary and plots
+	// (e.g. "MB", "MiB", "GB"). Empty to use human-readable units.
+	MemoryUnit string `protobuf:"bytes,4,opt,name=MemoryUnit,proto3" json:"MemoryUnit,omitempty" yaml:"memory_unit"`
+	// ThroughputUnit is the unit of throughput in the summary and plots
+	// (e.g. "ops/s", "kops/s"). Empty to use requests per second.
+	ThroughputUnit string `protobuf:"bytes,5,opt,name=ThroughputUnit,proto3" json:"ThroughputUnit,omitempty" yaml:"throughput_unit"`
+	// NormalizeThroughput, if not empty, adds throughput normalized by the
+	// server hardware in run metadata, to roughly compare runs on different
+	// machines: "cpu-cores" for per core, "cpu-frequency" for per GHz.
+	NormalizeThroughput string `protobuf:"bytes,6,opt,name=NormalizeThroughput,proto3" json:"NormalizeThroughput,omitempty" yaml:"normalize_throughput"`
+	// ClientSaturationCPUPercent is the client CPU usage per core (100 when
+	// all cores are busy), at or above which
+	// the seconds short of the offered load in rate-limited benchmarks are
+	// marked as client-saturated, and excluded from latency percentiles.
+	// Defaults to 90. Negative to disable the detection.
+	ClientSaturationCPUPercent float64 `protobuf:"fixed64,7,opt,name=ClientSaturationCPUPercent,proto3" json:"ClientSaturationCPUPercent,omitempty" yaml:"client_saturation_cpu_percent"`
+	// SuspectMonitoringGapSeconds, SuspectCPUStealPercent, and
+	// SuspectClockDriftMs are the thresholds to flag a run as suspect:
+	// seconds without server metrics (defaults to 5), average CPU steal
+	// time of a server host (defaults to 5), and clock drift of a server
+	// during the run (defaults to 500). Negative to disable each check.
+	SuspectMonitoringGapSeconds int64   `protobuf:"varint,8,opt,name=SuspectMonitoringGapSeconds,proto3" json:"SuspectMonitoringGapSeconds,omitempty" yaml:"suspect_monitoring_gap_seconds"`
+	SuspectCPUStealPercent      float64 `protobuf:"fixed64,9,opt,name=SuspectCPUStealPercent,proto3" json:"SuspectCPUStealPercent,omitempty" yaml:"suspect_cpu_steal_percent"`
+	SuspectClockDriftMs         float64 `protobuf:"fixed64,10,opt,name=SuspectClockDriftMs,proto3" json:"SuspectClockDriftMs,omitempty" yaml:"suspect_clock_drift_ms"`
+	// TimeAxis is the time format of the x-axis in timeseries plots, and of
+	// the "TIME" column in aggregated timeseries: "seconds" since the start
+	// (default), "elapsed" for HH:MM:SS since the start, or "wall-clock".
+	TimeAxis string `protobuf:"bytes,11,opt,name=TimeAxis,proto3" json:"TimeAxis,omitempty" yaml:"time_axis"`
+	// TimeZone is the IANA time zone of wall-clock time
+	// (e.g. "America/Los_Angeles", "Local"). Defaults to UTC.
+	TimeZone string `protobuf:"bytes,12,opt,name=TimeZone,proto3" json:"TimeZone,omitempty" yaml:"time_zone"`
+	// TimeFormat is the Go time layout of wall-clock time
+	// (e.g. "3:04PM", "Jan 2 15:04"). Defaults to "15:04:05".
+	TimeFormat string `protobuf:"bytes,13,opt,name=TimeFormat,proto3" json:"TimeFormat,omitempty" yaml:"time_format"`
+	// DerivedColumns are custom columns computed from other columns of each
+	// second, as 'name = expression' (e.g. "throughput_per_core =
+	// avg_throughput / (avg_cpu / 100 * cores)"). Columns are referred to in
+	// lower case with underscores, and 'cores', 'cpu_mhz', and 'servers' are
+	// the server hardware in run metadata. Derived columns can be plotted as
+	// 'NAME' (e.g. "THROUGHPUT-PER-CORE"), and are averaged in the summary.
+	DerivedColumns []string `protobuf:"bytes,14,rep,name=DerivedColumns" json:"DerivedColumns,omitempty" yaml:"derived_columns"`
+	// NormalizeClusterSize, if not empty, adds server totals and throughput
+	// divided by the number of servers, to compare clusters of different
+	// sizes (e.g. 3-node etcd and 5-node Zookeeper): "per-node" for per server.
+	NormalizeClusterSize string `protobuf:"bytes,15,opt,name=NormalizeClusterSize,proto3" json:"NormalizeClusterSize,omitempty" yaml:"normalize_cluster_size"`
 }
 
 func (m *ConfigAnalyzeMachineAllAggregatedOutput) Reset() {
@@ -103,6 +194,17 @@ type ConfigAnalyzeMachinePlot struct {
 	YAxis          string   `protobuf:"bytes,3,opt,name=YAxis,proto3" json:"YAxis,omitempty" yaml:"y_axis"`
 	OutputPathCSV  string   `protobuf:"bytes,4,opt,name=OutputPathCSV,proto3" json:"OutputPathCSV,omitempty" yaml:"output_path_csv"`
 	OutputPathList []string `protobuf:"bytes,5,rep,name=OutputPathList" json:"OutputPathList,omitempty" yaml:"output_path_list"`
+	// Band is the shaded band around each database's line, computed over
+	// repetitions: "stddev" for ±1 standard deviation, "ci95" for 95%
+	// confidence interval of the mean. Empty to not draw bands.
+	Band string `protobuf:"bytes,6,opt,name=Band,proto3" json:"Band,omitempty" yaml:"band"`
+	// ConnectionEvents is true to overlay the client connection events
+	// (e.g. disconnects, reconnects) of each database as vertical lines,
+	// to tell latency spikes of client-side reconnects from the servers'.
+	ConnectionEvents bool `protobuf:"varint,7,opt,name=ConnectionEvents,proto3" json:"ConnectionEvents,omitempty" yaml:"connection_events"`
+	// NemesisEvents is true to overlay the faults of each database, when
+	// injected and recovered, as vertical lines.
+	NemesisEvents bool `protobuf:"varint,8,opt,name=NemesisEvents,proto3" json:"NemesisEvents,omitempty" yaml:"nemesis_events"`
 }
 
 func (m *ConfigAnalyzeMachinePlot) Reset()         { *m = ConfigAnalyzeMachinePlot{} }
@@ -268,6 +370,86 @@ func (m *ConfigAnalyzeMachineInitial) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.AllAggregatedOutputPath)))
 		i += copy(dAtA[i:], m.AllAggregatedOutputPath)
 	}
+	if len(m.RunMetadataPath) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.RunMetadataPath)))
+		i += copy(dAtA[i:], m.RunMetadataPath)
+	}
+	if m.ServerMachinePricePerHour != 0 {
+		dAtA[i] = 0x91
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeFixed64ConfigAnalyzeMachine(dAtA, i, uint64(math.Float64bits(float64(m.ServerMachinePricePerHour))))
+	}
+	if m.ClientMachinePricePerHour != 0 {
+		dAtA[i] = 0x99
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeFixed64ConfigAnalyzeMachine(dAtA, i, uint64(math.Float64bits(float64(m.ClientMachinePricePerHour))))
+	}
+	if len(m.RepetitionAllAggregatedPathList) > 0 {
+		for _, s := range m.RepetitionAllAggregatedPathList {
+			dAtA[i] = 0xa2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ClientLatencyHistogramLogPath) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientLatencyHistogramLogPath)))
+		i += copy(dAtA[i:], m.ClientLatencyHistogramLogPath)
+	}
+	if len(m.ServerSystemMetricsPathList) > 0 {
+		for _, s := range m.ServerSystemMetricsPathList {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ClientConnectionEventsPath) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientConnectionEventsPath)))
+		i += copy(dAtA[i:], m.ClientConnectionEventsPath)
+	}
+	if len(m.NemesisEventsPath) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.NemesisEventsPath)))
+		i += copy(dAtA[i:], m.NemesisEventsPath)
+	}
 	return i, nil
 }
 
@@ -298,6 +480,88 @@ func (m *ConfigAnalyzeMachineAllAggregatedOutput) MarshalTo(dAtA []byte) (int, e
 		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.AllAggregatedOutputPathTXT)))
 		i += copy(dAtA[i:], m.AllAggregatedOutputPathTXT)
 	}
+	if m.ClientMaxCPUPercent != 0 {
+		dAtA[i] = 0x19
+		i++
+		i = encodeFixed64ConfigAnalyzeMachine(dAtA, i, uint64(math.Float64bits(float64(m.ClientMaxCPUPercent))))
+	}
+	if len(m.MemoryUnit) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.MemoryUnit)))
+		i += copy(dAtA[i:], m.MemoryUnit)
+	}
+	if len(m.ThroughputUnit) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ThroughputUnit)))
+		i += copy(dAtA[i:], m.ThroughputUnit)
+	}
+	if len(m.NormalizeThroughput) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.NormalizeThroughput)))
+		i += copy(dAtA[i:], m.NormalizeThroughput)
+	}
+	if m.ClientSaturationCPUPercent != 0 {
+		dAtA[i] = 0x39
+		i++
+		i = encodeFixed64ConfigAnalyzeMachine(dAtA, i, uint64(math.Float64bits(float64(m.ClientSaturationCPUPercent))))
+	}
+	if m.SuspectMonitoringGapSeconds != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(m.SuspectMonitoringGapSeconds))
+	}
+	if m.SuspectCPUStealPercent != 0 {
+		dAtA[i] = 0x49
+		i++
+		i = encodeFixed64ConfigAnalyzeMachine(dAtA, i, uint64(math.Float64bits(float64(m.SuspectCPUStealPercent))))
+	}
+	if m.SuspectClockDriftMs != 0 {
+		dAtA[i] = 0x51
+		i++
+		i = encodeFixed64ConfigAnalyzeMachine(dAtA, i, uint64(math.Float64bits(float64(m.SuspectClockDriftMs))))
+	}
+	if len(m.TimeAxis) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.TimeAxis)))
+		i += copy(dAtA[i:], m.TimeAxis)
+	}
+	if len(m.TimeZone) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.TimeZone)))
+		i += copy(dAtA[i:], m.TimeZone)
+	}
+	if len(m.TimeFormat) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.TimeFormat)))
+		i += copy(dAtA[i:], m.TimeFormat)
+	}
+	if len(m.DerivedColumns) > 0 {
+		for _, s := range m.DerivedColumns {
+			dAtA[i] = 0x72
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NormalizeClusterSize) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.NormalizeClusterSize)))
+		i += copy(dAtA[i:], m.NormalizeClusterSize)
+	}
 	return i, nil
 }
 
@@ -355,6 +619,32 @@ func (m *ConfigAnalyzeMachinePlot) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.Band) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.Band)))
+		i += copy(dAtA[i:], m.Band)
+	}
+	if m.ConnectionEvents {
+		dAtA[i] = 0x38
+		i++
+		if m.ConnectionEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.NemesisEvents {
+		dAtA[i] = 0x40
+		i++
+		if m.NemesisEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -430,6 +720,17 @@ func (m *ConfigAnalyzeMachineREADME) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func encodeFixed64ConfigAnalyzeMachine(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
 func encodeVarintConfigAnalyzeMachine(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -508,6 +809,40 @@ func (m *ConfigAnalyzeMachineInitial) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
 	}
+	l = len(m.RunMetadataPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	if m.ServerMachinePricePerHour != 0 {
+		n += 10
+	}
+	if m.ClientMachinePricePerHour != 0 {
+		n += 10
+	}
+	if len(m.RepetitionAllAggregatedPathList) > 0 {
+		for _, s := range m.RepetitionAllAggregatedPathList {
+			l = len(s)
+			n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+		}
+	}
+	l = len(m.ClientLatencyHistogramLogPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	if len(m.ServerSystemMetricsPathList) > 0 {
+		for _, s := range m.ServerSystemMetricsPathList {
+			l = len(s)
+			n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+		}
+	}
+	l = len(m.ClientConnectionEventsPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.NemesisEventsPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
 	return n
 }
 
@@ -522,6 +857,55 @@ func (m *ConfigAnalyzeMachineAllAggregatedOutput) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
 	}
+	if m.ClientMaxCPUPercent != 0 {
+		n += 9
+	}
+	l = len(m.MemoryUnit)
+	if l > 0 {
+		n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.ThroughputUnit)
+	if l > 0 {
+		n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.NormalizeThroughput)
+	if l > 0 {
+		n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	if m.ClientSaturationCPUPercent != 0 {
+		n += 9
+	}
+	if m.SuspectMonitoringGapSeconds != 0 {
+		n += 1 + sovConfigAnalyzeMachine(uint64(m.SuspectMonitoringGapSeconds))
+	}
+	if m.SuspectCPUStealPercent != 0 {
+		n += 9
+	}
+	if m.SuspectClockDriftMs != 0 {
+		n += 9
+	}
+	l = len(m.TimeAxis)
+	if l > 0 {
+		n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.TimeFormat)
+	if l > 0 {
+		n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	if len(m.DerivedColumns) > 0 {
+		for _, s := range m.DerivedColumns {
+			l = len(s)
+			n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
+		}
+	}
+	l = len(m.NormalizeClusterSize)
+	if l > 0 {
+		n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
 	return n
 }
 
@@ -550,6 +934,16 @@ func (m *ConfigAnalyzeMachinePlot) Size() (n int) {
 			n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
 		}
 	}
+	l = len(m.Band)
+	if l > 0 {
+		n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	if m.ConnectionEvents {
+		n += 2
+	}
+	if m.NemesisEvents {
+		n += 2
+	}
 	return n
 }
 
@@ -1093,59 +1487,74 @@ func (m *ConfigAnalyzeMachineInitial) Unmarshal(dAtA []byte) error {
 			}
 			m.AllAggregatedOutputPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipConfigAnalyzeMachine(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunMetadataPath", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthConfigAnalyzeMachine
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConfigAnalyzeMachineAllAggregatedOutput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowConfigAnalyzeMachine
+			m.RunMetadataPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerMachinePricePerHour", wireType)
 			}
-			if iNdEx >= l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.ServerMachinePricePerHour = float64(math.Float64frombits(v))
+		case 19:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientMachinePricePerHour", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigAnalyzeMachineAllAggregatedOutput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigAnalyzeMachineAllAggregatedOutput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.ClientMachinePricePerHour = float64(math.Float64frombits(v))
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllAggregatedOutputPathCSV", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepetitionAllAggregatedPathList", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1170,11 +1579,11 @@ func (m *ConfigAnalyzeMachineAllAggregatedOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllAggregatedOutputPathCSV = string(dAtA[iNdEx:postIndex])
+			m.RepetitionAllAggregatedPathList = append(m.RepetitionAllAggregatedPathList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllAggregatedOutputPathTXT", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyHistogramLogPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1199,29 +1608,547 @@ func (m *ConfigAnalyzeMachineAllAggregatedOutput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllAggregatedOutputPathTXT = string(dAtA[iNdEx:postIndex])
+			m.ClientLatencyHistogramLogPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipConfigAnalyzeMachine(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthConfigAnalyzeMachine
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSystemMetricsPathList", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerSystemMetricsPathList = append(m.ServerSystemMetricsPathList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientConnectionEventsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientConnectionEventsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NemesisEventsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NemesisEventsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigAnalyzeMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigAnalyzeMachineAllAggregatedOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigAnalyzeMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigAnalyzeMachineAllAggregatedOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigAnalyzeMachineAllAggregatedOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllAggregatedOutputPathCSV", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllAggregatedOutputPathCSV = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllAggregatedOutputPathTXT", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllAggregatedOutputPathTXT = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientMaxCPUPercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.ClientMaxCPUPercent = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryUnit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemoryUnit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThroughputUnit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThroughputUnit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeThroughput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizeThroughput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSaturationCPUPercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.ClientSaturationCPUPercent = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspectMonitoringGapSeconds", wireType)
+			}
+			m.SuspectMonitoringGapSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspectMonitoringGapSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspectCPUStealPercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.SuspectCPUStealPercent = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspectClockDriftMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.SuspectClockDriftMs = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeAxis", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeAxis = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedColumns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedColumns = append(m.DerivedColumns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeClusterSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizeClusterSize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigAnalyzeMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ConfigAnalyzeMachinePlot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1396,6 +2323,75 @@ func (m *ConfigAnalyzeMachinePlot) Unmarshal(dAtA []byte) error {
 			}
 			m.OutputPathList = append(m.OutputPathList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Band", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Band = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConnectionEvents = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NemesisEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NemesisEvents = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigAnalyzeMachine(dAtA[iNdEx:])
@@ -1774,68 +2770,108 @@ func init() {
 }
 
 var fileDescriptorConfigAnalyzeMachine = []byte{
-	// 999 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6e, 0xdc, 0x44,
-	0x18, 0xaf, 0x9b, 0x26, 0x90, 0x49, 0xd3, 0x96, 0x01, 0xb5, 0x4b, 0x82, 0xd6, 0xc1, 0x69, 0x48,
-	0xaa, 0x42, 0x52, 0x12, 0x28, 0x12, 0x27, 0x76, 0xb3, 0x3d, 0x44, 0x34, 0x10, 0x39, 0x0b, 0x84,
-	0xd3, 0x68, 0xec, 0x9d, 0x78, 0x47, 0xf1, 0x3f, 0x79, 0xc6, 0x65, 0x0d, 0x57, 0x24, 0x24, 0x24,
-	0x24, 0xb8, 0x71, 0xe2, 0xc8, 0xb3, 0xf4, 0xc8, 0x13, 0x18, 0x08, 0x6f, 0xe0, 0x17, 0xa0, 0x9a,
-	0x6f, 0x9c, 0xc4, 0xbb, 0xf1, 0xfe, 0xb9, 0xad, 0xe7, 0xfb, 0xfd, 0xfb, 0x3e, 0xcf, 0x8e, 0x07,
-	0x6d, 0xf6, 0x1c, 0xc9, 0x84, 0x64, 0x49, 0xec, 0xec, 0xb8, 0x51, 0x78, 0xca, 0x3d, 0x42, 0x43,
-	0xea, 0x67, 0xdf, 0x33, 0x12, 0x50, 0xb7, 0xcf, 0x43, 0xb6, 0x1d, 0x27, 0x91, 0x8c, 0x30, 0xba,
-	0x02, 0xae, 0x7c, 0xe0, 0x71, 0xd9, 0x4f, 0x9d, 0x6d, 0x37, 0x0a, 0x76, 0xbc, 0xc8, 0x8b, 0x76,
-	0x00, 0xe2, 0xa4, 0xa7, 0xf0, 0x04, 0x0f, 0xf0, 0x4b, 0x53, 0xad, 0xbf, 0x97, 0xd1, 0xea, 0x3e,
-	0x68, 0xb7, 0xb4, 0xf4, 0xa1, 0x56, 0x3e, 0x08, 0xb9, 0xe4, 0xd4, 0xc7, 0x4d, 0x84, 0x3a, 0x54,
-	0x52, 0x87, 0x0a, 0x76, 0xd0, 0x69, 0x18, 0x6b, 0xc6, 0xd6, 0xa2, 0x5d, 0x59, 0xc1, 0x6b, 0x68,
-	0xe9, 0xe2, 0xa9, 0x4b, 0xbd, 0xc6, 0x4d, 0x00, 0x54, 0x97, 0xf0, 0x13, 0xf4, 0xe6, 0xc5, 0x63,
-	0x87, 0x09, 0x37, 0xe1, 0xb1, 0xe4, 0x51, 0xd8, 0x98, 0x03, 0x64, 0x5d, 0x09, 0x3f, 0x45, 0xe8,
-	0x88, 0xca, 0xfe, 0x51, 0xc2, 0x4e, 0xf9, 0xa0, 0x71, 0x4b, 0x01, 0xdb, 0xf7, 0x8b, 0xdc, 0xc4,
-	0x19, 0x0d, 0xfc, 0x4f, 0xad, 0x98, 0xca, 0x3e, 0x89, 0xa1, 0x68, 0xd9, 0x15, 0x24, 0xfe, 0xd1,
-	0x40, 0xeb, 0xfb, 0x3e, 0x67, 0xa1, 0x3c, 0xce, 0x84, 0x64, 0xc1, 0x21, 0x93, 0x09, 0x77, 0xc5,
-	0x41, 0xa8, 0x26, 0x13, 0xf9, 0x54, 0xb2, 0x9e, 0x42, 0x37, 0xe6, 0x41, 0x71, 0xb7, 0xc8, 0xcd,
-	0x6d, 0xad, 0xe8, 0x02, 0x89, 0x08, 0x60, 0x91, 0x40, 0xd3, 0x08, 0xaf, 0xf0, 0x88, 0x32, 0xb5,
-	0xec, 0x59, 0xe4, 0xf1, 0xcf, 0x06, 0xda, 0xd0, 0xb8, 0xe7, 0x54, 0xb2, 0xd0, 0xcd, 0xba, 0xfd,
-	0x24, 0x4a, 0xbd, 0x7e, 0x9c, 0xca, 0x2e, 0x0f, 0x98, 0x60, 0x09, 0x67, 0x02, 0x82, 0x2c, 0x40,
-	0x90, 0x8f, 0x8a, 0xdc, 0x7c, 0x32, 0x14, 0xc4, 0xd7, 0x3c, 0x22, 0x2f, 0x89, 0x44, 0x5e, 0x32,
-	0xcb, 0x28, 0xb3, 0x59, 0xe0, 0x1f, 0xd0, 0xda, 0x10, 0xb0, 0xc3, 0x85, 0x4c, 0xb8, 0x93, 0xaa,
-	0x41, 0xb7, 0x7c, 0x1f, 0x62, 0xbc, 0x06, 0x31, 0x76, 0x8a, 0xdc, 0x7c, 0x5c, 0x1b, 0xa3, 0x57,
-	0xe1, 0x10, 0xea, 0xfb, 0x65, 0x82, 0xa9, 0xc2, 0xf8, 0x57, 0x03, 0x6d, 0x8e, 0x05, 0x1d, 0xb1,
-	0xc4, 0x65, 0xa1, 0xe4, 0x3e, 0x83, 0x10, 0xaf, 0x43, 0x88, 0xa7, 0x45, 0x6e, 0xee, 0x4e, 0x0f,
-	0x11, 0x5f, 0x72, 0xcb, 0x2c, 0xb3, 0xda, 0xe0, 0x9f, 0x0c, 0xf4, 0x70, 0x2c, 0xf6, 0x38, 0x0d,
-	0x02, 0x9a, 0x64, 0x90, 0x67, 0x11, 0xf2, 0xec, 0x15, 0xb9, 0xb9, 0x33, 0x3d, 0x8f, 0xd0, 0xc4,
-	0x32, 0xcc, 0x4c, 0x06, 0x38, 0x46, 0xef, 0x0c, 0xe1, 0xda, 0xd9, 0xe7, 0x2c, 0xfb, 0x22, 0x0d,
-	0x1c, 0x96, 0x40, 0x00, 0x04, 0x01, 0xde, 0x2f, 0x72, 0x73, 0xab, 0x36, 0x80, 0x93, 0x91, 0x33,
-	0x96, 0x91, 0x10, 0x18, 0xa5, 0xf3, 0x44, 0x45, 0x9c, 0x21, 0xf3, 0x98, 0x25, 0x2f, 0x58, 0xd2,
-	0xe1, 0xe2, 0xec, 0x38, 0xa6, 0x2e, 0xfb, 0x4a, 0x50, 0x8f, 0x55, 0xbb, 0x5e, 0x1a, 0xdd, 0x0a,
-	0x02, 0x08, 0xaa, 0xdb, 0x33, 0x22, 0x14, 0x85, 0xa4, 0x8a, 0x33, 0xd2, 0xf1, 0x34, 0x5d, 0x1c,
-	0xa0, 0x55, 0x0d, 0x39, 0x64, 0x41, 0x94, 0x5c, 0xeb, 0xf5, 0x36, 0xd8, 0x3e, 0x2e, 0x72, 0x73,
-	0x73, 0xc8, 0x36, 0x00, 0x74, 0x6d, 0xab, 0x93, 0xf4, 0xd4, 0x5b, 0x5e, 0xd7, 0x75, 0x9b, 0xd1,
-	0x5e, 0x3b, 0x93, 0x4c, 0x74, 0x98, 0x2f, 0xe9, 0xa8, 0xef, 0x32, 0xf8, 0x7e, 0x5c, 0xe4, 0xe6,
-	0x87, 0x43, 0xbe, 0x09, 0xa3, 0x3d, 0xe2, 0x28, 0x1a, 0xe9, 0x29, 0x5e, 0x6d, 0x82, 0x59, 0x1c,
-	0xd4, 0x61, 0xf0, 0x50, 0xe3, 0xbe, 0x49, 0xb8, 0x64, 0xe3, 0xa3, 0xdc, 0x19, 0xdd, 0xff, 0x65,
-	0x94, 0xef, 0x14, 0x6d, 0x6a, 0x96, 0x99, 0x3c, 0xf0, 0x6f, 0x06, 0xda, 0xd4, 0xc0, 0x89, 0x27,
-	0xd8, 0x73, 0x2e, 0x64, 0xe3, 0xee, 0xda, 0xdc, 0xd6, 0x62, 0xfb, 0x93, 0x22, 0x37, 0xf7, 0x86,
-	0xf2, 0x4c, 0x3b, 0x24, 0x89, 0xcf, 0x85, 0xb4, 0xec, 0x59, 0x7d, 0x30, 0x41, 0x0f, 0x5a, 0xbe,
-	0xdf, 0xf2, 0xbc, 0x84, 0x79, 0xaa, 0xf0, 0x65, 0x2a, 0xe3, 0x54, 0xc2, 0x48, 0xee, 0xc1, 0x48,
-	0x36, 0x8a, 0xdc, 0x7c, 0x57, 0x47, 0x50, 0x67, 0x0f, 0xbd, 0x44, 0x92, 0x08, 0xa0, 0xe5, 0x04,
-	0xc6, 0xa9, 0x58, 0xff, 0xab, 0x43, 0xa8, 0xe6, 0x0b, 0x57, 0x83, 0xc7, 0x1c, 0xad, 0x8c, 0x91,
-	0xd9, 0x3f, 0xfe, 0x5a, 0x7f, 0xfd, 0xda, 0x8f, 0x8a, 0xdc, 0xdc, 0x98, 0x96, 0x87, 0xb8, 0xe2,
-	0x85, 0x65, 0x4f, 0x10, 0x9b, 0x60, 0xd5, 0x3d, 0xe9, 0xea, 0xef, 0xe8, 0x8c, 0x56, 0x72, 0x20,
-	0xc7, 0x5b, 0x75, 0x4f, 0xba, 0xd6, 0x1f, 0x37, 0x51, 0xa3, 0x6e, 0x02, 0x47, 0x7e, 0x24, 0xf1,
-	0x23, 0xb4, 0xb0, 0x1f, 0xf9, 0x69, 0x10, 0x96, 0xed, 0xbd, 0x51, 0xe4, 0xe6, 0x72, 0x79, 0xe0,
-	0xc0, 0xba, 0x65, 0x97, 0x00, 0xbc, 0x89, 0xe6, 0x4f, 0x5a, 0x03, 0x2e, 0xca, 0x74, 0x15, 0xe4,
-	0x80, 0xd0, 0x01, 0x17, 0x96, 0xad, 0xeb, 0x0a, 0xf8, 0x2d, 0x00, 0xe7, 0x46, 0x81, 0xd9, 0x05,
-	0x10, 0xea, 0xf8, 0x33, 0xb4, 0x3c, 0x3c, 0x62, 0xfd, 0xb1, 0x5f, 0x29, 0x72, 0xf3, 0xbe, 0x26,
-	0x5c, 0x9b, 0xe9, 0x30, 0x01, 0xef, 0xa3, 0x3b, 0x57, 0x0b, 0xb0, 0x71, 0xe7, 0x61, 0xe3, 0xae,
-	0x16, 0xb9, 0xf9, 0xe0, 0xba, 0x84, 0xde, 0x9c, 0x23, 0x14, 0xeb, 0x17, 0x03, 0xbd, 0x5d, 0x7b,
-	0x09, 0x0a, 0xa8, 0xc7, 0xf0, 0x7b, 0x68, 0xbe, 0xcb, 0xa5, 0xcf, 0xca, 0x01, 0xdd, 0x2b, 0x72,
-	0xf3, 0xb6, 0x56, 0x96, 0x6a, 0xd9, 0xb2, 0x75, 0x19, 0xaf, 0xa3, 0x5b, 0xb0, 0x6d, 0xf5, 0x74,
-	0xee, 0x16, 0xb9, 0xb9, 0x74, 0x75, 0x61, 0xb1, 0x6c, 0x28, 0x2a, 0x50, 0x37, 0x8b, 0x59, 0x39,
-	0x99, 0x0a, 0x48, 0x66, 0x31, 0xb3, 0x6c, 0x28, 0x5a, 0x7f, 0x1a, 0x68, 0xa5, 0x2e, 0x8f, 0xfd,
-	0xac, 0xd5, 0x39, 0x7c, 0xa6, 0xee, 0x47, 0x95, 0x7f, 0x89, 0x31, 0x7a, 0x3f, 0x1a, 0xfa, 0x5b,
-	0x54, 0x90, 0xf8, 0x08, 0x2d, 0x40, 0x47, 0xea, 0x05, 0xce, 0x6d, 0x2d, 0xed, 0x6e, 0x6c, 0x5f,
-	0xdd, 0x1b, 0xb7, 0xc7, 0xf6, 0x5f, 0x7d, 0x7d, 0x1c, 0xe8, 0x96, 0x5d, 0xea, 0xb4, 0xdf, 0x7a,
-	0xf9, 0x6f, 0xf3, 0xc6, 0xcb, 0xf3, 0xa6, 0xf1, 0xd7, 0x79, 0xd3, 0xf8, 0xe7, 0xbc, 0x69, 0xfc,
-	0xfe, 0x5f, 0xf3, 0x86, 0xb3, 0x00, 0x57, 0xcb, 0xbd, 0x57, 0x01, 0x00, 0x00, 0xff, 0xff, 0x47,
-	0x8f, 0xbe, 0x24, 0xc0, 0x0a, 0x00, 0x00,
+	// 1644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xdd, 0x6e, 0x23, 0x49,
+	0x15, 0xde, 0x1e, 0xcf, 0xcc, 0x26, 0x95, 0xc9, 0xcf, 0x54, 0xb2, 0x99, 0x9e, 0x64, 0x37, 0x9d,
+	0xed, 0x49, 0x36, 0xd9, 0x5d, 0x48, 0x86, 0x19, 0x58, 0x24, 0x6e, 0x20, 0xb6, 0x67, 0x99, 0x11,
+	0xc9, 0x60, 0xda, 0x0e, 0x0c, 0x08, 0xa9, 0x54, 0x6e, 0x57, 0xda, 0xa5, 0xf4, 0x9f, 0xba, 0xaa,
+	0x83, 0x1d, 0x6e, 0x91, 0x90, 0x40, 0x48, 0x70, 0xc7, 0x13, 0xf0, 0x2c, 0x7b, 0x85, 0x78, 0x82,
+	0x16, 0x0c, 0x3c, 0x41, 0x3f, 0x01, 0xaa, 0xaa, 0xb6, 0xdd, 0xdd, 0x6e, 0xdb, 0xd9, 0xbb, 0xd8,
+	0xe7, 0xfb, 0xbe, 0xf3, 0x53, 0xa7, 0x4e, 0x9d, 0x18, 0x1c, 0xf5, 0xba, 0x9c, 0x30, 0x4e, 0xa2,
+	0xb0, 0x7b, 0x6a, 0x07, 0xfe, 0x15, 0x75, 0x10, 0xf6, 0xb1, 0x3b, 0xbc, 0x25, 0xc8, 0xc3, 0x76,
+	0x9f, 0xfa, 0xe4, 0x24, 0x8c, 0x02, 0x1e, 0x40, 0x30, 0x01, 0xee, 0x7c, 0xd7, 0xa1, 0xbc, 0x1f,
+	0x77, 0x4f, 0xec, 0xc0, 0x3b, 0x75, 0x02, 0x27, 0x38, 0x95, 0x90, 0x6e, 0x7c, 0x25, 0x3f, 0xc9,
+	0x0f, 0xf2, 0x2f, 0x45, 0x35, 0xff, 0xbc, 0x05, 0x76, 0x1b, 0x52, 0xfb, 0x4c, 0x49, 0x5f, 0x28,
+	0xe5, 0x37, 0x3e, 0xe5, 0x14, 0xbb, 0x70, 0x0f, 0x80, 0x26, 0xe6, 0xb8, 0x8b, 0x19, 0x79, 0xd3,
+	0xd4, 0xb5, 0x7d, 0xed, 0x78, 0xd9, 0xca, 0x7d, 0x03, 0xf7, 0xc1, 0xca, 0xe8, 0x53, 0x07, 0x3b,
+	0xfa, 0x3d, 0x09, 0xc8, 0x7f, 0x05, 0x9f, 0x83, 0xcd, 0xd1, 0xc7, 0x26, 0x61, 0x76, 0x44, 0x43,
+	0x4e, 0x03, 0x5f, 0xaf, 0x49, 0x64, 0x95, 0x09, 0x7e, 0x05, 0x40, 0x0b, 0xf3, 0x7e, 0x2b, 0x22,
+	0x57, 0x74, 0xa0, 0xdf, 0x17, 0xc0, 0xfa, 0x76, 0x9a, 0x18, 0x70, 0x88, 0x3d, 0xf7, 0x47, 0x66,
+	0x88, 0x79, 0x1f, 0x85, 0xd2, 0x68, 0x5a, 0x39, 0x24, 0xfc, 0x83, 0x06, 0x9e, 0x35, 0x5c, 0x4a,
+	0x7c, 0xde, 0x1e, 0x32, 0x4e, 0xbc, 0x0b, 0xc2, 0x23, 0x6a, 0xb3, 0x37, 0xbe, 0xa8, 0x4c, 0xe0,
+	0x62, 0x4e, 0x7a, 0x02, 0xad, 0x3f, 0x90, 0x8a, 0x2f, 0xd2, 0xc4, 0x38, 0x51, 0x8a, 0xb6, 0x24,
+	0x21, 0x26, 0x59, 0xc8, 0x53, 0x34, 0x44, 0x73, 0x3c, 0x24, 0x9c, 0x9a, 0xd6, 0x5d, 0xe4, 0xe1,
+	0x9f, 0x34, 0x70, 0xa8, 0x70, 0xe7, 0x98, 0x13, 0xdf, 0x1e, 0x76, 0xfa, 0x51, 0x10, 0x3b, 0xfd,
+	0x30, 0xe6, 0x1d, 0xea, 0x11, 0x46, 0x22, 0x4a, 0x98, 0x0c, 0xe4, 0xa1, 0x0c, 0xe4, 0xfb, 0x69,
+	0x62, 0x3c, 0x2f, 0x04, 0xe2, 0x2a, 0x1e, 0xe2, 0x63, 0x22, 0xe2, 0x63, 0x66, 0x16, 0xca, 0xdd,
+	0x5c, 0xc0, 0xdf, 0x83, 0xfd, 0x02, 0xb0, 0x49, 0x19, 0x8f, 0x68, 0x37, 0x16, 0x85, 0x3e, 0x73,
+	0x5d, 0x19, 0xc6, 0x87, 0x32, 0x8c, 0xd3, 0x34, 0x31, 0xbe, 0xac, 0x0c, 0xa3, 0x97, 0xe3, 0x20,
+	0xec, 0xba, 0x59, 0x04, 0x0b, 0x85, 0xe1, 0x5f, 0x35, 0x70, 0x34, 0x13, 0xd4, 0x22, 0x91, 0x4d,
+	0x7c, 0x4e, 0x5d, 0x22, 0x83, 0x58, 0x92, 0x41, 0x7c, 0x95, 0x26, 0xc6, 0x8b, 0xc5, 0x41, 0x84,
+	0x63, 0x6e, 0x16, 0xcb, 0x5d, 0xdd, 0xc0, 0x3f, 0x6a, 0xe0, 0x60, 0x26, 0xb6, 0x1d, 0x7b, 0x1e,
+	0x8e, 0x86, 0x32, 0x9e, 0x65, 0x19, 0xcf, 0xcb, 0x34, 0x31, 0x4e, 0x17, 0xc7, 0xc3, 0x14, 0x31,
+	0x0b, 0xe6, 0x4e, 0x0e, 0x60, 0x08, 0x3e, 0x2e, 0xe0, 0xea, 0xc3, 0x9f, 0x91, 0xe1, 0xdb, 0xd8,
+	0xeb, 0x92, 0x48, 0x06, 0x00, 0x64, 0x00, 0xdf, 0x49, 0x13, 0xe3, 0xb8, 0x32, 0x80, 0xee, 0x10,
+	0x5d, 0x93, 0x21, 0xf2, 0x25, 0x23, 0xf3, 0x3c, 0x57, 0x11, 0x0e, 0x81, 0xd1, 0x26, 0xd1, 0x0d,
+	0x89, 0x9a, 0x94, 0x5d, 0xb7, 0x43, 0x6c, 0x93, 0x4b, 0x86, 0x1d, 0x92, 0xcf, 0x7a, 0xa5, 0xdc,
+	0x0a, 0x4c, 0x12, 0x44, 0xb6, 0xd7, 0x88, 0x09, 0x0a, 0x8a, 0x05, 0xa7, 0x94, 0xf1, 0x22, 0x5d,
+	0xe8, 0x81, 0x5d, 0x05, 0xb9, 0x20, 0x5e, 0x10, 0x4d, 0xe5, 0xfa, 0x48, 0xba, 0xfd, 0x32, 0x4d,
+	0x8c, 0xa3, 0x82, 0x5b, 0x4f, 0xa2, 0x2b, 0x53, 0x9d, 0xa7, 0x27, 0x4e, 0xf9, 0x99, 0xb2, 0x5b,
+	0x04, 0xf7, 0xea, 0x43, 0x4e, 0x58, 0x93, 0xb8, 0x1c, 0x97, 0xfd, 0xae, 0x4a, 0xbf, 0x3f, 0x48,
+	0x13, 0xe3, 0x7b, 0x05, 0xbf, 0x11, 0xc1, 0x3d, 0xd4, 0x15, 0x34, 0xd4, 0x13, 0xbc, 0xca, 0x08,
+	0xee, 0xe2, 0x41, 0x0c, 0x83, 0x03, 0x85, 0xfb, 0x55, 0x44, 0x39, 0x99, 0x1d, 0xca, 0x5a, 0xb9,
+	0xff, 0xb3, 0x50, 0x7e, 0x27, 0x68, 0x0b, 0x63, 0xb9, 0x93, 0x0f, 0xf8, 0x37, 0x0d, 0x1c, 0x29,
+	0xe0, 0xdc, 0x09, 0x76, 0x4e, 0x19, 0xd7, 0xd7, 0xf7, 0x6b, 0xc7, 0xcb, 0xf5, 0x1f, 0xa6, 0x89,
+	0xf1, 0xb2, 0x10, 0xcf, 0xa2, 0x21, 0x89, 0x5c, 0xca, 0xb8, 0x69, 0xdd, 0xd5, 0x0f, 0x44, 0xe0,
+	0xc9, 0x99, 0xeb, 0x9e, 0x39, 0x4e, 0x44, 0x1c, 0x61, 0xf8, 0x79, 0xcc, 0xc3, 0x98, 0xcb, 0x92,
+	0x6c, 0xc8, 0x92, 0x1c, 0xa6, 0x89, 0xf1, 0xa9, 0x0a, 0x41, 0xcc, 0x1e, 0x3c, 0x46, 0xa2, 0x40,
+	0x42, 0xb3, 0x0a, 0xcc, 0x52, 0x81, 0x5f, 0x83, 0x75, 0x2b, 0xf6, 0x2f, 0x08, 0xc7, 0x3d, 0xcc,
+	0xb1, 0x14, 0x7e, 0x2c, 0x85, 0x3f, 0x4e, 0x13, 0x43, 0x57, 0xc2, 0x51, 0xec, 0x23, 0x2f, 0x43,
+	0x64, 0x7a, 0x65, 0x12, 0xbc, 0x02, 0x4f, 0xb3, 0x96, 0x53, 0x2f, 0x64, 0x2b, 0xa2, 0x36, 0x69,
+	0x91, 0xe8, 0x75, 0x10, 0x47, 0x3a, 0xdc, 0xd7, 0x8e, 0xb5, 0xfa, 0x71, 0x9a, 0x18, 0x07, 0xc5,
+	0x06, 0x56, 0x58, 0x14, 0x0a, 0xb0, 0x18, 0x5b, 0xa8, 0x1f, 0xc4, 0x91, 0x69, 0xcd, 0x96, 0x12,
+	0x7e, 0xd4, 0x2d, 0xae, 0xf2, 0xb3, 0x59, 0xf6, 0x93, 0x0d, 0x85, 0x99, 0x7e, 0x66, 0x4a, 0xc1,
+	0x01, 0x30, 0x2c, 0x12, 0x12, 0x4e, 0xb3, 0x89, 0x3d, 0x29, 0xde, 0xb8, 0x07, 0xb6, 0x64, 0x0f,
+	0x9c, 0xa4, 0x89, 0xf1, 0x45, 0x56, 0xa7, 0x31, 0x01, 0x95, 0xce, 0x22, 0x77, 0xf4, 0x8b, 0x64,
+	0x61, 0x04, 0x3e, 0x29, 0xcc, 0xa9, 0xd7, 0x94, 0xf1, 0xc0, 0x89, 0xb0, 0x77, 0x1e, 0x38, 0xf2,
+	0x7c, 0x3e, 0x5a, 0x30, 0xfa, 0xfa, 0x23, 0x02, 0x72, 0x03, 0x27, 0x3b, 0xaf, 0xf9, 0x92, 0xd0,
+	0x05, 0xbb, 0x15, 0x1d, 0x39, 0xce, 0x74, 0x5b, 0x66, 0xfa, 0x45, 0x9a, 0x18, 0x9f, 0xcd, 0xeb,
+	0xf6, 0x5c, 0x96, 0xf3, 0xe4, 0x60, 0x1f, 0xec, 0xa8, 0x70, 0x1a, 0x81, 0xef, 0x13, 0x5b, 0x94,
+	0xe2, 0xd5, 0x0d, 0xf1, 0xb9, 0x7a, 0xf6, 0x9f, 0xc8, 0xf4, 0xa6, 0x0f, 0xd1, 0x1e, 0x83, 0x11,
+	0x91, 0xe8, 0x2c, 0xb5, 0x39, 0x5a, 0xf0, 0x1c, 0x3c, 0x7e, 0x4b, 0x3c, 0xc2, 0x28, 0xcb, 0x39,
+	0xd0, 0xa5, 0x83, 0xbd, 0x34, 0x31, 0x76, 0x94, 0x03, 0x5f, 0x41, 0x8a, 0xb2, 0xd3, 0x44, 0xf3,
+	0x7f, 0xcb, 0xe0, 0xa8, 0x6a, 0x1b, 0xac, 0xb8, 0x5b, 0x90, 0x82, 0x9d, 0x19, 0x57, 0xae, 0xd1,
+	0xfe, 0xa5, 0xda, 0x14, 0xeb, 0x9f, 0xa7, 0x89, 0x71, 0xb8, 0xe8, 0xee, 0x22, 0x9b, 0xdd, 0x98,
+	0xd6, 0x1c, 0xb1, 0x39, 0xae, 0x3a, 0xef, 0x3a, 0xfa, 0xbd, 0x6f, 0xe1, 0x8a, 0x0f, 0xf8, 0x6c,
+	0x57, 0x9d, 0x77, 0x1d, 0xd8, 0x06, 0x9b, 0xa3, 0x2b, 0x33, 0x68, 0xb4, 0x2e, 0xb3, 0xed, 0x41,
+	0x6e, 0xab, 0x5a, 0xfd, 0xd3, 0x34, 0x31, 0x3e, 0x29, 0xdd, 0xbb, 0x01, 0xb2, 0xc3, 0x78, 0xb4,
+	0x90, 0x98, 0x56, 0x15, 0x5b, 0x2c, 0xb4, 0xea, 0x9d, 0xba, 0xf4, 0x29, 0x9f, 0x5e, 0x68, 0xb3,
+	0x57, 0x2e, 0xf6, 0x29, 0x37, 0xad, 0x1c, 0x12, 0xd6, 0xc1, 0xda, 0x64, 0xb1, 0x93, 0x5c, 0xb5,
+	0xba, 0xee, 0xa4, 0x89, 0xb1, 0xad, 0xb8, 0xb9, 0x15, 0x51, 0xf1, 0x4b, 0x0c, 0xf8, 0x0b, 0xb0,
+	0xf9, 0x36, 0x88, 0x3c, 0xec, 0xd2, 0x5b, 0x32, 0x31, 0x65, 0xab, 0xa7, 0x91, 0x26, 0xc6, 0x6e,
+	0xd6, 0x22, 0x23, 0x50, 0x6e, 0xeb, 0x34, 0xad, 0x2a, 0xee, 0xa4, 0xbb, 0xdb, 0x98, 0xc7, 0x11,
+	0x16, 0x1d, 0x99, 0x2b, 0xd5, 0x87, 0x33, 0x46, 0x14, 0x1b, 0x83, 0x8b, 0x15, 0x9b, 0xa3, 0x05,
+	0xaf, 0xc1, 0x6e, 0x3b, 0x66, 0x21, 0xb1, 0xf9, 0x45, 0xe0, 0x53, 0x1e, 0x44, 0xd4, 0x77, 0x7e,
+	0x8a, 0xc3, 0x36, 0xb1, 0x03, 0xbf, 0xc7, 0xe4, 0xce, 0x58, 0xcb, 0x9f, 0x3c, 0x53, 0x60, 0xe4,
+	0x8d, 0xd1, 0xc8, 0xc1, 0x21, 0x62, 0x0a, 0x2f, 0x2e, 0xed, 0x6c, 0x35, 0xf8, 0x5b, 0xb0, 0x9d,
+	0x99, 0x1b, 0xad, 0xcb, 0x36, 0x27, 0xd8, 0x1d, 0xa5, 0xb4, 0x2c, 0x53, 0x3a, 0x48, 0x13, 0x63,
+	0xbf, 0xe8, 0x47, 0x24, 0xc2, 0x04, 0x72, 0x92, 0xce, 0x0c, 0x0d, 0xd1, 0x58, 0x23, 0x8b, 0x1b,
+	0xd8, 0xd7, 0xcd, 0x88, 0x5e, 0xf1, 0x0b, 0xa6, 0x83, 0x72, 0x63, 0x8d, 0xa5, 0x05, 0x0a, 0xf5,
+	0x04, 0x0c, 0x79, 0xcc, 0xb4, 0xaa, 0xd8, 0xf0, 0x39, 0x58, 0x12, 0xfb, 0xfe, 0xd9, 0x80, 0xb2,
+	0x6c, 0x75, 0xdb, 0x4a, 0x13, 0x63, 0x23, 0x6b, 0x0d, 0xea, 0x11, 0x84, 0x07, 0x94, 0x99, 0xd6,
+	0x18, 0x35, 0x62, 0xfc, 0x26, 0xf0, 0x89, 0xfe, 0xa8, 0x92, 0x71, 0x1b, 0xf8, 0xc4, 0xb4, 0xc6,
+	0x28, 0xd1, 0xbc, 0xe2, 0xef, 0xaf, 0x45, 0x23, 0x70, 0x7d, 0xb5, 0xdc, 0xbc, 0x92, 0x73, 0x25,
+	0x8d, 0xa6, 0x95, 0x43, 0x8a, 0xe6, 0x6d, 0x92, 0x88, 0xde, 0x90, 0x5e, 0x23, 0x70, 0x63, 0xcf,
+	0x67, 0xfa, 0xda, 0x7e, 0xad, 0xd8, 0xbc, 0x3d, 0x65, 0x47, 0xb6, 0x02, 0x98, 0x56, 0x89, 0x01,
+	0x2f, 0xc1, 0xd6, 0xb8, 0x01, 0x1b, 0x6e, 0x2c, 0xfe, 0xc5, 0x6d, 0xd3, 0x5b, 0xa2, 0xaf, 0xcb,
+	0x28, 0x72, 0x55, 0x9b, 0x74, 0xaf, 0xad, 0x60, 0x88, 0xd1, 0x5b, 0x62, 0x5a, 0x95, 0x74, 0xf3,
+	0x9f, 0x35, 0xa0, 0x57, 0x8d, 0xb9, 0x96, 0x1b, 0x70, 0xf8, 0x39, 0x78, 0xa8, 0xdc, 0x67, 0x33,
+	0xec, 0x71, 0x9a, 0x18, 0xab, 0x59, 0x27, 0xcb, 0xef, 0x4d, 0x2b, 0x03, 0xc0, 0x23, 0xf0, 0xe0,
+	0x9d, 0xac, 0xfd, 0xbd, 0x32, 0x72, 0x90, 0x15, 0x5e, 0xd9, 0x05, 0xf0, 0xd7, 0x12, 0x58, 0x2b,
+	0x03, 0x87, 0x23, 0xa0, 0xb4, 0xc3, 0x9f, 0x80, 0xd5, 0xe2, 0x1c, 0xbd, 0x5f, 0xbe, 0xf0, 0x53,
+	0x83, 0xb3, 0x48, 0x80, 0x0d, 0xb0, 0x36, 0xf9, 0x42, 0xbe, 0x6d, 0x0f, 0x64, 0xd9, 0x77, 0xd3,
+	0xc4, 0x78, 0x32, 0x2d, 0xa1, 0x1e, 0xb3, 0x12, 0x05, 0x3e, 0x03, 0xf7, 0xeb, 0xd8, 0xef, 0x65,
+	0x53, 0x62, 0x3d, 0x4d, 0x8c, 0x15, 0x45, 0xed, 0x62, 0xbf, 0x67, 0x5a, 0xd2, 0x08, 0x5f, 0x83,
+	0x8d, 0xf2, 0x93, 0x24, 0x2f, 0xff, 0x52, 0x7e, 0xb3, 0x9a, 0x7a, 0xd3, 0x4c, 0x6b, 0x8a, 0x05,
+	0x7f, 0x0c, 0x56, 0x0b, 0x6f, 0x91, 0xbc, 0xd8, 0x4b, 0xf5, 0xa7, 0x69, 0x62, 0x7c, 0x54, 0xf5,
+	0x80, 0x99, 0x56, 0x11, 0x6f, 0xfe, 0x45, 0x03, 0x4f, 0x2b, 0x7f, 0xc5, 0xf0, 0xb0, 0x43, 0xe0,
+	0x67, 0xe0, 0x41, 0x87, 0x72, 0x97, 0x64, 0x07, 0xba, 0x91, 0x26, 0xc6, 0xa3, 0x51, 0xf3, 0x72,
+	0x97, 0x98, 0x96, 0x32, 0x8b, 0xac, 0xe5, 0xf3, 0x79, 0xaf, 0x9c, 0xb5, 0x7a, 0x2f, 0xa5, 0x51,
+	0x80, 0x3a, 0xc3, 0x90, 0xe8, 0xb5, 0x32, 0x88, 0x0f, 0x43, 0x62, 0x5a, 0xd2, 0x68, 0xfe, 0x43,
+	0x03, 0x3b, 0x55, 0xf1, 0x58, 0xaf, 0xce, 0x9a, 0x17, 0xaf, 0xc4, 0x95, 0xca, 0xad, 0xb9, 0x5a,
+	0xf9, 0x4a, 0x15, 0xf6, 0xda, 0x1c, 0x12, 0xb6, 0xc0, 0x43, 0x99, 0x91, 0x68, 0xb8, 0xda, 0xf1,
+	0xca, 0x8b, 0xc3, 0x93, 0xc9, 0x0f, 0x3f, 0x27, 0x33, 0xf3, 0xcf, 0xb7, 0x1b, 0x95, 0x74, 0xd3,
+	0xca, 0x74, 0xea, 0x5b, 0xdf, 0xfc, 0x67, 0xef, 0x83, 0x6f, 0xde, 0xef, 0x69, 0xff, 0x7a, 0xbf,
+	0xa7, 0xfd, 0xfb, 0xfd, 0x9e, 0xf6, 0xf7, 0xff, 0xee, 0x7d, 0xd0, 0x7d, 0x28, 0x7f, 0x1b, 0x7a,
+	0xf9, 0xff, 0x01, 0x00, 0x83, 0xeb, 0x57, 0x53, 0x81, 0x12, 0x00, 0x00,
 }
@@ -27,11 +27,67 @@ type ConfigClientMachineInitial struct {
 	ClientLatencyDistributionSummaryPath    string `protobuf:"bytes,8,opt,name=ClientLatencyDistributionSummaryPath,proto3" json:"ClientLatencyDistributionSummaryPath,omitempty" yaml:"client_latency_distribution_summary_path"`
 	ClientLatencyByKeyNumberPath            string `protobuf:"bytes,9,opt,name=ClientLatencyByKeyNumberPath,proto3" json:"ClientLatencyByKeyNumberPath,omitempty" yaml:"client_latency_by_key_number_path"`
 	ServerDiskSpaceUsageSummaryPath         string `protobuf:"bytes,10,opt,name=ServerDiskSpaceUsageSummaryPath,proto3" json:"ServerDiskSpaceUsageSummaryPath,omitempty" yaml:"server_disk_space_usage_summary_path"`
-	GoogleCloudProjectName                  string `protobuf:"bytes,100,opt,name=GoogleCloudProjectName,proto3" json:"GoogleCloudProjectName,omitempty" yaml:"google_cloud_project_name"`
-	GoogleCloudStorageKeyPath               string `protobuf:"bytes,101,opt,name=GoogleCloudStorageKeyPath,proto3" json:"GoogleCloudStorageKeyPath,omitempty" yaml:"google_cloud_storage_key_path"`
-	GoogleCloudStorageKey                   string `protobuf:"bytes,102,opt,name=GoogleCloudStorageKey,proto3" json:"GoogleCloudStorageKey,omitempty"`
-	GoogleCloudStorageBucketName            string `protobuf:"bytes,103,opt,name=GoogleCloudStorageBucketName,proto3" json:"GoogleCloudStorageBucketName,omitempty" yaml:"google_cloud_storage_bucket_name"`
-	GoogleCloudStorageSubDirectory          string `protobuf:"bytes,104,opt,name=GoogleCloudStorageSubDirectory,proto3" json:"GoogleCloudStorageSubDirectory,omitempty" yaml:"google_cloud_storage_sub_directory"`
+	ServerSystemMetricsPath                 string `protobuf:"bytes,11,opt,name=ServerSystemMetricsPath,proto3" json:"ServerSystemMetricsPath,omitempty" yaml:"server_system_metrics_path"`
+	ServerSystemMetricsInterpolatedPath     string `protobuf:"bytes,12,opt,name=ServerSystemMetricsInterpolatedPath,proto3" json:"ServerSystemMetricsInterpolatedPath,omitempty" yaml:"server_system_metrics_interpolated_path"`
+	NemesisEventsPath                       string `protobuf:"bytes,13,opt,name=NemesisEventsPath,proto3" json:"NemesisEventsPath,omitempty" yaml:"nemesis_events_path"`
+	RunMetadataPath                         string `protobuf:"bytes,14,opt,name=RunMetadataPath,proto3" json:"RunMetadataPath,omitempty" yaml:"run_metadata_path"`
+	ClientThroughputCeilingPath             string `protobuf:"bytes,15,opt,name=ClientThroughputCeilingPath,proto3" json:"ClientThroughputCeilingPath,omitempty" yaml:"client_throughput_ceiling_path"`
+	// RunID, if not empty, saves and uploads all results in the standard layout
+	// '<path_prefix>/<run_id>/<database_tag>/{client,server-N}/'.
+	// 'auto' generates a unique run ID for each run. If empty, results
+	// of a previous run at the configured paths are kept, unless '--force'.
+	RunID string `protobuf:"bytes,16,opt,name=RunID,proto3" json:"RunID,omitempty" yaml:"run_id"`
+	// ClientLatencyHistogramLogPath, if not empty, saves the full latency
+	// histogram of every second in HdrHistogram log format.
+	ClientLatencyHistogramLogPath string `protobuf:"bytes,17,opt,name=ClientLatencyHistogramLogPath,proto3" json:"ClientLatencyHistogramLogPath,omitempty" yaml:"client_latency_histogram_log_path"`
+	// Anonymize, if true, strips hostnames, IPs, and cloud project names
+	// from results and logs before upload, so they can be published.
+	Anonymize bool `protobuf:"varint,18,opt,name=Anonymize,proto3" json:"Anonymize,omitempty" yaml:"anonymize"`
+	// ClientRequestLogPath, if not empty, saves the sequence of requests
+	// in CSV, to replay with "replay" type benchmark.
+	ClientRequestLogPath string `protobuf:"bytes,19,opt,name=ClientRequestLogPath,proto3" json:"ClientRequestLogPath,omitempty" yaml:"client_request_log_path"`
+	// ClientWatchEventsPath, if not empty, saves watch event latency and
+	// compacted errors per second in "watch-compaction" type benchmark.
+	ClientWatchEventsPath string `protobuf:"bytes,20,opt,name=ClientWatchEventsPath,proto3" json:"ClientWatchEventsPath,omitempty" yaml:"client_watch_events_path"`
+	// ClientResultDatabasePath, if not empty, writes all per-second samples
+	// and summaries of the run into a single SQLite database file.
+	ClientResultDatabasePath string `protobuf:"bytes,21,opt,name=ClientResultDatabasePath,proto3" json:"ClientResultDatabasePath,omitempty" yaml:"client_result_database_path"`
+	// RunTags are 'key=value' tags of the run (e.g. 'env=gce-n1-standard-8',
+	// 'purpose=nightly'), saved in run metadata to filter and group runs.
+	RunTags []string `protobuf:"bytes,22,rep,name=RunTags" json:"RunTags,omitempty" yaml:"run_tags"`
+	// ClientLeaseExpiryPath, if not empty, saves expiry propagation latency
+	// per second in "lease-expiry" type benchmark.
+	ClientLeaseExpiryPath string `protobuf:"bytes,23,opt,name=ClientLeaseExpiryPath,proto3" json:"ClientLeaseExpiryPath,omitempty" yaml:"client_lease_expiry_path"`
+	// ClientBatchWritesPath, if not empty, saves per-batch and per-key
+	// latency and throughput of each batch size in 'batch_sizes'.
+	ClientBatchWritesPath string `protobuf:"bytes,24,opt,name=ClientBatchWritesPath,proto3" json:"ClientBatchWritesPath,omitempty" yaml:"client_batch_writes_path"`
+	// ClientRollingRestartPath, if not empty, saves the throughput dip depth
+	// and duration of each server restart in 'rolling_restart'.
+	ClientRollingRestartPath string `protobuf:"bytes,25,opt,name=ClientRollingRestartPath,proto3" json:"ClientRollingRestartPath,omitempty" yaml:"client_rolling_restart_path"`
+	// ClientRangeLatencyPath, if not empty, saves latency percentiles
+	// of range reads per number of returned keys in 'range_sizes'.
+	ClientRangeLatencyPath string `protobuf:"bytes,26,opt,name=ClientRangeLatencyPath,proto3" json:"ClientRangeLatencyPath,omitempty" yaml:"client_range_latency_path"`
+	// MonitorIntervalMs is the interval to sample system metrics of servers
+	// and the client, from 100 to 10000. Defaults to 1000. Interpolated
+	// metrics are resampled to one row per second.
+	MonitorIntervalMs int64 `protobuf:"varint,27,opt,name=MonitorIntervalMs,proto3" json:"MonitorIntervalMs,omitempty" yaml:"monitor_interval_ms"`
+	// ClientSoakRollupPath is the path to save the interval summaries of 'soak'.
+	ClientSoakRollupPath string `protobuf:"bytes,28,opt,name=ClientSoakRollupPath,proto3" json:"ClientSoakRollupPath,omitempty" yaml:"client_soak_rollup_path"`
+	// ClientLeaseExpiryAccuracyPath, if not empty, saves the distribution of
+	// key expiry deviations from the lease TTL in "lease-expiry" type benchmark.
+	ClientLeaseExpiryAccuracyPath string `protobuf:"bytes,29,opt,name=ClientLeaseExpiryAccuracyPath,proto3" json:"ClientLeaseExpiryAccuracyPath,omitempty" yaml:"client_lease_expiry_accuracy_path"`
+	// ClientRequestTimeoutsPath, if not empty, saves the error rates and
+	// effective throughput of each step of 'request_timeouts_ms'.
+	ClientRequestTimeoutsPath string `protobuf:"bytes,30,opt,name=ClientRequestTimeoutsPath,proto3" json:"ClientRequestTimeoutsPath,omitempty" yaml:"client_request_timeouts_path"`
+	// ClientConnectionEventsPath, if not empty, saves the connection events
+	// of clients (dials, disconnects, SRV re-resolves, leader redirects),
+	// to overlay on plots with 'connection_events'.
+	ClientConnectionEventsPath     string `protobuf:"bytes,31,opt,name=ClientConnectionEventsPath,proto3" json:"ClientConnectionEventsPath,omitempty" yaml:"client_connection_events_path"`
+	GoogleCloudProjectName         string `protobuf:"bytes,100,opt,name=GoogleCloudProjectName,proto3" json:"GoogleCloudProjectName,omitempty" yaml:"google_cloud_project_name"`
+	GoogleCloudStorageKeyPath      string `protobuf:"bytes,101,opt,name=GoogleCloudStorageKeyPath,proto3" json:"GoogleCloudStorageKeyPath,omitempty" yaml:"google_cloud_storage_key_path"`
+	GoogleCloudStorageKey          string `protobuf:"bytes,102,opt,name=GoogleCloudStorageKey,proto3" json:"GoogleCloudStorageKey,omitempty"`
+	GoogleCloudStorageBucketName   string `protobuf:"bytes,103,opt,name=GoogleCloudStorageBucketName,proto3" json:"GoogleCloudStorageBucketName,omitempty" yaml:"google_cloud_storage_bucket_name"`
+	GoogleCloudStorageSubDirectory string `protobuf:"bytes,104,opt,name=GoogleCloudStorageSubDirectory,proto3" json:"GoogleCloudStorageSubDirectory,omitempty" yaml:"google_cloud_storage_sub_directory"`
 }
 
 func (m *ConfigClientMachineInitial) Reset()         { *m = ConfigClientMachineInitial{} }
@@ -53,6 +109,88 @@ type ConfigClientMachineBenchmarkOptions struct {
 	KeySizeBytes               int64   `protobuf:"varint,8,opt,name=KeySizeBytes,proto3" json:"KeySizeBytes,omitempty" yaml:"key_size_bytes"`
 	ValueSizeBytes             int64   `protobuf:"varint,9,opt,name=ValueSizeBytes,proto3" json:"ValueSizeBytes,omitempty" yaml:"value_size_bytes"`
 	StaleRead                  bool    `protobuf:"varint,10,opt,name=StaleRead,proto3" json:"StaleRead,omitempty" yaml:"stale_read"`
+	// ConnectionChurnPerSecond is the number of connections (or sessions)
+	// to open and close per second, while the benchmark runs.
+	ConnectionChurnPerSecond int64 `protobuf:"varint,11,opt,name=ConnectionChurnPerSecond,proto3" json:"ConnectionChurnPerSecond,omitempty" yaml:"connection_churn_per_second"`
+	// KeyPrefix is prepended to all keys, within 'key_size_bytes'.
+	KeyPrefix string `protobuf:"bytes,12,opt,name=KeyPrefix,proto3" json:"KeyPrefix,omitempty" yaml:"key_prefix"`
+	// TenantGroups, if not empty, runs the client groups concurrently
+	// with separate keyspaces, and records latency of each group.
+	TenantGroups []*ConfigClientMachineTenantGroup `protobuf:"bytes,13,rep,name=TenantGroups" json:"TenantGroups,omitempty" yaml:"tenant_groups"`
+	// ConsistencyCheck runs a read-your-writes checker alongside the benchmark:
+	// one client increments a counter while readers on every endpoint verify
+	// that values never go backwards. Violations are reported in the summary.
+	ConsistencyCheck bool `protobuf:"varint,14,opt,name=ConsistencyCheck,proto3" json:"ConsistencyCheck,omitempty" yaml:"consistency_check"`
+	// ThroughputCeiling, if set, keeps increasing the write rate until
+	// p99 latency exceeds the SLO, and records the maximum sustainable throughput.
+	ThroughputCeiling *ConfigClientMachineThroughputCeiling `protobuf:"bytes,15,opt,name=ThroughputCeiling" json:"ThroughputCeiling,omitempty" yaml:"throughput_ceiling"`
+	// LatencySLOMs is the list of latency thresholds in milliseconds.
+	// Fraction of requests under each threshold is saved per second,
+	// and overall compliance is saved in the summary. Failed requests
+	// count as over every threshold.
+	LatencySLOMs []int64 `protobuf:"varint,16,rep,packed,name=LatencySLOMs" json:"LatencySLOMs,omitempty" yaml:"latency_slo_ms"`
+	// // ReadPercent is the percentage of reads in "mixed" type benchmark,
+	// where the rest are writes. Latency and throughput are also saved
+	// per operation type.
+	ReadPercent int64 `protobuf:"varint,17,opt,name=ReadPercent,proto3" json:"ReadPercent,omitempty" yaml:"read_percent"`
+	// Retry, if set, retries failed requests. Latency is measured from the
+	// first attempt, and retries are counted apart from first-attempt successes.
+	// Built-in retries of the etcd client are disabled.
+	Retry *ConfigClientMachineRetry `protobuf:"bytes,18,opt,name=Retry" json:"Retry,omitempty" yaml:"retry"`
+	// Connection, if set, overrides client connection settings so that
+	// network-fault scenarios behave the same across runs.
+	Connection *ConfigClientMachineConnection `protobuf:"bytes,19,opt,name=Connection" json:"Connection,omitempty" yaml:"connection"`
+	// Seed seeds random values and operations, so that runs with the same
+	// seed issue identical request sequences. Zero picks a random seed.
+	Seed int64 `protobuf:"varint,20,opt,name=Seed,proto3" json:"Seed,omitempty" yaml:"seed"`
+	// ReplayRequestLogPath is the request log to replay in "replay" type
+	// benchmark, saved by 'client_request_log_path' or from external traces.
+	ReplayRequestLogPath string `protobuf:"bytes,21,opt,name=ReplayRequestLogPath,proto3" json:"ReplayRequestLogPath,omitempty" yaml:"replay_request_log_path"`
+	// WatchCompaction configures watchers and compaction
+	// in "watch-compaction" type benchmark (etcd only).
+	WatchCompaction *ConfigClientMachineWatchCompaction `protobuf:"bytes,22,opt,name=WatchCompaction" json:"WatchCompaction,omitempty" yaml:"watch_compaction"`
+	// SessionExpiry configures sessions to expire at once in
+	// "session-expiry" type benchmark (Zookeeper sessions or etcd leases).
+	SessionExpiry *ConfigClientMachineSessionExpiry `protobuf:"bytes,23,opt,name=SessionExpiry" json:"SessionExpiry,omitempty" yaml:"session_expiry"`
+	// LeaseExpiry configures short TTL leases with keys attached
+	// in "lease-expiry" type benchmark (etcd leases or Consul sessions).
+	LeaseExpiry *ConfigClientMachineLeaseExpiry `protobuf:"bytes,24,opt,name=LeaseExpiry" json:"LeaseExpiry,omitempty" yaml:"lease_expiry"`
+	// BatchSizes, if not empty, writes keys in batches (etcd txn, Zookeeper
+	// multi, or Consul txn) in "write" type benchmark, one step per batch
+	// size, 'request_number' keys each. Each batch is one request.
+	BatchSizes []int64 `protobuf:"varint,25,rep,packed,name=BatchSizes" json:"BatchSizes,omitempty" yaml:"batch_sizes"`
+	// KeyOrder is the insertion order of written keys, "sequential" (default)
+	// or "random", where the same keys are shuffled with the workload seed.
+	KeyOrder string `protobuf:"bytes,26,opt,name=KeyOrder,proto3" json:"KeyOrder,omitempty" yaml:"key_order"`
+	// PhaseKeyPrefix, if true, prepends a unique prefix of the run to
+	// 'key_prefix', so that runs back to back on the same database
+	// without wiping data never write the same keys.
+	PhaseKeyPrefix bool `protobuf:"varint,27,opt,name=PhaseKeyPrefix,proto3" json:"PhaseKeyPrefix,omitempty" yaml:"phase_key_prefix"`
+	// DeleteKeyPrefix, if true, deletes all keys under 'key_prefix' after
+	// the stress step, so that the residue does not skew the next runs.
+	DeleteKeyPrefix bool `protobuf:"varint,28,opt,name=DeleteKeyPrefix,proto3" json:"DeleteKeyPrefix,omitempty" yaml:"delete_key_prefix"`
+	// RangeSizes, if not empty, range reads keys in "read" type benchmark,
+	// one step per range size, 'request_number' requests each, where each
+	// request returns 'range size' keys (etcd range or Consul list).
+	RangeSizes []int64 `protobuf:"varint,29,rep,packed,name=RangeSizes" json:"RangeSizes,omitempty" yaml:"range_sizes"`
+	// Soak, if set, rolls up and saves the summary of every interval
+	// while the benchmark runs, for long-duration stability runs.
+	Soak *ConfigClientMachineSoak `protobuf:"bytes,30,opt,name=Soak" json:"Soak,omitempty" yaml:"soak"`
+	// ErrorBudget, if set, aborts the run once errors exceed the budget,
+	// and marks the run failed. Results so far are still saved and uploaded.
+	ErrorBudget *ConfigClientMachineErrorBudget `protobuf:"bytes,31,opt,name=ErrorBudget" json:"ErrorBudget,omitempty" yaml:"error_budget"`
+	// RequestTimeoutsMs, if not empty, runs 'request_number' requests of
+	// "write" or "read" type benchmark for each client request timeout,
+	// where requests not done in the timeout fail with deadline exceeded.
+	RequestTimeoutsMs []int64 `protobuf:"varint,32,rep,packed,name=RequestTimeoutsMs" json:"RequestTimeoutsMs,omitempty" yaml:"request_timeouts_ms"`
+	// KeyspaceSnapshotPath, if not empty, is the keyspace snapshot file
+	// (see 'dbtester keyspace export') to import after the database starts,
+	// so that "read" type benchmark reads keys sampled from the same dataset
+	// on all databases, instead of one key.
+	KeyspaceSnapshotPath string `protobuf:"bytes,33,opt,name=KeyspaceSnapshotPath,proto3" json:"KeyspaceSnapshotPath,omitempty" yaml:"keyspace_snapshot_path"`
+	// KeyStartIndex is the key number of the first written key, so that
+	// client pods of 'dbtester kube' write disjoint key ranges.
+	KeyStartIndex int64 `protobuf:"varint,34,opt,name=KeyStartIndex,proto3" json:"KeyStartIndex,omitempty" yaml:"key_start_index"`
 }
 
 func (m *ConfigClientMachineBenchmarkOptions) Reset()         { *m = ConfigClientMachineBenchmarkOptions{} }
@@ -79,15 +217,25 @@ func (*ConfigClientMachineBenchmarkSteps) Descriptor() ([]byte, []int) {
 
 // ConfigClientMachineAgentControl represents control options on client machine.
 type ConfigClientMachineAgentControl struct {
-	DatabaseID                          string                               `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty" yaml:"database_id"`
-	DatabaseDescription                 string                               `protobuf:"bytes,2,opt,name=DatabaseDescription,proto3" json:"DatabaseDescription,omitempty" yaml:"database_description"`
-	DatabaseTag                         string                               `protobuf:"bytes,3,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty" yaml:"database_tag"`
-	PeerIPs                             []string                             `protobuf:"bytes,4,rep,name=PeerIPs" json:"PeerIPs,omitempty" yaml:"peer_ips"`
-	PeerIPsString                       string                               `protobuf:"bytes,5,opt,name=PeerIPsString,proto3" json:"PeerIPsString,omitempty" yaml:"peer_ips_string"`
-	AgentPortToConnect                  int64                                `protobuf:"varint,6,opt,name=AgentPortToConnect,proto3" json:"AgentPortToConnect,omitempty" yaml:"agent_port_to_connect"`
-	AgentEndpoints                      []string                             `protobuf:"bytes,7,rep,name=AgentEndpoints" json:"AgentEndpoints,omitempty" yaml:"agent_endpoints"`
-	DatabasePortToConnect               int64                                `protobuf:"varint,8,opt,name=DatabasePortToConnect,proto3" json:"DatabasePortToConnect,omitempty" yaml:"database_port_to_connect"`
-	DatabaseEndpoints                   []string                             `protobuf:"bytes,9,rep,name=DatabaseEndpoints" json:"DatabaseEndpoints,omitempty" yaml:"database_endpoints"`
+	DatabaseID            string   `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty" yaml:"database_id"`
+	DatabaseDescription   string   `protobuf:"bytes,2,opt,name=DatabaseDescription,proto3" json:"DatabaseDescription,omitempty" yaml:"database_description"`
+	DatabaseTag           string   `protobuf:"bytes,3,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty" yaml:"database_tag"`
+	PeerIPs               []string `protobuf:"bytes,4,rep,name=PeerIPs" json:"PeerIPs,omitempty" yaml:"peer_ips"`
+	PeerIPsString         string   `protobuf:"bytes,5,opt,name=PeerIPsString,proto3" json:"PeerIPsString,omitempty" yaml:"peer_ips_string"`
+	AgentPortToConnect    int64    `protobuf:"varint,6,opt,name=AgentPortToConnect,proto3" json:"AgentPortToConnect,omitempty" yaml:"agent_port_to_connect"`
+	AgentEndpoints        []string `protobuf:"bytes,7,rep,name=AgentEndpoints" json:"AgentEndpoints,omitempty" yaml:"agent_endpoints"`
+	DatabasePortToConnect int64    `protobuf:"varint,8,opt,name=DatabasePortToConnect,proto3" json:"DatabasePortToConnect,omitempty" yaml:"database_port_to_connect"`
+	DatabaseEndpoints     []string `protobuf:"bytes,9,rep,name=DatabaseEndpoints" json:"DatabaseEndpoints,omitempty" yaml:"database_endpoints"`
+	// ProxyEndpoints, if not empty, are the endpoints of proxies or gateways
+	// in front of the database (e.g. etcd grpc-proxy, Consul client agents),
+	// to stress the database through them instead of database endpoints.
+	ProxyEndpoints []string `protobuf:"bytes,10,rep,name=ProxyEndpoints" json:"ProxyEndpoints,omitempty" yaml:"proxy_endpoints"`
+	// DiscoverySRV, if not empty, is the DNS SRV record name to discover
+	// database endpoints from (e.g. '_etcd-client._tcp.example.com'),
+	// instead of peer IPs or proxy endpoints. Records are re-resolved every
+	// DiscoverySRVRefreshSeconds (30 by default) while stressing.
+	DiscoverySRV                        string                               `protobuf:"bytes,11,opt,name=DiscoverySRV,proto3" json:"DiscoverySRV,omitempty" yaml:"discovery_srv"`
+	DiscoverySRVRefreshSeconds          int64                                `protobuf:"varint,12,opt,name=DiscoverySRVRefreshSeconds,proto3" json:"DiscoverySRVRefreshSeconds,omitempty" yaml:"discovery_srv_refresh_seconds"`
 	Flag_Etcd_Tip                       *Flag_Etcd_Tip                       `protobuf:"bytes,100,opt,name=flag__etcd__tip,json=flagEtcdTip" json:"flag__etcd__tip,omitempty" yaml:"etcd__tip"`
 	Flag_Etcd_V3_2                      *Flag_Etcd_V3_2                      `protobuf:"bytes,101,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty" yaml:"etcd__v3_2"`
 	Flag_Etcd_V3_3                      *Flag_Etcd_V3_3                      `protobuf:"bytes,102,opt,name=flag__etcd__v3_3,json=flagEtcdV33" json:"flag__etcd__v3_3,omitempty" yaml:"etcd__v3_3"`
@@ -97,6 +245,39 @@ type ConfigClientMachineAgentControl struct {
 	Flag_Zetcd_Beta                     *Flag_Zetcd_Beta                     `protobuf:"bytes,500,opt,name=flag__zetcd__beta,json=flagZetcdBeta" json:"flag__zetcd__beta,omitempty" yaml:"zetcd__beta"`
 	ConfigClientMachineBenchmarkOptions *ConfigClientMachineBenchmarkOptions `protobuf:"bytes,1000,opt,name=ConfigClientMachineBenchmarkOptions" json:"ConfigClientMachineBenchmarkOptions,omitempty" yaml:"benchmark_options"`
 	ConfigClientMachineBenchmarkSteps   *ConfigClientMachineBenchmarkSteps   `protobuf:"bytes,1001,opt,name=ConfigClientMachineBenchmarkSteps" json:"ConfigClientMachineBenchmarkSteps,omitempty" yaml:"benchmark_steps"`
+	ConfigDocker                        *ConfigDocker                        `protobuf:"bytes,1002,opt,name=ConfigDocker" json:"ConfigDocker,omitempty" yaml:"docker"`
+	// NemesisSchedule is the sequence of faults injected while stressing.
+	NemesisSchedule []*ConfigNemesisStep `protobuf:"bytes,1003,rep,name=NemesisSchedule" json:"NemesisSchedule,omitempty" yaml:"nemesis_schedule"`
+	// ConfigRelease is set to install the official release binary on agents.
+	ConfigRelease *ConfigRelease `protobuf:"bytes,1004,opt,name=ConfigRelease" json:"ConfigRelease,omitempty" yaml:"release"`
+	// ConfigSource is set to build the database from source, and install on agents.
+	ConfigSource *ConfigSource `protobuf:"bytes,1005,opt,name=ConfigSource" json:"ConfigSource,omitempty" yaml:"source"`
+	// ConfigProfile is set to capture profiles from the database while stressing.
+	ConfigProfile *ConfigProfile `protobuf:"bytes,1006,opt,name=ConfigProfile" json:"ConfigProfile,omitempty" yaml:"profile"`
+	// ConfigWANTopology is set to inject latency and packet loss between
+	// agents in different regions, to simulate cross-region deployments.
+	ConfigWANTopology *ConfigWANTopology `protobuf:"bytes,1007,opt,name=ConfigWANTopology" json:"ConfigWANTopology,omitempty" yaml:"wan_topology"`
+	// ClientBandwidthMbitPerSecond, if not zero, caps the bandwidth from
+	// the client machine to database endpoints with 'tc' while stressing,
+	// to benchmark over constrained links.
+	ClientBandwidthMbitPerSecond float64 `protobuf:"fixed64,1008,opt,name=ClientBandwidthMbitPerSecond,proto3" json:"ClientBandwidthMbitPerSecond,omitempty" yaml:"client_bandwidth_mbit_per_second"`
+	// ConfigDiskDelay is set to run databases on a disk with artificial
+	// latency on agents, to measure the sensitivity to slow disks.
+	ConfigDiskDelay *ConfigDiskDelay `protobuf:"bytes,1009,opt,name=ConfigDiskDelay" json:"ConfigDiskDelay,omitempty" yaml:"disk_delay"`
+	// ConfigRollingRestart is set to restart each server one at a time
+	// while stressing, to measure the throughput dip of each restart.
+	ConfigRollingRestart *ConfigRollingRestart `protobuf:"bytes,1010,opt,name=ConfigRollingRestart" json:"ConfigRollingRestart,omitempty" yaml:"rolling_restart"`
+	// Collectors are extra metric sources sampled every second by agents
+	// along with system metrics (e.g. store-specific metrics from scripts
+	// or endpoints), saved and uploaded as one CSV file per collector.
+	Collectors []*ConfigCollector `protobuf:"bytes,1011,rep,name=Collectors" json:"Collectors,omitempty" yaml:"collectors"`
+	// ConfigRandomNemesis is set to inject faults at random servers and
+	// times generated from its seed, instead of 'nemesis_schedule'.
+	ConfigRandomNemesis *ConfigRandomNemesis `protobuf:"bytes,1012,opt,name=ConfigRandomNemesis" json:"ConfigRandomNemesis,omitempty" yaml:"random_nemesis"`
+	// DatabaseEnv are 'KEY=VALUE' environment variables of the database
+	// process on agents (e.g. 'GOGC=400' for GC tuning of Go databases),
+	// also recorded as run tags.
+	DatabaseEnv []string `protobuf:"bytes,1013,rep,name=DatabaseEnv" json:"DatabaseEnv,omitempty" yaml:"database_env"`
 }
 
 func (m *ConfigClientMachineAgentControl) Reset()         { *m = ConfigClientMachineAgentControl{} }
@@ -106,11 +287,443 @@ func (*ConfigClientMachineAgentControl) Descriptor() ([]byte, []int) {
 	return fileDescriptorConfigClientMachine, []int{3}
 }
 
+// ConfigDocker represents options to run the database in a Docker container.
+// The container shares the host network by default, and data directories
+// are mounted at the same paths as on the host.
+type ConfigDocker struct {
+	Image string `protobuf:"bytes,1,opt,name=Image,proto3" json:"Image,omitempty" yaml:"image"`
+	Tag   string `protobuf:"bytes,2,opt,name=Tag,proto3" json:"Tag,omitempty" yaml:"tag"`
+	// Command is the database executable path in the container.
+	// If empty, database flags are passed to the image entrypoint.
+	Command string `protobuf:"bytes,3,opt,name=Command,proto3" json:"Command,omitempty" yaml:"command"`
+	// Volumes are mounted in the form of 'host-path:container-path'.
+	Volumes []string `protobuf:"bytes,4,rep,name=Volumes" json:"Volumes,omitempty" yaml:"volumes"`
+	Network string   `protobuf:"bytes,5,opt,name=Network,proto3" json:"Network,omitempty" yaml:"network"`
+}
+
+func (m *ConfigDocker) Reset()                    { *m = ConfigDocker{} }
+func (m *ConfigDocker) String() string            { return proto.CompactTextString(m) }
+func (*ConfigDocker) ProtoMessage()               {}
+func (*ConfigDocker) Descriptor() ([]byte, []int) { return fileDescriptorConfigClientMachine, []int{4} }
+
+// ConfigClientMachineTenantGroup represents a labeled client group
+// that shares the database with other groups.
+type ConfigClientMachineTenantGroup struct {
+	Name                       string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty" yaml:"name"`
+	ClientNumber               int64  `protobuf:"varint,2,opt,name=ClientNumber,proto3" json:"ClientNumber,omitempty" yaml:"client_number"`
+	ConnectionNumber           int64  `protobuf:"varint,3,opt,name=ConnectionNumber,proto3" json:"ConnectionNumber,omitempty" yaml:"connection_number"`
+	RequestNumber              int64  `protobuf:"varint,4,opt,name=RequestNumber,proto3" json:"RequestNumber,omitempty" yaml:"request_number"`
+	RateLimitRequestsPerSecond int64  `protobuf:"varint,5,opt,name=RateLimitRequestsPerSecond,proto3" json:"RateLimitRequestsPerSecond,omitempty" yaml:"rate_limit_requests_per_second"`
+	// KeyPrefix defaults to the group name.
+	KeyPrefix string `protobuf:"bytes,6,opt,name=KeyPrefix,proto3" json:"KeyPrefix,omitempty" yaml:"key_prefix"`
+}
+
+func (m *ConfigClientMachineTenantGroup) Reset()         { *m = ConfigClientMachineTenantGroup{} }
+func (m *ConfigClientMachineTenantGroup) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineTenantGroup) ProtoMessage()    {}
+func (*ConfigClientMachineTenantGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{5}
+}
+
+// ConfigNemesisStep represents a fault injected by an agent.
+type ConfigNemesisStep struct {
+	// Operation is 'partition', 'kill', 'stop', or 'clock-skew'.
+	// 'kill' sends SIGKILL to the database, and 'stop' sends SIGTERM for
+	// graceful shutdown. Both restart the database on recovery.
+	Operation string `protobuf:"bytes,1,opt,name=Operation,proto3" json:"Operation,omitempty" yaml:"operation"`
+	// TargetIndex is the index of the agent to inject the fault.
+	TargetIndex int64 `protobuf:"varint,2,opt,name=TargetIndex,proto3" json:"TargetIndex,omitempty" yaml:"target_index"`
+	// DelaySeconds is the time to wait after the previous step recovers.
+	DelaySeconds int64 `protobuf:"varint,3,opt,name=DelaySeconds,proto3" json:"DelaySeconds,omitempty" yaml:"delay_seconds"`
+	// DurationSeconds is the time until the fault is recovered.
+	DurationSeconds       int64 `protobuf:"varint,4,opt,name=DurationSeconds,proto3" json:"DurationSeconds,omitempty" yaml:"duration_seconds"`
+	ClockSkewMilliseconds int64 `protobuf:"varint,5,opt,name=ClockSkewMilliseconds,proto3" json:"ClockSkewMilliseconds,omitempty" yaml:"clock_skew_milliseconds"`
+}
+
+func (m *ConfigNemesisStep) Reset()         { *m = ConfigNemesisStep{} }
+func (m *ConfigNemesisStep) String() string { return proto.CompactTextString(m) }
+func (*ConfigNemesisStep) ProtoMessage()    {}
+func (*ConfigNemesisStep) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{6}
+}
+
+// ConfigRelease represents an upstream release of the database.
+type ConfigRelease struct {
+	// Version is the release version (e.g. 'v3.1.0').
+	Version string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty" yaml:"version"`
+	// URL overrides the default download URL of the release archive.
+	URL string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty" yaml:"url"`
+	// SHA256 overrides the checksum of the release archive
+	// published by upstream.
+	SHA256 string `protobuf:"bytes,3,opt,name=SHA256,proto3" json:"SHA256,omitempty" yaml:"sha256"`
+}
+
+func (m *ConfigRelease) Reset()         { *m = ConfigRelease{} }
+func (m *ConfigRelease) String() string { return proto.CompactTextString(m) }
+func (*ConfigRelease) ProtoMessage()    {}
+func (*ConfigRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{7}
+}
+
+// ConfigSource represents a git revision of the database to build.
+type ConfigSource struct {
+	Repository string `protobuf:"bytes,1,opt,name=Repository,proto3" json:"Repository,omitempty" yaml:"repository"`
+	// Revision is the commit, branch, or tag to build.
+	Revision string `protobuf:"bytes,2,opt,name=Revision,proto3" json:"Revision,omitempty" yaml:"revision"`
+	// BuildCommand is run with 'sh -c' in the repository root.
+	BuildCommand string `protobuf:"bytes,3,opt,name=BuildCommand,proto3" json:"BuildCommand,omitempty" yaml:"build_command"`
+	// BinaryPath is the path of the built binary, relative to the repository root.
+	BinaryPath string `protobuf:"bytes,4,opt,name=BinaryPath,proto3" json:"BinaryPath,omitempty" yaml:"binary_path"`
+}
+
+func (m *ConfigSource) Reset()                    { *m = ConfigSource{} }
+func (m *ConfigSource) String() string            { return proto.CompactTextString(m) }
+func (*ConfigSource) ProtoMessage()               {}
+func (*ConfigSource) Descriptor() ([]byte, []int) { return fileDescriptorConfigClientMachine, []int{8} }
+
+// ConfigProfile represents when to capture CPU and heap profiles.
+type ConfigProfile struct {
+	// AtSeconds is the list of seconds since the stress starts.
+	AtSeconds  []int64 `protobuf:"varint,1,rep,packed,name=AtSeconds" json:"AtSeconds,omitempty" yaml:"at_seconds"`
+	CPUSeconds int64   `protobuf:"varint,2,opt,name=CPUSeconds,proto3" json:"CPUSeconds,omitempty" yaml:"cpu_seconds"`
+	// Perf is set to run 'perf record' on the database process
+	// for 'cpu_seconds', for databases without pprof endpoints.
+	Perf bool `protobuf:"varint,3,opt,name=Perf,proto3" json:"Perf,omitempty" yaml:"perf"`
+	// PerfArgs overrides the default 'perf record' arguments '-g'
+	// (e.g. '-e sched:sched_switch -g' for off-CPU profiling).
+	PerfArgs []string `protobuf:"bytes,4,rep,name=PerfArgs" json:"PerfArgs,omitempty" yaml:"perf_args"`
+}
+
+func (m *ConfigProfile) Reset()         { *m = ConfigProfile{} }
+func (m *ConfigProfile) String() string { return proto.CompactTextString(m) }
+func (*ConfigProfile) ProtoMessage()    {}
+func (*ConfigProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{9}
+}
+
+// ConfigClientMachineThroughputCeiling represents the steps to find the maximum
+// throughput that meets the latency SLO.
+type ConfigClientMachineThroughputCeiling struct {
+	StartRequestsPerSecond int64 `protobuf:"varint,1,opt,name=StartRequestsPerSecond,proto3" json:"StartRequestsPerSecond,omitempty" yaml:"start_requests_per_second"`
+	StepRequestsPerSecond  int64 `protobuf:"varint,2,opt,name=StepRequestsPerSecond,proto3" json:"StepRequestsPerSecond,omitempty" yaml:"step_requests_per_second"`
+	// StepSeconds is how long each rate is offered.
+	StepSeconds int64 `protobuf:"varint,3,opt,name=StepSeconds,proto3" json:"StepSeconds,omitempty" yaml:"step_seconds"`
+	// MaxRequestsPerSecond stops the steps even if the SLO is still met.
+	MaxRequestsPerSecond int64 `protobuf:"varint,4,opt,name=MaxRequestsPerSecond,proto3" json:"MaxRequestsPerSecond,omitempty" yaml:"max_requests_per_second"`
+	// LatencyP99Ms is the latency SLO in milliseconds.
+	LatencyP99Ms float64 `protobuf:"fixed64,5,opt,name=LatencyP99Ms,proto3" json:"LatencyP99Ms,omitempty" yaml:"latency_p99_ms"`
+}
+
+func (m *ConfigClientMachineThroughputCeiling) Reset()         { *m = ConfigClientMachineThroughputCeiling{} }
+func (m *ConfigClientMachineThroughputCeiling) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineThroughputCeiling) ProtoMessage()    {}
+func (*ConfigClientMachineThroughputCeiling) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{10}
+}
+
+// ConfigClientMachineRetry represents the policy to retry failed requests
+// with exponential backoff.
+type ConfigClientMachineRetry struct {
+	// MaxAttempts is the maximum number of attempts, including the first.
+	MaxAttempts int64 `protobuf:"varint,1,opt,name=MaxAttempts,proto3" json:"MaxAttempts,omitempty" yaml:"max_attempts"`
+	// InitialBackoffMs is the wait before the first retry, doubled on each retry.
+	InitialBackoffMs int64 `protobuf:"varint,2,opt,name=InitialBackoffMs,proto3" json:"InitialBackoffMs,omitempty" yaml:"initial_backoff_ms"`
+	// MaxBackoffMs caps the wait between retries.
+	MaxBackoffMs int64 `protobuf:"varint,3,opt,name=MaxBackoffMs,proto3" json:"MaxBackoffMs,omitempty" yaml:"max_backoff_ms"`
+}
+
+func (m *ConfigClientMachineRetry) Reset()         { *m = ConfigClientMachineRetry{} }
+func (m *ConfigClientMachineRetry) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineRetry) ProtoMessage()    {}
+func (*ConfigClientMachineRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{11}
+}
+
+// ConfigClientMachineConnection represents client connection settings.
+// Zero values keep the client library defaults.
+type ConfigClientMachineConnection struct {
+	// DialTimeoutMs is the timeout to establish a connection.
+	DialTimeoutMs int64 `protobuf:"varint,1,opt,name=DialTimeoutMs,proto3" json:"DialTimeoutMs,omitempty" yaml:"dial_timeout_ms"`
+	// KeepAliveTimeMs is the idle time before the client pings the server.
+	KeepAliveTimeMs int64 `protobuf:"varint,2,opt,name=KeepAliveTimeMs,proto3" json:"KeepAliveTimeMs,omitempty" yaml:"keep_alive_time_ms"`
+	// KeepAliveTimeoutMs is the wait for a ping response before the
+	// connection is closed (gRPC only).
+	KeepAliveTimeoutMs int64 `protobuf:"varint,3,opt,name=KeepAliveTimeoutMs,proto3" json:"KeepAliveTimeoutMs,omitempty" yaml:"keep_alive_timeout_ms"`
+	// MaxConcurrentStreams caps in-flight requests on each gRPC connection.
+	MaxConcurrentStreams int64 `protobuf:"varint,4,opt,name=MaxConcurrentStreams,proto3" json:"MaxConcurrentStreams,omitempty" yaml:"max_concurrent_streams"`
+	// MaxIdleConnsPerHost caps idle connections kept by HTTP clients.
+	MaxIdleConnsPerHost int64 `protobuf:"varint,5,opt,name=MaxIdleConnsPerHost,proto3" json:"MaxIdleConnsPerHost,omitempty" yaml:"max_idle_conns_per_host"`
+	// IdleConnTimeoutMs closes idle HTTP connections after the duration.
+	IdleConnTimeoutMs int64 `protobuf:"varint,6,opt,name=IdleConnTimeoutMs,proto3" json:"IdleConnTimeoutMs,omitempty" yaml:"idle_conn_timeout_ms"`
+	// Warm dials all connections and sends one request on each,
+	// before the measured window starts.
+	Warm bool `protobuf:"varint,7,opt,name=Warm,proto3" json:"Warm,omitempty" yaml:"warm"`
+}
+
+func (m *ConfigClientMachineConnection) Reset()         { *m = ConfigClientMachineConnection{} }
+func (m *ConfigClientMachineConnection) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineConnection) ProtoMessage()    {}
+func (*ConfigClientMachineConnection) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{12}
+}
+
+// ConfigClientMachineWatchCompaction represents watchers of written keys
+// with periodic compaction, where lagging watchers fail with
+// "required revision has been compacted".
+type ConfigClientMachineWatchCompaction struct {
+	// WatcherNumber is the number of watchers on 'key_prefix'.
+	WatcherNumber int64 `protobuf:"varint,1,opt,name=WatcherNumber,proto3" json:"WatcherNumber,omitempty" yaml:"watcher_number"`
+	// CompactionIntervalSeconds is the interval between compactions.
+	CompactionIntervalSeconds int64 `protobuf:"varint,2,opt,name=CompactionIntervalSeconds,proto3" json:"CompactionIntervalSeconds,omitempty" yaml:"compaction_interval_seconds"`
+	// CompactionRetainRevisions is the number of latest revisions
+	// to keep on compaction.
+	CompactionRetainRevisions int64 `protobuf:"varint,3,opt,name=CompactionRetainRevisions,proto3" json:"CompactionRetainRevisions,omitempty" yaml:"compaction_retain_revisions"`
+}
+
+func (m *ConfigClientMachineWatchCompaction) Reset()         { *m = ConfigClientMachineWatchCompaction{} }
+func (m *ConfigClientMachineWatchCompaction) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineWatchCompaction) ProtoMessage()    {}
+func (*ConfigClientMachineWatchCompaction) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{13}
+}
+
+// ConfigClientMachineSessionExpiry represents sessions with ephemeral
+// nodes (or etcd leases with keys), whose clients are paused at once
+// past the session timeout while writes are running.
+type ConfigClientMachineSessionExpiry struct {
+	// SessionNumber is the number of sessions to expire.
+	SessionNumber int64 `protobuf:"varint,1,opt,name=SessionNumber,proto3" json:"SessionNumber,omitempty" yaml:"session_number"`
+	// EphemeralNodesPerSession is the number of ephemeral nodes
+	// (or keys attached to the lease) of each session.
+	EphemeralNodesPerSession int64 `protobuf:"varint,2,opt,name=EphemeralNodesPerSession,proto3" json:"EphemeralNodesPerSession,omitempty" yaml:"ephemeral_nodes_per_session"`
+	// SessionTimeoutSeconds is the session timeout (or lease TTL).
+	SessionTimeoutSeconds int64 `protobuf:"varint,3,opt,name=SessionTimeoutSeconds,proto3" json:"SessionTimeoutSeconds,omitempty" yaml:"session_timeout_seconds"`
+	// PauseAfterSeconds is the time from the start of writes
+	// to pause all session clients.
+	PauseAfterSeconds int64 `protobuf:"varint,4,opt,name=PauseAfterSeconds,proto3" json:"PauseAfterSeconds,omitempty" yaml:"pause_after_seconds"`
+}
+
+func (m *ConfigClientMachineSessionExpiry) Reset()         { *m = ConfigClientMachineSessionExpiry{} }
+func (m *ConfigClientMachineSessionExpiry) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineSessionExpiry) ProtoMessage()    {}
+func (*ConfigClientMachineSessionExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{14}
+}
+
+// ConfigWANTopology represents regions of agents and network conditions
+// between regions, applied by agents with 'tc netem' while databases run.
+type ConfigWANTopology struct {
+	// Regions are the region names of agents, in order of agent index.
+	Regions []string `protobuf:"bytes,1,rep,name=Regions" json:"Regions,omitempty" yaml:"regions"`
+	// Links are the network conditions between two regions, in both
+	// directions. Agents in the same region, or regions without a link,
+	// have no injected latency.
+	Links []*ConfigWANLink `protobuf:"bytes,2,rep,name=Links" json:"Links,omitempty" yaml:"links"`
+}
+
+func (m *ConfigWANTopology) Reset()         { *m = ConfigWANTopology{} }
+func (m *ConfigWANTopology) String() string { return proto.CompactTextString(m) }
+func (*ConfigWANTopology) ProtoMessage()    {}
+func (*ConfigWANTopology) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{15}
+}
+
+// ConfigWANLink represents network conditions between two regions.
+type ConfigWANLink struct {
+	From string `protobuf:"bytes,1,opt,name=From,proto3" json:"From,omitempty" yaml:"from"`
+	To   string `protobuf:"bytes,2,opt,name=To,proto3" json:"To,omitempty" yaml:"to"`
+	// DelayMilliseconds is added to outgoing packets on both sides,
+	// so round trip time increases by twice the delay.
+	DelayMilliseconds  int64   `protobuf:"varint,3,opt,name=DelayMilliseconds,proto3" json:"DelayMilliseconds,omitempty" yaml:"delay_milliseconds"`
+	JitterMilliseconds int64   `protobuf:"varint,4,opt,name=JitterMilliseconds,proto3" json:"JitterMilliseconds,omitempty" yaml:"jitter_milliseconds"`
+	LossPercent        float64 `protobuf:"fixed64,5,opt,name=LossPercent,proto3" json:"LossPercent,omitempty" yaml:"loss_percent"`
+}
+
+func (m *ConfigWANLink) Reset()         { *m = ConfigWANLink{} }
+func (m *ConfigWANLink) String() string { return proto.CompactTextString(m) }
+func (*ConfigWANLink) ProtoMessage()    {}
+func (*ConfigWANLink) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{16}
+}
+
+// ConfigDiskDelay represents artificial disk latency, injected by agents
+// with a 'dm-delay' device mounted at the database data directory.
+type ConfigDiskDelay struct {
+	ReadDelayMilliseconds int64 `protobuf:"varint,1,opt,name=ReadDelayMilliseconds,proto3" json:"ReadDelayMilliseconds,omitempty" yaml:"read_delay_milliseconds"`
+	// WriteDelayMilliseconds delays all writes, so fsync waits
+	// at least as long for the written data.
+	WriteDelayMilliseconds int64 `protobuf:"varint,2,opt,name=WriteDelayMilliseconds,proto3" json:"WriteDelayMilliseconds,omitempty" yaml:"write_delay_milliseconds"`
+	// SizeGigabytes is the size of the delayed disk, 10 GB by default.
+	SizeGigabytes int64 `protobuf:"varint,3,opt,name=SizeGigabytes,proto3" json:"SizeGigabytes,omitempty" yaml:"size_gigabytes"`
+}
+
+func (m *ConfigDiskDelay) Reset()         { *m = ConfigDiskDelay{} }
+func (m *ConfigDiskDelay) String() string { return proto.CompactTextString(m) }
+func (*ConfigDiskDelay) ProtoMessage()    {}
+func (*ConfigDiskDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{17}
+}
+
+// ConfigClientMachineLeaseExpiry represents short TTL leases (or Consul
+// sessions with TTL) with keys attached, granted while writes are running
+// and never kept alive, to measure the time from lease expiry until the
+// keys are deleted.
+type ConfigClientMachineLeaseExpiry struct {
+	// LeasesPerSecond is the rate of leases to grant.
+	LeasesPerSecond int64 `protobuf:"varint,1,opt,name=LeasesPerSecond,proto3" json:"LeasesPerSecond,omitempty" yaml:"leases_per_second"`
+	// KeysPerLease is the number of keys attached to each lease.
+	KeysPerLease int64 `protobuf:"varint,2,opt,name=KeysPerLease,proto3" json:"KeysPerLease,omitempty" yaml:"keys_per_lease"`
+	// LeaseTTLSeconds is the lease TTL. Consul requires at least 10 seconds.
+	LeaseTTLSeconds int64 `protobuf:"varint,3,opt,name=LeaseTTLSeconds,proto3" json:"LeaseTTLSeconds,omitempty" yaml:"lease_ttl_seconds"`
+	// ExpiryToleranceMilliseconds is the maximum deviation of key expiry
+	// from the TTL to count as accurate, 1000 by default.
+	ExpiryToleranceMilliseconds int64 `protobuf:"varint,4,opt,name=ExpiryToleranceMilliseconds,proto3" json:"ExpiryToleranceMilliseconds,omitempty" yaml:"expiry_tolerance_milliseconds"`
+}
+
+func (m *ConfigClientMachineLeaseExpiry) Reset()         { *m = ConfigClientMachineLeaseExpiry{} }
+func (m *ConfigClientMachineLeaseExpiry) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineLeaseExpiry) ProtoMessage()    {}
+func (*ConfigClientMachineLeaseExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{18}
+}
+
+// ConfigRollingRestart represents restarts of all servers one at a time,
+// in the order of agents, under the load of the benchmark.
+type ConfigRollingRestart struct {
+	// DelaySeconds is the time to wait before each restart, from the start
+	// of the benchmark or the previous restart, so throughput settles.
+	DelaySeconds int64 `protobuf:"varint,1,opt,name=DelaySeconds,proto3" json:"DelaySeconds,omitempty" yaml:"delay_seconds"`
+	// DownSeconds is the time each server is kept down before restart.
+	DownSeconds int64 `protobuf:"varint,2,opt,name=DownSeconds,proto3" json:"DownSeconds,omitempty" yaml:"down_seconds"`
+	// Kill, if true, sends SIGKILL instead of SIGTERM to stop servers.
+	Kill bool `protobuf:"varint,3,opt,name=Kill,proto3" json:"Kill,omitempty" yaml:"kill"`
+}
+
+func (m *ConfigRollingRestart) Reset()         { *m = ConfigRollingRestart{} }
+func (m *ConfigRollingRestart) String() string { return proto.CompactTextString(m) }
+func (*ConfigRollingRestart) ProtoMessage()    {}
+func (*ConfigRollingRestart) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{19}
+}
+
+// ConfigCollector represents an extra metric source on agents.
+type ConfigCollector struct {
+	// Name is the name of the collector, used in the CSV file name
+	// '<system-metrics-csv>-<name>.csv'.
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty" yaml:"name"`
+	// Type is the type of the collector: 'exec' runs Command and reads
+	// one CSV line of Columns values from its output, 'http' reads
+	// the sum of each metric in Columns from Endpoint in Prometheus text
+	// format. Other types can be registered in the agent.
+	Type string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty" yaml:"type"`
+	// Command is the command and its arguments for 'exec' type.
+	Command []string `protobuf:"bytes,3,rep,name=Command" json:"Command,omitempty" yaml:"command"`
+	// Endpoint is the URL for 'http' type.
+	Endpoint string `protobuf:"bytes,4,opt,name=Endpoint,proto3" json:"Endpoint,omitempty" yaml:"endpoint"`
+	// Columns are the column names of the samples.
+	Columns []string `protobuf:"bytes,5,rep,name=Columns" json:"Columns,omitempty" yaml:"columns"`
+}
+
+func (m *ConfigCollector) Reset()         { *m = ConfigCollector{} }
+func (m *ConfigCollector) String() string { return proto.CompactTextString(m) }
+func (*ConfigCollector) ProtoMessage()    {}
+func (*ConfigCollector) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{20}
+}
+
+// ConfigClientMachineSoak represents the periodic summary rollups
+// of long-duration soak runs.
+type ConfigClientMachineSoak struct {
+	// RollupMinutes is the interval to roll up and save summaries.
+	RollupMinutes int64 `protobuf:"varint,1,opt,name=RollupMinutes,proto3" json:"RollupMinutes,omitempty" yaml:"rollup_minutes"`
+	// TruncateRawData, if true, truncates the request log once rolled up,
+	// so that it only holds the requests since the last rollup, and builds
+	// the run summary, percentiles, and latency distribution from histograms
+	// instead of keeping the latency of every request in memory. Only
+	// supported for 'write' and 'read' types with fixed client number.
+	TruncateRawData bool `protobuf:"varint,2,opt,name=TruncateRawData,proto3" json:"TruncateRawData,omitempty" yaml:"truncate_raw_data"`
+}
+
+func (m *ConfigClientMachineSoak) Reset()         { *m = ConfigClientMachineSoak{} }
+func (m *ConfigClientMachineSoak) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineSoak) ProtoMessage()    {}
+func (*ConfigClientMachineSoak) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{21}
+}
+
+// ConfigClientMachineErrorBudget represents the error budget of a run.
+type ConfigClientMachineErrorBudget struct {
+	// MaxErrorPercent is the maximum percentage of failed requests
+	// over any window.
+	MaxErrorPercent float64 `protobuf:"fixed64,1,opt,name=MaxErrorPercent,proto3" json:"MaxErrorPercent,omitempty" yaml:"max_error_percent"`
+	// WindowSeconds is the length of the sliding window in seconds.
+	WindowSeconds int64 `protobuf:"varint,2,opt,name=WindowSeconds,proto3" json:"WindowSeconds,omitempty" yaml:"window_seconds"`
+	// MinRequests is the minimum number of requests in a window to
+	// check the budget, so that a few early failures do not abort.
+	MinRequests int64 `protobuf:"varint,3,opt,name=MinRequests,proto3" json:"MinRequests,omitempty" yaml:"min_requests"`
+}
+
+func (m *ConfigClientMachineErrorBudget) Reset()         { *m = ConfigClientMachineErrorBudget{} }
+func (m *ConfigClientMachineErrorBudget) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineErrorBudget) ProtoMessage()    {}
+func (*ConfigClientMachineErrorBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{22}
+}
+
+// ConfigRandomNemesis represents faults injected at random servers and
+// times. Runs with the same seed, and the same number of servers,
+// inject identical faults.
+type ConfigRandomNemesis struct {
+	// Operations are the nemesis operations to pick from.
+	Operations []string `protobuf:"bytes,1,rep,name=Operations" json:"Operations,omitempty" yaml:"operations"`
+	// Steps is the number of faults to inject.
+	Steps int64 `protobuf:"varint,2,opt,name=Steps,proto3" json:"Steps,omitempty" yaml:"steps"`
+	// MinDelaySeconds and MaxDelaySeconds are the range of time to wait
+	// after the previous fault recovers.
+	MinDelaySeconds int64 `protobuf:"varint,3,opt,name=MinDelaySeconds,proto3" json:"MinDelaySeconds,omitempty" yaml:"min_delay_seconds"`
+	MaxDelaySeconds int64 `protobuf:"varint,4,opt,name=MaxDelaySeconds,proto3" json:"MaxDelaySeconds,omitempty" yaml:"max_delay_seconds"`
+	// MinDurationSeconds and MaxDurationSeconds are the range of time
+	// until each fault is recovered.
+	MinDurationSeconds    int64 `protobuf:"varint,5,opt,name=MinDurationSeconds,proto3" json:"MinDurationSeconds,omitempty" yaml:"min_duration_seconds"`
+	MaxDurationSeconds    int64 `protobuf:"varint,6,opt,name=MaxDurationSeconds,proto3" json:"MaxDurationSeconds,omitempty" yaml:"max_duration_seconds"`
+	ClockSkewMilliseconds int64 `protobuf:"varint,7,opt,name=ClockSkewMilliseconds,proto3" json:"ClockSkewMilliseconds,omitempty" yaml:"clock_skew_milliseconds"`
+	// Seed seeds the fault schedule. Zero picks a random seed,
+	// recorded in run metadata to replay the same faults.
+	Seed int64 `protobuf:"varint,8,opt,name=Seed,proto3" json:"Seed,omitempty" yaml:"seed"`
+}
+
+func (m *ConfigRandomNemesis) Reset()         { *m = ConfigRandomNemesis{} }
+func (m *ConfigRandomNemesis) String() string { return proto.CompactTextString(m) }
+func (*ConfigRandomNemesis) ProtoMessage()    {}
+func (*ConfigRandomNemesis) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{23}
+}
+
 func init() {
 	proto.RegisterType((*ConfigClientMachineInitial)(nil), "dbtesterpb.ConfigClientMachineInitial")
 	proto.RegisterType((*ConfigClientMachineBenchmarkOptions)(nil), "dbtesterpb.ConfigClientMachineBenchmarkOptions")
 	proto.RegisterType((*ConfigClientMachineBenchmarkSteps)(nil), "dbtesterpb.ConfigClientMachineBenchmarkSteps")
 	proto.RegisterType((*ConfigClientMachineAgentControl)(nil), "dbtesterpb.ConfigClientMachineAgentControl")
+	proto.RegisterType((*ConfigDocker)(nil), "dbtesterpb.ConfigDocker")
+	proto.RegisterType((*ConfigClientMachineTenantGroup)(nil), "dbtesterpb.ConfigClientMachineTenantGroup")
+	proto.RegisterType((*ConfigNemesisStep)(nil), "dbtesterpb.ConfigNemesisStep")
+	proto.RegisterType((*ConfigRelease)(nil), "dbtesterpb.ConfigRelease")
+	proto.RegisterType((*ConfigSource)(nil), "dbtesterpb.ConfigSource")
+	proto.RegisterType((*ConfigProfile)(nil), "dbtesterpb.ConfigProfile")
+	proto.RegisterType((*ConfigClientMachineThroughputCeiling)(nil), "dbtesterpb.ConfigClientMachineThroughputCeiling")
+	proto.RegisterType((*ConfigClientMachineRetry)(nil), "dbtesterpb.ConfigClientMachineRetry")
+	proto.RegisterType((*ConfigClientMachineConnection)(nil), "dbtesterpb.ConfigClientMachineConnection")
+	proto.RegisterType((*ConfigClientMachineWatchCompaction)(nil), "dbtesterpb.ConfigClientMachineWatchCompaction")
+	proto.RegisterType((*ConfigClientMachineSessionExpiry)(nil), "dbtesterpb.ConfigClientMachineSessionExpiry")
+	proto.RegisterType((*ConfigWANTopology)(nil), "dbtesterpb.ConfigWANTopology")
+	proto.RegisterType((*ConfigWANLink)(nil), "dbtesterpb.ConfigWANLink")
+	proto.RegisterType((*ConfigDiskDelay)(nil), "dbtesterpb.ConfigDiskDelay")
+	proto.RegisterType((*ConfigClientMachineLeaseExpiry)(nil), "dbtesterpb.ConfigClientMachineLeaseExpiry")
+	proto.RegisterType((*ConfigRollingRestart)(nil), "dbtesterpb.ConfigRollingRestart")
+	proto.RegisterType((*ConfigCollector)(nil), "dbtesterpb.ConfigCollector")
+	proto.RegisterType((*ConfigClientMachineSoak)(nil), "dbtesterpb.ConfigClientMachineSoak")
+	proto.RegisterType((*ConfigClientMachineErrorBudget)(nil), "dbtesterpb.ConfigClientMachineErrorBudget")
+	proto.RegisterType((*ConfigRandomNemesis)(nil), "dbtesterpb.ConfigRandomNemesis")
 }
 func (m *ConfigClientMachineInitial) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -187,6 +800,176 @@ func (m *ConfigClientMachineInitial) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ServerDiskSpaceUsageSummaryPath)))
 		i += copy(dAtA[i:], m.ServerDiskSpaceUsageSummaryPath)
 	}
+	if len(m.ServerSystemMetricsPath) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ServerSystemMetricsPath)))
+		i += copy(dAtA[i:], m.ServerSystemMetricsPath)
+	}
+	if len(m.ServerSystemMetricsInterpolatedPath) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ServerSystemMetricsInterpolatedPath)))
+		i += copy(dAtA[i:], m.ServerSystemMetricsInterpolatedPath)
+	}
+	if len(m.NemesisEventsPath) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.NemesisEventsPath)))
+		i += copy(dAtA[i:], m.NemesisEventsPath)
+	}
+	if len(m.RunMetadataPath) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.RunMetadataPath)))
+		i += copy(dAtA[i:], m.RunMetadataPath)
+	}
+	if len(m.ClientThroughputCeilingPath) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientThroughputCeilingPath)))
+		i += copy(dAtA[i:], m.ClientThroughputCeilingPath)
+	}
+	if len(m.RunID) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.RunID)))
+		i += copy(dAtA[i:], m.RunID)
+	}
+	if len(m.ClientLatencyHistogramLogPath) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientLatencyHistogramLogPath)))
+		i += copy(dAtA[i:], m.ClientLatencyHistogramLogPath)
+	}
+	if m.Anonymize {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Anonymize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.ClientRequestLogPath) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientRequestLogPath)))
+		i += copy(dAtA[i:], m.ClientRequestLogPath)
+	}
+	if len(m.ClientWatchEventsPath) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientWatchEventsPath)))
+		i += copy(dAtA[i:], m.ClientWatchEventsPath)
+	}
+	if len(m.ClientResultDatabasePath) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientResultDatabasePath)))
+		i += copy(dAtA[i:], m.ClientResultDatabasePath)
+	}
+	if len(m.RunTags) > 0 {
+		for _, s := range m.RunTags {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ClientLeaseExpiryPath) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientLeaseExpiryPath)))
+		i += copy(dAtA[i:], m.ClientLeaseExpiryPath)
+	}
+	if len(m.ClientBatchWritesPath) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientBatchWritesPath)))
+		i += copy(dAtA[i:], m.ClientBatchWritesPath)
+	}
+	if len(m.ClientRollingRestartPath) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientRollingRestartPath)))
+		i += copy(dAtA[i:], m.ClientRollingRestartPath)
+	}
+	if len(m.ClientRangeLatencyPath) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientRangeLatencyPath)))
+		i += copy(dAtA[i:], m.ClientRangeLatencyPath)
+	}
+	if m.MonitorIntervalMs != 0 {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MonitorIntervalMs))
+	}
+	if len(m.ClientSoakRollupPath) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientSoakRollupPath)))
+		i += copy(dAtA[i:], m.ClientSoakRollupPath)
+	}
+	if len(m.ClientLeaseExpiryAccuracyPath) > 0 {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientLeaseExpiryAccuracyPath)))
+		i += copy(dAtA[i:], m.ClientLeaseExpiryAccuracyPath)
+	}
+	if len(m.ClientRequestTimeoutsPath) > 0 {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientRequestTimeoutsPath)))
+		i += copy(dAtA[i:], m.ClientRequestTimeoutsPath)
+	}
+	if len(m.ClientConnectionEventsPath) > 0 {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientConnectionEventsPath)))
+		i += copy(dAtA[i:], m.ClientConnectionEventsPath)
+	}
 	if len(m.GoogleCloudProjectName) > 0 {
 		dAtA[i] = 0xa2
 		i++
@@ -319,99 +1102,375 @@ func (m *ConfigClientMachineBenchmarkOptions) MarshalTo(dAtA []byte) (int, error
 		}
 		i++
 	}
-	return i, nil
-}
-
-func (m *ConfigClientMachineBenchmarkSteps) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.ConnectionChurnPerSecond != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConnectionChurnPerSecond))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConfigClientMachineBenchmarkSteps) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Step1StartDatabase {
-		dAtA[i] = 0x8
+	if len(m.KeyPrefix) > 0 {
+		dAtA[i] = 0x62
 		i++
-		if m.Step1StartDatabase {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.KeyPrefix)))
+		i += copy(dAtA[i:], m.KeyPrefix)
+	}
+	if len(m.TenantGroups) > 0 {
+		for _, msg := range m.TenantGroups {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i++
 	}
-	if m.Step2StressDatabase {
-		dAtA[i] = 0x10
+	if m.ConsistencyCheck {
+		dAtA[i] = 0x70
 		i++
-		if m.Step2StressDatabase {
+		if m.ConsistencyCheck {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i++
 	}
-	if m.Step3StopDatabase {
-		dAtA[i] = 0x18
+	if m.ThroughputCeiling != nil {
+		dAtA[i] = 0x7a
 		i++
-		if m.Step3StopDatabase {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ThroughputCeiling.Size()))
+		n3, err := m.ThroughputCeiling.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.LatencySLOMs) > 0 {
+		dAtA5 := make([]byte, len(m.LatencySLOMs)*10)
+		var j4 int
+		for _, num4 := range m.LatencySLOMs {
+			num := uint64(num4)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
 		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j4))
+		i += copy(dAtA[i:], dAtA5[:j4])
 	}
-	if m.Step4UploadLogs {
-		dAtA[i] = 0x20
+	if m.ReadPercent != 0 {
+		dAtA[i] = 0x88
 		i++
-		if m.Step4UploadLogs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ReadPercent))
 	}
-	return i, nil
-}
-
-func (m *ConfigClientMachineAgentControl) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.Retry != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Retry.Size()))
+		n6, err := m.Retry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.DatabaseID) > 0 {
-		dAtA[i] = 0xa
+	if m.Connection != nil {
+		dAtA[i] = 0x9a
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseID)))
-		i += copy(dAtA[i:], m.DatabaseID)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Connection.Size()))
+		n7, err := m.Connection.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
 	}
-	if len(m.DatabaseDescription) > 0 {
-		dAtA[i] = 0x12
+	if m.Seed != 0 {
+		dAtA[i] = 0xa0
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseDescription)))
-		i += copy(dAtA[i:], m.DatabaseDescription)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Seed))
 	}
-	if len(m.DatabaseTag) > 0 {
-		dAtA[i] = 0x1a
+	if len(m.ReplayRequestLogPath) > 0 {
+		dAtA[i] = 0xaa
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseTag)))
-		i += copy(dAtA[i:], m.DatabaseTag)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ReplayRequestLogPath)))
+		i += copy(dAtA[i:], m.ReplayRequestLogPath)
+	}
+	if m.WatchCompaction != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.WatchCompaction.Size()))
+		n8, err := m.WatchCompaction.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.SessionExpiry != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.SessionExpiry.Size()))
+		n9, err := m.SessionExpiry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.LeaseExpiry != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.LeaseExpiry.Size()))
+		n10, err := m.LeaseExpiry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.BatchSizes) > 0 {
+		dAtA12 := make([]byte, len(m.BatchSizes)*10)
+		var j11 int
+		for _, num11 := range m.BatchSizes {
+			num := uint64(num11)
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j11))
+		i += copy(dAtA[i:], dAtA12[:j11])
+	}
+	if len(m.KeyOrder) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.KeyOrder)))
+		i += copy(dAtA[i:], m.KeyOrder)
+	}
+	if m.PhaseKeyPrefix {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.PhaseKeyPrefix {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DeleteKeyPrefix {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.DeleteKeyPrefix {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.RangeSizes) > 0 {
+		dAtA14 := make([]byte, len(m.RangeSizes)*10)
+		var j13 int
+		for _, num13 := range m.RangeSizes {
+			num := uint64(num13)
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j13))
+		i += copy(dAtA[i:], dAtA14[:j13])
+	}
+	if m.Soak != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Soak.Size()))
+		n15, err := m.Soak.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.ErrorBudget != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ErrorBudget.Size()))
+		n16, err := m.ErrorBudget.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if len(m.RequestTimeoutsMs) > 0 {
+		dAtA18 := make([]byte, len(m.RequestTimeoutsMs)*10)
+		var j17 int
+		for _, num17 := range m.RequestTimeoutsMs {
+			num := uint64(num17)
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j17))
+		i += copy(dAtA[i:], dAtA18[:j17])
+	}
+	if len(m.KeyspaceSnapshotPath) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.KeyspaceSnapshotPath)))
+		i += copy(dAtA[i:], m.KeyspaceSnapshotPath)
+	}
+	if m.KeyStartIndex != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.KeyStartIndex))
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineBenchmarkSteps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineBenchmarkSteps) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Step1StartDatabase {
+		dAtA[i] = 0x8
+		i++
+		if m.Step1StartDatabase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Step2StressDatabase {
+		dAtA[i] = 0x10
+		i++
+		if m.Step2StressDatabase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Step3StopDatabase {
+		dAtA[i] = 0x18
+		i++
+		if m.Step3StopDatabase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Step4UploadLogs {
+		dAtA[i] = 0x20
+		i++
+		if m.Step4UploadLogs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineAgentControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DatabaseID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseID)))
+		i += copy(dAtA[i:], m.DatabaseID)
+	}
+	if len(m.DatabaseDescription) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseDescription)))
+		i += copy(dAtA[i:], m.DatabaseDescription)
+	}
+	if len(m.DatabaseTag) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseTag)))
+		i += copy(dAtA[i:], m.DatabaseTag)
 	}
 	if len(m.PeerIPs) > 0 {
 		for _, s := range m.PeerIPs {
@@ -474,17 +1533,43 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.ProxyEndpoints) > 0 {
+		for _, s := range m.ProxyEndpoints {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.DiscoverySRV) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DiscoverySRV)))
+		i += copy(dAtA[i:], m.DiscoverySRV)
+	}
+	if m.DiscoverySRVRefreshSeconds != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DiscoverySRVRefreshSeconds))
+	}
 	if m.Flag_Etcd_Tip != nil {
 		dAtA[i] = 0xa2
 		i++
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_Tip.Size()))
-		n3, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
+		n19, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n19
 	}
 	if m.Flag_Etcd_V3_2 != nil {
 		dAtA[i] = 0xaa
@@ -492,11 +1577,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_2.Size()))
-		n4, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
+		n20, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n20
 	}
 	if m.Flag_Etcd_V3_3 != nil {
 		dAtA[i] = 0xb2
@@ -504,11 +1589,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_3.Size()))
-		n5, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
+		n21, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n21
 	}
 	if m.Flag_Zookeeper_R3_5_3Beta != nil {
 		dAtA[i] = 0xc2
@@ -516,11 +1601,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_3Beta.Size()))
-		n6, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
+		n22, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n22
 	}
 	if m.Flag_Consul_V1_0_2 != nil {
 		dAtA[i] = 0xe2
@@ -528,11 +1613,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Consul_V1_0_2.Size()))
-		n7, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
+		n23, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n23
 	}
 	if m.Flag_Cetcd_Beta != nil {
 		dAtA[i] = 0x82
@@ -540,11 +1625,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x19
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Cetcd_Beta.Size()))
-		n8, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
+		n24, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n24
 	}
 	if m.Flag_Zetcd_Beta != nil {
 		dAtA[i] = 0xa2
@@ -552,11 +1637,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1f
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zetcd_Beta.Size()))
-		n9, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
+		n25, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n25
 	}
 	if m.ConfigClientMachineBenchmarkOptions != nil {
 		dAtA[i] = 0xc2
@@ -564,11 +1649,11 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3e
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkOptions.Size()))
-		n10, err := m.ConfigClientMachineBenchmarkOptions.MarshalTo(dAtA[i:])
+		n26, err := m.ConfigClientMachineBenchmarkOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n26
 	}
 	if m.ConfigClientMachineBenchmarkSteps != nil {
 		dAtA[i] = 0xca
@@ -576,245 +1661,6573 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3e
 		i++
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkSteps.Size()))
-		n11, err := m.ConfigClientMachineBenchmarkSteps.MarshalTo(dAtA[i:])
+		n27, err := m.ConfigClientMachineBenchmarkSteps.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n27
 	}
-	return i, nil
-}
-
-func encodeVarintConfigClientMachine(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.ConfigDocker != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigDocker.Size()))
+		n28, err := m.ConfigDocker.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *ConfigClientMachineInitial) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.PathPrefix)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if len(m.NemesisSchedule) > 0 {
+		for _, msg := range m.NemesisSchedule {
+			dAtA[i] = 0xda
+			i++
+			dAtA[i] = 0x3e
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.LogPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ConfigRelease != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigRelease.Size()))
+		n29, err := m.ConfigRelease.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
 	}
-	l = len(m.ClientSystemMetricsPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ConfigSource != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigSource.Size()))
+		n30, err := m.ConfigSource.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
 	}
-	l = len(m.ClientSystemMetricsInterpolatedPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ConfigProfile != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigProfile.Size()))
+		n31, err := m.ConfigProfile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
 	}
-	l = len(m.ClientLatencyThroughputTimeseriesPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ConfigWANTopology != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigWANTopology.Size()))
+		n32, err := m.ConfigWANTopology.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
-	l = len(m.ClientLatencyDistributionAllPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ClientBandwidthMbitPerSecond != 0 {
+		dAtA[i] = 0x81
+		i++
+		dAtA[i] = 0x3f
+		i++
+		i = encodeFixed64ConfigClientMachine(dAtA, i, uint64(math.Float64bits(float64(m.ClientBandwidthMbitPerSecond))))
 	}
-	l = len(m.ClientLatencyDistributionPercentilePath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ConfigDiskDelay != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x3f
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigDiskDelay.Size()))
+		n33, err := m.ConfigDiskDelay.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
 	}
-	l = len(m.ClientLatencyDistributionSummaryPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ConfigRollingRestart != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x3f
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigRollingRestart.Size()))
+		n34, err := m.ConfigRollingRestart.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
 	}
-	l = len(m.ClientLatencyByKeyNumberPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if len(m.Collectors) > 0 {
+		for _, msg := range m.Collectors {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x3f
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.ServerDiskSpaceUsageSummaryPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ConfigRandomNemesis != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x3f
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigRandomNemesis.Size()))
+		n35, err := m.ConfigRandomNemesis.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
 	}
-	l = len(m.GoogleCloudProjectName)
-	if l > 0 {
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if len(m.DatabaseEnv) > 0 {
+		for _, s := range m.DatabaseEnv {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x3f
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	l = len(m.GoogleCloudStorageKeyPath)
-	if l > 0 {
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	return i, nil
+}
+
+func (m *ConfigDocker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.GoogleCloudStorageKey)
-	if l > 0 {
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ConfigDocker) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Image) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Image)))
+		i += copy(dAtA[i:], m.Image)
 	}
-	l = len(m.GoogleCloudStorageBucketName)
-	if l > 0 {
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if len(m.Tag) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Tag)))
+		i += copy(dAtA[i:], m.Tag)
 	}
-	l = len(m.GoogleCloudStorageSubDirectory)
-	if l > 0 {
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if len(m.Command) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Command)))
+		i += copy(dAtA[i:], m.Command)
 	}
-	return n
+	if len(m.Volumes) > 0 {
+		for _, s := range m.Volumes {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Network) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Network)))
+		i += copy(dAtA[i:], m.Network)
+	}
+	return i, nil
 }
 
-func (m *ConfigClientMachineBenchmarkOptions) Size() (n int) {
+func (m *ConfigClientMachineTenantGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineTenantGroup) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.RequestNumber != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.RequestNumber))
+	if m.ClientNumber != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ClientNumber))
 	}
 	if m.ConnectionNumber != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.ConnectionNumber))
-	}
-	if m.ClientNumber != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.ClientNumber))
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConnectionNumber))
 	}
-	if len(m.ConnectionClientNumbers) > 0 {
-		l = 0
-		for _, e := range m.ConnectionClientNumbers {
-			l += sovConfigClientMachine(uint64(e))
-		}
-		n += 1 + sovConfigClientMachine(uint64(l)) + l
+	if m.RequestNumber != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RequestNumber))
 	}
 	if m.RateLimitRequestsPerSecond != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.RateLimitRequestsPerSecond))
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RateLimitRequestsPerSecond))
 	}
-	if m.SameKey {
-		n += 2
+	if len(m.KeyPrefix) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.KeyPrefix)))
+		i += copy(dAtA[i:], m.KeyPrefix)
 	}
-	if m.KeySizeBytes != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.KeySizeBytes))
+	return i, nil
+}
+
+func (m *ConfigNemesisStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.ValueSizeBytes != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.ValueSizeBytes))
+	return dAtA[:n], nil
+}
+
+func (m *ConfigNemesisStep) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operation) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Operation)))
+		i += copy(dAtA[i:], m.Operation)
 	}
-	if m.StaleRead {
-		n += 2
+	if m.TargetIndex != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.TargetIndex))
 	}
-	return n
+	if m.DelaySeconds != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DelaySeconds))
+	}
+	if m.DurationSeconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DurationSeconds))
+	}
+	if m.ClockSkewMilliseconds != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ClockSkewMilliseconds))
+	}
+	return i, nil
 }
 
-func (m *ConfigClientMachineBenchmarkSteps) Size() (n int) {
+func (m *ConfigRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigRelease) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Step1StartDatabase {
-		n += 2
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
 	}
-	if m.Step2StressDatabase {
-		n += 2
+	if len(m.URL) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
 	}
-	if m.Step3StopDatabase {
-		n += 2
+	if len(m.SHA256) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.SHA256)))
+		i += copy(dAtA[i:], m.SHA256)
 	}
-	if m.Step4UploadLogs {
-		n += 2
+	return i, nil
+}
+
+func (m *ConfigSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ConfigClientMachineAgentControl) Size() (n int) {
+func (m *ConfigSource) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DatabaseID)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if len(m.Repository) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Repository)))
+		i += copy(dAtA[i:], m.Repository)
 	}
-	l = len(m.DatabaseDescription)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if len(m.Revision) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Revision)))
+		i += copy(dAtA[i:], m.Revision)
 	}
-	l = len(m.DatabaseTag)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if len(m.BuildCommand) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.BuildCommand)))
+		i += copy(dAtA[i:], m.BuildCommand)
 	}
-	if len(m.PeerIPs) > 0 {
-		for _, s := range m.PeerIPs {
-			l = len(s)
-			n += 1 + l + sovConfigClientMachine(uint64(l))
-		}
+	if len(m.BinaryPath) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.BinaryPath)))
+		i += copy(dAtA[i:], m.BinaryPath)
 	}
-	l = len(m.PeerIPsString)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	return i, nil
+}
+
+func (m *ConfigProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.AgentPortToConnect != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.AgentPortToConnect))
+	return dAtA[:n], nil
+}
+
+func (m *ConfigProfile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AtSeconds) > 0 {
+		dAtA37 := make([]byte, len(m.AtSeconds)*10)
+		var j36 int
+		for _, num36 := range m.AtSeconds {
+			num := uint64(num36)
+			for num >= 1<<7 {
+				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j36++
+			}
+			dAtA37[j36] = uint8(num)
+			j36++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j36))
+		i += copy(dAtA[i:], dAtA37[:j36])
 	}
-	if len(m.AgentEndpoints) > 0 {
-		for _, s := range m.AgentEndpoints {
+	if m.CPUSeconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.CPUSeconds))
+	}
+	if m.Perf {
+		dAtA[i] = 0x18
+		i++
+		if m.Perf {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PerfArgs) > 0 {
+		for _, s := range m.PerfArgs {
+			dAtA[i] = 0x22
+			i++
 			l = len(s)
-			n += 1 + l + sovConfigClientMachine(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if m.DatabasePortToConnect != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.DatabasePortToConnect))
+	return i, nil
+}
+
+func (m *ConfigClientMachineThroughputCeiling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DatabaseEndpoints) > 0 {
-		for _, s := range m.DatabaseEndpoints {
-			l = len(s)
-			n += 1 + l + sovConfigClientMachine(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineThroughputCeiling) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StartRequestsPerSecond != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.StartRequestsPerSecond))
+	}
+	if m.StepRequestsPerSecond != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.StepRequestsPerSecond))
+	}
+	if m.StepSeconds != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.StepSeconds))
+	}
+	if m.MaxRequestsPerSecond != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MaxRequestsPerSecond))
+	}
+	if m.LatencyP99Ms != 0 {
+		dAtA[i] = 0x29
+		i++
+		i = encodeFixed64ConfigClientMachine(dAtA, i, uint64(math.Float64bits(float64(m.LatencyP99Ms))))
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineRetry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MaxAttempts))
+	}
+	if m.InitialBackoffMs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.InitialBackoffMs))
+	}
+	if m.MaxBackoffMs != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MaxBackoffMs))
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineConnection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineConnection) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DialTimeoutMs != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DialTimeoutMs))
+	}
+	if m.KeepAliveTimeMs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.KeepAliveTimeMs))
+	}
+	if m.KeepAliveTimeoutMs != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.KeepAliveTimeoutMs))
+	}
+	if m.MaxConcurrentStreams != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MaxConcurrentStreams))
+	}
+	if m.MaxIdleConnsPerHost != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MaxIdleConnsPerHost))
+	}
+	if m.IdleConnTimeoutMs != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.IdleConnTimeoutMs))
+	}
+	if m.Warm {
+		dAtA[i] = 0x38
+		i++
+		if m.Warm {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if m.Flag_Etcd_Tip != nil {
-		l = m.Flag_Etcd_Tip.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	return i, nil
+}
+
+func (m *ConfigClientMachineWatchCompaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Flag_Etcd_V3_2 != nil {
-		l = m.Flag_Etcd_V3_2.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineWatchCompaction) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.WatcherNumber != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.WatcherNumber))
 	}
-	if m.Flag_Etcd_V3_3 != nil {
-		l = m.Flag_Etcd_V3_3.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if m.CompactionIntervalSeconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.CompactionIntervalSeconds))
 	}
-	if m.Flag_Zookeeper_R3_5_3Beta != nil {
-		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if m.CompactionRetainRevisions != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.CompactionRetainRevisions))
 	}
-	if m.Flag_Consul_V1_0_2 != nil {
-		l = m.Flag_Consul_V1_0_2.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	return i, nil
+}
+
+func (m *ConfigClientMachineSessionExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Flag_Cetcd_Beta != nil {
-		l = m.Flag_Cetcd_Beta.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineSessionExpiry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SessionNumber != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.SessionNumber))
 	}
-	if m.Flag_Zetcd_Beta != nil {
-		l = m.Flag_Zetcd_Beta.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if m.EphemeralNodesPerSession != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.EphemeralNodesPerSession))
 	}
-	if m.ConfigClientMachineBenchmarkOptions != nil {
-		l = m.ConfigClientMachineBenchmarkOptions.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if m.SessionTimeoutSeconds != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.SessionTimeoutSeconds))
 	}
-	if m.ConfigClientMachineBenchmarkSteps != nil {
-		l = m.ConfigClientMachineBenchmarkSteps.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if m.PauseAfterSeconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.PauseAfterSeconds))
+	}
+	return i, nil
+}
+
+func (m *ConfigWANTopology) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigWANTopology) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		for _, s := range m.Regions {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Links) > 0 {
+		for _, msg := range m.Links {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ConfigWANLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigWANLink) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.From)))
+		i += copy(dAtA[i:], m.From)
+	}
+	if len(m.To) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.To)))
+		i += copy(dAtA[i:], m.To)
+	}
+	if m.DelayMilliseconds != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DelayMilliseconds))
+	}
+	if m.JitterMilliseconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.JitterMilliseconds))
+	}
+	if m.LossPercent != 0 {
+		dAtA[i] = 0x29
+		i++
+		i = encodeFixed64ConfigClientMachine(dAtA, i, uint64(math.Float64bits(float64(m.LossPercent))))
+	}
+	return i, nil
+}
+
+func (m *ConfigDiskDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigDiskDelay) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ReadDelayMilliseconds != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ReadDelayMilliseconds))
+	}
+	if m.WriteDelayMilliseconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.WriteDelayMilliseconds))
+	}
+	if m.SizeGigabytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.SizeGigabytes))
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineLeaseExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineLeaseExpiry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LeasesPerSecond != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.LeasesPerSecond))
+	}
+	if m.KeysPerLease != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.KeysPerLease))
+	}
+	if m.LeaseTTLSeconds != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.LeaseTTLSeconds))
+	}
+	if m.ExpiryToleranceMilliseconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ExpiryToleranceMilliseconds))
+	}
+	return i, nil
+}
+
+func (m *ConfigRollingRestart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigRollingRestart) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DelaySeconds != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DelaySeconds))
+	}
+	if m.DownSeconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DownSeconds))
+	}
+	if m.Kill {
+		dAtA[i] = 0x18
+		i++
+		if m.Kill {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConfigCollector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigCollector) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Endpoint) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Endpoint)))
+		i += copy(dAtA[i:], m.Endpoint)
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineSoak) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineSoak) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RollupMinutes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RollupMinutes))
+	}
+	if m.TruncateRawData {
+		dAtA[i] = 0x10
+		i++
+		if m.TruncateRawData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineErrorBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineErrorBudget) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxErrorPercent != 0 {
+		dAtA[i] = 0x9
+		i++
+		i = encodeFixed64ConfigClientMachine(dAtA, i, uint64(math.Float64bits(float64(m.MaxErrorPercent))))
+	}
+	if m.WindowSeconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.WindowSeconds))
+	}
+	if m.MinRequests != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MinRequests))
+	}
+	return i, nil
+}
+
+func (m *ConfigRandomNemesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigRandomNemesis) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, s := range m.Operations {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Steps != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Steps))
+	}
+	if m.MinDelaySeconds != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MinDelaySeconds))
+	}
+	if m.MaxDelaySeconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MaxDelaySeconds))
+	}
+	if m.MinDurationSeconds != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MinDurationSeconds))
+	}
+	if m.MaxDurationSeconds != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MaxDurationSeconds))
+	}
+	if m.ClockSkewMilliseconds != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ClockSkewMilliseconds))
+	}
+	if m.Seed != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Seed))
+	}
+	return i, nil
+}
+
+func encodeFixed64ConfigClientMachine(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeVarintConfigClientMachine(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ConfigClientMachineInitial) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PathPrefix)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.LogPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientSystemMetricsPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientSystemMetricsInterpolatedPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyThroughputTimeseriesPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyDistributionAllPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyDistributionPercentilePath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyDistributionSummaryPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyByKeyNumberPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ServerDiskSpaceUsageSummaryPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ServerSystemMetricsPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ServerSystemMetricsInterpolatedPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.NemesisEventsPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.RunMetadataPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientThroughputCeilingPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.RunID)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyHistogramLogPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Anonymize {
+		n += 3
+	}
+	l = len(m.ClientRequestLogPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientWatchEventsPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientResultDatabasePath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.RunTags) > 0 {
+		for _, s := range m.RunTags {
+			l = len(s)
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.ClientLeaseExpiryPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientBatchWritesPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientRollingRestartPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientRangeLatencyPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.MonitorIntervalMs != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.MonitorIntervalMs))
+	}
+	l = len(m.ClientSoakRollupPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLeaseExpiryAccuracyPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientRequestTimeoutsPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientConnectionEventsPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.GoogleCloudProjectName)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.GoogleCloudStorageKeyPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.GoogleCloudStorageKey)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.GoogleCloudStorageBucketName)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.GoogleCloudStorageSubDirectory)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineBenchmarkOptions) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.RequestNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.RequestNumber))
+	}
+	if m.ConnectionNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ConnectionNumber))
+	}
+	if m.ClientNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ClientNumber))
+	}
+	if len(m.ConnectionClientNumbers) > 0 {
+		l = 0
+		for _, e := range m.ConnectionClientNumbers {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 1 + sovConfigClientMachine(uint64(l)) + l
+	}
+	if m.RateLimitRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.RateLimitRequestsPerSecond))
+	}
+	if m.SameKey {
+		n += 2
+	}
+	if m.KeySizeBytes != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.KeySizeBytes))
+	}
+	if m.ValueSizeBytes != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ValueSizeBytes))
+	}
+	if m.StaleRead {
+		n += 2
+	}
+	if m.ConnectionChurnPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ConnectionChurnPerSecond))
+	}
+	l = len(m.KeyPrefix)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.TenantGroups) > 0 {
+		for _, e := range m.TenantGroups {
+			l = e.Size()
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if m.ConsistencyCheck {
+		n += 2
+	}
+	if m.ThroughputCeiling != nil {
+		l = m.ThroughputCeiling.Size()
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.LatencySLOMs) > 0 {
+		l = 0
+		for _, e := range m.LatencySLOMs {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 2 + sovConfigClientMachine(uint64(l)) + l
+	}
+	if m.ReadPercent != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ReadPercent))
+	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Connection != nil {
+		l = m.Connection.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Seed != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.Seed))
+	}
+	l = len(m.ReplayRequestLogPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.WatchCompaction != nil {
+		l = m.WatchCompaction.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.SessionExpiry != nil {
+		l = m.SessionExpiry.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.LeaseExpiry != nil {
+		l = m.LeaseExpiry.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.BatchSizes) > 0 {
+		l = 0
+		for _, e := range m.BatchSizes {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 2 + sovConfigClientMachine(uint64(l)) + l
+	}
+	l = len(m.KeyOrder)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.PhaseKeyPrefix {
+		n += 3
+	}
+	if m.DeleteKeyPrefix {
+		n += 3
+	}
+	if len(m.RangeSizes) > 0 {
+		l = 0
+		for _, e := range m.RangeSizes {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 2 + sovConfigClientMachine(uint64(l)) + l
+	}
+	if m.Soak != nil {
+		l = m.Soak.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ErrorBudget != nil {
+		l = m.ErrorBudget.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.RequestTimeoutsMs) > 0 {
+		l = 0
+		for _, e := range m.RequestTimeoutsMs {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 2 + sovConfigClientMachine(uint64(l)) + l
+	}
+	l = len(m.KeyspaceSnapshotPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.KeyStartIndex != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.KeyStartIndex))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineBenchmarkSteps) Size() (n int) {
+	var l int
+	_ = l
+	if m.Step1StartDatabase {
+		n += 2
+	}
+	if m.Step2StressDatabase {
+		n += 2
+	}
+	if m.Step3StopDatabase {
+		n += 2
+	}
+	if m.Step4UploadLogs {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConfigClientMachineAgentControl) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DatabaseID)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.DatabaseDescription)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.DatabaseTag)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.PeerIPs) > 0 {
+		for _, s := range m.PeerIPs {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.PeerIPsString)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.AgentPortToConnect != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.AgentPortToConnect))
+	}
+	if len(m.AgentEndpoints) > 0 {
+		for _, s := range m.AgentEndpoints {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if m.DatabasePortToConnect != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DatabasePortToConnect))
+	}
+	if len(m.DatabaseEndpoints) > 0 {
+		for _, s := range m.DatabaseEndpoints {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if len(m.ProxyEndpoints) > 0 {
+		for _, s := range m.ProxyEndpoints {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.DiscoverySRV)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.DiscoverySRVRefreshSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DiscoverySRVRefreshSeconds))
+	}
+	if m.Flag_Etcd_Tip != nil {
+		l = m.Flag_Etcd_Tip.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Etcd_V3_2 != nil {
+		l = m.Flag_Etcd_V3_2.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Etcd_V3_3 != nil {
+		l = m.Flag_Etcd_V3_3.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Zookeeper_R3_5_3Beta != nil {
+		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Consul_V1_0_2 != nil {
+		l = m.Flag_Consul_V1_0_2.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Cetcd_Beta != nil {
+		l = m.Flag_Cetcd_Beta.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Zetcd_Beta != nil {
+		l = m.Flag_Zetcd_Beta.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ConfigClientMachineBenchmarkOptions != nil {
+		l = m.ConfigClientMachineBenchmarkOptions.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ConfigClientMachineBenchmarkSteps != nil {
+		l = m.ConfigClientMachineBenchmarkSteps.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ConfigDocker != nil {
+		l = m.ConfigDocker.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.NemesisSchedule) > 0 {
+		for _, e := range m.NemesisSchedule {
+			l = e.Size()
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if m.ConfigRelease != nil {
+		l = m.ConfigRelease.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ConfigSource != nil {
+		l = m.ConfigSource.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ConfigProfile != nil {
+		l = m.ConfigProfile.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ConfigWANTopology != nil {
+		l = m.ConfigWANTopology.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ClientBandwidthMbitPerSecond != 0 {
+		n += 10
+	}
+	if m.ConfigDiskDelay != nil {
+		l = m.ConfigDiskDelay.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ConfigRollingRestart != nil {
+		l = m.ConfigRollingRestart.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.Collectors) > 0 {
+		for _, e := range m.Collectors {
+			l = e.Size()
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if m.ConfigRandomNemesis != nil {
+		l = m.ConfigRandomNemesis.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.DatabaseEnv) > 0 {
+		for _, s := range m.DatabaseEnv {
+			l = len(s)
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigDocker) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.Command)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.Volumes) > 0 {
+		for _, s := range m.Volumes {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.Network)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineTenantGroup) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ClientNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ClientNumber))
+	}
+	if m.ConnectionNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ConnectionNumber))
+	}
+	if m.RequestNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.RequestNumber))
+	}
+	if m.RateLimitRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.RateLimitRequestsPerSecond))
+	}
+	l = len(m.KeyPrefix)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigNemesisStep) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.TargetIndex != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.TargetIndex))
+	}
+	if m.DelaySeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DelaySeconds))
+	}
+	if m.DurationSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DurationSeconds))
+	}
+	if m.ClockSkewMilliseconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ClockSkewMilliseconds))
+	}
+	return n
+}
+
+func (m *ConfigRelease) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.SHA256)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigSource) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Repository)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.BuildCommand)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.BinaryPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigProfile) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.AtSeconds) > 0 {
+		l = 0
+		for _, e := range m.AtSeconds {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 1 + sovConfigClientMachine(uint64(l)) + l
+	}
+	if m.CPUSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.CPUSeconds))
+	}
+	if m.Perf {
+		n += 2
+	}
+	if len(m.PerfArgs) > 0 {
+		for _, s := range m.PerfArgs {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigClientMachineThroughputCeiling) Size() (n int) {
+	var l int
+	_ = l
+	if m.StartRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.StartRequestsPerSecond))
+	}
+	if m.StepRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.StepRequestsPerSecond))
+	}
+	if m.StepSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.StepSeconds))
+	}
+	if m.MaxRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MaxRequestsPerSecond))
+	}
+	if m.LatencyP99Ms != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *ConfigClientMachineRetry) Size() (n int) {
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MaxAttempts))
+	}
+	if m.InitialBackoffMs != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.InitialBackoffMs))
+	}
+	if m.MaxBackoffMs != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MaxBackoffMs))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineConnection) Size() (n int) {
+	var l int
+	_ = l
+	if m.DialTimeoutMs != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DialTimeoutMs))
+	}
+	if m.KeepAliveTimeMs != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.KeepAliveTimeMs))
+	}
+	if m.KeepAliveTimeoutMs != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.KeepAliveTimeoutMs))
+	}
+	if m.MaxConcurrentStreams != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MaxConcurrentStreams))
+	}
+	if m.MaxIdleConnsPerHost != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MaxIdleConnsPerHost))
+	}
+	if m.IdleConnTimeoutMs != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.IdleConnTimeoutMs))
+	}
+	if m.Warm {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConfigClientMachineWatchCompaction) Size() (n int) {
+	var l int
+	_ = l
+	if m.WatcherNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.WatcherNumber))
+	}
+	if m.CompactionIntervalSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.CompactionIntervalSeconds))
+	}
+	if m.CompactionRetainRevisions != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.CompactionRetainRevisions))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineSessionExpiry) Size() (n int) {
+	var l int
+	_ = l
+	if m.SessionNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.SessionNumber))
+	}
+	if m.EphemeralNodesPerSession != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.EphemeralNodesPerSession))
+	}
+	if m.SessionTimeoutSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.SessionTimeoutSeconds))
+	}
+	if m.PauseAfterSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.PauseAfterSeconds))
+	}
+	return n
+}
+
+func (m *ConfigWANTopology) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		for _, s := range m.Regions {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigWANLink) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.DelayMilliseconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DelayMilliseconds))
+	}
+	if m.JitterMilliseconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.JitterMilliseconds))
+	}
+	if m.LossPercent != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *ConfigDiskDelay) Size() (n int) {
+	var l int
+	_ = l
+	if m.ReadDelayMilliseconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ReadDelayMilliseconds))
+	}
+	if m.WriteDelayMilliseconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.WriteDelayMilliseconds))
+	}
+	if m.SizeGigabytes != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.SizeGigabytes))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineLeaseExpiry) Size() (n int) {
+	var l int
+	_ = l
+	if m.LeasesPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.LeasesPerSecond))
+	}
+	if m.KeysPerLease != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.KeysPerLease))
+	}
+	if m.LeaseTTLSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.LeaseTTLSeconds))
+	}
+	if m.ExpiryToleranceMilliseconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ExpiryToleranceMilliseconds))
+	}
+	return n
+}
+
+func (m *ConfigRollingRestart) Size() (n int) {
+	var l int
+	_ = l
+	if m.DelaySeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DelaySeconds))
+	}
+	if m.DownSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DownSeconds))
+	}
+	if m.Kill {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConfigCollector) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigClientMachineSoak) Size() (n int) {
+	var l int
+	_ = l
+	if m.RollupMinutes != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.RollupMinutes))
+	}
+	if m.TruncateRawData {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConfigClientMachineErrorBudget) Size() (n int) {
+	var l int
+	_ = l
+	if m.MaxErrorPercent != 0 {
+		n += 9
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.WindowSeconds))
+	}
+	if m.MinRequests != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MinRequests))
+	}
+	return n
+}
+
+func (m *ConfigRandomNemesis) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, s := range m.Operations {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if m.Steps != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.Steps))
+	}
+	if m.MinDelaySeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MinDelaySeconds))
+	}
+	if m.MaxDelaySeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MaxDelaySeconds))
+	}
+	if m.MinDurationSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MinDurationSeconds))
+	}
+	if m.MaxDurationSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MaxDurationSeconds))
+	}
+	if m.ClockSkewMilliseconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ClockSkewMilliseconds))
+	}
+	if m.Seed != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.Seed))
+	}
+	return n
+}
+
+func sovConfigClientMachine(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozConfigClientMachine(x uint64) (n int) {
+	return sovConfigClientMachine(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineInitial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineInitial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSystemMetricsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSystemMetricsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSystemMetricsInterpolatedPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSystemMetricsInterpolatedPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyThroughputTimeseriesPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyThroughputTimeseriesPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionAllPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyDistributionAllPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionPercentilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyDistributionPercentilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionSummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyDistributionSummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyByKeyNumberPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyByKeyNumberPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerDiskSpaceUsageSummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerDiskSpaceUsageSummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSystemMetricsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerSystemMetricsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSystemMetricsInterpolatedPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerSystemMetricsInterpolatedPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NemesisEventsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NemesisEventsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunMetadataPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunMetadataPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientThroughputCeilingPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientThroughputCeilingPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyHistogramLogPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyHistogramLogPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anonymize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Anonymize = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRequestLogPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientRequestLogPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientWatchEventsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientWatchEventsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientResultDatabasePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientResultDatabasePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunTags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunTags = append(m.RunTags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLeaseExpiryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLeaseExpiryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientBatchWritesPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientBatchWritesPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRollingRestartPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientRollingRestartPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRangeLatencyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientRangeLatencyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorIntervalMs", wireType)
+			}
+			m.MonitorIntervalMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonitorIntervalMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSoakRollupPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSoakRollupPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLeaseExpiryAccuracyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLeaseExpiryAccuracyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRequestTimeoutsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientRequestTimeoutsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientConnectionEventsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientConnectionEventsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKeyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageBucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageBucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageSubDirectory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageSubDirectory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestNumber", wireType)
+			}
+			m.RequestNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionNumber", wireType)
+			}
+			m.ConnectionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientNumber", wireType)
+			}
+			m.ClientNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionClientNumbers", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitRequestsPerSecond", wireType)
+			}
+			m.RateLimitRequestsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SameKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SameKey = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySizeBytes", wireType)
+			}
+			m.KeySizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeySizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeBytes", wireType)
+			}
+			m.ValueSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueSizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StaleRead = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionChurnPerSecond", wireType)
+			}
+			m.ConnectionChurnPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionChurnPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantGroups = append(m.TenantGroups, &ConfigClientMachineTenantGroup{})
+			if err := m.TenantGroups[len(m.TenantGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistencyCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConsistencyCheck = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThroughputCeiling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ThroughputCeiling == nil {
+				m.ThroughputCeiling = &ConfigClientMachineThroughputCeiling{}
+			}
+			if err := m.ThroughputCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LatencySLOMs = append(m.LatencySLOMs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LatencySLOMs = append(m.LatencySLOMs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencySLOMs", wireType)
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPercent", wireType)
+			}
+			m.ReadPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadPercent |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retry == nil {
+				m.Retry = &ConfigClientMachineRetry{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Connection == nil {
+				m.Connection = &ConfigClientMachineConnection{}
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			m.Seed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplayRequestLogPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplayRequestLogPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchCompaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WatchCompaction == nil {
+				m.WatchCompaction = &ConfigClientMachineWatchCompaction{}
+			}
+			if err := m.WatchCompaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionExpiry == nil {
+				m.SessionExpiry = &ConfigClientMachineSessionExpiry{}
+			}
+			if err := m.SessionExpiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeaseExpiry == nil {
+				m.LeaseExpiry = &ConfigClientMachineLeaseExpiry{}
+			}
+			if err := m.LeaseExpiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BatchSizes = append(m.BatchSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BatchSizes = append(m.BatchSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSizes", wireType)
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyOrder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyOrder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseKeyPrefix", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PhaseKeyPrefix = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteKeyPrefix", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeleteKeyPrefix = bool(v != 0)
+		case 29:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RangeSizes = append(m.RangeSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RangeSizes = append(m.RangeSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeSizes", wireType)
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Soak", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Soak == nil {
+				m.Soak = &ConfigClientMachineSoak{}
+			}
+			if err := m.Soak.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ErrorBudget == nil {
+				m.ErrorBudget = &ConfigClientMachineErrorBudget{}
+			}
+			if err := m.ErrorBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RequestTimeoutsMs = append(m.RequestTimeoutsMs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestTimeoutsMs = append(m.RequestTimeoutsMs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimeoutsMs", wireType)
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyspaceSnapshotPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyspaceSnapshotPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyStartIndex", wireType)
+			}
+			m.KeyStartIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyStartIndex |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step1StartDatabase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Step1StartDatabase = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step2StressDatabase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Step2StressDatabase = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step3StopDatabase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Step3StopDatabase = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step4UploadLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Step4UploadLogs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineAgentControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineAgentControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerIPs = append(m.PeerIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPortToConnect", wireType)
+			}
+			m.AgentPortToConnect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentPortToConnect |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentEndpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentEndpoints = append(m.AgentEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabasePortToConnect", wireType)
+			}
+			m.DatabasePortToConnect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabasePortToConnect |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseEndpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseEndpoints = append(m.DatabaseEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyEndpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyEndpoints = append(m.ProxyEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoverySRV", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiscoverySRV = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoverySRVRefreshSeconds", wireType)
+			}
+			m.DiscoverySRVRefreshSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoverySRVRefreshSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Tip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_Tip == nil {
+				m.Flag_Etcd_Tip = &Flag_Etcd_Tip{}
+			}
+			if err := m.Flag_Etcd_Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_V3_2 == nil {
+				m.Flag_Etcd_V3_2 = &Flag_Etcd_V3_2{}
+			}
+			if err := m.Flag_Etcd_V3_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_V3_3 == nil {
+				m.Flag_Etcd_V3_3 = &Flag_Etcd_V3_3{}
+			}
+			if err := m.Flag_Etcd_V3_3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 200:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_3Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zookeeper_R3_5_3Beta == nil {
+				m.Flag_Zookeeper_R3_5_3Beta = &Flag_Zookeeper_R3_5_3Beta{}
+			}
+			if err := m.Flag_Zookeeper_R3_5_3Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 300:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V1_0_2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Consul_V1_0_2 == nil {
+				m.Flag_Consul_V1_0_2 = &Flag_Consul_V1_0_2{}
+			}
+			if err := m.Flag_Consul_V1_0_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 400:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Cetcd_Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Cetcd_Beta == nil {
+				m.Flag_Cetcd_Beta = &Flag_Cetcd_Beta{}
+			}
+			if err := m.Flag_Cetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 500:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zetcd_Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zetcd_Beta == nil {
+				m.Flag_Zetcd_Beta = &Flag_Zetcd_Beta{}
+			}
+			if err := m.Flag_Zetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineBenchmarkOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigClientMachineBenchmarkOptions == nil {
+				m.ConfigClientMachineBenchmarkOptions = &ConfigClientMachineBenchmarkOptions{}
+			}
+			if err := m.ConfigClientMachineBenchmarkOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1001:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineBenchmarkSteps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigClientMachineBenchmarkSteps == nil {
+				m.ConfigClientMachineBenchmarkSteps = &ConfigClientMachineBenchmarkSteps{}
+			}
+			if err := m.ConfigClientMachineBenchmarkSteps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1002:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDocker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigDocker == nil {
+				m.ConfigDocker = &ConfigDocker{}
+			}
+			if err := m.ConfigDocker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1003:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NemesisSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NemesisSchedule = append(m.NemesisSchedule, &ConfigNemesisStep{})
+			if err := m.NemesisSchedule[len(m.NemesisSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1004:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigRelease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigRelease == nil {
+				m.ConfigRelease = &ConfigRelease{}
+			}
+			if err := m.ConfigRelease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1005:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigSource == nil {
+				m.ConfigSource = &ConfigSource{}
+			}
+			if err := m.ConfigSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1006:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigProfile == nil {
+				m.ConfigProfile = &ConfigProfile{}
+			}
+			if err := m.ConfigProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1007:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigWANTopology", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigWANTopology == nil {
+				m.ConfigWANTopology = &ConfigWANTopology{}
+			}
+			if err := m.ConfigWANTopology.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1008:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientBandwidthMbitPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.ClientBandwidthMbitPerSecond = float64(math.Float64frombits(v))
+		case 1009:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDiskDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigDiskDelay == nil {
+				m.ConfigDiskDelay = &ConfigDiskDelay{}
+			}
+			if err := m.ConfigDiskDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1010:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigRollingRestart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigRollingRestart == nil {
+				m.ConfigRollingRestart = &ConfigRollingRestart{}
+			}
+			if err := m.ConfigRollingRestart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1011:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collectors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collectors = append(m.Collectors, &ConfigCollector{})
+			if err := m.Collectors[len(m.Collectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1012:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigRandomNemesis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigRandomNemesis == nil {
+				m.ConfigRandomNemesis = &ConfigRandomNemesis{}
+			}
+			if err := m.ConfigRandomNemesis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1013:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseEnv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseEnv = append(m.DatabaseEnv, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigDocker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigDocker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigDocker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Network = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineTenantGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineTenantGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineTenantGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientNumber", wireType)
+			}
+			m.ClientNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionNumber", wireType)
+			}
+			m.ConnectionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestNumber", wireType)
+			}
+			m.RequestNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitRequestsPerSecond", wireType)
+			}
+			m.RateLimitRequestsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigNemesisStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigNemesisStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigNemesisStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetIndex", wireType)
+			}
+			m.TargetIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetIndex |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelaySeconds", wireType)
+			}
+			m.DelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelaySeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+			}
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockSkewMilliseconds", wireType)
+			}
+			m.ClockSkewMilliseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClockSkewMilliseconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SHA256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SHA256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repository = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildCommand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildCommand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AtSeconds = append(m.AtSeconds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AtSeconds = append(m.AtSeconds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtSeconds", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUSeconds", wireType)
+			}
+			m.CPUSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perf", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Perf = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerfArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerfArgs = append(m.PerfArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineThroughputCeiling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineThroughputCeiling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineThroughputCeiling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRequestsPerSecond", wireType)
+			}
+			m.StartRequestsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepRequestsPerSecond", wireType)
+			}
+			m.StepRequestsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepSeconds", wireType)
+			}
+			m.StepSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequestsPerSecond", wireType)
+			}
+			m.MaxRequestsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyP99Ms", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.LatencyP99Ms = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBackoffMs", wireType)
+			}
+			m.InitialBackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialBackoffMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoffMs", wireType)
+			}
+			m.MaxBackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackoffMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovConfigClientMachine(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozConfigClientMachine(x uint64) (n int) {
-	return sovConfigClientMachine(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
+func (m *ConfigClientMachineConnection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -837,17 +8250,17 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineInitial: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigClientMachineConnection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineInitial: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigClientMachineConnection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathPrefix", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DialTimeoutMs", wireType)
 			}
-			var stringLen uint64
+			m.DialTimeoutMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -857,26 +8270,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.DialTimeoutMs |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PathPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepAliveTimeMs", wireType)
 			}
-			var stringLen uint64
+			m.KeepAliveTimeMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -886,26 +8289,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.KeepAliveTimeMs |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LogPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSystemMetricsPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepAliveTimeoutMs", wireType)
 			}
-			var stringLen uint64
+			m.KeepAliveTimeoutMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -915,26 +8308,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.KeepAliveTimeoutMs |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientSystemMetricsPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSystemMetricsInterpolatedPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrentStreams", wireType)
 			}
-			var stringLen uint64
+			m.MaxConcurrentStreams = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -944,26 +8327,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxConcurrentStreams |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientSystemMetricsInterpolatedPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyThroughputTimeseriesPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIdleConnsPerHost", wireType)
 			}
-			var stringLen uint64
+			m.MaxIdleConnsPerHost = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -973,26 +8346,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxIdleConnsPerHost |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientLatencyThroughputTimeseriesPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionAllPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleConnTimeoutMs", wireType)
 			}
-			var stringLen uint64
+			m.IdleConnTimeoutMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1002,26 +8365,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.IdleConnTimeoutMs |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientLatencyDistributionAllPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionPercentilePath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warm", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1031,26 +8384,67 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Warm = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientLatencyDistributionPercentilePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionSummaryPath", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineWatchCompaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineWatchCompaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineWatchCompaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatcherNumber", wireType)
+			}
+			m.WatcherNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1060,26 +8454,35 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.WatcherNumber |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactionIntervalSeconds", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CompactionIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompactionIntervalSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ClientLatencyDistributionSummaryPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyByKeyNumberPath", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactionRetainRevisions", wireType)
 			}
-			var stringLen uint64
+			m.CompactionRetainRevisions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1089,26 +8492,66 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.CompactionRetainRevisions |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientLatencyByKeyNumberPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerDiskSpaceUsageSummaryPath", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineSessionExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineSessionExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineSessionExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionNumber", wireType)
 			}
-			var stringLen uint64
+			m.SessionNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1118,26 +8561,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SessionNumber |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServerDiskSpaceUsageSummaryPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 100:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudProjectName", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralNodesPerSession", wireType)
 			}
-			var stringLen uint64
+			m.EphemeralNodesPerSession = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1147,26 +8580,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.EphemeralNodesPerSession |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GoogleCloudProjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 101:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKeyPath", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionTimeoutSeconds", wireType)
 			}
-			var stringLen uint64
+			m.SessionTimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1176,26 +8599,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SessionTimeoutSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GoogleCloudStorageKeyPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 102:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKey", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseAfterSeconds", wireType)
 			}
-			var stringLen uint64
+			m.PauseAfterSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1205,24 +8618,64 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.PauseAfterSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudStorageKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 103:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigWANTopology) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigWANTopology: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigWANTopology: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageBucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1247,13 +8700,13 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudStorageBucketName = string(dAtA[iNdEx:postIndex])
+			m.Regions = append(m.Regions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 104:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageSubDirectory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1263,20 +8716,22 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudStorageSubDirectory = string(dAtA[iNdEx:postIndex])
+			m.Links = append(m.Links, &ConfigWANLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1299,7 +8754,7 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
+func (m *ConfigWANLink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1322,15 +8777,15 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigWANLink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigWANLink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1355,13 +8810,13 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			m.RequestNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1371,16 +8826,26 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestNumber |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayMilliseconds", wireType)
 			}
-			m.ConnectionNumber = 0
+			m.DelayMilliseconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1390,16 +8855,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConnectionNumber |= (int64(b) & 0x7F) << shift
+				m.DelayMilliseconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JitterMilliseconds", wireType)
 			}
-			m.ClientNumber = 0
+			m.JitterMilliseconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1409,78 +8874,122 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientNumber |= (int64(b) & 0x7F) << shift
+				m.JitterMilliseconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowConfigClientMachine
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossPercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.LossPercent = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigDiskDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigDiskDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigDiskDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadDelayMilliseconds", wireType)
+			}
+			m.ReadDelayMilliseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
 				}
-				m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowConfigClientMachine
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthConfigClientMachine
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadDelayMilliseconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteDelayMilliseconds", wireType)
+			}
+			m.WriteDelayMilliseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowConfigClientMachine
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteDelayMilliseconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionClientNumbers", wireType)
 			}
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitRequestsPerSecond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeGigabytes", wireType)
 			}
-			m.RateLimitRequestsPerSecond = 0
+			m.SizeGigabytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1490,16 +8999,66 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RateLimitRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				m.SizeGigabytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineLeaseExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineLeaseExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineLeaseExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SameKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeasesPerSecond", wireType)
 			}
-			var v int
+			m.LeasesPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1509,17 +9068,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.LeasesPerSecond |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SameKey = bool(v != 0)
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysPerLease", wireType)
 			}
-			m.KeySizeBytes = 0
+			m.KeysPerLease = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1529,16 +9087,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeySizeBytes |= (int64(b) & 0x7F) << shift
+				m.KeysPerLease |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseTTLSeconds", wireType)
 			}
-			m.ValueSizeBytes = 0
+			m.LeaseTTLSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1548,16 +9106,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValueSizeBytes |= (int64(b) & 0x7F) << shift
+				m.LeaseTTLSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaleRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryToleranceMilliseconds", wireType)
 			}
-			var v int
+			m.ExpiryToleranceMilliseconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1567,12 +9125,11 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.ExpiryToleranceMilliseconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StaleRead = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
@@ -1594,7 +9151,7 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
+func (m *ConfigRollingRestart) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1617,17 +9174,17 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigRollingRestart: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigRollingRestart: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step1StartDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelaySeconds", wireType)
 			}
-			var v int
+			m.DelaySeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1637,17 +9194,16 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.DelaySeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Step1StartDatabase = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step2StressDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownSeconds", wireType)
 			}
-			var v int
+			m.DownSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1657,35 +9213,14 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.DownSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Step2StressDatabase = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step3StopDatabase", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowConfigClientMachine
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Step3StopDatabase = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step4UploadLogs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kill", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1702,7 +9237,7 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Step4UploadLogs = bool(v != 0)
+			m.Kill = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
@@ -1724,7 +9259,7 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
+func (m *ConfigCollector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1747,15 +9282,15 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineAgentControl: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigCollector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineAgentControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigCollector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1780,11 +9315,11 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseDescription", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1809,11 +9344,11 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseDescription = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1838,11 +9373,11 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1867,11 +9402,11 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerIPs = append(m.PeerIPs, string(dAtA[iNdEx:postIndex]))
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1896,13 +9431,63 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineSoak) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineSoak: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineSoak: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentPortToConnect", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollupMinutes", wireType)
 			}
-			m.AgentPortToConnect = 0
+			m.RollupMinutes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1912,16 +9497,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AgentPortToConnect |= (int64(b) & 0x7F) << shift
+				m.RollupMinutes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentEndpoints", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncateRawData", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1931,26 +9516,85 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.TruncateRawData = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineErrorBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineErrorBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineErrorBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxErrorPercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentEndpoints = append(m.AgentEndpoints, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 8:
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.MaxErrorPercent = float64(math.Float64frombits(v))
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabasePortToConnect", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
 			}
-			m.DatabasePortToConnect = 0
+			m.WindowSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1960,16 +9604,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatabasePortToConnect |= (int64(b) & 0x7F) << shift
+				m.WindowSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseEndpoints", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRequests", wireType)
 			}
-			var stringLen uint64
+			m.MinRequests = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1979,59 +9623,66 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MinRequests |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseEndpoints = append(m.DatabaseEndpoints, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 100:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Tip", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowConfigClientMachine
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthConfigClientMachine
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigRandomNemesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_Tip == nil {
-				m.Flag_Etcd_Tip = &Flag_Etcd_Tip{}
-			}
-			if err := m.Flag_Etcd_Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 101:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigRandomNemesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigRandomNemesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -2041,30 +9692,26 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_V3_2 == nil {
-				m.Flag_Etcd_V3_2 = &Flag_Etcd_V3_2{}
-			}
-			if err := m.Flag_Etcd_V3_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operations = append(m.Operations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 102:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_3", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var msglen int
+			m.Steps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -2074,30 +9721,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Steps |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Etcd_V3_3 == nil {
-				m.Flag_Etcd_V3_3 = &Flag_Etcd_V3_3{}
-			}
-			if err := m.Flag_Etcd_V3_3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 200:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_3Beta", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelaySeconds", wireType)
 			}
-			var msglen int
+			m.MinDelaySeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -2107,30 +9740,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MinDelaySeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Zookeeper_R3_5_3Beta == nil {
-				m.Flag_Zookeeper_R3_5_3Beta = &Flag_Zookeeper_R3_5_3Beta{}
-			}
-			if err := m.Flag_Zookeeper_R3_5_3Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 300:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V1_0_2", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelaySeconds", wireType)
 			}
-			var msglen int
+			m.MaxDelaySeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -2140,30 +9759,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxDelaySeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Consul_V1_0_2 == nil {
-				m.Flag_Consul_V1_0_2 = &Flag_Consul_V1_0_2{}
-			}
-			if err := m.Flag_Consul_V1_0_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 400:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Cetcd_Beta", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDurationSeconds", wireType)
 			}
-			var msglen int
+			m.MinDurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -2173,30 +9778,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MinDurationSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Cetcd_Beta == nil {
-				m.Flag_Cetcd_Beta = &Flag_Cetcd_Beta{}
-			}
-			if err := m.Flag_Cetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 500:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zetcd_Beta", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDurationSeconds", wireType)
 			}
-			var msglen int
+			m.MaxDurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -2206,30 +9797,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxDurationSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Zetcd_Beta == nil {
-				m.Flag_Zetcd_Beta = &Flag_Zetcd_Beta{}
-			}
-			if err := m.Flag_Zetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 1000:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineBenchmarkOptions", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockSkewMilliseconds", wireType)
 			}
-			var msglen int
+			m.ClockSkewMilliseconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -2239,30 +9816,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ClockSkewMilliseconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConfigClientMachineBenchmarkOptions == nil {
-				m.ConfigClientMachineBenchmarkOptions = &ConfigClientMachineBenchmarkOptions{}
-			}
-			if err := m.ConfigClientMachineBenchmarkOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 1001:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineBenchmarkSteps", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
 			}
-			var msglen int
+			m.Seed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -2272,25 +9835,11 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Seed |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConfigClientMachineBenchmarkSteps == nil {
-				m.ConfigClientMachineBenchmarkSteps = &ConfigClientMachineBenchmarkSteps{}
-			}
-			if err := m.ConfigClientMachineBenchmarkSteps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
@@ -2422,111 +9971,309 @@ func init() {
 }
 
 var fileDescriptorConfigClientMachine = []byte{
-	// 1684 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0x4f, 0x73, 0xdb, 0xc6,
-	0x15, 0x0f, 0x4d, 0x3b, 0x96, 0x56, 0xfe, 0xbb, 0xb6, 0x6c, 0x5a, 0x96, 0xb5, 0x32, 0x6c, 0x37,
-	0xca, 0xa4, 0x96, 0x6c, 0xd2, 0xc9, 0x4c, 0x3b, 0xed, 0xb4, 0xa1, 0x94, 0xb6, 0x1e, 0x2b, 0x09,
-	0x0b, 0x2a, 0xee, 0xd4, 0xd3, 0xe9, 0x76, 0x09, 0x3e, 0x41, 0x88, 0x40, 0x2c, 0x8a, 0x5d, 0x78,
-	0x4a, 0xf5, 0xda, 0x99, 0x4e, 0x7b, 0xca, 0x31, 0xc7, 0xf6, 0xde, 0xcf, 0xd1, 0xf1, 0xb1, 0x9f,
-	0x00, 0xd3, 0xda, 0x97, 0xf6, 0x8a, 0xe9, 0x07, 0xc8, 0xec, 0x03, 0x48, 0x02, 0x24, 0x44, 0xf1,
-	0x26, 0xee, 0xfb, 0xfd, 0xdb, 0x05, 0xf6, 0xed, 0x42, 0xe4, 0x7b, 0xfd, 0x9e, 0x06, 0xa5, 0x21,
-	0x0a, 0x7b, 0x3b, 0x8e, 0x0c, 0x0e, 0x3d, 0x97, 0x3b, 0xbe, 0x07, 0x81, 0xe6, 0x03, 0xe1, 0x1c,
-	0x79, 0x01, 0x6c, 0x87, 0x91, 0xd4, 0x92, 0x92, 0x09, 0x6e, 0xed, 0xb1, 0xeb, 0xe9, 0xa3, 0xb8,
-	0xb7, 0xed, 0xc8, 0xc1, 0x8e, 0x2b, 0x5d, 0xb9, 0x83, 0x90, 0x5e, 0x7c, 0x88, 0xbf, 0xf0, 0x07,
-	0xfe, 0x95, 0x51, 0xd7, 0xd6, 0x0a, 0x16, 0x87, 0xbe, 0x70, 0x39, 0x68, 0xa7, 0x9f, 0xd7, 0xd8,
-	0x74, 0xed, 0x44, 0xca, 0x63, 0x80, 0x10, 0xa2, 0x1c, 0xb0, 0x3e, 0x0d, 0x70, 0x64, 0xa0, 0x62,
-	0x3f, 0xaf, 0xde, 0x9d, 0xa1, 0x17, 0xb4, 0x67, 0x8a, 0xce, 0xa4, 0x68, 0xbd, 0xbb, 0x44, 0xd6,
-	0x76, 0x71, 0xbe, 0xbb, 0x38, 0xdd, 0xcf, 0xb3, 0xd9, 0x3e, 0x0f, 0x3c, 0xed, 0x09, 0x9f, 0x7e,
-	0x42, 0x48, 0x47, 0xe8, 0xa3, 0x4e, 0x04, 0x87, 0xde, 0x1f, 0x1a, 0xb5, 0xcd, 0xda, 0xd6, 0x72,
-	0xfb, 0x56, 0x9a, 0x30, 0x3a, 0x14, 0x03, 0xff, 0x87, 0x56, 0x28, 0xf4, 0x11, 0x0f, 0xb1, 0x68,
-	0xd9, 0x05, 0x24, 0x7d, 0x4c, 0x2e, 0xee, 0x4b, 0xd7, 0x0c, 0x34, 0xce, 0x21, 0xe9, 0x46, 0x9a,
-	0xb0, 0xab, 0x19, 0xc9, 0x97, 0x2e, 0x37, 0x44, 0xcb, 0x1e, 0x61, 0x28, 0x27, 0xb7, 0x33, 0xfb,
-	0xee, 0x50, 0x69, 0x18, 0x7c, 0x0e, 0x3a, 0xf2, 0x1c, 0x85, 0xf4, 0x3a, 0xd2, 0x1f, 0xa5, 0x09,
-	0xbb, 0x9f, 0xd1, 0xf3, 0xc7, 0xa2, 0x10, 0xc9, 0x07, 0x19, 0x34, 0x17, 0x3c, 0x4d, 0x85, 0xfe,
-	0xa9, 0x46, 0x1e, 0x54, 0xd4, 0x9e, 0x07, 0x66, 0x59, 0xa4, 0x2f, 0x34, 0xf4, 0xd1, 0xed, 0x3c,
-	0xba, 0x35, 0xd3, 0x84, 0x6d, 0xcf, 0x73, 0xf3, 0x0a, 0xbc, 0xdc, 0x7a, 0x11, 0x79, 0xfa, 0xd7,
-	0x1a, 0x79, 0x94, 0xe1, 0xf6, 0x85, 0x86, 0xc0, 0x19, 0x1e, 0x1c, 0x45, 0x32, 0x76, 0x8f, 0xc2,
-	0x58, 0x1f, 0x78, 0x03, 0x50, 0x10, 0x79, 0x90, 0x4d, 0xfb, 0x02, 0x06, 0x79, 0x96, 0x26, 0xec,
-	0x49, 0x29, 0x88, 0x9f, 0xf1, 0xb8, 0x1e, 0x13, 0xb9, 0x1e, 0x33, 0xf3, 0x28, 0x8b, 0x59, 0xd0,
-	0x3f, 0x92, 0xcd, 0x12, 0x70, 0xcf, 0x53, 0x3a, 0xf2, 0x7a, 0xb1, 0xf6, 0x64, 0xf0, 0xa9, 0xef,
-	0x63, 0x8c, 0xf7, 0x31, 0xc6, 0x4e, 0x9a, 0xb0, 0x8f, 0x2a, 0x63, 0xf4, 0x0b, 0x1c, 0x2e, 0x7c,
-	0x3f, 0x4f, 0x70, 0xa6, 0x30, 0xfd, 0xa6, 0x46, 0x3e, 0x38, 0x15, 0xd4, 0x81, 0xc8, 0x81, 0x40,
-	0x7b, 0x3e, 0x60, 0x88, 0x8b, 0x18, 0xe2, 0x93, 0x34, 0x61, 0xcd, 0xb3, 0x43, 0x84, 0x63, 0x6e,
-	0x9e, 0x65, 0x51, 0x1b, 0xfa, 0xe7, 0x1a, 0x79, 0x78, 0x2a, 0xb6, 0x1b, 0x0f, 0x06, 0x22, 0x1a,
-	0x62, 0x9e, 0x25, 0xcc, 0xd3, 0x4a, 0x13, 0xb6, 0x73, 0x76, 0x1e, 0x95, 0x11, 0xf3, 0x30, 0x0b,
-	0x19, 0xd0, 0x90, 0xac, 0x97, 0x70, 0xed, 0xe1, 0x0b, 0x18, 0x7e, 0x11, 0x0f, 0x7a, 0x10, 0x61,
-	0x80, 0x65, 0x0c, 0xf0, 0xfd, 0x34, 0x61, 0x5b, 0x95, 0x01, 0x7a, 0x43, 0x7e, 0x0c, 0x43, 0x1e,
-	0x20, 0x23, 0x77, 0x9e, 0xab, 0x48, 0x87, 0x84, 0x75, 0x21, 0x7a, 0x0d, 0xd1, 0x9e, 0xa7, 0x8e,
-	0xbb, 0xa1, 0x70, 0xe0, 0x2b, 0x25, 0x5c, 0x28, 0xce, 0x9a, 0x4c, 0xbf, 0x0a, 0x0a, 0x09, 0x66,
-	0xb6, 0xc7, 0x5c, 0x19, 0x0a, 0x8f, 0x0d, 0x67, 0x6a, 0xc6, 0x67, 0xe9, 0xd2, 0xdf, 0x90, 0x5b,
-	0x3f, 0x97, 0xd2, 0xf5, 0x61, 0xd7, 0x97, 0x71, 0xbf, 0x13, 0xc9, 0xaf, 0xc1, 0xd1, 0x5f, 0x88,
-	0x01, 0x34, 0xfa, 0xe8, 0xf8, 0x30, 0x4d, 0xd8, 0x66, 0xe6, 0xe8, 0x22, 0x8e, 0x3b, 0x06, 0xc8,
-	0xc3, 0x0c, 0xc9, 0x03, 0x31, 0x00, 0xcb, 0x3e, 0x45, 0x83, 0x1e, 0x92, 0x3b, 0x85, 0x4a, 0x57,
-	0xcb, 0x48, 0xb8, 0xf0, 0x02, 0xb2, 0x29, 0x01, 0x1a, 0x6c, 0xa5, 0x09, 0x7b, 0x58, 0x61, 0xa0,
-	0x32, 0x30, 0x2e, 0x65, 0x36, 0x97, 0xd3, 0xa5, 0xe8, 0x33, 0xb2, 0x5a, 0x59, 0x6c, 0x1c, 0x1a,
-	0x0f, 0xbb, 0xba, 0x48, 0x25, 0x59, 0x9f, 0x2d, 0xb4, 0x63, 0xe7, 0x18, 0xb2, 0x15, 0x70, 0x31,
-	0xe0, 0x47, 0x69, 0xc2, 0x3e, 0x98, 0x13, 0xb0, 0x87, 0x84, 0x7c, 0x21, 0xe6, 0x0a, 0xd2, 0x98,
-	0x6c, 0xcc, 0xd6, 0xbb, 0x71, 0x6f, 0xcf, 0x8b, 0xc0, 0xd1, 0x32, 0x1a, 0x36, 0x8e, 0xd0, 0xf2,
-	0x71, 0x9a, 0xb0, 0x0f, 0xe7, 0x58, 0xaa, 0xb8, 0xc7, 0xfb, 0x23, 0x8e, 0x65, 0x9f, 0x21, 0x6a,
-	0xfd, 0xf3, 0x02, 0x79, 0x50, 0x71, 0xca, 0xb4, 0x21, 0x70, 0x8e, 0x06, 0x22, 0x3a, 0xfe, 0x32,
-	0x34, 0x5b, 0x40, 0xd1, 0x07, 0xe4, 0xfc, 0xc1, 0x30, 0x84, 0xfc, 0xa0, 0xb9, 0x9a, 0x26, 0x6c,
-	0x25, 0x0b, 0xa1, 0x87, 0x21, 0x58, 0x36, 0x16, 0xe9, 0x4f, 0xc8, 0x65, 0x1b, 0x7e, 0x1f, 0x83,
-	0xd2, 0xd9, 0x0b, 0x8c, 0x27, 0x4c, 0xbd, 0x7d, 0x27, 0x4d, 0xd8, 0x6a, 0x86, 0x8e, 0xb2, 0x72,
-	0xbe, 0x01, 0x2c, 0xbb, 0x8c, 0xa7, 0xbf, 0x20, 0xd7, 0x76, 0x65, 0x10, 0x80, 0x63, 0x4c, 0x73,
-	0x8d, 0x3a, 0x6a, 0xac, 0xa7, 0x09, 0x6b, 0xe4, 0x5b, 0x6a, 0x8c, 0x18, 0xcb, 0xcc, 0xb0, 0xe8,
-	0x8f, 0xc8, 0xa5, 0x6c, 0x42, 0xb9, 0xca, 0x79, 0x54, 0x69, 0xa4, 0x09, 0xbb, 0x59, 0xda, 0x98,
-	0x23, 0x85, 0x12, 0x9a, 0xfe, 0x96, 0xdc, 0x9e, 0x28, 0x16, 0x2b, 0xaa, 0x71, 0x61, 0xb3, 0xbe,
-	0x55, 0x2f, 0xbe, 0xfa, 0x85, 0x38, 0x25, 0x4d, 0x65, 0x0e, 0xbd, 0x6a, 0x11, 0xea, 0x91, 0x35,
-	0x5b, 0x68, 0xd8, 0xf7, 0x06, 0x9e, 0xce, 0x57, 0x40, 0x75, 0x20, 0xea, 0x82, 0x23, 0x83, 0x3e,
-	0xb6, 0xf6, 0x7a, 0xfb, 0xc3, 0x34, 0x61, 0x8f, 0xf2, 0x55, 0x13, 0x1a, 0xb8, 0x6f, 0xc0, 0x3c,
-	0x5f, 0x40, 0x65, 0xba, 0x29, 0x57, 0x88, 0xb7, 0xec, 0x39, 0x62, 0xe6, 0xbc, 0xef, 0x8a, 0x01,
-	0xbe, 0xf0, 0xa6, 0x5b, 0x2f, 0x15, 0xcf, 0x7b, 0x25, 0x06, 0xb8, 0x89, 0x2c, 0x7b, 0x84, 0xa1,
-	0x3f, 0x26, 0x97, 0x5e, 0xc0, 0xb0, 0xeb, 0x9d, 0x40, 0x7b, 0xa8, 0x41, 0x61, 0x47, 0x2d, 0x3d,
-	0x41, 0xb3, 0xe7, 0x94, 0x77, 0x02, 0xbc, 0x67, 0xea, 0x96, 0x5d, 0x82, 0xd3, 0x5d, 0x72, 0xe5,
-	0xa5, 0xf0, 0x63, 0x98, 0x08, 0x2c, 0xa3, 0xc0, 0xdd, 0x34, 0x61, 0xb7, 0x33, 0x81, 0xd7, 0xa6,
-	0x5e, 0x92, 0x98, 0xa2, 0xd0, 0x16, 0x59, 0xee, 0x6a, 0xe1, 0x83, 0x0d, 0xa2, 0x8f, 0xcd, 0x6d,
-	0xa9, 0xbd, 0x9a, 0x26, 0xec, 0x7a, 0x1e, 0xda, 0x94, 0x78, 0x04, 0xa2, 0x6f, 0xd9, 0x13, 0x9c,
-	0x95, 0x9c, 0x23, 0xf7, 0xe7, 0xbd, 0xc8, 0x5d, 0x0d, 0xa1, 0xa2, 0x5f, 0x12, 0x6a, 0xfe, 0x78,
-	0xda, 0xd5, 0x22, 0xd2, 0x7b, 0x42, 0x8b, 0x9e, 0x50, 0xd9, 0x4b, 0xbd, 0xd4, 0x66, 0x69, 0xc2,
-	0xee, 0x8e, 0x3c, 0x20, 0x7c, 0xca, 0x95, 0x01, 0xf1, 0x7e, 0x8e, 0xb2, 0xec, 0x0a, 0x2a, 0xb5,
-	0xc9, 0x0d, 0x33, 0xda, 0xec, 0xea, 0x08, 0x94, 0x1a, 0x2b, 0x9e, 0x43, 0xc5, 0xcd, 0x34, 0x61,
-	0xeb, 0x13, 0xc5, 0x26, 0x57, 0x88, 0x2a, 0x48, 0x56, 0x91, 0xe9, 0x3e, 0xb9, 0x6e, 0x86, 0x5b,
-	0x5d, 0x2d, 0xc3, 0xb1, 0x62, 0x1d, 0x15, 0x37, 0xd2, 0x84, 0xad, 0x4d, 0x14, 0x5b, 0x66, 0xdb,
-	0x87, 0x05, 0xbd, 0x59, 0x22, 0xfd, 0x19, 0xb9, 0x6a, 0x06, 0x9f, 0x7d, 0x15, 0xfa, 0x52, 0xf4,
-	0xf7, 0xa5, 0xab, 0x70, 0x33, 0x2c, 0x15, 0xb7, 0x94, 0xd1, 0x7a, 0xc6, 0x63, 0x44, 0x70, 0x5f,
-	0xba, 0xca, 0xb2, 0xa7, 0x49, 0xd6, 0xdf, 0x2f, 0x13, 0x56, 0xb1, 0xc0, 0x9f, 0xba, 0x10, 0xe8,
-	0x5d, 0x19, 0xe8, 0x48, 0xe2, 0xa5, 0x74, 0xe4, 0xfb, 0x7c, 0x6f, 0xf6, 0x52, 0x3a, 0xca, 0xc9,
-	0xbd, 0xbe, 0x65, 0x17, 0x90, 0xf4, 0x97, 0xe4, 0xc6, 0xe8, 0xd7, 0x1e, 0x28, 0x27, 0xf2, 0xb0,
-	0xeb, 0xe4, 0x17, 0xd4, 0xc2, 0x73, 0x19, 0x0b, 0xf4, 0x27, 0x28, 0xcb, 0xae, 0xe2, 0xd2, 0x1f,
-	0x90, 0x95, 0xd1, 0xf0, 0x81, 0x70, 0xf3, 0xcb, 0xea, 0xed, 0x34, 0x61, 0x37, 0xa6, 0xa4, 0xb4,
-	0x70, 0x2d, 0xbb, 0x88, 0x35, 0x5b, 0xa6, 0x03, 0x10, 0x3d, 0xef, 0x98, 0x95, 0xaa, 0x97, 0xaf,
-	0xc8, 0x21, 0x40, 0xc4, 0xbd, 0x50, 0x59, 0xf6, 0x08, 0x43, 0x7f, 0x4a, 0x2e, 0xe7, 0x7f, 0x76,
-	0x75, 0xe4, 0x05, 0x6e, 0x7e, 0x43, 0x5c, 0x4b, 0x13, 0x76, 0xab, 0x4c, 0x32, 0xcf, 0xdf, 0x0b,
-	0x5c, 0xcb, 0x2e, 0x13, 0x68, 0x87, 0x50, 0x5c, 0xc6, 0x8e, 0x8c, 0xf4, 0x81, 0xcc, 0x9b, 0x46,
-	0xde, 0x06, 0x0a, 0xef, 0x90, 0x30, 0x18, 0x1e, 0xca, 0x48, 0x73, 0x2d, 0x79, 0xde, 0x77, 0x2c,
-	0xbb, 0x82, 0x4b, 0xdb, 0xe4, 0x0a, 0x8e, 0x7e, 0x16, 0xf4, 0x43, 0xe9, 0x05, 0x5a, 0x35, 0x2e,
-	0xe2, 0x4c, 0x0a, 0xa1, 0x32, 0x35, 0x18, 0x01, 0x2c, 0x7b, 0x8a, 0x41, 0x7f, 0x4d, 0x56, 0x47,
-	0xab, 0x52, 0x0e, 0x96, 0xf5, 0x84, 0x07, 0x69, 0xc2, 0xd8, 0xd4, 0x5a, 0xce, 0x64, 0xab, 0x56,
-	0xa0, 0x2f, 0xc8, 0xf5, 0x51, 0x61, 0x92, 0x70, 0x19, 0x13, 0xde, 0x4b, 0x13, 0x76, 0x67, 0x4a,
-	0xb6, 0x10, 0x72, 0x96, 0x47, 0x7f, 0x45, 0xae, 0xe2, 0xc7, 0x13, 0x7e, 0xb5, 0x71, 0xae, 0xbd,
-	0x10, 0xef, 0x27, 0x2b, 0xcd, 0xbb, 0xdb, 0x93, 0xef, 0xab, 0xed, 0x29, 0x48, 0xfb, 0x66, 0x9a,
-	0xb0, 0x6b, 0x99, 0xcf, 0x78, 0xd0, 0xb2, 0x57, 0x0c, 0xec, 0x33, 0xed, 0xf4, 0x0f, 0xbc, 0x90,
-	0xbe, 0x22, 0xd7, 0x8a, 0xac, 0xd7, 0x2d, 0xde, 0xc4, 0x8b, 0xc9, 0x4a, 0x73, 0xfd, 0x34, 0x65,
-	0x83, 0x29, 0x36, 0xab, 0xc9, 0x68, 0x41, 0xfb, 0x65, 0xab, 0x59, 0xa1, 0xdd, 0xc2, 0x0b, 0xc9,
-	0x7c, 0xed, 0x56, 0xa5, 0x76, 0xab, 0xa4, 0xdd, 0xa2, 0x7f, 0xa9, 0x91, 0xf5, 0x8c, 0x38, 0xfe,
-	0x56, 0xe5, 0x3c, 0x6a, 0xf1, 0x8f, 0x79, 0x8b, 0xf7, 0x40, 0x8b, 0xc6, 0x9b, 0x1a, 0x3a, 0x6d,
-	0xcd, 0x3a, 0x55, 0x13, 0xda, 0xf7, 0xd3, 0x84, 0xdd, 0xcb, 0x5c, 0xab, 0x11, 0x96, 0xbd, 0x6a,
-	0x04, 0x5e, 0x8d, 0x8a, 0x76, 0xeb, 0xe3, 0x56, 0x1b, 0xb4, 0xa0, 0x5f, 0x93, 0x9b, 0x99, 0x72,
-	0xf6, 0x55, 0xcc, 0xf9, 0xeb, 0xa7, 0xfc, 0x09, 0x6f, 0x36, 0xfe, 0x71, 0x0e, 0x23, 0x6c, 0xce,
-	0x46, 0x28, 0x03, 0x8b, 0x47, 0x4f, 0xb9, 0x62, 0xd9, 0x57, 0x0c, 0x61, 0x17, 0x07, 0x5f, 0x3e,
-	0x7d, 0xd2, 0xa4, 0xbf, 0x23, 0xd7, 0x73, 0x89, 0x6c, 0x69, 0x70, 0xae, 0xdf, 0xd4, 0xd1, 0xe8,
-	0x5e, 0x85, 0xd1, 0x04, 0x55, 0x6c, 0x52, 0x85, 0x61, 0xcb, 0xbe, 0x8c, 0x16, 0x66, 0x04, 0x67,
-	0x33, 0x76, 0x38, 0x29, 0x38, 0xfc, 0xff, 0x54, 0x87, 0x93, 0x6a, 0x87, 0x93, 0x19, 0x87, 0x57,
-	0x63, 0x87, 0xbf, 0xd5, 0x16, 0xba, 0x8f, 0x35, 0xfe, 0x7b, 0x11, 0x4d, 0x77, 0x8a, 0xa6, 0x0b,
-	0xf0, 0x8a, 0x4d, 0xbf, 0x37, 0xaa, 0x71, 0x99, 0x15, 0xcd, 0xa7, 0xf2, 0x02, 0x57, 0xc1, 0x6f,
-	0x6b, 0x0b, 0x9c, 0xb4, 0x8d, 0xff, 0x65, 0x01, 0x1f, 0x2f, 0x1a, 0x10, 0x59, 0xc5, 0xfe, 0x34,
-	0x89, 0x67, 0x4e, 0x27, 0x65, 0xd9, 0x67, 0x9b, 0xb6, 0x6f, 0xbe, 0xf9, 0xcf, 0xc6, 0x7b, 0x6f,
-	0xde, 0x6e, 0xd4, 0xfe, 0xf5, 0x76, 0xa3, 0xf6, 0xef, 0xb7, 0x1b, 0xb5, 0x6f, 0xdf, 0x6d, 0xbc,
-	0xd7, 0x7b, 0x1f, 0xff, 0xa1, 0xd2, 0xfa, 0x2e, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xef, 0x79, 0xe4,
-	0x4a, 0x12, 0x00, 0x00,
+	// 4854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7b, 0xcf, 0x6f, 0x1c, 0x47,
+	0x76, 0xff, 0x0e, 0x87, 0x32, 0xa5, 0xa2, 0x24, 0x4a, 0xa5, 0x5f, 0xad, 0x9f, 0x4d, 0x97, 0xbc,
+	0x6b, 0xf9, 0xbb, 0x6b, 0xc9, 0x26, 0x6d, 0x03, 0x5a, 0x7c, 0x83, 0x84, 0x3f, 0x64, 0x5b, 0x11,
+	0x29, 0x33, 0x3d, 0xb4, 0x94, 0x38, 0x3f, 0x7a, 0x6b, 0x66, 0x8a, 0xc3, 0xf6, 0xf4, 0x74, 0xf7,
+	0x76, 0xd7, 0x90, 0x1c, 0x05, 0xb9, 0x2d, 0x10, 0xec, 0x9e, 0xf6, 0xb8, 0x97, 0x00, 0x41, 0x80,
+	0x9c, 0x12, 0x04, 0x58, 0x20, 0x7f, 0x84, 0x2f, 0x01, 0x02, 0xe4, 0x18, 0x60, 0x92, 0x75, 0x72,
+	0xd8, 0x24, 0x9b, 0x38, 0x99, 0x6c, 0xee, 0xc1, 0x7b, 0x55, 0x3d, 0x5d, 0xd5, 0xdd, 0x43, 0xd2,
+	0xc8, 0x9e, 0xc4, 0xa9, 0xf7, 0x79, 0x9f, 0xf7, 0xaa, 0xba, 0xea, 0xd5, 0xab, 0x57, 0x25, 0xf2,
+	0xad, 0x6e, 0x5b, 0x8a, 0x4c, 0x8a, 0x34, 0x69, 0x3f, 0xea, 0xc4, 0xd1, 0x5e, 0xd0, 0xf3, 0x3b,
+	0x61, 0x20, 0x22, 0xe9, 0x0f, 0x78, 0x67, 0x3f, 0x88, 0xc4, 0xc3, 0x24, 0x8d, 0x65, 0x4c, 0x49,
+	0x81, 0xbb, 0xf5, 0x76, 0x2f, 0x90, 0xfb, 0xc3, 0xf6, 0xc3, 0x4e, 0x3c, 0x78, 0xd4, 0x8b, 0x7b,
+	0xf1, 0x23, 0x84, 0xb4, 0x87, 0x7b, 0xf8, 0x0b, 0x7f, 0xe0, 0x5f, 0x4a, 0xf5, 0xd6, 0x2d, 0xc3,
+	0xc4, 0x5e, 0xc8, 0x7b, 0xbe, 0x90, 0x9d, 0xae, 0x96, 0xb9, 0x65, 0xd9, 0xab, 0x38, 0xee, 0x0b,
+	0x91, 0x88, 0x54, 0x03, 0xee, 0x94, 0x01, 0x9d, 0x38, 0xca, 0x86, 0xa1, 0x96, 0xde, 0xae, 0xa8,
+	0x1b, 0xdc, 0x15, 0x61, 0xa7, 0x10, 0xb2, 0x2f, 0x6e, 0x93, 0x5b, 0x1b, 0xd8, 0xdf, 0x0d, 0xec,
+	0xee, 0xb6, 0xea, 0xed, 0xd3, 0x28, 0x90, 0x01, 0x0f, 0xe9, 0x07, 0x84, 0xec, 0x70, 0xb9, 0xbf,
+	0x93, 0x8a, 0xbd, 0xe0, 0xc8, 0x69, 0x2c, 0x37, 0x1e, 0x9c, 0x5b, 0xbf, 0x3e, 0x19, 0xbb, 0x74,
+	0xc4, 0x07, 0xe1, 0x77, 0x59, 0xc2, 0xe5, 0xbe, 0x9f, 0xa0, 0x90, 0x79, 0x06, 0x92, 0xbe, 0x4d,
+	0x16, 0xb6, 0xe2, 0x1e, 0x34, 0x38, 0x73, 0xa8, 0x74, 0x65, 0x32, 0x76, 0x97, 0x94, 0x52, 0x18,
+	0xf7, 0x7c, 0x50, 0x64, 0x5e, 0x8e, 0xa1, 0x3e, 0xb9, 0xa1, 0xcc, 0xb7, 0x46, 0x99, 0x14, 0x83,
+	0x6d, 0x21, 0xd3, 0xa0, 0x93, 0xa1, 0x7a, 0x13, 0xd5, 0xbf, 0x39, 0x19, 0xbb, 0xaf, 0x2b, 0x75,
+	0xfd, 0x59, 0x32, 0x44, 0xfa, 0x03, 0x05, 0xd5, 0x84, 0xb3, 0x58, 0xe8, 0x0f, 0x1a, 0xe4, 0x7e,
+	0x8d, 0xec, 0x69, 0x04, 0xc3, 0x12, 0x87, 0x5c, 0x8a, 0x2e, 0x5a, 0x9b, 0x47, 0x6b, 0x2b, 0x93,
+	0xb1, 0xfb, 0xf0, 0x38, 0x6b, 0x81, 0xa1, 0xa7, 0x4d, 0x9f, 0x86, 0x9e, 0xfe, 0xa8, 0x41, 0xbe,
+	0xa9, 0x70, 0x5b, 0x5c, 0x8a, 0xa8, 0x33, 0xda, 0xdd, 0x4f, 0xe3, 0x61, 0x6f, 0x3f, 0x19, 0xca,
+	0xdd, 0x60, 0x20, 0x32, 0x91, 0x06, 0x42, 0x75, 0xfb, 0x0c, 0x3a, 0xf2, 0xde, 0x64, 0xec, 0xbe,
+	0x63, 0x39, 0x12, 0x2a, 0x3d, 0x5f, 0x4e, 0x15, 0x7d, 0x39, 0xd5, 0xd4, 0xae, 0x9c, 0xce, 0x04,
+	0xfd, 0x43, 0xb2, 0x6c, 0x01, 0x37, 0x83, 0x4c, 0xa6, 0x41, 0x7b, 0x28, 0x83, 0x38, 0x5a, 0x0b,
+	0x43, 0x74, 0xe3, 0x35, 0x74, 0xe3, 0xd1, 0x64, 0xec, 0x7e, 0xbb, 0xd6, 0x8d, 0xae, 0xa1, 0xe3,
+	0xf3, 0x30, 0xd4, 0x1e, 0x9c, 0x48, 0x4c, 0x7f, 0xdc, 0x20, 0x6f, 0xce, 0x04, 0xed, 0x88, 0xb4,
+	0x23, 0x22, 0x19, 0x84, 0x02, 0x9d, 0x58, 0x40, 0x27, 0x3e, 0x98, 0x8c, 0xdd, 0x95, 0x93, 0x9d,
+	0x48, 0xa6, 0xba, 0xda, 0x97, 0xd3, 0x9a, 0xa1, 0x7f, 0xdc, 0x20, 0x6f, 0xcc, 0xc4, 0xb6, 0x86,
+	0x83, 0x01, 0x4f, 0x47, 0xe8, 0xcf, 0x59, 0xf4, 0x67, 0x75, 0x32, 0x76, 0x1f, 0x9d, 0xec, 0x4f,
+	0xa6, 0x14, 0xb5, 0x33, 0xa7, 0x32, 0x40, 0x13, 0x72, 0xc7, 0xc2, 0xad, 0x8f, 0x9e, 0x89, 0xd1,
+	0xf3, 0xe1, 0xa0, 0x2d, 0x52, 0x74, 0xe0, 0x1c, 0x3a, 0xf0, 0x9d, 0xc9, 0xd8, 0x7d, 0x50, 0xeb,
+	0x40, 0x7b, 0xe4, 0xf7, 0xc5, 0xc8, 0x8f, 0x50, 0x43, 0x5b, 0x3e, 0x96, 0x91, 0x8e, 0x88, 0xdb,
+	0x12, 0xe9, 0x81, 0x48, 0x37, 0x83, 0xac, 0xdf, 0x4a, 0x78, 0x47, 0x7c, 0x9a, 0xf1, 0x9e, 0x30,
+	0x7b, 0x4d, 0xca, 0x53, 0x21, 0x43, 0x05, 0xe8, 0x6d, 0xdf, 0xcf, 0x40, 0xc5, 0x1f, 0x82, 0x4e,
+	0xa9, 0xc7, 0x27, 0xf1, 0xc2, 0xda, 0x57, 0x90, 0xea, 0xda, 0x5f, 0x2c, 0xaf, 0x7d, 0x6d, 0xb2,
+	0x7e, 0xed, 0xcf, 0x60, 0xc1, 0xb5, 0x5f, 0x23, 0xab, 0xac, 0xfd, 0xf3, 0xe5, 0xb5, 0x5f, 0x6f,
+	0xad, 0x6e, 0xed, 0x9f, 0x82, 0x9e, 0x6e, 0x91, 0xcb, 0xcf, 0xc5, 0x40, 0x64, 0x41, 0xf6, 0xe4,
+	0x40, 0x44, 0x52, 0xf5, 0xf0, 0x02, 0xda, 0xbc, 0x37, 0x19, 0xbb, 0xb7, 0x94, 0xcd, 0x48, 0x41,
+	0x7c, 0x81, 0x18, 0xcd, 0x5f, 0x55, 0xa4, 0x1f, 0x92, 0x25, 0x6f, 0x18, 0x6d, 0x0b, 0xc9, 0xbb,
+	0x5c, 0x72, 0xe4, 0xba, 0x88, 0x5c, 0x77, 0x26, 0x63, 0xd7, 0x51, 0x5c, 0xe9, 0x30, 0xf2, 0x07,
+	0x1a, 0xa1, 0x99, 0xca, 0x4a, 0xb4, 0x4f, 0x6e, 0xab, 0x89, 0x51, 0x84, 0x89, 0x0d, 0x11, 0x84,
+	0x41, 0xa4, 0x82, 0xf7, 0x12, 0x72, 0xbe, 0x35, 0x19, 0xbb, 0xdf, 0xb4, 0x66, 0x9a, 0x11, 0x7e,
+	0x3a, 0x0a, 0xae, 0x0d, 0x1c, 0xc7, 0x46, 0xdf, 0x24, 0x67, 0xbc, 0x61, 0xf4, 0x74, 0xd3, 0xb9,
+	0x84, 0xb4, 0x97, 0x27, 0x63, 0xf7, 0x42, 0xe1, 0x6a, 0xd0, 0x65, 0x9e, 0x92, 0xd3, 0x94, 0xdc,
+	0xb5, 0xa6, 0xeb, 0xc7, 0x41, 0x26, 0xe3, 0x5e, 0xca, 0x07, 0xf9, 0xa6, 0x72, 0xf9, 0x84, 0x15,
+	0xb0, 0x9f, 0x2b, 0xf8, 0xc5, 0x6e, 0x73, 0x3c, 0x25, 0x5d, 0x21, 0xe7, 0xd6, 0xa2, 0x38, 0x1a,
+	0x0d, 0x82, 0x57, 0xc2, 0xa1, 0xcb, 0x8d, 0x07, 0x67, 0xd7, 0xaf, 0x4e, 0xc6, 0xee, 0x25, 0xc5,
+	0xcf, 0x73, 0x11, 0xf3, 0x0a, 0x18, 0x7d, 0x41, 0xae, 0x2a, 0x52, 0x4f, 0x7c, 0x7f, 0x28, 0x32,
+	0x99, 0xbb, 0x77, 0x05, 0xdd, 0x63, 0x93, 0xb1, 0x7b, 0xcf, 0x72, 0x2f, 0x55, 0x30, 0xc3, 0xa9,
+	0x5a, 0x7d, 0xfa, 0x3b, 0xe4, 0x9a, 0x6a, 0x7f, 0xc9, 0x65, 0x67, 0xdf, 0x98, 0x2f, 0x57, 0x91,
+	0xf8, 0xfe, 0x64, 0xec, 0xba, 0x16, 0xf1, 0x21, 0xe0, 0xec, 0x49, 0x53, 0xcf, 0x40, 0xdb, 0xc4,
+	0xc9, 0x4d, 0x66, 0xc3, 0x50, 0x6e, 0x72, 0xc9, 0xdb, 0x3c, 0x53, 0x81, 0xf6, 0x1a, 0xb2, 0x7f,
+	0x6b, 0x32, 0x76, 0x59, 0xc9, 0x6d, 0x80, 0xfa, 0x5d, 0x8d, 0xd5, 0x06, 0x66, 0xf2, 0xc0, 0xee,
+	0xef, 0x0d, 0xa3, 0x5d, 0xde, 0xcb, 0x9c, 0xeb, 0xcb, 0x4d, 0x7b, 0xf7, 0x87, 0x2f, 0x2d, 0x79,
+	0x2f, 0x63, 0x5e, 0x8e, 0x29, 0x7a, 0xbb, 0x25, 0x78, 0x26, 0x9e, 0x1c, 0x25, 0x81, 0x0e, 0x39,
+	0x37, 0x66, 0xf4, 0x36, 0x04, 0x9c, 0x2f, 0x10, 0x68, 0xf7, 0xb6, 0xc4, 0x50, 0x50, 0xaf, 0xc3,
+	0x30, 0xbc, 0x4c, 0x03, 0xa9, 0xf7, 0x57, 0x67, 0x06, 0x75, 0x1b, 0x07, 0xf2, 0x10, 0x81, 0x36,
+	0x75, 0x89, 0xc1, 0x18, 0xc8, 0x38, 0x84, 0x19, 0xee, 0x89, 0x4c, 0xf2, 0x54, 0x22, 0xfb, 0xcd,
+	0x59, 0x03, 0xa9, 0xa0, 0x7e, 0xaa, 0xb0, 0xa5, 0x81, 0xac, 0xf0, 0xd0, 0xdf, 0x23, 0xd7, 0xb5,
+	0x8c, 0x47, 0x3d, 0xa1, 0x67, 0x2e, 0x5a, 0xb8, 0x85, 0x16, 0xde, 0x98, 0x8c, 0xdd, 0x65, 0xdb,
+	0x02, 0x00, 0xa7, 0xcb, 0x40, 0xf1, 0xcf, 0xe0, 0x80, 0x88, 0xb4, 0x1d, 0x47, 0x81, 0x8c, 0x53,
+	0x0c, 0x56, 0x07, 0x3c, 0xdc, 0xce, 0x9c, 0xdb, 0xcb, 0x8d, 0x07, 0x4d, 0x33, 0x22, 0x0d, 0x14,
+	0x44, 0xc5, 0xbd, 0x03, 0x1e, 0xfa, 0x83, 0x8c, 0x79, 0x55, 0xc5, 0x62, 0x2d, 0xb4, 0x62, 0xde,
+	0x87, 0xbe, 0x0c, 0x13, 0xf4, 0xf4, 0xce, 0x8c, 0xb5, 0x90, 0xc5, 0xbc, 0x8f, 0x03, 0x32, 0x4c,
+	0xec, 0xb5, 0x60, 0xeb, 0x1b, 0xb1, 0xa0, 0xf8, 0xb6, 0x6b, 0x9d, 0xce, 0x30, 0xe5, 0x7a, 0x28,
+	0xee, 0xce, 0x8a, 0x05, 0xe6, 0x2c, 0xe1, 0x5a, 0xa3, 0x14, 0x0b, 0xea, 0x29, 0xa9, 0x20, 0x37,
+	0xad, 0x75, 0x09, 0x99, 0x53, 0x3c, 0xd4, 0x6b, 0xf0, 0x1e, 0xda, 0x7b, 0x73, 0x32, 0x76, 0xef,
+	0xd7, 0x2e, 0x6e, 0xa9, 0xc1, 0xda, 0xd4, 0x6c, 0x26, 0xba, 0x4f, 0x6e, 0x29, 0xe1, 0x46, 0x1c,
+	0x45, 0xa2, 0x03, 0x69, 0x80, 0xb1, 0xd6, 0x5d, 0xb4, 0xf3, 0x60, 0x32, 0x76, 0xdf, 0xb0, 0xec,
+	0x74, 0xa6, 0x60, 0x7b, 0xc1, 0x1f, 0xc3, 0x05, 0x13, 0xe9, 0xa3, 0x38, 0xee, 0x85, 0x62, 0x23,
+	0x8c, 0x87, 0xdd, 0x9d, 0x34, 0xfe, 0x5c, 0x74, 0xe4, 0x73, 0x3e, 0x10, 0x4e, 0xb7, 0x3c, 0x91,
+	0x7a, 0x88, 0xf3, 0x3b, 0x00, 0xf4, 0x13, 0x85, 0xf4, 0x23, 0x3e, 0x10, 0xcc, 0x9b, 0xc1, 0x41,
+	0xf7, 0xc8, 0x4d, 0x43, 0xd2, 0x92, 0x71, 0xca, 0x7b, 0xe2, 0x99, 0x50, 0x9f, 0x47, 0x94, 0xbb,
+	0x61, 0x19, 0xc8, 0x14, 0x18, 0xf3, 0x15, 0x3d, 0x5e, 0x33, 0xa9, 0xe8, 0x7b, 0xe4, 0x5a, 0xad,
+	0xd0, 0xd9, 0x03, 0x1b, 0x5e, 0xbd, 0x90, 0xc6, 0xe4, 0x4e, 0x55, 0xb0, 0x3e, 0xec, 0xf4, 0x85,
+	0x1a, 0x81, 0x1e, 0x3a, 0xf8, 0xed, 0xc9, 0xd8, 0x7d, 0xf3, 0x18, 0x07, 0xdb, 0xa8, 0xa0, 0x07,
+	0xe2, 0x58, 0x42, 0x3a, 0x24, 0xf7, 0xaa, 0xf2, 0xd6, 0xb0, 0xbd, 0x19, 0xa4, 0xa2, 0x23, 0xe3,
+	0x74, 0xe4, 0xec, 0xa3, 0xc9, 0xb7, 0x27, 0x63, 0xf7, 0xad, 0x63, 0x4c, 0x66, 0xc3, 0xb6, 0xdf,
+	0xcd, 0x75, 0x98, 0x77, 0x02, 0x29, 0xfb, 0x9b, 0xab, 0xe4, 0x7e, 0xcd, 0x51, 0x6e, 0x5d, 0x44,
+	0x9d, 0xfd, 0x01, 0x4f, 0xfb, 0x9f, 0x24, 0x30, 0x29, 0x32, 0x7a, 0x9f, 0xcc, 0xef, 0x8e, 0x12,
+	0xa1, 0x4f, 0x73, 0x4b, 0x93, 0xb1, 0xbb, 0xa8, 0x9c, 0x90, 0xa3, 0x44, 0x30, 0x0f, 0x85, 0xf4,
+	0xd7, 0xc9, 0x05, 0x3d, 0x63, 0x55, 0x96, 0x88, 0xc7, 0xb8, 0xe6, 0xfa, 0xcd, 0xc9, 0xd8, 0xbd,
+	0xa6, 0xd0, 0xf9, 0x74, 0x57, 0x59, 0x26, 0xf3, 0x6c, 0x3c, 0xfd, 0x98, 0x5c, 0x2a, 0x66, 0xa2,
+	0xe6, 0x68, 0x22, 0x87, 0x91, 0xa1, 0x18, 0x53, 0x39, 0xa7, 0xa9, 0x68, 0xd1, 0xff, 0x4f, 0xce,
+	0xab, 0x0e, 0x69, 0x96, 0x79, 0x64, 0x71, 0x26, 0x63, 0xf7, 0xaa, 0xb5, 0x2e, 0x72, 0x06, 0x0b,
+	0x4d, 0xff, 0x80, 0xdc, 0x28, 0x18, 0x4d, 0x49, 0xe6, 0x9c, 0x59, 0x6e, 0x3e, 0x68, 0x5a, 0x31,
+	0xb4, 0x70, 0xc7, 0xe2, 0xcc, 0xe0, 0x64, 0x59, 0x4f, 0x42, 0x03, 0x72, 0xcb, 0xe3, 0x52, 0x6c,
+	0x05, 0x83, 0x20, 0x5f, 0xe3, 0xd9, 0x8e, 0x48, 0x5b, 0xa2, 0x13, 0x47, 0x5d, 0x3c, 0x3f, 0x35,
+	0xcd, 0xfc, 0x29, 0xe5, 0x52, 0xf8, 0x21, 0x80, 0xf3, 0x78, 0x91, 0xc1, 0x91, 0xc5, 0xcf, 0x10,
+	0xcf, 0xbc, 0x63, 0xc8, 0x60, 0x5b, 0x6d, 0xf1, 0x01, 0x4e, 0xf8, 0x05, 0xcc, 0x4f, 0x8c, 0x6d,
+	0x35, 0xe3, 0x03, 0x5c, 0x44, 0xcc, 0xcb, 0x31, 0xf4, 0xd7, 0xc8, 0xf9, 0x67, 0x62, 0xd4, 0x0a,
+	0x5e, 0x89, 0xf5, 0x91, 0x14, 0x99, 0x73, 0xb6, 0xfc, 0x05, 0x61, 0xcd, 0x65, 0xc1, 0x2b, 0xe1,
+	0xb7, 0x41, 0xce, 0x3c, 0x0b, 0x4e, 0x37, 0xc8, 0xc5, 0x17, 0x3c, 0x1c, 0x8a, 0x82, 0xe0, 0x1c,
+	0x12, 0xdc, 0x9e, 0x8c, 0xdd, 0x1b, 0x8a, 0xe0, 0x00, 0xe4, 0x16, 0x45, 0x49, 0x85, 0xae, 0x92,
+	0x73, 0x2d, 0xc9, 0x43, 0xe1, 0x09, 0xde, 0xc5, 0x13, 0xc4, 0xd9, 0xf5, 0x6b, 0x93, 0xb1, 0x7b,
+	0x59, 0x3b, 0x0d, 0x22, 0x3f, 0x15, 0xbc, 0xcb, 0xbc, 0x02, 0x87, 0x3b, 0x6b, 0x31, 0xda, 0xfb,
+	0xc3, 0x34, 0x2a, 0x06, 0x74, 0x11, 0x7d, 0x30, 0x77, 0x56, 0xe3, 0x9b, 0x01, 0xd4, 0x1a, 0xcd,
+	0x99, 0x3c, 0xe0, 0x18, 0x44, 0x15, 0x55, 0xd7, 0x50, 0x99, 0xbf, 0xe1, 0x18, 0x46, 0x23, 0x5d,
+	0xd6, 0x28, 0x70, 0x74, 0x9f, 0x9c, 0xdf, 0x15, 0x11, 0x8f, 0xe4, 0x47, 0x69, 0x3c, 0x4c, 0x32,
+	0xe7, 0xc2, 0x72, 0xf3, 0xc1, 0xe2, 0xca, 0xff, 0x7b, 0x58, 0x14, 0x58, 0x1e, 0xd6, 0x2c, 0x40,
+	0x43, 0xc5, 0x9c, 0xb5, 0x12, 0x9b, 0xfd, 0x1e, 0x52, 0x31, 0xcf, 0x62, 0xd6, 0xab, 0x27, 0x0b,
+	0x32, 0xdc, 0xad, 0x37, 0xf6, 0x45, 0xa7, 0x8f, 0xf9, 0xfd, 0xd9, 0xd2, 0xea, 0xc9, 0x11, 0x7e,
+	0x07, 0x20, 0x6a, 0xf5, 0x58, 0x5a, 0xf4, 0x8f, 0xc8, 0xe5, 0x4a, 0x32, 0x8e, 0x69, 0xfd, 0xe2,
+	0xca, 0x3b, 0x27, 0x39, 0x5e, 0xd6, 0x5b, 0xbf, 0x3b, 0x19, 0xbb, 0x37, 0xb5, 0xfb, 0x95, 0x13,
+	0x00, 0xf3, 0xaa, 0x96, 0x60, 0x12, 0xea, 0x94, 0xa3, 0xb5, 0xf5, 0xc9, 0x76, 0xe6, 0x5c, 0x5a,
+	0x6e, 0xda, 0x93, 0x30, 0x4f, 0x55, 0xb2, 0x30, 0xc6, 0xcc, 0xc2, 0x82, 0xd3, 0xc7, 0x64, 0x11,
+	0xa6, 0x84, 0x3e, 0xa9, 0x63, 0xda, 0xdf, 0x5c, 0xbf, 0x31, 0x19, 0xbb, 0x57, 0xf2, 0x20, 0xc4,
+	0xbb, 0xf9, 0x91, 0x9f, 0x79, 0x26, 0x96, 0x6e, 0x91, 0x33, 0x9e, 0x90, 0xe9, 0x08, 0x73, 0xf9,
+	0xc5, 0x95, 0x37, 0x4e, 0xe8, 0x2c, 0x62, 0xd7, 0x2f, 0x4d, 0xc6, 0xee, 0xf9, 0x9c, 0x5a, 0x42,
+	0xd4, 0x55, 0x24, 0xf4, 0x7b, 0x84, 0x14, 0x73, 0x09, 0xf3, 0xfb, 0xc5, 0x95, 0xb7, 0x4e, 0xa0,
+	0x2c, 0x14, 0xcc, 0xb9, 0x55, 0x4c, 0x58, 0xe6, 0x19, 0x9c, 0x10, 0x96, 0x5b, 0x42, 0x74, 0x31,
+	0xc5, 0x6f, 0x9a, 0x61, 0x39, 0x13, 0xa2, 0xcb, 0x3c, 0x14, 0x42, 0x92, 0xe5, 0x89, 0x24, 0xe4,
+	0xa3, 0xd2, 0x81, 0xe3, 0x5a, 0x39, 0xc9, 0x4a, 0x11, 0x55, 0x77, 0xe0, 0xa8, 0xd3, 0xa7, 0x43,
+	0xb2, 0x84, 0x07, 0x85, 0x8d, 0x78, 0x90, 0x70, 0xd5, 0xc7, 0xeb, 0xd8, 0xc7, 0x87, 0x27, 0xf4,
+	0xb1, 0xa4, 0x65, 0x46, 0x07, 0x75, 0x26, 0xe9, 0x4c, 0x65, 0xcc, 0x2b, 0xdb, 0xa0, 0x03, 0x72,
+	0xa1, 0x25, 0xb2, 0x0c, 0x72, 0x15, 0x4c, 0xc2, 0x30, 0xe3, 0x5f, 0x5c, 0xf9, 0xce, 0x09, 0x46,
+	0x2d, 0x1d, 0x73, 0x32, 0x65, 0x4a, 0xa0, 0x93, 0x3e, 0xe6, 0xd9, 0xec, 0x54, 0x90, 0x45, 0x23,
+	0xe3, 0xc3, 0x33, 0xc0, 0xc9, 0xcb, 0xd7, 0xd0, 0x30, 0x67, 0x9e, 0x99, 0x5d, 0x32, 0xcf, 0xe4,
+	0x85, 0xa2, 0x29, 0x1e, 0x16, 0x20, 0x0c, 0x66, 0xce, 0x4d, 0x9c, 0xf1, 0x46, 0xd1, 0x54, 0x1d,
+	0x31, 0x20, 0x6a, 0x66, 0xcc, 0x33, 0x90, 0xf4, 0x1d, 0x72, 0xf6, 0x99, 0x18, 0x7d, 0x92, 0x76,
+	0x45, 0xaa, 0xf3, 0x7b, 0xe3, 0x00, 0x0a, 0x21, 0x29, 0x06, 0x11, 0xf3, 0xa6, 0x28, 0x88, 0xd1,
+	0x3b, 0xfb, 0x3c, 0x13, 0x45, 0x28, 0xbb, 0x8d, 0x41, 0xc2, 0xf8, 0x0a, 0x09, 0xc8, 0x7d, 0x33,
+	0xa0, 0x95, 0x54, 0xa0, 0x94, 0xb0, 0x29, 0x42, 0x21, 0x0d, 0x96, 0x3b, 0xe5, 0x50, 0xd3, 0x45,
+	0x80, 0x45, 0x53, 0x56, 0x82, 0x6e, 0xe3, 0x11, 0x43, 0x75, 0xfb, 0x6e, 0xb9, 0xdb, 0xea, 0x64,
+	0x92, 0x77, 0xbb, 0x40, 0xd2, 0x8f, 0xc9, 0x3c, 0xa4, 0xfc, 0x98, 0x57, 0x2f, 0xae, 0xdc, 0x3f,
+	0xe9, 0xdb, 0xc7, 0xbc, 0x6f, 0xad, 0x8e, 0x98, 0xf7, 0x61, 0x75, 0xc4, 0xbc, 0x0f, 0xdf, 0xf7,
+	0x49, 0x9a, 0xc6, 0xe9, 0xfa, 0xb0, 0xdb, 0x13, 0xd2, 0x71, 0x4f, 0xf5, 0x7d, 0x0d, 0x0d, 0xf3,
+	0xfb, 0x0a, 0x68, 0xf6, 0xdb, 0xd8, 0xce, 0x3c, 0x93, 0x17, 0xce, 0x4d, 0xa5, 0x6c, 0x7e, 0x3b,
+	0x73, 0x96, 0x97, 0x9b, 0xf6, 0xb9, 0xa9, 0x72, 0x1c, 0xc0, 0x73, 0x53, 0x45, 0x91, 0x7e, 0x4a,
+	0xae, 0x3e, 0x13, 0x23, 0x2c, 0xa0, 0xb5, 0x22, 0x9e, 0x64, 0xfb, 0xb1, 0x3a, 0x43, 0xbe, 0x8e,
+	0x33, 0xe0, 0xf5, 0xc9, 0xd8, 0xbd, 0x3b, 0x9d, 0x01, 0x88, 0xf2, 0x33, 0x0d, 0xcb, 0x57, 0x74,
+	0x9d, 0x3a, 0xfd, 0x0d, 0x72, 0x01, 0xb6, 0x73, 0xc9, 0x53, 0xf9, 0x34, 0xea, 0x8a, 0x23, 0x87,
+	0x61, 0x5c, 0xb9, 0x35, 0x19, 0xbb, 0xd7, 0x8d, 0xed, 0x1f, 0xe4, 0x7e, 0x00, 0x00, 0xe6, 0xd9,
+	0x0a, 0x6c, 0x3c, 0x47, 0x5e, 0x3f, 0x2e, 0x9f, 0x6c, 0x49, 0x91, 0x64, 0xf4, 0x13, 0x42, 0xe1,
+	0x8f, 0x77, 0x51, 0x31, 0xaf, 0x02, 0x60, 0x6e, 0x79, 0x76, 0xdd, 0x9d, 0x8c, 0xdd, 0xdb, 0xf9,
+	0x56, 0x2f, 0x92, 0x77, 0xb5, 0xb9, 0xbc, 0x8e, 0xc0, 0xbc, 0x1a, 0x55, 0xea, 0x91, 0x2b, 0xd0,
+	0xba, 0xd2, 0x92, 0xa9, 0xc8, 0xb2, 0x29, 0xe3, 0x1c, 0x32, 0x2e, 0x4f, 0xc6, 0xee, 0x9d, 0x82,
+	0x71, 0xc5, 0xcf, 0x10, 0x65, 0x50, 0xd6, 0x29, 0xc3, 0x17, 0x83, 0xe6, 0xd5, 0x96, 0x8c, 0x93,
+	0x29, 0x63, 0x13, 0x19, 0x8d, 0x2f, 0x06, 0x8c, 0xab, 0x90, 0x7d, 0x27, 0x06, 0x5f, 0x55, 0x11,
+	0x16, 0x0c, 0x34, 0xbe, 0xf7, 0x69, 0x12, 0xc6, 0xbc, 0xbb, 0x15, 0xf7, 0x32, 0x67, 0xbe, 0xbc,
+	0x60, 0x80, 0xeb, 0x3d, 0x7f, 0x88, 0x08, 0x88, 0xbe, 0x19, 0xf3, 0xca, 0x4a, 0xec, 0xab, 0x1b,
+	0xc4, 0xad, 0x19, 0xe0, 0xb5, 0x9e, 0x3a, 0xc5, 0xc9, 0x34, 0xc6, 0x0b, 0x98, 0xdc, 0xee, 0xd3,
+	0xcd, 0xea, 0x05, 0x4c, 0xee, 0x27, 0x16, 0xcf, 0x0c, 0x24, 0xfd, 0x2d, 0x72, 0x25, 0xff, 0xb5,
+	0x29, 0xb2, 0x4e, 0x1a, 0x60, 0xf2, 0xaf, 0x2f, 0x63, 0x8c, 0xef, 0x32, 0x25, 0xe8, 0x16, 0x28,
+	0xe6, 0xd5, 0xe9, 0xc2, 0x5e, 0x9c, 0x37, 0xef, 0xf2, 0x9e, 0xbe, 0x98, 0x31, 0x56, 0xcc, 0x94,
+	0x4a, 0xf2, 0x1e, 0xf3, 0x4c, 0x2c, 0x64, 0xae, 0x3b, 0x42, 0xa4, 0x4f, 0x77, 0x60, 0xa4, 0x4a,
+	0x05, 0xa1, 0x44, 0x88, 0xd4, 0x0f, 0x20, 0x05, 0xca, 0x31, 0x30, 0x77, 0xf5, 0x9f, 0x2d, 0x99,
+	0x42, 0xbe, 0xa2, 0x6e, 0x43, 0x8c, 0xb9, 0x9b, 0x2b, 0xc1, 0xf7, 0xc7, 0xd4, 0xc3, 0x56, 0xa0,
+	0x3b, 0x84, 0xe2, 0x30, 0xee, 0xc4, 0xa9, 0xdc, 0x8d, 0xf5, 0x2e, 0xab, 0xb3, 0x71, 0x63, 0x0e,
+	0x71, 0xc0, 0xf8, 0x49, 0x9c, 0x4a, 0x5f, 0xc6, 0xf9, 0xc1, 0x9a, 0x79, 0x35, 0xba, 0x74, 0x9d,
+	0x5c, 0xc4, 0xd6, 0x27, 0x51, 0x37, 0x89, 0x83, 0x48, 0x66, 0xce, 0xc2, 0x72, 0xd3, 0x76, 0x4a,
+	0xb1, 0x89, 0x1c, 0xc0, 0xbc, 0x92, 0x06, 0x54, 0xa3, 0xa6, 0x75, 0x32, 0xcb, 0x31, 0x95, 0x9a,
+	0x1b, 0xd5, 0xa8, 0xa2, 0xd4, 0x56, 0xf6, 0xad, 0x9e, 0x81, 0x3e, 0x23, 0x97, 0x73, 0x41, 0xe1,
+	0xe1, 0x39, 0xf4, 0xd0, 0x48, 0xda, 0xa6, 0xb4, 0x86, 0x93, 0x55, 0x3d, 0xe8, 0xeb, 0x4e, 0x1a,
+	0x1f, 0x8d, 0x0a, 0x26, 0x52, 0xee, 0x6b, 0x02, 0x72, 0xab, 0xaf, 0xb6, 0x06, 0x9c, 0xda, 0x36,
+	0x83, 0xac, 0x13, 0x1f, 0x88, 0x74, 0xd4, 0xf2, 0x5e, 0xe8, 0x5a, 0xbe, 0x91, 0xff, 0x76, 0x73,
+	0xa9, 0x9f, 0xa5, 0x07, 0xcc, 0xb3, 0xd0, 0x50, 0x19, 0x31, 0x7f, 0x7b, 0x62, 0x2f, 0x15, 0xd9,
+	0xbe, 0xca, 0xdd, 0x33, 0xcc, 0xd7, 0x9b, 0x66, 0x49, 0xc1, 0xe2, 0xf2, 0x53, 0x85, 0xd6, 0xa7,
+	0x80, 0x8c, 0x79, 0xc7, 0x70, 0xd1, 0x97, 0x64, 0x09, 0x2f, 0x45, 0xf1, 0x36, 0xd6, 0xf7, 0x65,
+	0x90, 0x60, 0x49, 0x64, 0x71, 0xe5, 0xb6, 0xb9, 0x6f, 0x94, 0x20, 0xe6, 0xc6, 0x3c, 0x6d, 0x64,
+	0xde, 0x22, 0xc0, 0x9e, 0xc8, 0x4e, 0x77, 0x37, 0x48, 0xe8, 0x67, 0xe4, 0x92, 0xa9, 0x75, 0xb0,
+	0xea, 0xaf, 0x60, 0x2d, 0x64, 0x71, 0xe5, 0xce, 0x2c, 0x66, 0xc0, 0x98, 0xa9, 0x62, 0xd1, 0x6a,
+	0x70, 0xbf, 0x58, 0x5d, 0xa9, 0xe1, 0x5e, 0x75, 0xf6, 0x4e, 0xe4, 0x5e, 0xad, 0xe5, 0x5e, 0xb5,
+	0xb8, 0x57, 0xe9, 0x0f, 0x1b, 0xe4, 0x8e, 0x52, 0x9c, 0xde, 0x41, 0xfb, 0x7e, 0xba, 0xea, 0xbf,
+	0xef, 0xaf, 0xfa, 0x6d, 0x21, 0xb9, 0xf3, 0x45, 0x03, 0x2d, 0x3d, 0xa8, 0x5a, 0xaa, 0x57, 0x30,
+	0xf7, 0xb0, 0x7a, 0x04, 0xf3, 0xae, 0x01, 0xc1, 0x67, 0xb9, 0xd0, 0x5b, 0x7d, 0x7f, 0x75, 0x5d,
+	0x48, 0x4e, 0x3f, 0x27, 0x57, 0x15, 0xb3, 0xba, 0xed, 0xf6, 0xfd, 0x83, 0x77, 0xfd, 0x77, 0xfc,
+	0x15, 0xe7, 0x2f, 0xe7, 0xd0, 0x85, 0xe5, 0xaa, 0x0b, 0x36, 0xd0, 0xcc, 0x0d, 0x6d, 0x09, 0xf3,
+	0x2e, 0x82, 0xc2, 0x06, 0x36, 0xbe, 0x78, 0xf7, 0x9d, 0x15, 0xfa, 0x3d, 0x72, 0x59, 0x53, 0xa8,
+	0xa1, 0xc1, 0xbe, 0xfe, 0xb8, 0x89, 0x86, 0xee, 0xd6, 0x18, 0x2a, 0x50, 0x66, 0x40, 0x36, 0x9a,
+	0x99, 0x77, 0x01, 0x4d, 0x40, 0x0b, 0xf6, 0x66, 0x6a, 0xe1, 0x95, 0x61, 0xe1, 0x97, 0x33, 0x2d,
+	0xbc, 0xaa, 0xb7, 0xf0, 0xaa, 0x62, 0xe1, 0xb3, 0xa9, 0x85, 0x3f, 0x6d, 0x9c, 0xaa, 0x04, 0xe4,
+	0xfc, 0x7c, 0x01, 0x8d, 0x3e, 0x3a, 0x21, 0x35, 0x2a, 0xeb, 0x99, 0x1b, 0x5c, 0x3b, 0x97, 0xf9,
+	0xb1, 0x12, 0xc2, 0x15, 0xf8, 0xc9, 0x14, 0xf4, 0x27, 0x8d, 0x53, 0x64, 0x15, 0xce, 0xbf, 0x28,
+	0x07, 0xdf, 0x3e, 0xad, 0x83, 0xa8, 0x65, 0xc6, 0xa7, 0xc2, 0x3d, 0xd8, 0x89, 0x33, 0xe6, 0x9d,
+	0x6c, 0x94, 0xee, 0x90, 0xf3, 0x0a, 0xb4, 0x19, 0x77, 0xfa, 0x22, 0x75, 0xfe, 0x55, 0x39, 0xe1,
+	0x54, 0x9d, 0x50, 0x00, 0xf3, 0x02, 0xab, 0x8b, 0x2d, 0x50, 0x7c, 0x32, 0x00, 0x54, 0x90, 0x25,
+	0x7d, 0x75, 0xd7, 0xea, 0xec, 0x8b, 0xee, 0x30, 0x14, 0xce, 0xbf, 0x2d, 0x2c, 0x37, 0xcb, 0xdf,
+	0x5b, 0xe9, 0xe4, 0x48, 0x29, 0x12, 0x33, 0x81, 0xcf, 0x6f, 0x04, 0x33, 0xcd, 0xc0, 0xbc, 0x32,
+	0x27, 0xdd, 0x25, 0x17, 0x14, 0x85, 0x27, 0xf0, 0x58, 0xe2, 0xfc, 0x42, 0x79, 0x7e, 0xb3, 0x6a,
+	0x44, 0x23, 0xd6, 0xe9, 0x64, 0xec, 0x5e, 0xcc, 0x13, 0x55, 0x6c, 0x62, 0x9e, 0x4d, 0x52, 0x0c,
+	0x47, 0x2b, 0x1e, 0xa6, 0x1d, 0xe1, 0xfc, 0xfb, 0xcc, 0xe1, 0x50, 0x00, 0x73, 0x38, 0x32, 0x6c,
+	0x99, 0x0e, 0x87, 0x02, 0x14, 0x7e, 0xee, 0xa4, 0xf1, 0x5e, 0x10, 0x0a, 0xe7, 0x3f, 0x66, 0xfa,
+	0xa9, 0x11, 0xa6, 0x9f, 0x89, 0x6a, 0x9a, 0xfa, 0xa9, 0x21, 0x54, 0x90, 0xcb, 0xaa, 0xe1, 0xe5,
+	0xda, 0xf3, 0xdd, 0x38, 0x89, 0xc3, 0xb8, 0x37, 0x72, 0xbe, 0x5a, 0xa8, 0x2e, 0xab, 0x0a, 0xca,
+	0xcc, 0x5e, 0x0e, 0x79, 0xe4, 0x4b, 0xdd, 0xce, 0xbc, 0x2a, 0x63, 0x71, 0x29, 0xbf, 0xce, 0xa3,
+	0xee, 0x61, 0xd0, 0x95, 0xfb, 0xdb, 0xed, 0x40, 0x16, 0x95, 0xa9, 0xff, 0x04, 0x8b, 0x0d, 0xb3,
+	0x8e, 0x3c, 0xbd, 0x52, 0xd2, 0x78, 0x7f, 0xd0, 0x0e, 0xa4, 0x55, 0x9f, 0x3a, 0x96, 0x91, 0xfe,
+	0x3e, 0x59, 0xd2, 0xb3, 0x29, 0xc8, 0xfa, 0x9b, 0x22, 0xe4, 0x23, 0xe7, 0xbf, 0x16, 0xaa, 0x7b,
+	0x53, 0x09, 0x63, 0x06, 0x79, 0xbc, 0x9b, 0xef, 0x42, 0x2b, 0xf3, 0xca, 0x5c, 0xf4, 0xfb, 0xe4,
+	0xaa, 0xfe, 0xe0, 0xd6, 0xc5, 0x93, 0x33, 0x59, 0xa8, 0x06, 0xd7, 0x3a, 0xa0, 0xb9, 0xdc, 0x4a,
+	0x17, 0x5b, 0x70, 0x97, 0x53, 0xa3, 0x41, 0x5b, 0x50, 0x45, 0x09, 0x43, 0x2c, 0x58, 0x67, 0xce,
+	0x7f, 0xab, 0xa5, 0x50, 0xd3, 0x99, 0x29, 0xc8, 0x2e, 0x9c, 0xe4, 0x9a, 0x58, 0x38, 0xc9, 0x7f,
+	0xd0, 0x01, 0xb9, 0xa2, 0x8d, 0xf1, 0xa8, 0x1b, 0x0f, 0xf4, 0xe2, 0x70, 0x7e, 0xa9, 0xba, 0xe1,
+	0xd6, 0x74, 0xc3, 0xc4, 0x59, 0x25, 0x6d, 0x14, 0xf8, 0x7a, 0xc5, 0x31, 0xaf, 0x8e, 0x97, 0x7e,
+	0xb7, 0x48, 0x83, 0x9f, 0x44, 0x07, 0xce, 0xff, 0xa8, 0x34, 0xb0, 0x2e, 0x0f, 0x16, 0xd1, 0x81,
+	0x91, 0x07, 0x3f, 0x89, 0x0e, 0xd8, 0x97, 0x0d, 0x3b, 0xc4, 0xd0, 0x6f, 0x91, 0x33, 0x4f, 0x07,
+	0xbc, 0x97, 0x17, 0xe3, 0x8d, 0xf2, 0x53, 0x00, 0xcd, 0xcc, 0x53, 0x62, 0xba, 0x4c, 0x9a, 0x90,
+	0x73, 0xab, 0xf4, 0xfd, 0xe2, 0x64, 0xec, 0x12, 0x85, 0xc2, 0x54, 0x1b, 0x44, 0xf4, 0x3b, 0x64,
+	0x61, 0x23, 0x1e, 0x0c, 0x78, 0xd4, 0xd5, 0x99, 0xb9, 0xb1, 0x72, 0x3a, 0x4a, 0xc0, 0xbc, 0x1c,
+	0x02, 0xe8, 0x17, 0x71, 0x38, 0x1c, 0x88, 0x3c, 0x21, 0x37, 0xd0, 0x07, 0x4a, 0xc0, 0xbc, 0x1c,
+	0x02, 0xe8, 0xe7, 0x42, 0x1e, 0xc6, 0x69, 0x5f, 0x67, 0xe2, 0x06, 0x3a, 0x52, 0x02, 0xe6, 0xe5,
+	0x10, 0xf6, 0x57, 0x4d, 0x72, 0xef, 0xf8, 0x32, 0x28, 0xd4, 0xba, 0xf0, 0xea, 0xa5, 0x72, 0x05,
+	0xa1, 0xae, 0x57, 0x50, 0x58, 0xa9, 0xfb, 0xcf, 0x7d, 0xad, 0xba, 0xff, 0xaf, 0xee, 0xfe, 0xa1,
+	0x72, 0x15, 0x32, 0xff, 0x35, 0xaf, 0x42, 0x8e, 0xbf, 0x22, 0x38, 0xf3, 0xab, 0xbc, 0x22, 0xb0,
+	0xca, 0xda, 0xaf, 0x9d, 0xae, 0xac, 0xcd, 0x7e, 0x36, 0x97, 0x47, 0x50, 0x63, 0x0b, 0x82, 0xf7,
+	0x10, 0x9f, 0x24, 0x22, 0xe5, 0x78, 0x6e, 0x6c, 0x94, 0xcb, 0x51, 0x71, 0x2e, 0x62, 0x5e, 0x01,
+	0x83, 0x23, 0xe2, 0x2e, 0x4f, 0x7b, 0x42, 0x97, 0x1c, 0xe6, 0xca, 0xe5, 0x5a, 0x89, 0xc2, 0xbc,
+	0xde, 0x60, 0x62, 0xf1, 0xbc, 0x00, 0x61, 0x29, 0xcf, 0xf1, 0x9b, 0xe5, 0xaf, 0x8d, 0x61, 0xac,
+	0xc8, 0xe9, 0x2d, 0x34, 0x7d, 0x42, 0x96, 0x36, 0x87, 0xca, 0x89, 0x9c, 0x60, 0xbe, 0x7c, 0x5b,
+	0xd1, 0xd5, 0x80, 0x82, 0xa3, 0xac, 0x43, 0x7f, 0x1b, 0x9e, 0x0b, 0xc4, 0x9d, 0x7e, 0xab, 0x2f,
+	0x0e, 0xb7, 0x83, 0x30, 0x0c, 0x34, 0x54, 0x7f, 0x24, 0xeb, 0x12, 0x3b, 0xee, 0xf4, 0xfd, 0xac,
+	0x2f, 0x0e, 0xfd, 0x81, 0x01, 0x64, 0x5e, 0x3d, 0x01, 0xfb, 0x51, 0xa3, 0xb4, 0x47, 0xe3, 0x12,
+	0x14, 0x69, 0x56, 0x8c, 0xae, 0xb9, 0x04, 0x95, 0x00, 0x96, 0xa0, 0xfa, 0x0b, 0x02, 0xc0, 0xa7,
+	0xde, 0x56, 0x35, 0x00, 0x0c, 0xd3, 0x90, 0x79, 0x20, 0xa2, 0x6f, 0x91, 0xd7, 0x5a, 0x1f, 0xaf,
+	0xad, 0xbc, 0xff, 0x81, 0x5e, 0xff, 0xe6, 0x6e, 0xbc, 0xcf, 0x57, 0xde, 0xff, 0x80, 0x79, 0x1a,
+	0xc0, 0x7e, 0xd1, 0xb0, 0xb7, 0x76, 0xfa, 0x3e, 0x21, 0x9e, 0x48, 0xe2, 0x2c, 0xc0, 0xdb, 0xc9,
+	0x46, 0x79, 0xde, 0xa4, 0x53, 0x19, 0x54, 0xee, 0xa6, 0x3f, 0xe8, 0x23, 0x72, 0xd6, 0x13, 0x07,
+	0x41, 0x56, 0x54, 0x16, 0xcc, 0x87, 0x1e, 0x5a, 0xc2, 0xbc, 0x29, 0x08, 0x3e, 0xf2, 0xfa, 0x30,
+	0x08, 0xbb, 0x76, 0xa4, 0x32, 0x3e, 0x72, 0x1b, 0xa4, 0xfe, 0x34, 0x5e, 0x59, 0x68, 0xac, 0xab,
+	0x06, 0x51, 0xfe, 0x1e, 0x6d, 0xbe, 0x5c, 0x0b, 0x69, 0xa3, 0x4c, 0x17, 0xc5, 0x0c, 0x24, 0xfb,
+	0xbb, 0x46, 0x29, 0xef, 0x80, 0x65, 0xb2, 0x26, 0xf3, 0x89, 0xd2, 0xc0, 0xca, 0x9d, 0xd1, 0x5d,
+	0x2e, 0x8b, 0x29, 0x52, 0xe0, 0xc0, 0xfc, 0xc6, 0xce, 0xa7, 0xb9, 0x96, 0x9a, 0xdb, 0x86, 0xf9,
+	0x4e, 0x32, 0x2c, 0xd4, 0x0c, 0x24, 0x04, 0xbb, 0x1d, 0x91, 0xee, 0xe9, 0x7a, 0x93, 0x11, 0xec,
+	0x12, 0x91, 0xee, 0x31, 0x0f, 0x85, 0x50, 0xfb, 0x85, 0x7f, 0xd7, 0xd2, 0x5e, 0x1e, 0x91, 0x8d,
+	0xc5, 0x06, 0x40, 0x9f, 0xa7, 0x50, 0x44, 0x9a, 0xa2, 0xd8, 0x4f, 0x9b, 0xe4, 0x8d, 0xd3, 0x5c,
+	0xda, 0xc0, 0xdd, 0x3f, 0x56, 0xd8, 0xaa, 0xa1, 0xa7, 0xb1, 0xdc, 0xb0, 0x2f, 0x40, 0x55, 0x7d,
+	0xae, 0x36, 0xea, 0xcc, 0xe0, 0x80, 0x9a, 0x06, 0x84, 0x8b, 0x2a, 0xf9, 0x5c, 0xb9, 0xa6, 0x01,
+	0x89, 0x78, 0x3d, 0x77, 0x3d, 0x03, 0x44, 0x13, 0x10, 0xd8, 0x11, 0xc1, 0x88, 0x26, 0x48, 0x38,
+	0x1d, 0x72, 0x13, 0x0b, 0xf7, 0x24, 0xdb, 0xfc, 0xa8, 0xea, 0xd4, 0x7c, 0x79, 0x1d, 0x0f, 0xf8,
+	0x51, 0xbd, 0x4f, 0xb5, 0xfa, 0xc6, 0x75, 0xd6, 0xce, 0xe3, 0xc7, 0xdb, 0x2a, 0x2e, 0x34, 0xea,
+	0xae, 0xb3, 0x92, 0xc7, 0x8f, 0xad, 0xeb, 0x2c, 0x84, 0xb3, 0x7f, 0x68, 0x10, 0xa7, 0xe6, 0x9b,
+	0xa9, 0x2b, 0xa6, 0xc7, 0x64, 0x71, 0x9b, 0x1f, 0xad, 0x49, 0x29, 0x06, 0x89, 0xcc, 0x9c, 0x46,
+	0xb9, 0xbb, 0xe0, 0x2a, 0xd7, 0x52, 0xe6, 0x99, 0x58, 0xfa, 0x94, 0x5c, 0xd2, 0x2f, 0xb6, 0xd7,
+	0x79, 0xa7, 0x1f, 0xef, 0xed, 0x6d, 0xe7, 0x13, 0xd4, 0x28, 0xfe, 0x04, 0x0a, 0xe1, 0xb7, 0x15,
+	0x04, 0xdd, 0xab, 0xa8, 0x41, 0x0f, 0xb7, 0xf9, 0x51, 0x41, 0xd3, 0x2c, 0x6f, 0x76, 0xe0, 0x86,
+	0x49, 0x61, 0xc1, 0xd9, 0x9f, 0xcc, 0x93, 0xbb, 0xc7, 0x5e, 0x85, 0x41, 0x71, 0x6f, 0x33, 0xe0,
+	0xa1, 0xae, 0x80, 0x6f, 0xe7, 0x1d, 0x35, 0x92, 0xc9, 0x2e, 0x78, 0xa9, 0xcb, 0xe6, 0x68, 0xc2,
+	0x56, 0xa0, 0x1f, 0x91, 0xa5, 0x67, 0x42, 0x24, 0x6b, 0x61, 0x70, 0x20, 0xa0, 0xb5, 0xae, 0xb3,
+	0x50, 0x48, 0xf0, 0x39, 0x20, 0x90, 0x09, 0x69, 0xca, 0x5a, 0x50, 0x25, 0xb4, 0x9a, 0x94, 0x3f,
+	0xcd, 0x72, 0x95, 0xb0, 0xc4, 0x95, 0x7b, 0x55, 0xa3, 0x0b, 0xc5, 0xfc, 0x6d, 0x7e, 0xb4, 0x11,
+	0x47, 0x9d, 0x61, 0x9a, 0xc2, 0x5b, 0x26, 0x99, 0x0a, 0x3e, 0xc8, 0x37, 0x23, 0xa3, 0x10, 0x02,
+	0xa3, 0xd8, 0x99, 0xc2, 0xb0, 0x8c, 0xcd, 0x81, 0xb4, 0x56, 0x9d, 0xee, 0x92, 0x2b, 0xdb, 0xfc,
+	0xe8, 0x69, 0x37, 0xc4, 0x81, 0x84, 0xf9, 0xf8, 0x71, 0x9c, 0xc9, 0xea, 0xae, 0x04, 0xac, 0x41,
+	0x37, 0x14, 0x40, 0x1d, 0xa9, 0xf9, 0xbc, 0x1f, 0x67, 0x92, 0x79, 0x75, 0xea, 0x74, 0x9b, 0x5c,
+	0xce, 0xdb, 0x8a, 0xde, 0xab, 0x1a, 0xa9, 0x51, 0x21, 0x9e, 0xf2, 0x59, 0x9d, 0xaf, 0x6a, 0x42,
+	0x9c, 0x7b, 0xc9, 0xd3, 0x81, 0xb3, 0x50, 0x8e, 0x73, 0x87, 0x3c, 0x1d, 0x30, 0x0f, 0x85, 0xec,
+	0xa7, 0x73, 0x84, 0x9d, 0x7c, 0x8d, 0x08, 0x39, 0x17, 0x36, 0x89, 0x54, 0xe7, 0x5c, 0x8d, 0xf2,
+	0x34, 0x3c, 0x54, 0xe2, 0x22, 0xe7, 0xb2, 0xf0, 0xb4, 0x4b, 0x6e, 0x16, 0x74, 0xf9, 0x2b, 0x35,
+	0x3b, 0x76, 0x5b, 0x8f, 0x08, 0x72, 0x68, 0xf1, 0xcc, 0x6d, 0x1a, 0x58, 0x66, 0x13, 0xd9, 0x56,
+	0x3c, 0x21, 0x79, 0x10, 0xe5, 0x7b, 0x5d, 0x3e, 0x8f, 0xea, 0xad, 0xa4, 0x88, 0xf5, 0xf3, 0x3d,
+	0xd2, 0xb6, 0x52, 0x22, 0x62, 0x5f, 0xcd, 0x91, 0xe5, 0x93, 0x6e, 0x41, 0x61, 0xc4, 0x74, 0xc3,
+	0xac, 0x11, 0xcb, 0x2f, 0x47, 0xa7, 0x23, 0x66, 0xe1, 0xe1, 0xd5, 0xc5, 0x93, 0x64, 0x5f, 0x0c,
+	0x44, 0xca, 0xc3, 0xe7, 0x71, 0x57, 0xa8, 0xa8, 0x97, 0x4d, 0x37, 0x77, 0xab, 0x2b, 0x22, 0x47,
+	0xfa, 0x11, 0x40, 0x75, 0xe4, 0xcc, 0xd4, 0x7e, 0x3f, 0x93, 0x07, 0xf2, 0x2b, 0xfd, 0xa7, 0x9e,
+	0x36, 0x76, 0x6c, 0x37, 0x66, 0x72, 0xee, 0x6c, 0x3e, 0xe7, 0x8a, 0xfc, 0xaa, 0x96, 0x00, 0x6e,
+	0x78, 0x76, 0xf8, 0x30, 0x13, 0x6b, 0x7b, 0x32, 0x0f, 0xd6, 0xf9, 0xaa, 0x33, 0x6e, 0x78, 0x12,
+	0x80, 0xf8, 0x1c, 0x30, 0x05, 0x63, 0x55, 0x91, 0xfd, 0xa0, 0x51, 0x53, 0x53, 0x80, 0x8c, 0xcd,
+	0x13, 0x3d, 0xfc, 0xb6, 0x8d, 0xf2, 0xa1, 0x29, 0x55, 0x02, 0x78, 0xd5, 0xaa, 0xfe, 0xa2, 0x6b,
+	0xe4, 0xcc, 0x56, 0x10, 0xf5, 0x61, 0xb6, 0x35, 0xeb, 0x6b, 0x1c, 0x2f, 0xd7, 0x9e, 0x03, 0xc2,
+	0x3c, 0xf5, 0x85, 0xa0, 0xc1, 0x3c, 0xa5, 0xc9, 0xfe, 0x62, 0x8e, 0x5c, 0xb0, 0xa0, 0xb0, 0xc6,
+	0x3e, 0x4c, 0xe3, 0x41, 0xf5, 0xe0, 0xb4, 0x97, 0xc6, 0xb0, 0xc6, 0x40, 0x48, 0xef, 0x92, 0xb9,
+	0xdd, 0x58, 0x27, 0x64, 0x17, 0x26, 0x63, 0xf7, 0x9c, 0x82, 0xc8, 0x98, 0x79, 0x73, 0xbb, 0x31,
+	0x5e, 0x15, 0x40, 0xee, 0x6c, 0x25, 0xb8, 0xcd, 0x72, 0x00, 0x55, 0xe9, 0xb6, 0x9d, 0xdb, 0x56,
+	0xf5, 0xe8, 0x73, 0x42, 0x7f, 0x33, 0x90, 0x52, 0xa4, 0x16, 0x5b, 0x65, 0xe0, 0x3f, 0x47, 0x4c,
+	0x89, 0xae, 0x46, 0x13, 0x36, 0xc1, 0xad, 0x38, 0xcb, 0xf2, 0x07, 0x1f, 0x6a, 0x7f, 0x35, 0xaf,
+	0xdd, 0xe3, 0x2c, 0x33, 0x1e, 0x7c, 0x18, 0x58, 0xf6, 0xc3, 0xb9, 0x4a, 0xbd, 0x04, 0x26, 0x1c,
+	0xbc, 0x09, 0xa9, 0xf6, 0xb7, 0x51, 0x9e, 0x70, 0xf8, 0x92, 0xa4, 0xae, 0xd3, 0xf5, 0x04, 0xf4,
+	0x77, 0xc9, 0x75, 0x7c, 0x0c, 0x5c, 0xa5, 0xae, 0x24, 0x3e, 0xf8, 0x9a, 0xb8, 0x96, 0x7b, 0x06,
+	0x05, 0x2e, 0xe6, 0xe0, 0x95, 0xf8, 0x28, 0xe8, 0x71, 0x7c, 0x58, 0x55, 0xdd, 0x85, 0xf1, 0xd1,
+	0x55, 0x2f, 0x97, 0x33, 0xcf, 0xc6, 0xb3, 0xbf, 0x9f, 0xab, 0x3d, 0x83, 0x9b, 0xaf, 0x14, 0x3e,
+	0x24, 0x4b, 0xf8, 0xb3, 0x92, 0x0f, 0x1a, 0xe7, 0x63, 0x3c, 0xa9, 0xd8, 0x79, 0x51, 0x59, 0x49,
+	0x3f, 0x33, 0x83, 0x06, 0x94, 0x54, 0x1f, 0x0a, 0xc2, 0xbd, 0x35, 0x52, 0xe8, 0x32, 0xa3, 0x05,
+	0x9f, 0xba, 0xb1, 0xbb, 0xbb, 0x65, 0x07, 0x83, 0xb2, 0x1b, 0xbe, 0x94, 0x46, 0x50, 0x2e, 0x2b,
+	0xd1, 0xcf, 0xc9, 0x6d, 0xd5, 0xb1, 0xdd, 0x38, 0x14, 0x29, 0x8f, 0x3a, 0xa2, 0x66, 0x46, 0x1a,
+	0x57, 0x46, 0xfa, 0x5d, 0xb0, 0xcc, 0xd1, 0xa5, 0x2f, 0x73, 0x1c, 0x19, 0xfb, 0xeb, 0x46, 0x7d,
+	0xe9, 0xac, 0x72, 0x88, 0x6d, 0x7c, 0xad, 0x43, 0x2c, 0x5c, 0xb0, 0xc6, 0x87, 0x91, 0xbd, 0x4b,
+	0x99, 0x85, 0xa5, 0xf8, 0xd0, 0x38, 0xbc, 0x9a, 0x58, 0x88, 0x0b, 0xcf, 0x82, 0x30, 0xac, 0x9e,
+	0x31, 0xfa, 0x41, 0x18, 0x32, 0x0f, 0x85, 0xec, 0xe7, 0x8d, 0x7c, 0x81, 0x4c, 0xab, 0x67, 0xa7,
+	0xab, 0xc4, 0xe4, 0x2f, 0x46, 0xe7, 0x8e, 0x7b, 0x31, 0x6a, 0x15, 0xa0, 0x9a, 0x27, 0x15, 0xa0,
+	0x1e, 0x91, 0xb3, 0xf9, 0x5d, 0xa1, 0x33, 0x5f, 0x3e, 0x3a, 0xe6, 0xd7, 0x8a, 0xcc, 0x9b, 0x82,
+	0x14, 0x7d, 0x38, 0x1c, 0x44, 0xea, 0xdd, 0x66, 0x89, 0x1e, 0x05, 0x48, 0xaf, 0xfe, 0xfa, 0xb3,
+	0x06, 0xb9, 0xa1, 0xbb, 0x5a, 0x7e, 0x3c, 0x82, 0xf5, 0x1c, 0x7c, 0x5e, 0xbe, 0x1d, 0x44, 0x43,
+	0x58, 0x5c, 0x95, 0x9d, 0x52, 0xbf, 0x4a, 0x1f, 0x28, 0x39, 0xd4, 0x73, 0x4c, 0x3c, 0x4c, 0xd9,
+	0xdd, 0x74, 0x18, 0x75, 0xb8, 0x14, 0x1e, 0x3f, 0x84, 0xfa, 0x9e, 0x7e, 0x9d, 0x60, 0x4c, 0x59,
+	0xa9, 0x01, 0x7e, 0xca, 0x0f, 0xf1, 0x35, 0x01, 0xf3, 0xca, 0x4a, 0xec, 0x9f, 0x1b, 0xb5, 0x8b,
+	0xd4, 0x7c, 0x6a, 0xf2, 0x21, 0x59, 0xda, 0xe6, 0x47, 0xd8, 0x92, 0x87, 0xc4, 0x06, 0x86, 0x44,
+	0xc3, 0x14, 0x24, 0x7d, 0xea, 0xb5, 0xca, 0x34, 0x2e, 0x96, 0x95, 0x30, 0x9f, 0x0a, 0xa2, 0x6e,
+	0x7c, 0x68, 0x4f, 0x2e, 0x33, 0x9f, 0x42, 0x71, 0x31, 0xbd, 0x6c, 0x3c, 0x1e, 0x4e, 0x82, 0x28,
+	0x3f, 0x10, 0x55, 0xcf, 0x62, 0x03, 0xcc, 0x66, 0x94, 0x94, 0x79, 0x26, 0x96, 0xfd, 0xf9, 0x7c,
+	0x6d, 0x81, 0x16, 0x8a, 0x0e, 0xd3, 0xca, 0x51, 0xbe, 0xa3, 0x1a, 0xa7, 0xf0, 0x69, 0x85, 0x09,
+	0x8e, 0xd3, 0x05, 0x10, 0x4a, 0xa6, 0xea, 0x8e, 0x48, 0x75, 0xc1, 0xd8, 0x3c, 0xf5, 0x4d, 0x8f,
+	0x12, 0xe3, 0xd0, 0x05, 0x51, 0x4d, 0x4d, 0xc9, 0x1c, 0xba, 0x20, 0xf2, 0x4b, 0x4b, 0xb2, 0xac,
+	0xa4, 0x3f, 0x81, 0xc5, 0x33, 0x5f, 0xe1, 0xe1, 0x47, 0x55, 0x1e, 0x5b, 0x09, 0x1e, 0xca, 0x00,
+	0x75, 0xa9, 0x4a, 0x75, 0xa6, 0x9c, 0x6e, 0xa3, 0x4b, 0x95, 0x4a, 0x55, 0x8d, 0x2a, 0x12, 0xf2,
+	0xa3, 0x32, 0x61, 0x25, 0x7f, 0x47, 0xdf, 0x6a, 0x08, 0x2b, 0xaa, 0xb3, 0xab, 0x5f, 0x0b, 0xff,
+	0xc7, 0xea, 0xd7, 0xf4, 0x6d, 0xe3, 0xd9, 0x63, 0xde, 0x36, 0xae, 0x5f, 0xfd, 0xe2, 0x67, 0xf7,
+	0xbe, 0xf1, 0xc5, 0x97, 0xf7, 0x1a, 0x7f, 0xfb, 0xe5, 0xbd, 0xc6, 0x3f, 0x7e, 0x79, 0xaf, 0xf1,
+	0x93, 0x7f, 0xba, 0xf7, 0x8d, 0xf6, 0x6b, 0xf8, 0xff, 0x94, 0x57, 0xff, 0x77, 0x00, 0x06, 0x5d,
+	0xc3, 0xc4, 0xa1, 0x3d, 0x00, 0x00,
 }
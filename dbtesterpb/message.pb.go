@@ -24,17 +24,28 @@ const (
 	Operation_Start     Operation = 0
 	Operation_Stop      Operation = 1
 	Operation_Heartbeat Operation = 2
+	Operation_Nemesis   Operation = 3
+	Operation_Profile   Operation = 4
+	// ClockOffset returns the agent clock, to measure its offset
+	// from the control node.
+	Operation_ClockOffset Operation = 5
 )
 
 var Operation_name = map[int32]string{
 	0: "Start",
 	1: "Stop",
 	2: "Heartbeat",
+	3: "Nemesis",
+	4: "Profile",
+	5: "ClockOffset",
 }
 var Operation_value = map[string]int32{
-	"Start":     0,
-	"Stop":      1,
-	"Heartbeat": 2,
+	"Start":       0,
+	"Stop":        1,
+	"Heartbeat":   2,
+	"Nemesis":     3,
+	"Profile":     4,
+	"ClockOffset": 5,
 }
 
 func (x Operation) String() string {
@@ -42,6 +53,27 @@ func (x Operation) String() string {
 }
 func (Operation) EnumDescriptor() ([]byte, []int) { return fileDescriptorMessage, []int{0} }
 
+type ControlOperation int32
+
+const (
+	ControlOperation_Pause  ControlOperation = 0
+	ControlOperation_Resume ControlOperation = 1
+)
+
+var ControlOperation_name = map[int32]string{
+	0: "Pause",
+	1: "Resume",
+}
+var ControlOperation_value = map[string]int32{
+	"Pause":  0,
+	"Resume": 1,
+}
+
+func (x ControlOperation) String() string {
+	return proto.EnumName(ControlOperation_name, int32(x))
+}
+func (ControlOperation) EnumDescriptor() ([]byte, []int) { return fileDescriptorMessage, []int{1} }
+
 type Request struct {
 	Operation        Operation  `protobuf:"varint,1,opt,name=Operation,proto3,enum=dbtesterpb.Operation" json:"Operation,omitempty"`
 	TriggerLogUpload bool       `protobuf:"varint,2,opt,name=TriggerLogUpload,proto3" json:"TriggerLogUpload,omitempty"`
@@ -54,13 +86,38 @@ type Request struct {
 	IPIndex                    uint32                      `protobuf:"varint,6,opt,name=IPIndex,proto3" json:"IPIndex,omitempty"`
 	CurrentClientNumber        int64                       `protobuf:"varint,7,opt,name=CurrentClientNumber,proto3" json:"CurrentClientNumber,omitempty"`
 	ConfigClientMachineInitial *ConfigClientMachineInitial `protobuf:"bytes,8,opt,name=ConfigClientMachineInitial" json:"ConfigClientMachineInitial,omitempty"`
-	Flag_Etcd_Tip              *Flag_Etcd_Tip              `protobuf:"bytes,100,opt,name=flag__etcd__tip,json=flagEtcdTip" json:"flag__etcd__tip,omitempty"`
-	Flag_Etcd_V3_2             *Flag_Etcd_V3_2             `protobuf:"bytes,101,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty"`
-	Flag_Etcd_V3_3             *Flag_Etcd_V3_3             `protobuf:"bytes,102,opt,name=flag__etcd__v3_3,json=flagEtcdV33" json:"flag__etcd__v3_3,omitempty"`
-	Flag_Zookeeper_R3_5_3Beta  *Flag_Zookeeper_R3_5_3Beta  `protobuf:"bytes,200,opt,name=flag__zookeeper__r3_5_3_beta,json=flagZookeeperR353Beta" json:"flag__zookeeper__r3_5_3_beta,omitempty"`
-	Flag_Consul_V1_0_2         *Flag_Consul_V1_0_2         `protobuf:"bytes,300,opt,name=flag__consul__v1_0_2,json=flagConsulV102" json:"flag__consul__v1_0_2,omitempty"`
-	Flag_Cetcd_Beta            *Flag_Cetcd_Beta            `protobuf:"bytes,400,opt,name=flag__cetcd__beta,json=flagCetcdBeta" json:"flag__cetcd__beta,omitempty"`
-	Flag_Zetcd_Beta            *Flag_Zetcd_Beta            `protobuf:"bytes,500,opt,name=flag__zetcd__beta,json=flagZetcdBeta" json:"flag__zetcd__beta,omitempty"`
+	// ConfigDocker is set to run the database in a Docker container.
+	ConfigDocker *ConfigDocker `protobuf:"bytes,9,opt,name=ConfigDocker" json:"ConfigDocker,omitempty"`
+	// NemesisStep is the fault to inject, or to recover
+	// if NemesisRecover is true.
+	NemesisStep    *ConfigNemesisStep `protobuf:"bytes,10,opt,name=NemesisStep" json:"NemesisStep,omitempty"`
+	NemesisRecover bool               `protobuf:"varint,11,opt,name=NemesisRecover,proto3" json:"NemesisRecover,omitempty"`
+	// EnablePprof is set to start the database with profiling endpoints.
+	EnablePprof bool `protobuf:"varint,12,opt,name=EnablePprof,proto3" json:"EnablePprof,omitempty"`
+	// ProfileSeconds is the duration of CPU profile to capture.
+	ProfileSeconds int64 `protobuf:"varint,13,opt,name=ProfileSeconds,proto3" json:"ProfileSeconds,omitempty"`
+	// Perf is set to capture 'perf record' output with PerfArgs.
+	Perf     bool     `protobuf:"varint,14,opt,name=Perf,proto3" json:"Perf,omitempty"`
+	PerfArgs []string `protobuf:"bytes,15,rep,name=PerfArgs" json:"PerfArgs,omitempty"`
+	// ConfigWANTopology is set to inject latency and packet loss
+	// to peers in other regions while the database runs.
+	ConfigWANTopology *ConfigWANTopology `protobuf:"bytes,16,opt,name=ConfigWANTopology" json:"ConfigWANTopology,omitempty"`
+	// ConfigDiskDelay is set to run the database on a disk
+	// with artificial latency.
+	ConfigDiskDelay *ConfigDiskDelay `protobuf:"bytes,17,opt,name=ConfigDiskDelay" json:"ConfigDiskDelay,omitempty"`
+	// Collectors are extra metric sources to sample with system metrics.
+	Collectors []*ConfigCollector `protobuf:"bytes,18,rep,name=Collectors" json:"Collectors,omitempty"`
+	// DatabaseEnv are 'KEY=VALUE' environment variables of the database process.
+	DatabaseEnv []string `protobuf:"bytes,19,rep,name=DatabaseEnv" json:"DatabaseEnv,omitempty"`
+	// DatabasePort is the client port of the database.
+	DatabasePort              int64                      `protobuf:"varint,20,opt,name=DatabasePort,proto3" json:"DatabasePort,omitempty"`
+	Flag_Etcd_Tip             *Flag_Etcd_Tip             `protobuf:"bytes,100,opt,name=flag__etcd__tip,json=flagEtcdTip" json:"flag__etcd__tip,omitempty"`
+	Flag_Etcd_V3_2            *Flag_Etcd_V3_2            `protobuf:"bytes,101,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty"`
+	Flag_Etcd_V3_3            *Flag_Etcd_V3_3            `protobuf:"bytes,102,opt,name=flag__etcd__v3_3,json=flagEtcdV33" json:"flag__etcd__v3_3,omitempty"`
+	Flag_Zookeeper_R3_5_3Beta *Flag_Zookeeper_R3_5_3Beta `protobuf:"bytes,200,opt,name=flag__zookeeper__r3_5_3_beta,json=flagZookeeperR353Beta" json:"flag__zookeeper__r3_5_3_beta,omitempty"`
+	Flag_Consul_V1_0_2        *Flag_Consul_V1_0_2        `protobuf:"bytes,300,opt,name=flag__consul__v1_0_2,json=flagConsulV102" json:"flag__consul__v1_0_2,omitempty"`
+	Flag_Cetcd_Beta           *Flag_Cetcd_Beta           `protobuf:"bytes,400,opt,name=flag__cetcd__beta,json=flagCetcdBeta" json:"flag__cetcd__beta,omitempty"`
+	Flag_Zetcd_Beta           *Flag_Zetcd_Beta           `protobuf:"bytes,500,opt,name=flag__zetcd__beta,json=flagZetcdBeta" json:"flag__zetcd__beta,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
@@ -73,6 +130,30 @@ type Response struct {
 	// DiskSpaceUsageBytes is the data size of the database on disk in bytes.
 	// It measures after database is requested to stop.
 	DiskSpaceUsageBytes int64 `protobuf:"varint,2,opt,name=DiskSpaceUsageBytes,proto3" json:"DiskSpaceUsageBytes,omitempty"`
+	// CPUProfile and HeapProfile are captured from the database
+	// on 'Profile' operation, in pprof format.
+	CPUProfile  []byte `protobuf:"bytes,3,opt,name=CPUProfile,proto3" json:"CPUProfile,omitempty"`
+	HeapProfile []byte `protobuf:"bytes,4,opt,name=HeapProfile,proto3" json:"HeapProfile,omitempty"`
+	// PerfScript is the 'perf script' output of the 'perf record' data.
+	PerfScript []byte `protobuf:"bytes,5,opt,name=PerfScript,proto3" json:"PerfScript,omitempty"`
+	// CPUModel, CPUCores, and CPUMHz describe the agent machine,
+	// returned on 'Start' operation.
+	CPUModel string  `protobuf:"bytes,6,opt,name=CPUModel,proto3" json:"CPUModel,omitempty"`
+	CPUCores int64   `protobuf:"varint,7,opt,name=CPUCores,proto3" json:"CPUCores,omitempty"`
+	CPUMHz   float64 `protobuf:"fixed64,8,opt,name=CPUMHz,proto3" json:"CPUMHz,omitempty"`
+	// UnixNanosecond is the agent clock on 'ClockOffset' operation.
+	UnixNanosecond int64 `protobuf:"varint,9,opt,name=UnixNanosecond,proto3" json:"UnixNanosecond,omitempty"`
+	// DiskWriteMBPerSecond is the write throughput of the agent disk
+	// device from its spec, to detect disk-bound runs.
+	DiskWriteMBPerSecond float64 `protobuf:"fixed64,10,opt,name=DiskWriteMBPerSecond,proto3" json:"DiskWriteMBPerSecond,omitempty"`
+	// ServerCommandLine and ProxyCommandLine are the command lines of the
+	// database process and its proxy (if any) launched by the agent, and
+	// ServerConfig is the content of the config file at ServerConfigPath
+	// (if any), returned on 'Start' operation.
+	ServerCommandLine string `protobuf:"bytes,11,opt,name=ServerCommandLine,proto3" json:"ServerCommandLine,omitempty"`
+	ProxyCommandLine  string `protobuf:"bytes,12,opt,name=ProxyCommandLine,proto3" json:"ProxyCommandLine,omitempty"`
+	ServerConfigPath  string `protobuf:"bytes,13,opt,name=ServerConfigPath,proto3" json:"ServerConfigPath,omitempty"`
+	ServerConfig      string `protobuf:"bytes,14,opt,name=ServerConfig,proto3" json:"ServerConfig,omitempty"`
 }
 
 func (m *Response) Reset()                    { *m = Response{} }
@@ -80,10 +161,203 @@ func (m *Response) String() string            { return proto.CompactTextString(m
 func (*Response) ProtoMessage()               {}
 func (*Response) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{1} }
 
+// MonitorSample is a system metrics sample, streamed from agent to control.
+type MonitorSample struct {
+	UnixNanosecond int64 `protobuf:"varint,1,opt,name=UnixNanosecond,proto3" json:"UnixNanosecond,omitempty"`
+	// Header and Row are encoded in comma-separated values,
+	// same as the system metrics CSV file written by the agent.
+	Header string `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
+	Row    string `protobuf:"bytes,3,opt,name=Row,proto3" json:"Row,omitempty"`
+}
+
+func (m *MonitorSample) Reset()                    { *m = MonitorSample{} }
+func (m *MonitorSample) String() string            { return proto.CompactTextString(m) }
+func (*MonitorSample) ProtoMessage()               {}
+func (*MonitorSample) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{2} }
+
+// ControlRequest pauses or resumes load generation in 'control'.
+type ControlRequest struct {
+	Operation ControlOperation `protobuf:"varint,1,opt,name=Operation,proto3,enum=dbtesterpb.ControlOperation" json:"Operation,omitempty"`
+}
+
+func (m *ControlRequest) Reset()                    { *m = ControlRequest{} }
+func (m *ControlRequest) String() string            { return proto.CompactTextString(m) }
+func (*ControlRequest) ProtoMessage()               {}
+func (*ControlRequest) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{3} }
+
+type ControlResponse struct {
+	// Paused is true if load generation is paused after the request.
+	Paused bool `protobuf:"varint,1,opt,name=Paused,proto3" json:"Paused,omitempty"`
+}
+
+func (m *ControlResponse) Reset()                    { *m = ControlResponse{} }
+func (m *ControlResponse) String() string            { return proto.CompactTextString(m) }
+func (*ControlResponse) ProtoMessage()               {}
+func (*ControlResponse) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{4} }
+
+// InstallChunk is a part of the database binary, streamed from
+// control to agent. The first chunk sets all fields other than Data.
+type InstallChunk struct {
+	DatabaseID DatabaseID `protobuf:"varint,1,opt,name=DatabaseID,proto3,enum=dbtesterpb.DatabaseID" json:"DatabaseID,omitempty"`
+	Version    string     `protobuf:"bytes,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	// SHA256 is the hex-encoded checksum of the whole binary.
+	SHA256 string `protobuf:"bytes,3,opt,name=SHA256,proto3" json:"SHA256,omitempty"`
+	Data   []byte `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *InstallChunk) Reset()                    { *m = InstallChunk{} }
+func (m *InstallChunk) String() string            { return proto.CompactTextString(m) }
+func (*InstallChunk) ProtoMessage()               {}
+func (*InstallChunk) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{5} }
+
+// QueryRunRequest identifies the results of a database in a run.
+type QueryRunRequest struct {
+	RunID       string `protobuf:"bytes,1,opt,name=RunID,proto3" json:"RunID,omitempty"`
+	DatabaseTag string `protobuf:"bytes,2,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty"`
+}
+
+func (m *QueryRunRequest) Reset()                    { *m = QueryRunRequest{} }
+func (m *QueryRunRequest) String() string            { return proto.CompactTextString(m) }
+func (*QueryRunRequest) ProtoMessage()               {}
+func (*QueryRunRequest) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{6} }
+
+// QueryRunSummary is the run metadata and latency distribution
+// summary of the results.
+type QueryRunSummary struct {
+	RunID       string `protobuf:"bytes,1,opt,name=RunID,proto3" json:"RunID,omitempty"`
+	DatabaseTag string `protobuf:"bytes,2,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty"`
+	DatabaseID  string `protobuf:"bytes,3,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty"`
+	// Version is the release version or source commit of the database.
+	Version   string `protobuf:"bytes,4,opt,name=Version,proto3" json:"Version,omitempty"`
+	StartedAt string `protobuf:"bytes,5,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`
+	// Tags are the run tags in 'key=value'.
+	Tags []string `protobuf:"bytes,6,rep,name=Tags" json:"Tags,omitempty"`
+	// Failure is the reason the run was marked failed, if any.
+	Failure string `protobuf:"bytes,7,opt,name=Failure,proto3" json:"Failure,omitempty"`
+	// Columns and Values are the latency distribution summary,
+	// in the same order.
+	Columns []string `protobuf:"bytes,8,rep,name=Columns" json:"Columns,omitempty"`
+	Values  []string `protobuf:"bytes,9,rep,name=Values" json:"Values,omitempty"`
+}
+
+func (m *QueryRunSummary) Reset()                    { *m = QueryRunSummary{} }
+func (m *QueryRunSummary) String() string            { return proto.CompactTextString(m) }
+func (*QueryRunSummary) ProtoMessage()               {}
+func (*QueryRunSummary) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{7} }
+
+// QueryTimeseriesRequest queries the latency-throughput timeseries
+// of the results.
+type QueryTimeseriesRequest struct {
+	RunID       string `protobuf:"bytes,1,opt,name=RunID,proto3" json:"RunID,omitempty"`
+	DatabaseTag string `protobuf:"bytes,2,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty"`
+	// Columns are the timeseries columns to return, all if empty.
+	Columns []string `protobuf:"bytes,3,rep,name=Columns" json:"Columns,omitempty"`
+	// MaxPoints is the maximum number of points of each column, where
+	// consecutive seconds are averaged. Zero returns all seconds.
+	MaxPoints int64 `protobuf:"varint,4,opt,name=MaxPoints,proto3" json:"MaxPoints,omitempty"`
+}
+
+func (m *QueryTimeseriesRequest) Reset()                    { *m = QueryTimeseriesRequest{} }
+func (m *QueryTimeseriesRequest) String() string            { return proto.CompactTextString(m) }
+func (*QueryTimeseriesRequest) ProtoMessage()               {}
+func (*QueryTimeseriesRequest) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{8} }
+
+// QueryTimeseriesPoint is the value at a second, or the average
+// of the seconds from the second if downsampled.
+type QueryTimeseriesPoint struct {
+	UnixSecond int64   `protobuf:"varint,1,opt,name=UnixSecond,proto3" json:"UnixSecond,omitempty"`
+	Value      float64 `protobuf:"fixed64,2,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (m *QueryTimeseriesPoint) Reset()                    { *m = QueryTimeseriesPoint{} }
+func (m *QueryTimeseriesPoint) String() string            { return proto.CompactTextString(m) }
+func (*QueryTimeseriesPoint) ProtoMessage()               {}
+func (*QueryTimeseriesPoint) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{9} }
+
+type QueryTimeseriesColumn struct {
+	Name   string                  `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Points []*QueryTimeseriesPoint `protobuf:"bytes,2,rep,name=Points" json:"Points,omitempty"`
+}
+
+func (m *QueryTimeseriesColumn) Reset()                    { *m = QueryTimeseriesColumn{} }
+func (m *QueryTimeseriesColumn) String() string            { return proto.CompactTextString(m) }
+func (*QueryTimeseriesColumn) ProtoMessage()               {}
+func (*QueryTimeseriesColumn) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{10} }
+
+type QueryTimeseriesResponse struct {
+	Columns []*QueryTimeseriesColumn `protobuf:"bytes,1,rep,name=Columns" json:"Columns,omitempty"`
+}
+
+func (m *QueryTimeseriesResponse) Reset()                    { *m = QueryTimeseriesResponse{} }
+func (m *QueryTimeseriesResponse) String() string            { return proto.CompactTextString(m) }
+func (*QueryTimeseriesResponse) ProtoMessage()               {}
+func (*QueryTimeseriesResponse) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{11} }
+
+// QueryCompareRequest compares the summaries of runs
+// against the first run.
+type QueryCompareRequest struct {
+	Runs []*QueryRunRequest `protobuf:"bytes,1,rep,name=Runs" json:"Runs,omitempty"`
+	// Columns are the summary columns to compare, all numeric
+	// columns of the first run if empty.
+	Columns []string `protobuf:"bytes,2,rep,name=Columns" json:"Columns,omitempty"`
+}
+
+func (m *QueryCompareRequest) Reset()                    { *m = QueryCompareRequest{} }
+func (m *QueryCompareRequest) String() string            { return proto.CompactTextString(m) }
+func (*QueryCompareRequest) ProtoMessage()               {}
+func (*QueryCompareRequest) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{12} }
+
+type QueryComparedValue struct {
+	Column string  `protobuf:"bytes,1,opt,name=Column,proto3" json:"Column,omitempty"`
+	Value  float64 `protobuf:"fixed64,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	// DeltaPercent is the difference from the value of the first run.
+	DeltaPercent float64 `protobuf:"fixed64,3,opt,name=DeltaPercent,proto3" json:"DeltaPercent,omitempty"`
+}
+
+func (m *QueryComparedValue) Reset()                    { *m = QueryComparedValue{} }
+func (m *QueryComparedValue) String() string            { return proto.CompactTextString(m) }
+func (*QueryComparedValue) ProtoMessage()               {}
+func (*QueryComparedValue) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{13} }
+
+type QueryComparedRun struct {
+	RunID       string                `protobuf:"bytes,1,opt,name=RunID,proto3" json:"RunID,omitempty"`
+	DatabaseTag string                `protobuf:"bytes,2,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty"`
+	Values      []*QueryComparedValue `protobuf:"bytes,3,rep,name=Values" json:"Values,omitempty"`
+}
+
+func (m *QueryComparedRun) Reset()                    { *m = QueryComparedRun{} }
+func (m *QueryComparedRun) String() string            { return proto.CompactTextString(m) }
+func (*QueryComparedRun) ProtoMessage()               {}
+func (*QueryComparedRun) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{14} }
+
+type QueryCompareResponse struct {
+	Runs []*QueryComparedRun `protobuf:"bytes,1,rep,name=Runs" json:"Runs,omitempty"`
+}
+
+func (m *QueryCompareResponse) Reset()                    { *m = QueryCompareResponse{} }
+func (m *QueryCompareResponse) String() string            { return proto.CompactTextString(m) }
+func (*QueryCompareResponse) ProtoMessage()               {}
+func (*QueryCompareResponse) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{15} }
+
 func init() {
 	proto.RegisterType((*Request)(nil), "dbtesterpb.Request")
 	proto.RegisterType((*Response)(nil), "dbtesterpb.Response")
+	proto.RegisterType((*MonitorSample)(nil), "dbtesterpb.MonitorSample")
+	proto.RegisterType((*ControlRequest)(nil), "dbtesterpb.ControlRequest")
+	proto.RegisterType((*ControlResponse)(nil), "dbtesterpb.ControlResponse")
+	proto.RegisterType((*InstallChunk)(nil), "dbtesterpb.InstallChunk")
+	proto.RegisterType((*QueryRunRequest)(nil), "dbtesterpb.QueryRunRequest")
+	proto.RegisterType((*QueryRunSummary)(nil), "dbtesterpb.QueryRunSummary")
+	proto.RegisterType((*QueryTimeseriesRequest)(nil), "dbtesterpb.QueryTimeseriesRequest")
+	proto.RegisterType((*QueryTimeseriesPoint)(nil), "dbtesterpb.QueryTimeseriesPoint")
+	proto.RegisterType((*QueryTimeseriesColumn)(nil), "dbtesterpb.QueryTimeseriesColumn")
+	proto.RegisterType((*QueryTimeseriesResponse)(nil), "dbtesterpb.QueryTimeseriesResponse")
+	proto.RegisterType((*QueryCompareRequest)(nil), "dbtesterpb.QueryCompareRequest")
+	proto.RegisterType((*QueryComparedValue)(nil), "dbtesterpb.QueryComparedValue")
+	proto.RegisterType((*QueryComparedRun)(nil), "dbtesterpb.QueryComparedRun")
+	proto.RegisterType((*QueryCompareResponse)(nil), "dbtesterpb.QueryCompareResponse")
 	proto.RegisterEnum("dbtesterpb.Operation", Operation_name, Operation_value)
+	proto.RegisterEnum("dbtesterpb.ControlOperation", ControlOperation_name, ControlOperation_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -98,6 +372,8 @@ const _ = grpc.SupportPackageIsVersion4
 
 type TransporterClient interface {
 	Transfer(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	Monitor(ctx context.Context, in *Request, opts ...grpc.CallOption) (Transporter_MonitorClient, error)
+	Install(ctx context.Context, opts ...grpc.CallOption) (Transporter_InstallClient, error)
 }
 
 type transporterClient struct {
@@ -117,10 +393,78 @@ func (c *transporterClient) Transfer(ctx context.Context, in *Request, opts ...g
 	return out, nil
 }
 
+func (c *transporterClient) Monitor(ctx context.Context, in *Request, opts ...grpc.CallOption) (Transporter_MonitorClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Transporter_serviceDesc.Streams[0], c.cc, "/dbtesterpb.Transporter/Monitor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transporterMonitorClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Transporter_MonitorClient interface {
+	Recv() (*MonitorSample, error)
+	grpc.ClientStream
+}
+
+type transporterMonitorClient struct {
+	grpc.ClientStream
+}
+
+func (x *transporterMonitorClient) Recv() (*MonitorSample, error) {
+	m := new(MonitorSample)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *transporterClient) Install(ctx context.Context, opts ...grpc.CallOption) (Transporter_InstallClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Transporter_serviceDesc.Streams[1], c.cc, "/dbtesterpb.Transporter/Install", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transporterInstallClient{stream}
+	return x, nil
+}
+
+type Transporter_InstallClient interface {
+	Send(*InstallChunk) error
+	CloseAndRecv() (*Response, error)
+	grpc.ClientStream
+}
+
+type transporterInstallClient struct {
+	grpc.ClientStream
+}
+
+func (x *transporterInstallClient) Send(m *InstallChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *transporterInstallClient) CloseAndRecv() (*Response, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Transporter service
 
 type TransporterServer interface {
 	Transfer(context.Context, *Request) (*Response, error)
+	Monitor(*Request, Transporter_MonitorServer) error
+	Install(Transporter_InstallServer) error
 }
 
 func RegisterTransporterServer(s *grpc.Server, srv TransporterServer) {
@@ -145,6 +489,53 @@ func _Transporter_Transfer_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transporter_Monitor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransporterServer).Monitor(m, &transporterMonitorServer{stream})
+}
+
+type Transporter_MonitorServer interface {
+	Send(*MonitorSample) error
+	grpc.ServerStream
+}
+
+type transporterMonitorServer struct {
+	grpc.ServerStream
+}
+
+func (x *transporterMonitorServer) Send(m *MonitorSample) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Transporter_Install_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TransporterServer).Install(&transporterInstallServer{stream})
+}
+
+type Transporter_InstallServer interface {
+	SendAndClose(*Response) error
+	Recv() (*InstallChunk, error)
+	grpc.ServerStream
+}
+
+type transporterInstallServer struct {
+	grpc.ServerStream
+}
+
+func (x *transporterInstallServer) SendAndClose(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *transporterInstallServer) Recv() (*InstallChunk, error) {
+	m := new(InstallChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Transporter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dbtesterpb.Transporter",
 	HandlerType: (*TransporterServer)(nil),
@@ -154,6 +545,211 @@ var _Transporter_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Transporter_Transfer_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Monitor",
+			Handler:       _Transporter_Monitor_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Install",
+			Handler:       _Transporter_Install_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "dbtesterpb/message.proto",
+}
+
+// Client API for Controller service
+
+type ControllerClient interface {
+	Control(ctx context.Context, in *ControlRequest, opts ...grpc.CallOption) (*ControlResponse, error)
+}
+
+type controllerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewControllerClient(cc *grpc.ClientConn) ControllerClient {
+	return &controllerClient{cc}
+}
+
+func (c *controllerClient) Control(ctx context.Context, in *ControlRequest, opts ...grpc.CallOption) (*ControlResponse, error) {
+	out := new(ControlResponse)
+	err := grpc.Invoke(ctx, "/dbtesterpb.Controller/Control", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Controller service
+
+type ControllerServer interface {
+	Control(context.Context, *ControlRequest) (*ControlResponse, error)
+}
+
+func RegisterControllerServer(s *grpc.Server, srv ControllerServer) {
+	s.RegisterService(&_Controller_serviceDesc, srv)
+}
+
+func _Controller_Control_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).Control(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dbtesterpb.Controller/Control",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).Control(ctx, req.(*ControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Controller_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dbtesterpb.Controller",
+	HandlerType: (*ControllerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Control",
+			Handler:    _Controller_Control_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dbtesterpb/message.proto",
+}
+
+// Client API for Query service
+
+type QueryClient interface {
+	GetSummary(ctx context.Context, in *QueryRunRequest, opts ...grpc.CallOption) (*QueryRunSummary, error)
+	GetTimeseries(ctx context.Context, in *QueryTimeseriesRequest, opts ...grpc.CallOption) (*QueryTimeseriesResponse, error)
+	CompareRuns(ctx context.Context, in *QueryCompareRequest, opts ...grpc.CallOption) (*QueryCompareResponse, error)
+}
+
+type queryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQueryClient(cc *grpc.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetSummary(ctx context.Context, in *QueryRunRequest, opts ...grpc.CallOption) (*QueryRunSummary, error) {
+	out := new(QueryRunSummary)
+	err := grpc.Invoke(ctx, "/dbtesterpb.Query/GetSummary", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTimeseries(ctx context.Context, in *QueryTimeseriesRequest, opts ...grpc.CallOption) (*QueryTimeseriesResponse, error) {
+	out := new(QueryTimeseriesResponse)
+	err := grpc.Invoke(ctx, "/dbtesterpb.Query/GetTimeseries", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CompareRuns(ctx context.Context, in *QueryCompareRequest, opts ...grpc.CallOption) (*QueryCompareResponse, error) {
+	out := new(QueryCompareResponse)
+	err := grpc.Invoke(ctx, "/dbtesterpb.Query/CompareRuns", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Query service
+
+type QueryServer interface {
+	GetSummary(context.Context, *QueryRunRequest) (*QueryRunSummary, error)
+	GetTimeseries(context.Context, *QueryTimeseriesRequest) (*QueryTimeseriesResponse, error)
+	CompareRuns(context.Context, *QueryCompareRequest) (*QueryCompareResponse, error)
+}
+
+func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_GetSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dbtesterpb.Query/GetSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSummary(ctx, req.(*QueryRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTimeseries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimeseriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTimeseries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dbtesterpb.Query/GetTimeseries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTimeseries(ctx, req.(*QueryTimeseriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CompareRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CompareRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dbtesterpb.Query/CompareRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CompareRuns(ctx, req.(*QueryCompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dbtesterpb.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSummary",
+			Handler:    _Query_GetSummary_Handler,
+		},
+		{
+			MethodName: "GetTimeseries",
+			Handler:    _Query_GetTimeseries_Handler,
+		},
+		{
+			MethodName: "CompareRuns",
+			Handler:    _Query_CompareRuns_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dbtesterpb/message.proto",
 }
@@ -225,53 +821,185 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
-	if m.Flag_Etcd_Tip != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x6
+	if m.ConfigDocker != nil {
+		dAtA[i] = 0x4a
 		i++
-		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_Tip.Size()))
-		n2, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConfigDocker.Size()))
+		n2, err := m.ConfigDocker.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n2
 	}
-	if m.Flag_Etcd_V3_2 != nil {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x6
+	if m.NemesisStep != nil {
+		dAtA[i] = 0x52
 		i++
-		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_V3_2.Size()))
-		n3, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
+		i = encodeVarintMessage(dAtA, i, uint64(m.NemesisStep.Size()))
+		n3, err := m.NemesisStep.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n3
 	}
-	if m.Flag_Etcd_V3_3 != nil {
-		dAtA[i] = 0xb2
+	if m.NemesisRecover {
+		dAtA[i] = 0x58
 		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_V3_3.Size()))
-		n4, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.NemesisRecover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n4
+		i++
 	}
-	if m.Flag_Zookeeper_R3_5_3Beta != nil {
-		dAtA[i] = 0xc2
+	if m.EnablePprof {
+		dAtA[i] = 0x60
+		i++
+		if m.EnablePprof {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ProfileSeconds != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ProfileSeconds))
+	}
+	if m.Perf {
+		dAtA[i] = 0x70
+		i++
+		if m.Perf {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PerfArgs) > 0 {
+		for _, s := range m.PerfArgs {
+			dAtA[i] = 0x7a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ConfigWANTopology != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConfigWANTopology.Size()))
+		n4, err := m.ConfigWANTopology.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.ConfigDiskDelay != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConfigDiskDelay.Size()))
+		n5, err := m.ConfigDiskDelay.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.Collectors) > 0 {
+		for _, msg := range m.Collectors {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DatabaseEnv) > 0 {
+		for _, s := range m.DatabaseEnv {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.DatabasePort != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.DatabasePort))
+	}
+	if m.Flag_Etcd_Tip != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_Tip.Size()))
+		n6, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Flag_Etcd_V3_2 != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_V3_2.Size()))
+		n7, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Flag_Etcd_V3_3 != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_V3_3.Size()))
+		n8, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.Flag_Zookeeper_R3_5_3Beta != nil {
+		dAtA[i] = 0xc2
 		i++
 		dAtA[i] = 0xc
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_3Beta.Size()))
-		n5, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
+		n9, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n9
 	}
 	if m.Flag_Consul_V1_0_2 != nil {
 		dAtA[i] = 0xe2
@@ -279,11 +1007,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Consul_V1_0_2.Size()))
-		n6, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
+		n10, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n10
 	}
 	if m.Flag_Cetcd_Beta != nil {
 		dAtA[i] = 0x82
@@ -291,11 +1019,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x19
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Cetcd_Beta.Size()))
-		n7, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
+		n11, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n11
 	}
 	if m.Flag_Zetcd_Beta != nil {
 		dAtA[i] = 0xa2
@@ -303,11 +1031,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1f
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Zetcd_Beta.Size()))
-		n8, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
+		n12, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n12
 	}
 	return i, nil
 }
@@ -342,105 +1070,3262 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.DiskSpaceUsageBytes))
 	}
+	if len(m.CPUProfile) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CPUProfile)))
+		i += copy(dAtA[i:], m.CPUProfile)
+	}
+	if len(m.HeapProfile) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.HeapProfile)))
+		i += copy(dAtA[i:], m.HeapProfile)
+	}
+	if len(m.PerfScript) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PerfScript)))
+		i += copy(dAtA[i:], m.PerfScript)
+	}
+	if len(m.CPUModel) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CPUModel)))
+		i += copy(dAtA[i:], m.CPUModel)
+	}
+	if m.CPUCores != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.CPUCores))
+	}
+	if m.CPUMHz != 0 {
+		dAtA[i] = 0x41
+		i++
+		i = encodeFixed64Message(dAtA, i, uint64(math.Float64bits(float64(m.CPUMHz))))
+	}
+	if m.UnixNanosecond != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.UnixNanosecond))
+	}
+	if m.DiskWriteMBPerSecond != 0 {
+		dAtA[i] = 0x51
+		i++
+		i = encodeFixed64Message(dAtA, i, uint64(math.Float64bits(float64(m.DiskWriteMBPerSecond))))
+	}
+	if len(m.ServerCommandLine) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ServerCommandLine)))
+		i += copy(dAtA[i:], m.ServerCommandLine)
+	}
+	if len(m.ProxyCommandLine) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ProxyCommandLine)))
+		i += copy(dAtA[i:], m.ProxyCommandLine)
+	}
+	if len(m.ServerConfigPath) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ServerConfigPath)))
+		i += copy(dAtA[i:], m.ServerConfigPath)
+	}
+	if len(m.ServerConfig) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ServerConfig)))
+		i += copy(dAtA[i:], m.ServerConfig)
+	}
 	return i, nil
 }
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MonitorSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Request) Size() (n int) {
+
+func (m *MonitorSample) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Operation != 0 {
-		n += 1 + sovMessage(uint64(m.Operation))
-	}
-	if m.TriggerLogUpload {
-		n += 2
-	}
-	if m.DatabaseID != 0 {
-		n += 1 + sovMessage(uint64(m.DatabaseID))
+	if m.UnixNanosecond != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.UnixNanosecond))
 	}
-	l = len(m.DatabaseTag)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.Header) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Header)))
+		i += copy(dAtA[i:], m.Header)
 	}
-	l = len(m.PeerIPsString)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.Row) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Row)))
+		i += copy(dAtA[i:], m.Row)
 	}
-	if m.IPIndex != 0 {
-		n += 1 + sovMessage(uint64(m.IPIndex))
+	return i, nil
+}
+
+func (m *ControlRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.CurrentClientNumber != 0 {
-		n += 1 + sovMessage(uint64(m.CurrentClientNumber))
+	return dAtA[:n], nil
+}
+
+func (m *ControlRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.Operation))
 	}
-	if m.ConfigClientMachineInitial != nil {
-		l = m.ConfigClientMachineInitial.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	return i, nil
+}
+
+func (m *ControlResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Flag_Etcd_Tip != nil {
-		l = m.Flag_Etcd_Tip.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ControlResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		dAtA[i] = 0x8
+		i++
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Flag_Etcd_V3_2 != nil {
-		l = m.Flag_Etcd_V3_2.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	return i, nil
+}
+
+func (m *InstallChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Flag_Etcd_V3_3 != nil {
-		l = m.Flag_Etcd_V3_3.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *InstallChunk) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DatabaseID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.DatabaseID))
 	}
-	if m.Flag_Zookeeper_R3_5_3Beta != nil {
-		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
 	}
-	if m.Flag_Consul_V1_0_2 != nil {
-		l = m.Flag_Consul_V1_0_2.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if len(m.SHA256) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SHA256)))
+		i += copy(dAtA[i:], m.SHA256)
 	}
-	if m.Flag_Cetcd_Beta != nil {
-		l = m.Flag_Cetcd_Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
 	}
-	if m.Flag_Zetcd_Beta != nil {
-		l = m.Flag_Zetcd_Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	return i, nil
+}
+
+func (m *QueryRunRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Response) Size() (n int) {
+func (m *QueryRunRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
+	if len(m.RunID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunID)))
+		i += copy(dAtA[i:], m.RunID)
 	}
-	if m.DiskSpaceUsageBytes != 0 {
+	if len(m.DatabaseTag) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DatabaseTag)))
+		i += copy(dAtA[i:], m.DatabaseTag)
+	}
+	return i, nil
+}
+
+func (m *QueryRunSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRunSummary) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunID)))
+		i += copy(dAtA[i:], m.RunID)
+	}
+	if len(m.DatabaseTag) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DatabaseTag)))
+		i += copy(dAtA[i:], m.DatabaseTag)
+	}
+	if len(m.DatabaseID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DatabaseID)))
+		i += copy(dAtA[i:], m.DatabaseID)
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.StartedAt) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.StartedAt)))
+		i += copy(dAtA[i:], m.StartedAt)
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Failure) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Failure)))
+		i += copy(dAtA[i:], m.Failure)
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *QueryTimeseriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeseriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunID)))
+		i += copy(dAtA[i:], m.RunID)
+	}
+	if len(m.DatabaseTag) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DatabaseTag)))
+		i += copy(dAtA[i:], m.DatabaseTag)
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.MaxPoints != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.MaxPoints))
+	}
+	return i, nil
+}
+
+func (m *QueryTimeseriesPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeseriesPoint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.UnixSecond != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.UnixSecond))
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x11
+		i++
+		i = encodeFixed64Message(dAtA, i, uint64(math.Float64bits(float64(m.Value))))
+	}
+	return i, nil
+}
+
+func (m *QueryTimeseriesColumn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeseriesColumn) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Points) > 0 {
+		for _, msg := range m.Points {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *QueryTimeseriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeseriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Columns) > 0 {
+		for _, msg := range m.Columns {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *QueryCompareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCompareRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Runs) > 0 {
+		for _, msg := range m.Runs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *QueryComparedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryComparedValue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Column) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Column)))
+		i += copy(dAtA[i:], m.Column)
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x11
+		i++
+		i = encodeFixed64Message(dAtA, i, uint64(math.Float64bits(float64(m.Value))))
+	}
+	if m.DeltaPercent != 0 {
+		dAtA[i] = 0x19
+		i++
+		i = encodeFixed64Message(dAtA, i, uint64(math.Float64bits(float64(m.DeltaPercent))))
+	}
+	return i, nil
+}
+
+func (m *QueryComparedRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryComparedRun) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.RunID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunID)))
+		i += copy(dAtA[i:], m.RunID)
+	}
+	if len(m.DatabaseTag) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DatabaseTag)))
+		i += copy(dAtA[i:], m.DatabaseTag)
+	}
+	if len(m.Values) > 0 {
+		for _, msg := range m.Values {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *QueryCompareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCompareResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Runs) > 0 {
+		for _, msg := range m.Runs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Message(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovMessage(uint64(m.Operation))
+	}
+	if m.TriggerLogUpload {
+		n += 2
+	}
+	if m.DatabaseID != 0 {
+		n += 1 + sovMessage(uint64(m.DatabaseID))
+	}
+	l = len(m.DatabaseTag)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.PeerIPsString)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.IPIndex != 0 {
+		n += 1 + sovMessage(uint64(m.IPIndex))
+	}
+	if m.CurrentClientNumber != 0 {
+		n += 1 + sovMessage(uint64(m.CurrentClientNumber))
+	}
+	if m.ConfigClientMachineInitial != nil {
+		l = m.ConfigClientMachineInitial.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ConfigDocker != nil {
+		l = m.ConfigDocker.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.NemesisStep != nil {
+		l = m.NemesisStep.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.NemesisRecover {
+		n += 2
+	}
+	if m.EnablePprof {
+		n += 2
+	}
+	if m.ProfileSeconds != 0 {
+		n += 1 + sovMessage(uint64(m.ProfileSeconds))
+	}
+	if m.Perf {
+		n += 2
+	}
+	if len(m.PerfArgs) > 0 {
+		for _, s := range m.PerfArgs {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.ConfigWANTopology != nil {
+		l = m.ConfigWANTopology.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.ConfigDiskDelay != nil {
+		l = m.ConfigDiskDelay.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if len(m.Collectors) > 0 {
+		for _, e := range m.Collectors {
+			l = e.Size()
+			n += 2 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.DatabaseEnv) > 0 {
+		for _, s := range m.DatabaseEnv {
+			l = len(s)
+			n += 2 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.DatabasePort != 0 {
+		n += 2 + sovMessage(uint64(m.DatabasePort))
+	}
+	if m.Flag_Etcd_Tip != nil {
+		l = m.Flag_Etcd_Tip.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Etcd_V3_2 != nil {
+		l = m.Flag_Etcd_V3_2.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Etcd_V3_3 != nil {
+		l = m.Flag_Etcd_V3_3.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Zookeeper_R3_5_3Beta != nil {
+		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Consul_V1_0_2 != nil {
+		l = m.Flag_Consul_V1_0_2.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Cetcd_Beta != nil {
+		l = m.Flag_Cetcd_Beta.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Zetcd_Beta != nil {
+		l = m.Flag_Zetcd_Beta.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.DiskSpaceUsageBytes != 0 {
 		n += 1 + sovMessage(uint64(m.DiskSpaceUsageBytes))
 	}
-	return n
-}
+	l = len(m.CPUProfile)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.HeapProfile)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.PerfScript)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.CPUModel)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CPUCores != 0 {
+		n += 1 + sovMessage(uint64(m.CPUCores))
+	}
+	if m.CPUMHz != 0 {
+		n += 9
+	}
+	if m.UnixNanosecond != 0 {
+		n += 1 + sovMessage(uint64(m.UnixNanosecond))
+	}
+	if m.DiskWriteMBPerSecond != 0 {
+		n += 9
+	}
+	l = len(m.ServerCommandLine)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.ProxyCommandLine)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.ServerConfigPath)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.ServerConfig)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *MonitorSample) Size() (n int) {
+	var l int
+	_ = l
+	if m.UnixNanosecond != 0 {
+		n += 1 + sovMessage(uint64(m.UnixNanosecond))
+	}
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Row)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ControlRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovMessage(uint64(m.Operation))
+	}
+	return n
+}
+
+func (m *ControlResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *InstallChunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.DatabaseID != 0 {
+		n += 1 + sovMessage(uint64(m.DatabaseID))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.SHA256)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRunRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RunID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.DatabaseTag)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRunSummary) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RunID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.DatabaseTag)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.DatabaseID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.StartedAt)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	l = len(m.Failure)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTimeseriesRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RunID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.DatabaseTag)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.MaxPoints != 0 {
+		n += 1 + sovMessage(uint64(m.MaxPoints))
+	}
+	return n
+}
+
+func (m *QueryTimeseriesPoint) Size() (n int) {
+	var l int
+	_ = l
+	if m.UnixSecond != 0 {
+		n += 1 + sovMessage(uint64(m.UnixSecond))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *QueryTimeseriesColumn) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTimeseriesResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Columns) > 0 {
+		for _, e := range m.Columns {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCompareRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Runs) > 0 {
+		for _, e := range m.Runs {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryComparedValue) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Column)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.DeltaPercent != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *QueryComparedRun) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RunID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.DatabaseTag)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCompareResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Runs) > 0 {
+		for _, e := range m.Runs {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= (Operation(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerLogUpload", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TriggerLogUpload = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			m.DatabaseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabaseID |= (DatabaseID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPIndex", wireType)
+			}
+			m.IPIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IPIndex |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentClientNumber", wireType)
+			}
+			m.CurrentClientNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentClientNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineInitial", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigClientMachineInitial == nil {
+				m.ConfigClientMachineInitial = &ConfigClientMachineInitial{}
+			}
+			if err := m.ConfigClientMachineInitial.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDocker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigDocker == nil {
+				m.ConfigDocker = &ConfigDocker{}
+			}
+			if err := m.ConfigDocker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NemesisStep", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NemesisStep == nil {
+				m.NemesisStep = &ConfigNemesisStep{}
+			}
+			if err := m.NemesisStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NemesisRecover", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NemesisRecover = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePprof", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnablePprof = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileSeconds", wireType)
+			}
+			m.ProfileSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProfileSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perf", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Perf = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerfArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerfArgs = append(m.PerfArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigWANTopology", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigWANTopology == nil {
+				m.ConfigWANTopology = &ConfigWANTopology{}
+			}
+			if err := m.ConfigWANTopology.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDiskDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigDiskDelay == nil {
+				m.ConfigDiskDelay = &ConfigDiskDelay{}
+			}
+			if err := m.ConfigDiskDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collectors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collectors = append(m.Collectors, &ConfigCollector{})
+			if err := m.Collectors[len(m.Collectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseEnv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseEnv = append(m.DatabaseEnv, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabasePort", wireType)
+			}
+			m.DatabasePort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabasePort |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Tip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_Tip == nil {
+				m.Flag_Etcd_Tip = &Flag_Etcd_Tip{}
+			}
+			if err := m.Flag_Etcd_Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_V3_2 == nil {
+				m.Flag_Etcd_V3_2 = &Flag_Etcd_V3_2{}
+			}
+			if err := m.Flag_Etcd_V3_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_V3_3 == nil {
+				m.Flag_Etcd_V3_3 = &Flag_Etcd_V3_3{}
+			}
+			if err := m.Flag_Etcd_V3_3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 200:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_3Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zookeeper_R3_5_3Beta == nil {
+				m.Flag_Zookeeper_R3_5_3Beta = &Flag_Zookeeper_R3_5_3Beta{}
+			}
+			if err := m.Flag_Zookeeper_R3_5_3Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 300:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V1_0_2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Consul_V1_0_2 == nil {
+				m.Flag_Consul_V1_0_2 = &Flag_Consul_V1_0_2{}
+			}
+			if err := m.Flag_Consul_V1_0_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 400:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Cetcd_Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Cetcd_Beta == nil {
+				m.Flag_Cetcd_Beta = &Flag_Cetcd_Beta{}
+			}
+			if err := m.Flag_Cetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 500:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zetcd_Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zetcd_Beta == nil {
+				m.Flag_Zetcd_Beta = &Flag_Zetcd_Beta{}
+			}
+			if err := m.Flag_Zetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskSpaceUsageBytes", wireType)
+			}
+			m.DiskSpaceUsageBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskSpaceUsageBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUProfile", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CPUProfile = append(m.CPUProfile[:0], dAtA[iNdEx:postIndex]...)
+			if m.CPUProfile == nil {
+				m.CPUProfile = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeapProfile", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeapProfile = append(m.HeapProfile[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeapProfile == nil {
+				m.HeapProfile = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerfScript", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerfScript = append(m.PerfScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.PerfScript == nil {
+				m.PerfScript = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUModel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CPUModel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUCores", wireType)
+			}
+			m.CPUCores = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUCores |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUMHz", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.CPUMHz = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixNanosecond", wireType)
+			}
+			m.UnixNanosecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnixNanosecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskWriteMBPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.DiskWriteMBPerSecond = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerCommandLine", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerCommandLine = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyCommandLine", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyCommandLine = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerConfigPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerConfigPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonitorSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonitorSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonitorSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixNanosecond", wireType)
+			}
+			m.UnixNanosecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnixNanosecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Row = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControlRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControlRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControlRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= (ControlOperation(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControlResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControlResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControlResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InstallChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstallChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstallChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			m.DatabaseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabaseID |= (DatabaseID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SHA256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SHA256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRunRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRunRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRunSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRunSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRunSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failure = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimeseriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeseriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeseriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoints", wireType)
+			}
+			m.MaxPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoints |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovMessage(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *QueryTimeseriesPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -463,17 +4348,17 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimeseriesPoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimeseriesPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixSecond", wireType)
 			}
-			m.Operation = 0
+			m.UnixSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -483,53 +4368,82 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= (Operation(b) & 0x7F) << shift
+				m.UnixSecond |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerLogUpload", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TriggerLogUpload = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Value = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.DatabaseID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DatabaseID |= (DatabaseID(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
 			}
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimeseriesColumn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeseriesColumn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeseriesColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -554,13 +4468,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -570,62 +4484,76 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
+			m.Points = append(m.Points, &QueryTimeseriesPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPIndex", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.IPIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IPIndex |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentClientNumber", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.CurrentClientNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentClientNumber |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimeseriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
 			}
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeseriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeseriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineInitial", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -649,16 +4577,64 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigClientMachineInitial == nil {
-				m.ConfigClientMachineInitial = &ConfigClientMachineInitial{}
+			m.Columns = append(m.Columns, &QueryTimeseriesColumn{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.ConfigClientMachineInitial.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 100:
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCompareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCompareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCompareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Tip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -682,18 +4658,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_Tip == nil {
-				m.Flag_Etcd_Tip = &Flag_Etcd_Tip{}
-			}
-			if err := m.Flag_Etcd_Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Runs = append(m.Runs, &QueryRunRequest{})
+			if err := m.Runs[len(m.Runs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 101:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -703,30 +4677,76 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_V3_2 == nil {
-				m.Flag_Etcd_V3_2 = &Flag_Etcd_V3_2{}
-			}
-			if err := m.Flag_Etcd_V3_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 102:
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryComparedValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryComparedValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryComparedValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -736,63 +4756,112 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_V3_3 == nil {
-				m.Flag_Etcd_V3_3 = &Flag_Etcd_V3_3{}
+			m.Column = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if err := m.Flag_Etcd_V3_3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 200:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_3Beta", wireType)
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Value = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeltaPercent", wireType)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.DeltaPercent = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Zookeeper_R3_5_3Beta == nil {
-				m.Flag_Zookeeper_R3_5_3Beta = &Flag_Zookeeper_R3_5_3Beta{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryComparedRun) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
 			}
-			if err := m.Flag_Zookeeper_R3_5_3Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 300:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryComparedRun: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryComparedRun: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V1_0_2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -802,30 +4871,26 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Consul_V1_0_2 == nil {
-				m.Flag_Consul_V1_0_2 = &Flag_Consul_V1_0_2{}
-			}
-			if err := m.Flag_Consul_V1_0_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RunID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 400:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Cetcd_Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -835,28 +4900,24 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Cetcd_Beta == nil {
-				m.Flag_Cetcd_Beta = &Flag_Cetcd_Beta{}
-			}
-			if err := m.Flag_Cetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 500:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zetcd_Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -880,10 +4941,8 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Zetcd_Beta == nil {
-				m.Flag_Zetcd_Beta = &Flag_Zetcd_Beta{}
-			}
-			if err := m.Flag_Zetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Values = append(m.Values, &QueryComparedValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -908,7 +4967,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *QueryCompareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -931,17 +4990,17 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCompareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCompareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -951,31 +5010,23 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskSpaceUsageBytes", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
 			}
-			m.DiskSpaceUsageBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DiskSpaceUsageBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runs = append(m.Runs, &QueryComparedRun{})
+			if err := m.Runs[len(m.Runs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1105,49 +5156,112 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/message.proto", fileDescriptorMessage) }
 
 var fileDescriptorMessage = []byte{
-	// 690 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x51, 0x4e, 0x1b, 0x3b,
-	0x14, 0x86, 0x33, 0x04, 0x48, 0xe2, 0x28, 0xdc, 0x5c, 0x03, 0x57, 0x56, 0xe0, 0xa6, 0x23, 0x54,
-	0xa1, 0x08, 0xa9, 0x09, 0x64, 0x44, 0xfb, 0x5c, 0x42, 0x2b, 0x22, 0xb5, 0x05, 0x39, 0x81, 0x07,
-	0x5e, 0x2c, 0xcf, 0xe4, 0x64, 0x18, 0x91, 0x8c, 0xa7, 0x1e, 0x07, 0xb5, 0xac, 0xa2, 0x8f, 0x5d,
-	0x44, 0x17, 0xd0, 0x25, 0xf0, 0xd8, 0x25, 0xb4, 0x74, 0x0b, 0x5d, 0x40, 0x35, 0x9e, 0x84, 0x18,
-	0x12, 0xda, 0xb7, 0x9c, 0xff, 0xff, 0xfd, 0x8d, 0x7d, 0x62, 0x1f, 0x44, 0x7a, 0xae, 0x82, 0x58,
-	0x81, 0x8c, 0xdc, 0xc6, 0x10, 0xe2, 0x98, 0xfb, 0x50, 0x8f, 0xa4, 0x50, 0x02, 0xa3, 0xa9, 0x53,
-	0x79, 0xe6, 0x07, 0xea, 0x62, 0xe4, 0xd6, 0x3d, 0x31, 0x6c, 0xf8, 0xc2, 0x17, 0x0d, 0x1d, 0x71,
-	0x47, 0x7d, 0x5d, 0xe9, 0x42, 0xff, 0x4a, 0x97, 0x56, 0x36, 0x0d, 0x68, 0x8f, 0x2b, 0xee, 0xf2,
-	0x18, 0x58, 0xd0, 0x1b, 0xbb, 0x15, 0xc3, 0xed, 0x0f, 0xb8, 0xcf, 0x40, 0x79, 0x13, 0xef, 0xc9,
-	0x43, 0xef, 0x5a, 0x88, 0x4b, 0x80, 0x08, 0xe4, 0x1c, 0xb4, 0x0e, 0x78, 0x22, 0x8c, 0x47, 0x83,
-	0xb1, 0xbb, 0x31, 0xb3, 0xdc, 0x60, 0xcf, 0x98, 0x9e, 0x61, 0x6e, 0x1b, 0xa6, 0x27, 0xc2, 0x7e,
-	0xe0, 0x33, 0x6f, 0x10, 0x40, 0xa8, 0xd8, 0x90, 0x7b, 0x17, 0x41, 0x38, 0xee, 0xca, 0xd6, 0xd7,
-	0x1c, 0xca, 0x51, 0x78, 0x3f, 0x82, 0x58, 0x61, 0x07, 0x15, 0x8e, 0x23, 0x90, 0x5c, 0x05, 0x22,
-	0x24, 0x96, 0x6d, 0xd5, 0x56, 0x9a, 0xeb, 0xf5, 0x29, 0xa7, 0x7e, 0x67, 0xd2, 0x69, 0x0e, 0xef,
-	0xa0, 0x72, 0x57, 0x06, 0xbe, 0x0f, 0xf2, 0x8d, 0xf0, 0x4f, 0xa3, 0x81, 0xe0, 0x3d, 0xb2, 0x60,
-	0x5b, 0xb5, 0x3c, 0x9d, 0xd1, 0xf1, 0x73, 0x84, 0x0e, 0xc7, 0xed, 0x6b, 0x1f, 0x92, 0xac, 0xfe,
-	0xc2, 0x7f, 0xe6, 0x17, 0xa6, 0x2e, 0x35, 0x92, 0xd8, 0x46, 0xc5, 0x49, 0xd5, 0xe5, 0x3e, 0x59,
-	0xb4, 0xad, 0x5a, 0x81, 0x9a, 0x12, 0x7e, 0x8a, 0x4a, 0x27, 0x00, 0xb2, 0x7d, 0x12, 0x77, 0x94,
-	0x0c, 0x42, 0x9f, 0x2c, 0xe9, 0xcc, 0x7d, 0x11, 0x13, 0x94, 0x6b, 0x9f, 0xb4, 0xc3, 0x1e, 0x7c,
-	0x20, 0xcb, 0xb6, 0x55, 0x2b, 0xd1, 0x49, 0x89, 0x77, 0xd1, 0x6a, 0x6b, 0x24, 0x25, 0x84, 0xaa,
-	0xa5, 0xbb, 0xf4, 0x6e, 0x34, 0x74, 0x41, 0x92, 0x9c, 0x6d, 0xd5, 0xb2, 0x74, 0x9e, 0x85, 0xfb,
-	0xa8, 0xd2, 0xd2, 0x7d, 0x4d, 0xd5, 0xb7, 0x69, 0x57, 0xdb, 0x61, 0xa0, 0x02, 0x3e, 0x20, 0x79,
-	0xdb, 0xaa, 0x15, 0x9b, 0xdb, 0xe6, 0xd9, 0x1e, 0x4f, 0xd3, 0x3f, 0x90, 0xf0, 0x4b, 0xf4, 0x8f,
-	0xfe, 0x73, 0xf5, 0xad, 0x62, 0x4c, 0x05, 0x11, 0xe9, 0x69, 0xf8, 0x86, 0x09, 0x7f, 0x10, 0xa1,
-	0xc5, 0x44, 0x78, 0xa5, 0xbc, 0x5e, 0x37, 0x88, 0x70, 0x0b, 0x95, 0x4d, 0xff, 0xca, 0x61, 0x4d,
-	0x02, 0x9a, 0xb1, 0xf9, 0x18, 0x23, 0xc9, 0x4c, 0x21, 0x67, 0x4e, 0x73, 0x0e, 0xc4, 0x21, 0xfd,
-	0xbf, 0x42, 0x1c, 0x13, 0xe2, 0xe0, 0x3e, 0xda, 0x4c, 0x03, 0x77, 0xcf, 0x80, 0x31, 0xe9, 0xb0,
-	0x7d, 0xe6, 0x30, 0x17, 0x14, 0x27, 0x37, 0x96, 0x26, 0xd6, 0x66, 0x89, 0xf3, 0x17, 0xd0, 0xf5,
-	0xc4, 0x3d, 0x9f, 0x78, 0xd4, 0xd9, 0x77, 0x0e, 0x40, 0x71, 0x7c, 0x8c, 0xd6, 0xd2, 0x65, 0xe9,
-	0x6b, 0x62, 0xec, 0x6a, 0x8f, 0xed, 0xb2, 0x26, 0xf9, 0xb2, 0xa0, 0xf9, 0xf6, 0x2c, 0xff, 0x7e,
-	0x90, 0xae, 0x24, 0x6a, 0x4b, 0x6b, 0x67, 0x7b, 0xbb, 0x4d, 0x7c, 0x84, 0xfe, 0x1d, 0xe7, 0xd2,
-	0xa3, 0xe9, 0xdd, 0x7e, 0xca, 0x6a, 0xda, 0xff, 0x73, 0x68, 0xd3, 0x14, 0x2d, 0x69, 0x54, 0x22,
-	0xe8, 0xad, 0xdd, 0x91, 0xae, 0x0d, 0xd2, 0xaf, 0x47, 0x49, 0xd7, 0x0f, 0x49, 0xe7, 0x13, 0xd2,
-	0xd6, 0x19, 0xca, 0x53, 0x88, 0x23, 0x11, 0xc6, 0x90, 0xdc, 0xec, 0xce, 0xc8, 0xf3, 0x20, 0x8e,
-	0xf5, 0xc3, 0xcd, 0xd3, 0x49, 0x99, 0xdc, 0xec, 0xc3, 0x20, 0xbe, 0xec, 0x44, 0xdc, 0x83, 0xd3,
-	0x64, 0x1c, 0x1e, 0x7c, 0x54, 0x10, 0xeb, 0x27, 0x9a, 0xa5, 0xf3, 0xac, 0x9d, 0x86, 0x31, 0x06,
-	0x70, 0x01, 0x2d, 0x75, 0x14, 0x97, 0xaa, 0x9c, 0xc1, 0x79, 0xb4, 0xd8, 0x51, 0x22, 0x2a, 0x5b,
-	0xb8, 0x84, 0x0a, 0x47, 0xc0, 0xa5, 0x72, 0x81, 0xab, 0xf2, 0x42, 0xf3, 0x35, 0x2a, 0x76, 0x25,
-	0x0f, 0xe3, 0x48, 0x48, 0x05, 0x12, 0xbf, 0x40, 0x79, 0x5d, 0xf6, 0x41, 0xe2, 0x55, 0xf3, 0x44,
-	0xe3, 0x39, 0x53, 0x59, 0xbb, 0x2f, 0xa6, 0x47, 0xd8, 0xca, 0x1c, 0xac, 0xdd, 0xfc, 0xa8, 0x66,
-	0x6e, 0x6e, 0xab, 0xd6, 0xb7, 0xdb, 0xaa, 0xf5, 0xfd, 0xb6, 0x6a, 0x7d, 0xfe, 0x59, 0xcd, 0xb8,
-	0xcb, 0x7a, 0x50, 0x39, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xed, 0x0b, 0xe3, 0xda, 0x05,
-	0x00, 0x00,
+	// 1711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x72, 0x1b, 0x4b,
+	0x15, 0xf6, 0x58, 0xfe, 0x9b, 0x23, 0xff, 0x28, 0x1d, 0xc7, 0x0c, 0x8e, 0xf1, 0x15, 0x03, 0x95,
+	0xf2, 0x4d, 0x41, 0xe2, 0x2b, 0x55, 0x02, 0xc5, 0x85, 0xa2, 0x1c, 0x39, 0x5c, 0xbb, 0x88, 0x1d,
+	0xd1, 0xb2, 0x7d, 0xab, 0xb2, 0x11, 0xad, 0xd1, 0x91, 0x32, 0xe5, 0xd1, 0xf4, 0xd0, 0xd3, 0x63,
+	0xe2, 0xec, 0x2e, 0x2b, 0x16, 0x14, 0xc5, 0x92, 0x87, 0xe0, 0x31, 0x58, 0x64, 0xc9, 0x23, 0x40,
+	0x78, 0x01, 0x16, 0x3c, 0x00, 0xd5, 0x47, 0x33, 0xd2, 0x48, 0x23, 0x19, 0x2a, 0xac, 0x3c, 0xe7,
+	0x9c, 0xaf, 0xbf, 0xee, 0x73, 0xfa, 0xf4, 0xd7, 0x6d, 0x81, 0xd3, 0xed, 0x68, 0x8c, 0x35, 0xaa,
+	0xa8, 0xf3, 0x74, 0x80, 0x71, 0x2c, 0xfa, 0xf8, 0x24, 0x52, 0x52, 0x4b, 0x06, 0xe3, 0xc8, 0xee,
+	0x0f, 0xfb, 0xbe, 0x7e, 0x9b, 0x74, 0x9e, 0x78, 0x72, 0xf0, 0xb4, 0x2f, 0xfb, 0xf2, 0x29, 0x41,
+	0x3a, 0x49, 0x8f, 0x2c, 0x32, 0xe8, 0x6b, 0x38, 0x74, 0x77, 0x2f, 0x47, 0xda, 0x15, 0x5a, 0x74,
+	0x44, 0x8c, 0x6d, 0xbf, 0x9b, 0x46, 0x77, 0x73, 0xd1, 0x5e, 0x20, 0xfa, 0x6d, 0xd4, 0x5e, 0x16,
+	0xfb, 0x6c, 0x3a, 0xf6, 0x5e, 0xca, 0x6b, 0xc4, 0x08, 0xd5, 0x0c, 0x6a, 0x02, 0x78, 0x32, 0x8c,
+	0x93, 0x20, 0x8d, 0x3e, 0x2c, 0x0c, 0xcf, 0x71, 0x17, 0x82, 0x5e, 0x2e, 0xf8, 0x28, 0x17, 0xf4,
+	0x64, 0xd8, 0xf3, 0xfb, 0x6d, 0x2f, 0xf0, 0x31, 0xd4, 0xed, 0x81, 0xf0, 0xde, 0xfa, 0x61, 0x5a,
+	0x15, 0xf7, 0x5f, 0x65, 0x58, 0xe5, 0xf8, 0x9b, 0x04, 0x63, 0xcd, 0xea, 0x60, 0xbf, 0x8e, 0x50,
+	0x09, 0xed, 0xcb, 0xd0, 0xb1, 0xaa, 0xd6, 0xc1, 0x66, 0xed, 0xc1, 0x93, 0x31, 0xcf, 0x93, 0x51,
+	0x90, 0x8f, 0x71, 0xec, 0x31, 0x54, 0x2e, 0x94, 0xdf, 0xef, 0xa3, 0x7a, 0x25, 0xfb, 0x97, 0x51,
+	0x20, 0x45, 0xd7, 0x59, 0xac, 0x5a, 0x07, 0x6b, 0xbc, 0xe0, 0x67, 0xcf, 0x01, 0x8e, 0xd3, 0xf2,
+	0x9d, 0x1e, 0x3b, 0x25, 0x9a, 0x61, 0x27, 0x3f, 0xc3, 0x38, 0xca, 0x73, 0x48, 0x56, 0x85, 0x72,
+	0x66, 0x5d, 0x88, 0xbe, 0xb3, 0x54, 0xb5, 0x0e, 0x6c, 0x9e, 0x77, 0xb1, 0xef, 0xc3, 0x46, 0x13,
+	0x51, 0x9d, 0x36, 0xe3, 0x96, 0x56, 0x7e, 0xd8, 0x77, 0x96, 0x09, 0x33, 0xe9, 0x64, 0x0e, 0xac,
+	0x9e, 0x36, 0x4f, 0xc3, 0x2e, 0xbe, 0x73, 0x56, 0xaa, 0xd6, 0xc1, 0x06, 0xcf, 0x4c, 0x76, 0x08,
+	0xf7, 0x1b, 0x89, 0x52, 0x18, 0xea, 0x06, 0x55, 0xe9, 0x3c, 0x19, 0x74, 0x50, 0x39, 0xab, 0x55,
+	0xeb, 0xa0, 0xc4, 0x67, 0x85, 0x58, 0x0f, 0x76, 0x1b, 0x54, 0xd7, 0xa1, 0xf7, 0x6c, 0x58, 0xd5,
+	0xd3, 0xd0, 0xd7, 0xbe, 0x08, 0x9c, 0xb5, 0xaa, 0x75, 0x50, 0xae, 0x3d, 0xca, 0xe7, 0x36, 0x1f,
+	0xcd, 0xef, 0x60, 0x62, 0x3f, 0x85, 0xf5, 0x61, 0xf4, 0x58, 0x7a, 0xd7, 0xa8, 0x1c, 0x9b, 0x98,
+	0x9d, 0x22, 0xf3, 0x30, 0xce, 0x27, 0xd0, 0xec, 0xe7, 0x50, 0x3e, 0xc7, 0x01, 0xc6, 0x7e, 0xdc,
+	0xd2, 0x18, 0x39, 0x40, 0x83, 0xbf, 0x53, 0x1c, 0x9c, 0x03, 0xf1, 0xfc, 0x08, 0xf6, 0x08, 0x36,
+	0x53, 0x93, 0xa3, 0x27, 0x6f, 0x50, 0x39, 0x65, 0xda, 0xdc, 0x29, 0xaf, 0xd9, 0xa2, 0x97, 0xa1,
+	0xe8, 0x04, 0xd8, 0x8c, 0x94, 0xec, 0x39, 0xeb, 0x04, 0xca, 0xbb, 0x0c, 0x53, 0x53, 0xc9, 0x9e,
+	0x1f, 0x60, 0x0b, 0x3d, 0x19, 0x76, 0x63, 0x67, 0x83, 0xaa, 0x3b, 0xe5, 0x65, 0x0c, 0x96, 0x9a,
+	0xa8, 0x7a, 0xce, 0x26, 0x51, 0xd0, 0x37, 0xdb, 0x85, 0x35, 0xf3, 0xf7, 0x48, 0xf5, 0x63, 0x67,
+	0xab, 0x5a, 0x3a, 0xb0, 0xf9, 0xc8, 0x66, 0xbf, 0x84, 0x7b, 0xc3, 0x1c, 0xbe, 0x3e, 0x3a, 0xbf,
+	0x90, 0x91, 0x0c, 0x64, 0xff, 0xd6, 0xa9, 0xcc, 0x4b, 0x34, 0x07, 0xe2, 0xc5, 0x71, 0xec, 0x25,
+	0x6c, 0xa5, 0xf5, 0xf3, 0xe3, 0xeb, 0x63, 0x0c, 0xc4, 0xad, 0x73, 0x8f, 0xa8, 0x1e, 0xce, 0x28,
+	0x78, 0x06, 0xe1, 0xd3, 0x63, 0xd8, 0x97, 0x00, 0x0d, 0x19, 0x04, 0xe8, 0x69, 0xa9, 0x62, 0x87,
+	0x55, 0x4b, 0xb3, 0x19, 0x46, 0x18, 0x9e, 0x83, 0xe7, 0xbb, 0xfd, 0x65, 0x78, 0xe3, 0xdc, 0xa7,
+	0x7c, 0xf3, 0x2e, 0xe6, 0xc2, 0x7a, 0x66, 0x36, 0xa5, 0xd2, 0xce, 0x36, 0x15, 0x72, 0xc2, 0xc7,
+	0x8e, 0x60, 0x8b, 0x44, 0x81, 0xd4, 0xa8, 0xdd, 0xd6, 0x7e, 0xe4, 0x74, 0x8b, 0x99, 0x4c, 0x41,
+	0x78, 0xd9, 0x38, 0x5e, 0x6a, 0xaf, 0x7b, 0xe1, 0x47, 0xac, 0x01, 0x95, 0x7c, 0xfc, 0xa6, 0xde,
+	0xae, 0x39, 0x48, 0x1c, 0x7b, 0xf3, 0x38, 0x0c, 0x66, 0x4c, 0x72, 0x55, 0xaf, 0xcd, 0x20, 0xa9,
+	0x3b, 0xbd, 0xff, 0x4a, 0x52, 0xcf, 0x93, 0xd4, 0x59, 0x0f, 0xf6, 0x86, 0x80, 0x91, 0x7c, 0xb6,
+	0xdb, 0xaa, 0xde, 0x7e, 0xd6, 0xae, 0xb7, 0x3b, 0xa8, 0x85, 0xf3, 0xc1, 0x22, 0xc6, 0x83, 0x22,
+	0xe3, 0xec, 0x01, 0xfc, 0x81, 0x89, 0xbe, 0xc9, 0x62, 0xbc, 0xfe, 0xac, 0xfe, 0x02, 0xb5, 0x60,
+	0xaf, 0x61, 0x7b, 0x38, 0x6c, 0xa8, 0xc2, 0xed, 0xf6, 0xcd, 0x17, 0xed, 0xc3, 0x76, 0xcd, 0xf9,
+	0xcb, 0x22, 0xf1, 0x57, 0x8b, 0xfc, 0x93, 0x40, 0xbe, 0x69, 0xbc, 0x0d, 0xf2, 0x5d, 0x7d, 0x71,
+	0x58, 0x63, 0x27, 0x70, 0x2f, 0xc5, 0x0d, 0x53, 0xa3, 0xd5, 0xfe, 0xa9, 0x54, 0xec, 0xce, 0x02,
+	0x8a, 0x6f, 0x10, 0x95, 0x71, 0xd0, 0xd2, 0x46, 0x4c, 0xef, 0x73, 0x4c, 0xff, 0x9e, 0xcb, 0xf4,
+	0x7e, 0x9a, 0xe9, 0x4d, 0xc6, 0xe4, 0xfe, 0x71, 0x09, 0xd6, 0x38, 0xc6, 0x91, 0x0c, 0x63, 0x34,
+	0x92, 0xd8, 0x4a, 0x3c, 0x0f, 0xe3, 0x98, 0x14, 0x7f, 0x8d, 0x67, 0xa6, 0x91, 0x44, 0xd3, 0xd0,
+	0xad, 0x48, 0x78, 0x78, 0x69, 0xee, 0xd1, 0x17, 0xb7, 0x1a, 0x63, 0xd2, 0xf6, 0x12, 0x9f, 0x15,
+	0x62, 0xfb, 0x00, 0x8d, 0xe6, 0x65, 0x7a, 0x9c, 0x49, 0xde, 0xd7, 0x79, 0xce, 0x63, 0x1a, 0xfb,
+	0x04, 0x45, 0x94, 0x01, 0x96, 0x08, 0x90, 0x77, 0x19, 0x06, 0x73, 0xae, 0x5b, 0x9e, 0xf2, 0x23,
+	0x4d, 0x1a, 0xbe, 0xce, 0x73, 0x1e, 0xa3, 0x03, 0x8d, 0xe6, 0xe5, 0x99, 0xec, 0x62, 0x40, 0x0a,
+	0x6e, 0xf3, 0x91, 0x9d, 0xc6, 0x1a, 0x52, 0x61, 0x9c, 0xea, 0xf6, 0xc8, 0x66, 0x3b, 0xb0, 0x62,
+	0x70, 0x27, 0xef, 0x49, 0x98, 0x2d, 0x9e, 0x5a, 0x46, 0x93, 0x2e, 0x43, 0xff, 0xdd, 0xb9, 0x08,
+	0x65, 0x4c, 0xf2, 0x43, 0xf2, 0x5a, 0xe2, 0x53, 0x5e, 0x56, 0x83, 0x6d, 0x93, 0xf0, 0xd7, 0xca,
+	0xd7, 0x78, 0xf6, 0xa2, 0x89, 0x6a, 0x28, 0x56, 0xa4, 0xa7, 0x16, 0x9f, 0x19, 0x63, 0x3f, 0x80,
+	0x7b, 0x2d, 0x54, 0x37, 0xa8, 0x1a, 0x72, 0x30, 0x10, 0x61, 0xf7, 0x95, 0x1f, 0x22, 0x89, 0xa7,
+	0xcd, 0x8b, 0x01, 0x73, 0x8d, 0x36, 0x95, 0x7c, 0x77, 0x9b, 0x07, 0xaf, 0x13, 0xb8, 0xe0, 0x37,
+	0xd8, 0x8c, 0xc0, 0xa8, 0x48, 0x53, 0xe8, 0xb7, 0xa4, 0xa5, 0x36, 0x2f, 0xf8, 0x8d, 0x54, 0xe4,
+	0x7d, 0xa4, 0xaa, 0x36, 0x9f, 0xf0, 0xb9, 0x02, 0x36, 0xce, 0x64, 0xe8, 0x6b, 0xa9, 0x5a, 0x62,
+	0x10, 0x05, 0x38, 0xa3, 0x2c, 0xd6, 0xcc, 0xb2, 0xec, 0xc0, 0xca, 0x09, 0x8a, 0x2e, 0x2a, 0xea,
+	0x0a, 0x9b, 0xa7, 0x16, 0xab, 0x40, 0x89, 0xcb, 0xdf, 0x52, 0x07, 0xd8, 0xdc, 0x7c, 0xba, 0xaf,
+	0x60, 0xb3, 0x21, 0x43, 0xad, 0x64, 0x90, 0x3d, 0x36, 0x7e, 0x52, 0x7c, 0x6c, 0xec, 0x4d, 0x29,
+	0xa4, 0x81, 0xcf, 0x7a, 0x73, 0xb8, 0x9f, 0xc3, 0x56, 0x1a, 0x1e, 0xf5, 0xf1, 0x0e, 0xac, 0x34,
+	0x45, 0x12, 0x63, 0x37, 0x6d, 0xe3, 0xd4, 0x72, 0xff, 0x60, 0xc1, 0xfa, 0x69, 0x18, 0x6b, 0x11,
+	0x04, 0x8d, 0xb7, 0x49, 0x78, 0x3d, 0xf5, 0x06, 0xb1, 0xfe, 0xe7, 0x37, 0x88, 0x03, 0xab, 0x57,
+	0xa8, 0x62, 0xb3, 0xda, 0x61, 0xb2, 0x99, 0x69, 0xa6, 0x6e, 0x9d, 0x1c, 0xd5, 0x9e, 0x3d, 0x4f,
+	0x13, 0x4e, 0x2d, 0x73, 0x91, 0x99, 0xf1, 0x69, 0x9f, 0xd3, 0xb7, 0x7b, 0x0a, 0x5b, 0xbf, 0x4a,
+	0x50, 0xdd, 0xf2, 0x24, 0xcc, 0x0a, 0xb1, 0x0d, 0xcb, 0x3c, 0x09, 0xd3, 0xb5, 0xd8, 0x7c, 0x68,
+	0x4c, 0x3f, 0x79, 0x16, 0x0b, 0x4f, 0x1e, 0xf7, 0x9b, 0xc5, 0x31, 0x57, 0x2b, 0x19, 0x0c, 0x84,
+	0xba, 0xfd, 0x54, 0x2e, 0x73, 0xee, 0xa6, 0x1e, 0x66, 0xf6, 0xbc, 0xe4, 0x97, 0x26, 0x93, 0xdf,
+	0x03, 0xbb, 0xa5, 0x85, 0xd2, 0xd8, 0x3d, 0xd2, 0xe9, 0xa3, 0x6b, 0xec, 0x30, 0x25, 0xb8, 0x10,
+	0xfd, 0xd8, 0x59, 0xa1, 0x3b, 0x8c, 0xbe, 0x0d, 0xd7, 0x2f, 0x84, 0x1f, 0x24, 0x0a, 0xe9, 0x98,
+	0xda, 0x3c, 0x33, 0x4d, 0xa4, 0x21, 0x83, 0x64, 0x10, 0xc6, 0xce, 0x1a, 0x0d, 0xc8, 0x4c, 0x53,
+	0xe2, 0x2b, 0x11, 0x24, 0x18, 0x3b, 0x36, 0x05, 0x52, 0xcb, 0xfd, 0xbd, 0x05, 0x3b, 0x54, 0x83,
+	0x0b, 0x7f, 0x80, 0x31, 0x2a, 0x1f, 0xe3, 0xff, 0xb3, 0xac, 0xf9, 0x45, 0x94, 0x26, 0x17, 0xb1,
+	0x07, 0xf6, 0x99, 0x78, 0xd7, 0x94, 0x7e, 0xa8, 0x63, 0x2a, 0x43, 0x89, 0x8f, 0x1d, 0xee, 0x2b,
+	0xd8, 0x9e, 0x5a, 0x09, 0x05, 0x4c, 0x69, 0xcd, 0xa9, 0x69, 0xe5, 0xcf, 0x51, 0xce, 0x63, 0xd6,
+	0x49, 0xc9, 0xd0, 0x5a, 0x2c, 0x3e, 0x34, 0x5c, 0x84, 0x07, 0x53, 0x6c, 0xc3, 0x55, 0x98, 0x8a,
+	0x9e, 0x8b, 0x01, 0xa6, 0x59, 0xd1, 0x37, 0xfb, 0x31, 0xac, 0xa4, 0xab, 0x5a, 0xac, 0x96, 0xa6,
+	0xaf, 0xa9, 0x59, 0x8b, 0xe2, 0x29, 0xde, 0xbd, 0x82, 0x6f, 0x15, 0xca, 0x97, 0x1e, 0xa8, 0x2f,
+	0xc7, 0x75, 0xb0, 0x88, 0xf5, 0xbb, 0x77, 0xb0, 0x0e, 0x91, 0xa3, 0x52, 0xb9, 0xbf, 0x86, 0xfb,
+	0x84, 0x68, 0xc8, 0x41, 0x24, 0x14, 0x66, 0x7b, 0xf2, 0x14, 0x96, 0x78, 0x32, 0x22, 0x7c, 0x58,
+	0x20, 0x1c, 0x9f, 0x0a, 0x4e, 0xc0, 0xfc, 0x66, 0x2c, 0x4e, 0x6c, 0x86, 0xdb, 0x03, 0x96, 0x9f,
+	0xa1, 0x4b, 0x65, 0x23, 0x9d, 0x27, 0x40, 0x5a, 0x9f, 0xd4, 0x9a, 0x5d, 0x64, 0x7a, 0x46, 0x61,
+	0xa0, 0x45, 0x13, 0x95, 0x87, 0xa1, 0xa6, 0xbe, 0xb7, 0xf8, 0x84, 0xcf, 0xfd, 0x9d, 0x05, 0x95,
+	0x89, 0x89, 0x78, 0x12, 0x7e, 0x72, 0x6f, 0x3d, 0x1f, 0xb5, 0x71, 0x89, 0x2a, 0xb0, 0x5f, 0xa8,
+	0xc0, 0x44, 0x3a, 0xa3, 0x36, 0x3f, 0x81, 0xed, 0x7c, 0x74, 0xb4, 0x47, 0x87, 0x13, 0xf5, 0xdc,
+	0x9b, 0xcb, 0x66, 0xea, 0x4a, 0xc8, 0xc7, 0x6f, 0x72, 0xaa, 0xcb, 0x6c, 0x58, 0xa6, 0xa3, 0x5a,
+	0x59, 0x60, 0x6b, 0xb0, 0xd4, 0xd2, 0x32, 0xaa, 0x58, 0x6c, 0x03, 0xec, 0x13, 0x14, 0x4a, 0x77,
+	0x50, 0xe8, 0xca, 0x22, 0x2b, 0xc3, 0x6a, 0xfa, 0xd2, 0xaf, 0x94, 0x8c, 0x91, 0xde, 0xd4, 0x95,
+	0x25, 0xb6, 0x05, 0xe5, 0x46, 0x20, 0xbd, 0xeb, 0xd7, 0xbd, 0x5e, 0x8c, 0xba, 0xb2, 0xfc, 0xf8,
+	0x73, 0xa8, 0x4c, 0x8b, 0xb6, 0x99, 0x82, 0x84, 0xb8, 0xb2, 0xc0, 0x00, 0x56, 0x38, 0xc6, 0xc9,
+	0x00, 0x2b, 0x56, 0xed, 0xaf, 0x16, 0x94, 0x2f, 0x94, 0x08, 0xe3, 0x48, 0x2a, 0x8d, 0x8a, 0xfd,
+	0x08, 0xd6, 0xc8, 0xec, 0xa1, 0x62, 0xf7, 0xf3, 0x69, 0xa4, 0xed, 0xb0, 0xbb, 0x3d, 0xe9, 0x1c,
+	0xe6, 0xef, 0x2e, 0xb0, 0x9f, 0xc1, 0x6a, 0x7a, 0x75, 0xcd, 0x1e, 0xf7, 0xed, 0xbc, 0x73, 0xe2,
+	0x92, 0x73, 0x17, 0x0e, 0x2d, 0x33, 0x3c, 0xbd, 0x1c, 0xd8, 0xc4, 0x7f, 0x54, 0xf9, 0x1b, 0x63,
+	0xde, 0xdc, 0x07, 0x56, 0x8d, 0x03, 0xa4, 0x19, 0x07, 0xa8, 0xd8, 0x31, 0xac, 0xa6, 0x16, 0xdb,
+	0x9d, 0x71, 0x93, 0x65, 0x4b, 0x7a, 0x38, 0x33, 0x96, 0xb1, 0xd6, 0xbe, 0x59, 0x84, 0x65, 0xda,
+	0x3c, 0x76, 0x02, 0xf0, 0x15, 0xea, 0x4c, 0xda, 0xef, 0x3a, 0x2d, 0xbb, 0x33, 0x83, 0xe9, 0x48,
+	0x77, 0x81, 0xbd, 0x81, 0x8d, 0xaf, 0x50, 0x8f, 0x8f, 0x2b, 0x73, 0xef, 0x38, 0xcb, 0x19, 0xe7,
+	0xf7, 0xee, 0xc4, 0x8c, 0x76, 0x80, 0x43, 0x39, 0x6b, 0x4b, 0x73, 0x62, 0x3f, 0x9b, 0xd7, 0x84,
+	0x19, 0x6d, 0x75, 0x3e, 0x20, 0xe3, 0x7c, 0xb1, 0xfd, 0xe1, 0x1f, 0xfb, 0x0b, 0x1f, 0x3e, 0xee,
+	0x5b, 0x7f, 0xfb, 0xb8, 0x6f, 0xfd, 0xfd, 0xe3, 0xbe, 0xf5, 0xe7, 0x7f, 0xee, 0x2f, 0x74, 0x56,
+	0xe8, 0x17, 0x8b, 0xfa, 0x7f, 0x06, 0x00, 0x17, 0x69, 0x40, 0x25, 0xe3, 0x11, 0x00, 0x00,
 }
@@ -15,18 +15,64 @@
 package dbtesterpb
 
 import (
+	"fmt"
 	"image/color"
+	"path/filepath"
 	"sort"
+	"strings"
+	"time"
 
 	"gonum.org/v1/plot/plotutil"
 )
 
-// IsValidDatabaseID returns false if the database id is not supported.
+// ClusterSeparator separates the database id and the cluster name
+// (e.g. 'etcd__v3_3@snapshot-10000'), to test multiple clusters of
+// the same database in one configuration.
+const ClusterSeparator = "@"
+
+// IsValidDatabaseID returns false if the database id is not supported,
+// or has an empty cluster name.
 func IsValidDatabaseID(id string) bool {
-	_, ok := DatabaseID_value[id]
+	if i := strings.Index(id, ClusterSeparator); i >= 0 && i == len(id)-len(ClusterSeparator) {
+		return false
+	}
+	_, ok := DatabaseID_value[BaseDatabaseID(id)]
 	return ok
 }
 
+const (
+	// MinMonitorIntervalMs is the minimum of 'monitor_interval_ms'.
+	MinMonitorIntervalMs = 100
+	// MaxMonitorIntervalMs is the maximum of 'monitor_interval_ms'.
+	MaxMonitorIntervalMs = 10000
+)
+
+// MonitorInterval returns the interval to sample system metrics,
+// or one second if not set.
+func MonitorInterval(cfg *ConfigClientMachineInitial) time.Duration {
+	if cfg == nil || cfg.MonitorIntervalMs == 0 {
+		return time.Second
+	}
+	return time.Duration(cfg.MonitorIntervalMs) * time.Millisecond
+}
+
+// Topology returns "proxy" if the database is stressed through
+// proxy endpoints, or "direct".
+func Topology(gcfg ConfigClientMachineAgentControl) string {
+	if len(gcfg.ProxyEndpoints) > 0 {
+		return "proxy"
+	}
+	return "direct"
+}
+
+// BaseDatabaseID returns the database id without the cluster name.
+func BaseDatabaseID(id string) string {
+	if i := strings.Index(id, ClusterSeparator); i >= 0 {
+		return id[:i]
+	}
+	return id
+}
+
 // GetAllDatabaseIDs returns all database ids.
 func GetAllDatabaseIDs() []string {
 	var ids []string
@@ -37,6 +83,18 @@ func GetAllDatabaseIDs() []string {
 	return ids
 }
 
+// ClientResultDir returns the directory of client-side results
+// in the standard layout '<run-id>/<database-tag>/client'.
+func ClientResultDir(runID, databaseTag string) string {
+	return filepath.Join(runID, databaseTag, "client")
+}
+
+// ServerResultDir returns the directory of results from the agent at index 'idx'
+// in the standard layout '<run-id>/<database-tag>/server-N' (N is 'idx'+1).
+func ServerResultDir(runID, databaseTag string, idx int) string {
+	return filepath.Join(runID, databaseTag, fmt.Sprintf("server-%d", idx+1))
+}
+
 func GetRGBI(databaseID string, i int) color.Color {
 	switch databaseID {
 	case "etcd__tip":
@@ -0,0 +1,47 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtesterpb
+
+import "testing"
+
+func TestDatabaseIDCluster(t *testing.T) {
+	tests := []struct {
+		id    string
+		base  string
+		valid bool
+	}{
+		{"etcd__v3_3", "etcd__v3_3", true},
+		{"etcd__v3_3@snapshot-10000", "etcd__v3_3", true},
+		{"etcd__v3_3@", "etcd__v3_3", false},
+		{"etcd__v9@snapshot-10000", "etcd__v9", false},
+	}
+	for i, tt := range tests {
+		if base := BaseDatabaseID(tt.id); base != tt.base {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.base, base)
+		}
+		if valid := IsValidDatabaseID(tt.id); valid != tt.valid {
+			t.Fatalf("#%d: expected valid %v, got %v", i, tt.valid, valid)
+		}
+	}
+}
+
+func TestTopology(t *testing.T) {
+	if tp := Topology(ConfigClientMachineAgentControl{}); tp != "direct" {
+		t.Fatalf("expected direct, got %q", tp)
+	}
+	if tp := Topology(ConfigClientMachineAgentControl{ProxyEndpoints: []string{"10.240.0.13:23790"}}); tp != "proxy" {
+		t.Fatalf("expected proxy, got %q", tp)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtesterpb
+
+import "fmt"
+
+// Validate returns an error if the topology does not have
+// a region for each of 'agentN' agents, or has an invalid link.
+func (t *ConfigWANTopology) Validate(agentN int) error {
+	if t == nil {
+		return nil
+	}
+	if len(t.Regions) != agentN {
+		return fmt.Errorf("wan_topology has %d regions, expected one for each of %d agents", len(t.Regions), agentN)
+	}
+	regions := make(map[string]bool, len(t.Regions))
+	for _, r := range t.Regions {
+		if r == "" {
+			return fmt.Errorf("wan_topology has empty region")
+		}
+		regions[r] = true
+	}
+	seen := make(map[[2]string]bool, len(t.Links))
+	for _, l := range t.Links {
+		if !regions[l.From] || !regions[l.To] {
+			return fmt.Errorf("wan_topology link %q-%q has unknown region", l.From, l.To)
+		}
+		if l.From == l.To {
+			return fmt.Errorf("wan_topology link %q-%q is in the same region", l.From, l.To)
+		}
+		if seen[[2]string{l.From, l.To}] || seen[[2]string{l.To, l.From}] {
+			return fmt.Errorf("wan_topology link %q-%q is duplicate", l.From, l.To)
+		}
+		seen[[2]string{l.From, l.To}] = true
+		if l.DelayMilliseconds < 0 || l.JitterMilliseconds < 0 || l.JitterMilliseconds > l.DelayMilliseconds {
+			return fmt.Errorf("wan_topology link %q-%q has invalid delay %d ms, jitter %d ms", l.From, l.To, l.DelayMilliseconds, l.JitterMilliseconds)
+		}
+		if l.LossPercent < 0 || l.LossPercent > 100 {
+			return fmt.Errorf("wan_topology link %q-%q has invalid loss %v%%", l.From, l.To, l.LossPercent)
+		}
+	}
+	return nil
+}
+
+// Link returns the network conditions from the agent at index 'from'
+// to the agent at index 'to', or nil if none.
+func (t *ConfigWANTopology) Link(from, to int) *ConfigWANLink {
+	if t == nil || from >= len(t.Regions) || to >= len(t.Regions) {
+		return nil
+	}
+	rf, rt := t.Regions[from], t.Regions[to]
+	for _, l := range t.Links {
+		if (l.From == rf && l.To == rt) || (l.From == rt && l.To == rf) {
+			return l
+		}
+	}
+	return nil
+}
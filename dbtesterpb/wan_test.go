@@ -0,0 +1,57 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtesterpb
+
+import "testing"
+
+func TestWANTopology(t *testing.T) {
+	tp := &ConfigWANTopology{
+		Regions: []string{"us-west", "us-west", "eu-west"},
+		Links:   []*ConfigWANLink{{From: "eu-west", To: "us-west", DelayMilliseconds: 70, JitterMilliseconds: 5, LossPercent: 0.1}},
+	}
+	if err := tp.Validate(3); err != nil {
+		t.Fatal(err)
+	}
+	if l := tp.Link(0, 1); l != nil {
+		t.Fatalf("expected no link in the same region, got %+v", l)
+	}
+	if l := tp.Link(0, 2); l == nil || l.DelayMilliseconds != 70 {
+		t.Fatalf("expected link to eu-west, got %+v", l)
+	}
+	if l := tp.Link(2, 1); l == nil || l.DelayMilliseconds != 70 {
+		t.Fatalf("expected link from eu-west, got %+v", l)
+	}
+
+	if err := tp.Validate(5); err == nil {
+		t.Fatal("expected error for missing regions")
+	}
+	for i, links := range [][]*ConfigWANLink{
+		{{From: "us-west", To: "ap-east"}},
+		{{From: "us-west", To: "us-west"}},
+		{{From: "us-west", To: "eu-west"}, {From: "eu-west", To: "us-west"}},
+		{{From: "us-west", To: "eu-west", DelayMilliseconds: 5, JitterMilliseconds: 10}},
+		{{From: "us-west", To: "eu-west", LossPercent: 120}},
+	} {
+		bad := &ConfigWANTopology{Regions: tp.Regions, Links: links}
+		if err := bad.Validate(3); err == nil {
+			t.Fatalf("#%d: expected error", i)
+		}
+	}
+
+	var none *ConfigWANTopology
+	if err := none.Validate(3); err != nil || none.Link(0, 1) != nil {
+		t.Fatalf("expected no-op for nil topology, got %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"golang.org/x/net/context"
+)
+
+const defaultDiscoverySRVRefresh = 30 * time.Second
+
+// srvDiscovery discovers database endpoints from DNS SRV records,
+// so that clients follow dynamically scheduled clusters.
+type srvDiscovery struct {
+	name   string
+	lookup func(name string) ([]*net.SRV, error)
+
+	mu          sync.Mutex
+	endpoints   []string
+	etcdClients []*clientv3.Client
+}
+
+// activeDiscovery is the discovery of the database being stressed, if any.
+// Connections created while it is set use the latest discovered endpoints.
+var activeDiscovery *srvDiscovery
+
+func lookupSRV(name string) ([]*net.SRV, error) {
+	_, addrs, err := net.LookupSRV("", "", name)
+	return addrs, err
+}
+
+func newSRVDiscovery(name string) (*srvDiscovery, error) {
+	d := &srvDiscovery{name: name, lookup: lookupSRV}
+	eps, err := d.resolve()
+	if err != nil {
+		return nil, err
+	}
+	d.endpoints = eps
+	plog.Infof("discovered endpoints %q from SRV %q", eps, name)
+	return d, nil
+}
+
+// resolve returns the sorted endpoints of the SRV records.
+func (d *srvDiscovery) resolve() ([]string, error) {
+	addrs, err := d.lookup(d.name)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no SRV record found for %q", d.name)
+	}
+	eps := make([]string, 0, len(addrs))
+	for _, a := range addrs {
+		eps = append(eps, net.JoinHostPort(strings.TrimSuffix(a.Target, "."), fmt.Sprint(a.Port)))
+	}
+	sort.Strings(eps)
+	return eps, nil
+}
+
+// Endpoints returns the latest discovered endpoints.
+func (d *srvDiscovery) Endpoints() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.endpoints
+}
+
+// trackEtcd keeps etcd clients to update their endpoints on changes.
+func (d *srvDiscovery) trackEtcd(clients ...*clientv3.Client) {
+	d.mu.Lock()
+	d.etcdClients = append(d.etcdClients, clients...)
+	d.mu.Unlock()
+}
+
+// refresh re-resolves the SRV records, and returns true if endpoints
+// have changed. On changes, tracked etcd clients are reassigned to the
+// new endpoints in round-robin order. Other clients keep connections,
+// and only new connections use the new endpoints.
+func (d *srvDiscovery) refresh() (bool, error) {
+	eps, err := d.resolve()
+	if err != nil {
+		return false, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if reflect.DeepEqual(eps, d.endpoints) {
+		return false, nil
+	}
+	plog.Infof("SRV %q endpoints changed from %q to %q", d.name, d.endpoints, eps)
+	d.endpoints = eps
+	alive := d.etcdClients[:0]
+	for _, c := range d.etcdClients {
+		if c.Ctx().Err() != nil {
+			// closed
+			continue
+		}
+		c.SetEndpoints(eps[len(alive)%len(eps)])
+		alive = append(alive, c)
+	}
+	d.etcdClients = alive
+	return true, nil
+}
+
+// run re-resolves every 'interval' until the context is canceled.
+// The returned channel is closed when it returns.
+func (d *srvDiscovery) run(ctx context.Context, interval time.Duration) <-chan struct{} {
+	donec := make(chan struct{})
+	go func() {
+		defer close(donec)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+			changed, err := d.refresh()
+			if err != nil {
+				plog.Warningf("failed to re-resolve SRV %q (%v)", d.name, err)
+			}
+			if l := connEvents; l != nil {
+				switch {
+				case err != nil:
+					l.record(connEventReResolve, d.name, err.Error())
+				case changed:
+					l.record(connEventReResolve, d.name, "changed to "+strings.Join(d.Endpoints(), " "))
+				default:
+					l.record(connEventReResolve, d.name, "unchanged")
+				}
+			}
+		}
+	}()
+	return donec
+}
+
+// discoveredEndpoints returns the latest discovered endpoints
+// while discovery is active, or 'endpoints' otherwise.
+func discoveredEndpoints(endpoints []string) []string {
+	if activeDiscovery == nil {
+		return endpoints
+	}
+	return activeDiscovery.Endpoints()
+}
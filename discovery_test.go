@@ -0,0 +1,59 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestSRVDiscovery(t *testing.T) {
+	records := []*net.SRV{
+		{Target: "infra1.example.com.", Port: 2379},
+		{Target: "infra0.example.com.", Port: 2379},
+	}
+	d := &srvDiscovery{
+		name:   "_etcd-client._tcp.example.com",
+		lookup: func(string) ([]*net.SRV, error) { return records, nil },
+	}
+	if _, err := d.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	exp := []string{"infra0.example.com:2379", "infra1.example.com:2379"}
+	if eps := d.Endpoints(); !reflect.DeepEqual(eps, exp) {
+		t.Fatalf("expected %q, got %q", exp, eps)
+	}
+
+	if changed, err := d.refresh(); err != nil || changed {
+		t.Fatalf("expected no change, got %v (%v)", changed, err)
+	}
+
+	records = append(records, &net.SRV{Target: "infra2.example.com.", Port: 2379})
+	if changed, err := d.refresh(); err != nil || !changed {
+		t.Fatalf("expected change, got %v (%v)", changed, err)
+	}
+	if n := len(d.Endpoints()); n != 3 {
+		t.Fatalf("expected 3 endpoints, got %d", n)
+	}
+
+	records = nil
+	if _, err := d.refresh(); err == nil {
+		t.Fatal("expected error on no record")
+	}
+	if n := len(d.Endpoints()); n != 3 {
+		t.Fatalf("expected to keep 3 endpoints, got %d", n)
+	}
+}
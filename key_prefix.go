@@ -0,0 +1,132 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/samuel/go-zookeeper/zk"
+	"golang.org/x/net/context"
+)
+
+// phaseKeyPrefix returns the unique key prefix of the run started at 'now'.
+// It has no '/', since Zookeeper keys are written under the root.
+func phaseKeyPrefix(now time.Time) string {
+	return "p" + strconv.FormatInt(now.UnixNano(), 36) + "-"
+}
+
+// ApplyPhaseKeyPrefix prepends the unique prefix of the run to 'key_prefix',
+// if 'phase_key_prefix' is set. It returns the key prefix of the run.
+func (cfg *Config) ApplyPhaseKeyPrefix(databaseID string, now time.Time) (string, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return "", fmt.Errorf("%q does not exist", databaseID)
+	}
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.PhaseKeyPrefix {
+		opts.KeyPrefix = phaseKeyPrefix(now) + opts.KeyPrefix
+	}
+	return opts.KeyPrefix, nil
+}
+
+// DeleteKeyPrefix deletes all keys under 'key_prefix' of the database,
+// and returns the number of deleted keys. Empty prefix is rejected,
+// not to delete all keys.
+func (cfg *Config) DeleteKeyPrefix(databaseID string) (int64, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return 0, fmt.Errorf("%q does not exist", databaseID)
+	}
+	prefix := gcfg.ConfigClientMachineBenchmarkOptions.KeyPrefix
+	if prefix == "" {
+		return 0, fmt.Errorf("%q has empty 'key_prefix' to delete", databaseID)
+	}
+
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		cli := mustCreateConnEtcdv3(clientEndpoints(gcfg))
+		defer cli.Close()
+		resp, err := cli.Delete(context.Background(), prefix, clientv3.WithPrefix())
+		if err != nil {
+			return 0, err
+		}
+		return resp.Deleted, nil
+
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		conn := mustCreateConnsZk(clientEndpoints(gcfg), 1)[0]
+		defer conn.Close()
+		return deleteZKPrefix(conn, prefix)
+
+	case "consul__v1_0_2", "cetcd__beta":
+		kv := mustCreateConnsConsul(clientEndpoints(gcfg), 1)[0]
+		keys, _, err := kv.Keys(prefix, "", nil)
+		if err != nil {
+			return 0, err
+		}
+		if _, err = kv.DeleteTree(prefix, nil); err != nil {
+			return 0, err
+		}
+		return int64(len(keys)), nil
+	}
+	return 0, fmt.Errorf("%q is unknown database ID", gcfg.DatabaseID)
+}
+
+// deleteZKPrefix deletes the nodes under the root with the prefix.
+// Listing many nodes may need larger 'jute.maxbuffer' on servers.
+func deleteZKPrefix(conn *zk.Conn, prefix string) (int64, error) {
+	children, _, err := conn.Children("/")
+	if err != nil {
+		return 0, err
+	}
+	keyc := make(chan string)
+	var (
+		deleted int64
+		errs    []string
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+	)
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := range keyc {
+				if err := conn.Delete("/"+k, -1); err != nil && err != zk.ErrNoNode {
+					mu.Lock()
+					errs = append(errs, err.Error())
+					mu.Unlock()
+					continue
+				}
+				atomic.AddInt64(&deleted, 1)
+			}
+		}()
+	}
+	for _, k := range children {
+		if strings.HasPrefix(k, prefix) {
+			keyc <- k
+		}
+	}
+	close(keyc)
+	wg.Wait()
+	if len(errs) > 0 {
+		return deleted, fmt.Errorf("failed to delete %d nodes (%s)", len(errs), errs[0])
+	}
+	return deleted, nil
+}
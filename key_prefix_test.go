@@ -0,0 +1,52 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestApplyPhaseKeyPrefix(t *testing.T) {
+	cfg := &Config{DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+		"etcd__tip":      {ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{KeyPrefix: "tenant-", PhaseKeyPrefix: true}},
+		"consul__v1_0_2": {ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{KeyPrefix: "tenant-"}},
+	}}
+	now := time.Unix(100, 0)
+
+	prefix, err := cfg.ApplyPhaseKeyPrefix("etcd__tip", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prefix != phaseKeyPrefix(now)+"tenant-" || strings.Contains(prefix, "/") {
+		t.Fatalf("unexpected phase key prefix %q", prefix)
+	}
+	if cfg.DatabaseIDToConfigClientMachineAgentControl["etcd__tip"].ConfigClientMachineBenchmarkOptions.KeyPrefix != prefix {
+		t.Fatal("expected 'key_prefix' to be updated")
+	}
+	if phaseKeyPrefix(now) == phaseKeyPrefix(now.Add(time.Millisecond)) {
+		t.Fatal("expected different prefixes of different runs")
+	}
+
+	if prefix, err = cfg.ApplyPhaseKeyPrefix("consul__v1_0_2", now); err != nil || prefix != "tenant-" {
+		t.Fatalf("expected unchanged prefix, got %q (%v)", prefix, err)
+	}
+	if _, err = cfg.ApplyPhaseKeyPrefix("unknown", now); err == nil {
+		t.Fatal("expected error of unknown database")
+	}
+}
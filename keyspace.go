@@ -0,0 +1,331 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/clientv3"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/samuel/go-zookeeper/zk"
+	"golang.org/x/net/context"
+)
+
+// KeyspaceColumns are the columns of keyspace snapshot file. Keys and
+// values are base64-encoded, so that binary data stays byte-identical
+// across databases.
+var KeyspaceColumns = []string{"KEY", "VALUE"}
+
+type keyValue struct {
+	key   string
+	value []byte
+}
+
+// keyspaceExportPageSize is the number of keys fetched in one etcd range request.
+const keyspaceExportPageSize = 1000
+
+// ExportKeyspace writes all keys under the prefix in the database to
+// the keyspace snapshot file, sorted by key. It returns the number of
+// exported keys. Empty prefix exports all keys.
+func ExportKeyspace(databaseID string, endpoints []string, prefix, fpath string) (int64, error) {
+	if !dbtesterpb.IsValidDatabaseID(databaseID) {
+		return 0, fmt.Errorf("databaseID %q is unknown", databaseID)
+	}
+	var (
+		kvs []keyValue
+		err error
+	)
+	switch databaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		cli := mustCreateConnEtcdv3(endpoints)
+		defer cli.Close()
+		kvs, err = exportEtcdv3(cli, prefix)
+
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		conn := mustCreateConnsZk(endpoints, 1)[0]
+		defer conn.Close()
+		kvs, err = exportZK(conn, prefix)
+
+	case "consul__v1_0_2", "cetcd__beta":
+		kv := mustCreateConnsConsul(endpoints, 1)[0]
+		var pairs consulapi.KVPairs
+		pairs, _, err = kv.List(prefix, nil)
+		for _, p := range pairs {
+			kvs = append(kvs, keyValue{key: p.Key, value: p.Value})
+		}
+
+	default:
+		return 0, fmt.Errorf("%q is unknown database ID", databaseID)
+	}
+	if err != nil {
+		return 0, err
+	}
+	sort.Slice(kvs, func(i, j int) bool { return kvs[i].key < kvs[j].key })
+
+	f, err := os.Create(fpath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	if err = writeKeyspace(f, kvs); err != nil {
+		return 0, err
+	}
+	return int64(len(kvs)), nil
+}
+
+// exportEtcdv3 reads the keys page by page, at the revision of the first
+// page, so that the keys written while exporting are not included.
+func exportEtcdv3(cli *clientv3.Client, prefix string) ([]keyValue, error) {
+	end := clientv3.GetPrefixRangeEnd(prefix)
+	if prefix == "" {
+		prefix, end = "\x00", "\x00"
+	}
+	var (
+		kvs []keyValue
+		rev int64
+	)
+	key := prefix
+	for {
+		opts := []clientv3.OpOption{clientv3.WithRange(end), clientv3.WithLimit(keyspaceExportPageSize)}
+		if rev > 0 {
+			opts = append(opts, clientv3.WithRev(rev))
+		}
+		resp, err := cli.Get(context.Background(), key, opts...)
+		if err != nil {
+			return nil, err
+		}
+		rev = resp.Header.Revision
+		for _, kv := range resp.Kvs {
+			kvs = append(kvs, keyValue{key: string(kv.Key), value: kv.Value})
+		}
+		if !resp.More || len(resp.Kvs) == 0 {
+			return kvs, nil
+		}
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+// exportZK reads the nodes under the root with the prefix,
+// the same layout as Zookeeper keys are written in benchmarks.
+func exportZK(conn *zk.Conn, prefix string) ([]keyValue, error) {
+	children, _, err := conn.Children("/")
+	if err != nil {
+		return nil, err
+	}
+	var kvs []keyValue
+	for _, k := range children {
+		if k == "zookeeper" || !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		v, _, err := conn.Get("/" + k)
+		if err == zk.ErrNoNode {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, keyValue{key: k, value: v})
+	}
+	return kvs, nil
+}
+
+// ImportKeyspace writes all keys in the keyspace snapshot file to the
+// database, overwriting existing keys. It returns the number of imported keys.
+func ImportKeyspace(databaseID string, endpoints []string, fpath string) (int64, error) {
+	if !dbtesterpb.IsValidDatabaseID(databaseID) {
+		return 0, fmt.Errorf("databaseID %q is unknown", databaseID)
+	}
+	f, err := os.Open(fpath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	kvs, err := readKeyspace(f)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a keyspace snapshot (%v)", fpath, err)
+	}
+
+	var put func(kv keyValue) error
+	switch databaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		cli := mustCreateConnEtcdv3(endpoints)
+		defer cli.Close()
+		put = func(kv keyValue) error {
+			_, err := cli.Put(context.Background(), kv.key, string(kv.value))
+			return err
+		}
+
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		for _, kv := range kvs {
+			if strings.Contains(kv.key, "/") {
+				return 0, fmt.Errorf("key %q has '/', which is not supported in Zookeeper", kv.key)
+			}
+		}
+		conn := mustCreateConnsZk(endpoints, 1)[0]
+		defer conn.Close()
+		put = func(kv keyValue) error {
+			_, err := conn.Create("/"+kv.key, kv.value, zkCreateFlags, zkCreateACL)
+			if err == zk.ErrNodeExists {
+				_, err = conn.Set("/"+kv.key, kv.value, -1)
+			}
+			return err
+		}
+
+	case "consul__v1_0_2", "cetcd__beta":
+		kv := mustCreateConnsConsul(endpoints, 1)[0]
+		put = func(p keyValue) error {
+			_, err := kv.Put(&consulapi.KVPair{Key: p.key, Value: p.value}, nil)
+			return err
+		}
+
+	default:
+		return 0, fmt.Errorf("%q is unknown database ID", databaseID)
+	}
+
+	kvc := make(chan keyValue)
+	var (
+		imported int64
+		errs     []string
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+	)
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for kv := range kvc {
+				if err := put(kv); err != nil {
+					mu.Lock()
+					errs = append(errs, err.Error())
+					mu.Unlock()
+					continue
+				}
+				atomic.AddInt64(&imported, 1)
+			}
+		}()
+	}
+	for _, kv := range kvs {
+		kvc <- kv
+	}
+	close(kvc)
+	wg.Wait()
+	if len(errs) > 0 {
+		return imported, fmt.Errorf("failed to import %d keys (%s)", len(errs), errs[0])
+	}
+	return imported, nil
+}
+
+// ImportKeyspaceSnapshot imports 'keyspace_snapshot_path' into the database,
+// after the database has started (and its data directory has been reset),
+// so that the imported keys are the only keys. It returns the number of
+// imported keys, or 0 if no snapshot is configured.
+func (cfg *Config) ImportKeyspaceSnapshot(databaseID string) (int64, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return 0, fmt.Errorf("%q does not exist", databaseID)
+	}
+	fpath := gcfg.ConfigClientMachineBenchmarkOptions.KeyspaceSnapshotPath
+	if fpath == "" {
+		return 0, nil
+	}
+	return ImportKeyspace(gcfg.DatabaseID, gcfg.DatabaseEndpoints, fpath)
+}
+
+// readKeyspaceKeys returns the keys in the keyspace snapshot file,
+// or nil if the path is empty.
+func readKeyspaceKeys(fpath string) ([]string, error) {
+	if fpath == "" {
+		return nil, nil
+	}
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	kvs, err := readKeyspace(f)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a keyspace snapshot (%v)", fpath, err)
+	}
+	if len(kvs) == 0 {
+		return nil, fmt.Errorf("keyspace snapshot %q has no key", fpath)
+	}
+	keys := make([]string, len(kvs))
+	for i := range kvs {
+		keys[i] = kvs[i].key
+	}
+	return keys, nil
+}
+
+func writeKeyspace(w io.Writer, kvs []keyValue) error {
+	bw := bufio.NewWriter(w)
+	cw := csv.NewWriter(bw)
+	if err := cw.Write(KeyspaceColumns); err != nil {
+		return err
+	}
+	for _, kv := range kvs {
+		row := []string{
+			base64.StdEncoding.EncodeToString([]byte(kv.key)),
+			base64.StdEncoding.EncodeToString(kv.value),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func readKeyspace(r io.Reader) ([]keyValue, error) {
+	rd := csv.NewReader(bufio.NewReader(r))
+	header, err := rd.Read()
+	if err != nil {
+		return nil, err
+	}
+	if len(header) != len(KeyspaceColumns) || header[0] != KeyspaceColumns[0] || header[1] != KeyspaceColumns[1] {
+		return nil, fmt.Errorf("unexpected header %q (expected %q)", header, KeyspaceColumns)
+	}
+	var kvs []keyValue
+	for {
+		row, err := rd.Read()
+		if err == io.EOF {
+			return kvs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		k, err := base64.StdEncoding.DecodeString(row[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q (%v)", row[0], err)
+		}
+		v, err := base64.StdEncoding.DecodeString(row[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of key %q (%v)", k, err)
+		}
+		kvs = append(kvs, keyValue{key: string(k), value: v})
+	}
+}
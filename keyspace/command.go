@@ -0,0 +1,93 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyspace
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/coreos/dbtester"
+
+	"github.com/spf13/cobra"
+)
+
+// Command implements 'keyspace' command.
+var Command = &cobra.Command{
+	Use:   "keyspace",
+	Short: "Exports and imports keyspace snapshots, to run read benchmarks on identical datasets.",
+}
+
+var exportCommand = &cobra.Command{
+	Use:   "export",
+	Short: "Exports keys under the prefix in the database to a snapshot file.",
+	RunE:  exportCommandFunc,
+}
+
+var importCommand = &cobra.Command{
+	Use:   "import",
+	Short: "Imports keys in the snapshot file into the database, overwriting existing keys.",
+	RunE:  importCommandFunc,
+}
+
+var databaseID string
+var endpoints []string
+var snapshotPath string
+var keyPrefix string
+
+func init() {
+	Command.PersistentFlags().StringVar(&databaseID, "database-id", "", "Database ID (e.g. 'etcd__v3_3', 'zookeeper__r3_5_3_beta', 'consul__v1_0_2').")
+	Command.PersistentFlags().StringSliceVar(&endpoints, "endpoints", nil, "Database client endpoints.")
+	Command.PersistentFlags().StringVar(&snapshotPath, "file", "keyspace.csv", "Path of keyspace snapshot file.")
+	exportCommand.Flags().StringVar(&keyPrefix, "key-prefix", "", "Prefix of keys to export (empty to export all keys).")
+
+	Command.AddCommand(exportCommand)
+	Command.AddCommand(importCommand)
+}
+
+func checkFlags() error {
+	if databaseID == "" {
+		return fmt.Errorf("'--database-id' is required")
+	}
+	if len(endpoints) == 0 {
+		return fmt.Errorf("'--endpoints' is required")
+	}
+	return nil
+}
+
+func exportCommandFunc(cmd *cobra.Command, args []string) error {
+	if err := checkFlags(); err != nil {
+		return err
+	}
+	now := time.Now()
+	n, err := dbtester.ExportKeyspace(databaseID, endpoints, keyPrefix, snapshotPath)
+	if err != nil {
+		return err
+	}
+	plog.Infof("exported %d keys from %q to %q (took %v)", n, databaseID, snapshotPath, time.Since(now))
+	return nil
+}
+
+func importCommandFunc(cmd *cobra.Command, args []string) error {
+	if err := checkFlags(); err != nil {
+		return err
+	}
+	now := time.Now()
+	n, err := dbtester.ImportKeyspace(databaseID, endpoints, snapshotPath)
+	if err != nil {
+		return err
+	}
+	plog.Infof("imported %d keys from %q to %q (took %v)", n, snapshotPath, databaseID, time.Since(now))
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestKeyspaceRoundTrip(t *testing.T) {
+	kvs := []keyValue{
+		{key: "a", value: []byte("1")},
+		{key: "b/c,d", value: []byte{0, 1, 0xff, '\n', '"'}},
+		{key: "e", value: []byte{}},
+	}
+	var buf bytes.Buffer
+	if err := writeKeyspace(&buf, kvs); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "KEY,VALUE\n") {
+		t.Fatalf("unexpected header in %q", buf.String())
+	}
+	got, err := readKeyspace(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(kvs) {
+		t.Fatalf("expected %d keys, got %d", len(kvs), len(got))
+	}
+	for i := range kvs {
+		if got[i].key != kvs[i].key || !bytes.Equal(got[i].value, kvs[i].value) {
+			t.Fatalf("#%d: expected %+v, got %+v", i, kvs[i], got[i])
+		}
+	}
+
+	if _, err = readKeyspace(strings.NewReader("OPERATION,KEY\nw,a\n")); err == nil {
+		t.Fatal("expected error on unexpected header")
+	}
+	if _, err = readKeyspace(strings.NewReader("KEY,VALUE\n!!,YQ==\n")); err == nil {
+		t.Fatal("expected error on invalid base64 key")
+	}
+	if got, _ = readKeyspace(strings.NewReader("KEY,VALUE\n")); !reflect.DeepEqual(got, []keyValue(nil)) {
+		t.Fatalf("expected no keys, got %+v", got)
+	}
+}
+
+func TestReadKeyspaceKeys(t *testing.T) {
+	if keys, err := readKeyspaceKeys(""); keys != nil || err != nil {
+		t.Fatalf("expected no keys without snapshot, got %v, %v", keys, err)
+	}
+
+	f, err := ioutil.TempFile("", "keyspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if err = writeKeyspace(f, []keyValue{{key: "a", value: []byte("1")}, {key: "b", value: []byte("2")}}); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	keys, err := readKeyspaceKeys(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Fatalf("expected [a b], got %v", keys)
+	}
+}
@@ -0,0 +1,427 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+
+	"github.com/coreos/etcd/pkg/report"
+)
+
+type second struct {
+	clientNum  int64
+	minLatency float64
+	maxLatency float64
+	// sum of average latency multiplied by throughput
+	latencySum float64
+	throughput int64
+}
+
+// aggregateTimeseries merges latency throughput timeseries CSVs from
+// all pods by unix second. Client numbers and throughputs are summed,
+// and average latencies are weighted by throughput.
+func aggregateTimeseries(paths []string, outputPath string) error {
+	seconds := make(map[int64]*second)
+	for _, fpath := range paths {
+		f, err := os.Open(fpath)
+		if err != nil {
+			return err
+		}
+		rows, err := csv.NewReader(f).ReadAll()
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%q: %v", fpath, err)
+		}
+		if len(rows) == 0 {
+			return fmt.Errorf("%q is empty", fpath)
+		}
+
+		idx := make(map[string]int)
+		for i, h := range rows[0] {
+			idx[h] = i
+		}
+		for _, h := range []string{"UNIX-SECOND", "CONTROL-CLIENT-NUM", "MIN-LATENCY-MS", "AVG-LATENCY-MS", "MAX-LATENCY-MS", "AVG-THROUGHPUT"} {
+			if _, ok := idx[h]; !ok {
+				return fmt.Errorf("%q has no column %q", fpath, h)
+			}
+		}
+
+		for _, row := range rows[1:] {
+			ts, err := strconv.ParseInt(row[idx["UNIX-SECOND"]], 10, 64)
+			if err != nil {
+				return err
+			}
+			clientNum, err := strconv.ParseInt(row[idx["CONTROL-CLIENT-NUM"]], 10, 64)
+			if err != nil {
+				return err
+			}
+			minLatency, err := strconv.ParseFloat(row[idx["MIN-LATENCY-MS"]], 64)
+			if err != nil {
+				return err
+			}
+			avgLatency, err := strconv.ParseFloat(row[idx["AVG-LATENCY-MS"]], 64)
+			if err != nil {
+				return err
+			}
+			maxLatency, err := strconv.ParseFloat(row[idx["MAX-LATENCY-MS"]], 64)
+			if err != nil {
+				return err
+			}
+			throughput, err := strconv.ParseInt(row[idx["AVG-THROUGHPUT"]], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			s, ok := seconds[ts]
+			if !ok {
+				s = &second{minLatency: minLatency, maxLatency: maxLatency}
+				seconds[ts] = s
+			}
+			s.clientNum += clientNum
+			if minLatency < s.minLatency {
+				s.minLatency = minLatency
+			}
+			if maxLatency > s.maxLatency {
+				s.maxLatency = maxLatency
+			}
+			s.latencySum += avgLatency * float64(throughput)
+			s.throughput += throughput
+		}
+	}
+
+	tss := make([]int64, 0, len(seconds))
+	for ts := range seconds {
+		tss = append(tss, ts)
+	}
+	sort.Slice(tss, func(i, j int) bool { return tss[i] < tss[j] })
+
+	f, err := os.OpenFile(outputPath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	wr.Write([]string{"UNIX-SECOND", "CONTROL-CLIENT-NUM", "MIN-LATENCY-MS", "AVG-LATENCY-MS", "MAX-LATENCY-MS", "AVG-THROUGHPUT"})
+	for _, ts := range tss {
+		s := seconds[ts]
+		var avgLatency float64
+		if s.throughput > 0 {
+			avgLatency = s.latencySum / float64(s.throughput)
+		}
+		wr.Write([]string{
+			fmt.Sprintf("%d", ts),
+			fmt.Sprintf("%d", s.clientNum),
+			fmt.Sprintf("%f", s.minLatency),
+			fmt.Sprintf("%f", avgLatency),
+			fmt.Sprintf("%f", s.maxLatency),
+			fmt.Sprintf("%d", s.throughput),
+		})
+	}
+	wr.Flush()
+	return wr.Error()
+}
+
+// aggregateSummary merges latency distribution summary CSVs of
+// 'name,value' rows from all pods. Durations and slowest latencies
+// take the maximum, fastest latencies the minimum, and throughputs and
+// counts are summed. The standard deviation is pooled, and other values
+// are averaged, weighted by the number of requests of each pod.
+func aggregateSummary(paths []string, outputPath string) error {
+	var names []string
+	values := make(map[string][]string)
+	weights := make([]float64, len(paths))
+	for i, fpath := range paths {
+		f, err := os.Open(fpath)
+		if err != nil {
+			return err
+		}
+		rd := csv.NewReader(f)
+		rd.FieldsPerRecord = -1
+		rows, err := rd.ReadAll()
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%q: %v", fpath, err)
+		}
+		kv := make(map[string]string, len(rows))
+		for _, row := range rows {
+			if len(row) < 2 {
+				continue
+			}
+			if _, ok := values[row[0]]; !ok {
+				names = append(names, row[0])
+				values[row[0]] = make([]string, len(paths))
+			}
+			values[row[0]][i] = row[1]
+			kv[row[0]] = row[1]
+		}
+		// requests of the pod
+		rps, _ := strconv.ParseFloat(kv["REQUESTS-PER-SECOND"], 64)
+		secs, _ := strconv.ParseFloat(kv["TOTAL-SECONDS"], 64)
+		weights[i] = rps * secs
+	}
+
+	f, err := os.OpenFile(outputPath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	for _, name := range names {
+		v := aggregateSummaryValue(name, values[name], weights)
+		if name == "STDDEV-LATENCY-MS" {
+			v = pooledStddev(values["AVERAGE-LATENCY-MS"], values[name], weights)
+		}
+		wr.Write([]string{name, v})
+	}
+	wr.Flush()
+	return wr.Error()
+}
+
+// aggregateSummaryValue merges the values of the summary row from all pods,
+// where pods without the row have empty values.
+func aggregateSummaryValue(name string, vals []string, weights []float64) string {
+	var (
+		floats, ws []float64
+		strs       []string
+		isInt      = true
+	)
+	for i, v := range vals {
+		if v == "" {
+			continue
+		}
+		fv, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			strs = append(strs, v)
+			continue
+		}
+		if _, err = strconv.ParseInt(v, 10, 64); err != nil {
+			isInt = false
+		}
+		floats = append(floats, fv)
+		ws = append(ws, weights[i])
+	}
+	if len(strs) > 0 {
+		return strings.Join(strs, ";")
+	}
+	if len(floats) == 0 {
+		return ""
+	}
+
+	switch {
+	case name == "TOTAL-SECONDS", strings.Contains(name, "SLOWEST"), strings.HasPrefix(name, "MAX-"):
+		max := floats[0]
+		for _, v := range floats[1:] {
+			if v > max {
+				max = v
+			}
+		}
+		return fmt.Sprintf("%4.4f", max)
+	case strings.Contains(name, "FASTEST"), strings.HasPrefix(name, "MIN-"):
+		min := floats[0]
+		for _, v := range floats[1:] {
+			if v < min {
+				min = v
+			}
+		}
+		return fmt.Sprintf("%4.4f", min)
+	case strings.Contains(name, "PER-SECOND"), strings.Contains(name, "THROUGHPUT"), isInt:
+		var sum float64
+		for _, v := range floats {
+			sum += v
+		}
+		if isInt {
+			return fmt.Sprintf("%d", int64(sum))
+		}
+		return fmt.Sprintf("%4.4f", sum)
+	}
+	return fmt.Sprintf("%4.4f", weightedAverage(floats, ws))
+}
+
+func weightedAverage(vs, ws []float64) float64 {
+	var sum, wsum float64
+	for i, v := range vs {
+		sum += v * ws[i]
+		wsum += ws[i]
+	}
+	if wsum == 0 {
+		// no request counts, so weigh pods equally
+		for _, v := range vs {
+			sum += v
+		}
+		return sum / float64(len(vs))
+	}
+	return sum / wsum
+}
+
+// pooledStddev returns the standard deviation of all requests
+// from the average and standard deviation of each pod.
+func pooledStddev(avgs, stddevs []string, weights []float64) string {
+	var n, sum, sumSq float64
+	for i := range stddevs {
+		avg, err1 := strconv.ParseFloat(avgs[i], 64)
+		sd, err2 := strconv.ParseFloat(stddevs[i], 64)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		n += weights[i]
+		sum += weights[i] * avg
+		sumSq += weights[i] * (sd*sd + avg*avg)
+	}
+	if n == 0 {
+		return fmt.Sprintf("%4.4f", 0.0)
+	}
+	mean := sum / n
+	return fmt.Sprintf("%4.4f", math.Sqrt(math.Max(sumSq/n-mean*mean, 0)))
+}
+
+// aggregateDistribution sums the request counts of each latency bucket
+// in latency distribution CSVs from all pods, and returns the counts.
+func aggregateDistribution(paths []string, outputPath string) (map[int64]int64, error) {
+	counts := make(map[int64]int64)
+	for _, fpath := range paths {
+		f, err := os.Open(fpath)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := csv.NewReader(f).ReadAll()
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%q: %v", fpath, err)
+		}
+		if len(rows) == 0 {
+			return nil, fmt.Errorf("%q is empty", fpath)
+		}
+		for _, row := range rows[1:] {
+			if len(row) < 2 {
+				return nil, fmt.Errorf("%q has row %v without count", fpath, row)
+			}
+			ms, err := strconv.ParseInt(row[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			n, err := strconv.ParseInt(row[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			counts[ms] += n
+		}
+	}
+
+	mss := make([]int64, 0, len(counts))
+	for ms := range counts {
+		mss = append(mss, ms)
+	}
+	sort.Slice(mss, func(i, j int) bool { return mss[i] < mss[j] })
+
+	f, err := os.OpenFile(outputPath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	wr.Write([]string{"LATENCY-MS", "COUNT"})
+	if len(mss) > 0 {
+		// same 10ms buckets as pods, including empty ones
+		for ms := mss[0]; ms <= mss[len(mss)-1]; ms += 10 {
+			wr.Write([]string{fmt.Sprintf("%d", ms), fmt.Sprintf("%d", counts[ms])})
+		}
+	}
+	wr.Flush()
+	return counts, wr.Error()
+}
+
+// aggregatePercentiles saves the latency percentiles of all pods' requests,
+// from the merged latency histogram logs if any, or from the merged latency
+// distribution, which is only as precise as its 10ms buckets.
+func aggregatePercentiles(histogramLogPaths []string, counts map[int64]int64, outputPath string) error {
+	pctls, _ := report.Percentiles(nil)
+	ms := make([]float64, len(pctls))
+	if len(histogramLogPaths) > 0 {
+		h, err := hdrhistogram.New(1, int64(time.Hour/time.Microsecond), 3)
+		if err != nil {
+			return err
+		}
+		for _, fpath := range histogramLogPaths {
+			f, err := os.Open(fpath)
+			if err != nil {
+				return err
+			}
+			es, err := hdrhistogram.ReadLog(f)
+			f.Close()
+			if err != nil {
+				return fmt.Errorf("%q: %v", fpath, err)
+			}
+			for _, e := range es {
+				// untagged histograms have all requests
+				if e.Tag == "" {
+					h.Merge(e.Histogram)
+				}
+			}
+		}
+		if h.TotalCount() > 0 {
+			for i, p := range pctls {
+				ms[i] = float64(h.ValueAtQuantile(p)) / 1000
+			}
+		}
+	} else {
+		plog.Warning("no latency histogram log to aggregate, so percentiles are from 10ms distribution buckets")
+		buckets := make([]int64, 0, len(counts))
+		var total int64
+		for b, n := range counts {
+			buckets = append(buckets, b)
+			total += n
+		}
+		sort.Slice(buckets, func(i, j int) bool { return buckets[i] < buckets[j] })
+		var cum int64
+		j := 0
+		for _, b := range buckets {
+			cum += counts[b]
+			for j < len(pctls) && float64(cum) >= pctls[j]*float64(total)/100 {
+				ms[j] = float64(b)
+				j++
+			}
+		}
+	}
+
+	f, err := os.OpenFile(outputPath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	wr.Write([]string{"LATENCY-PERCENTILE", "LATENCY-MS"})
+	for i, p := range pctls {
+		pct := fmt.Sprintf("p%.1f", p)
+		if strings.HasSuffix(pct, ".0") {
+			pct = strings.Replace(pct, ".0", "", -1)
+		}
+		wr.Write([]string{pct, fmt.Sprintf("%f", ms[i])})
+	}
+	wr.Flush()
+	return wr.Error()
+}
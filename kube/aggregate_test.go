@@ -0,0 +1,173 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAggregateTimeseries(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "kube-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	pod1 := `UNIX-SECOND,CONTROL-CLIENT-NUM,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS,AVG-THROUGHPUT
+100,10,1.000000,2.000000,3.000000,100
+101,10,1.000000,2.000000,5.000000,100
+`
+	pod2 := `UNIX-SECOND,CONTROL-CLIENT-NUM,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS,AVG-THROUGHPUT
+101,10,0.500000,5.000000,9.000000,200
+102,10,1.000000,1.000000,1.000000,50
+`
+	paths := []string{filepath.Join(dir, "pod1.csv"), filepath.Join(dir, "pod2.csv")}
+	if err = ioutil.WriteFile(paths[0], []byte(pod1), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(paths[1], []byte(pod2), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	outputPath := filepath.Join(dir, "aggregated.csv")
+	if err = aggregateTimeseries(paths, outputPath); err != nil {
+		t.Fatal(err)
+	}
+	bts, err := ioutil.ReadFile(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `UNIX-SECOND,CONTROL-CLIENT-NUM,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS,AVG-THROUGHPUT
+100,10,1.000000,2.000000,3.000000,100
+101,20,0.500000,4.000000,9.000000,300
+102,10,1.000000,1.000000,1.000000,50
+`
+	if string(bts) != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, string(bts))
+	}
+}
+
+func TestAggregateSummary(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "kube-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// 100 requests of 1ms and 300 requests of 3ms, with stddev 0 each
+	pod1 := `TOTAL-SECONDS,10.0000
+REQUESTS-PER-SECOND,10.0000
+SLOWEST-LATENCY-MS,1.0000
+FASTEST-LATENCY-MS,1.0000
+AVERAGE-LATENCY-MS,1.0000
+STDDEV-LATENCY-MS,0.0000
+ERROR,0
+`
+	pod2 := `TOTAL-SECONDS,20.0000
+REQUESTS-PER-SECOND,15.0000
+SLOWEST-LATENCY-MS,3.0000
+FASTEST-LATENCY-MS,3.0000
+AVERAGE-LATENCY-MS,3.0000
+STDDEV-LATENCY-MS,0.0000
+"ERROR: ""timeout""",2
+`
+	paths := []string{filepath.Join(dir, "pod1.csv"), filepath.Join(dir, "pod2.csv")}
+	if err = ioutil.WriteFile(paths[0], []byte(pod1), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(paths[1], []byte(pod2), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	outputPath := filepath.Join(dir, "aggregated.csv")
+	if err = aggregateSummary(paths, outputPath); err != nil {
+		t.Fatal(err)
+	}
+	bts, err := ioutil.ReadFile(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `TOTAL-SECONDS,20.0000
+REQUESTS-PER-SECOND,25.0000
+SLOWEST-LATENCY-MS,3.0000
+FASTEST-LATENCY-MS,1.0000
+AVERAGE-LATENCY-MS,2.5000
+STDDEV-LATENCY-MS,0.8660
+ERROR,0
+"ERROR: ""timeout""",2
+`
+	if string(bts) != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, string(bts))
+	}
+}
+
+func TestAggregateDistributionPercentiles(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "kube-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	pod1 := `LATENCY-MS,COUNT
+0,50
+10,0
+20,10
+`
+	pod2 := `LATENCY-MS,COUNT
+10,30
+`
+	paths := []string{filepath.Join(dir, "pod1.csv"), filepath.Join(dir, "pod2.csv")}
+	if err = ioutil.WriteFile(paths[0], []byte(pod1), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(paths[1], []byte(pod2), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	outputPath := filepath.Join(dir, "aggregated.csv")
+	counts, err := aggregateDistribution(paths, outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bts, err := ioutil.ReadFile(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `LATENCY-MS,COUNT
+0,50
+10,30
+20,10
+`
+	if string(bts) != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, string(bts))
+	}
+
+	if err = aggregatePercentiles(nil, counts, outputPath); err != nil {
+		t.Fatal(err)
+	}
+	bts, err = ioutil.ReadFile(outputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"p50,0.000000\n", "p75,10.000000\n", "p99,20.000000\n"} {
+		if !strings.Contains(string(bts), line) {
+			t.Fatalf("expected %q in\n%s", line, string(bts))
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/spf13/cobra"
+)
+
+// Command implements 'kube' command.
+var Command = &cobra.Command{
+	Use:   "kube",
+	Short: "Runs load generators as Kubernetes jobs.",
+	RunE:  commandFunc,
+}
+
+var databaseID string
+var configPath string
+var image string
+var namespace string
+var pods int
+var outputDir string
+var timeout time.Duration
+var startDelay time.Duration
+
+func init() {
+	ids := dbtesterpb.GetAllDatabaseIDs()
+	Command.PersistentFlags().StringVar(&databaseID, "database-id", ids[0], strings.Join(ids, ", "))
+	Command.PersistentFlags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
+	Command.PersistentFlags().StringVar(&image, "image", "", "Container image with 'dbtester' binary to run in pods.")
+	Command.PersistentFlags().StringVar(&namespace, "namespace", "default", "Kubernetes namespace to run jobs in.")
+	Command.PersistentFlags().IntVar(&pods, "pods", 1, "Number of client pods to run in parallel.")
+	Command.PersistentFlags().StringVar(&outputDir, "output-dir", "kube-results", "Directory to save results from each pod.")
+	Command.PersistentFlags().DurationVar(&timeout, "timeout", 2*time.Hour, "Maximum duration to wait for jobs to complete.")
+	Command.PersistentFlags().DurationVar(&startDelay, "start-delay", 0, "Duration after job creation for all pods to start sending requests at once, after dialing connections (0 to start each pod right away).")
+}
+
+func commandFunc(cmd *cobra.Command, args []string) error {
+	if !dbtesterpb.IsValidDatabaseID(databaseID) {
+		return fmt.Errorf("database id %q is unknown", databaseID)
+	}
+	if image == "" {
+		return fmt.Errorf("'--image' is required")
+	}
+	if pods < 1 {
+		return fmt.Errorf("need at least 1 pod (got %d)", pods)
+	}
+
+	cfg, err := dbtester.ReadConfig(configPath, false)
+	if err != nil {
+		return err
+	}
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q is not found", databaseID)
+	}
+
+	if gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase {
+		plog.Info("step 1: starting databases...")
+		if _, err = cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Start); err != nil {
+			return err
+		}
+		time.Sleep(5 * time.Second)
+	}
+
+	j := &job{
+		name:      "dbtester-" + strings.Replace(databaseID, "_", "-", -1),
+		namespace: namespace,
+		image:     image,
+		pods:      pods,
+	}
+	plog.Infof("step 2: starting %d pods in job %q...", pods, j.name)
+	var startAt time.Time
+	if startDelay > 0 {
+		startAt = time.Now().Add(startDelay)
+		plog.Infof("pods start sending requests at %s", startAt.Format(time.RFC3339))
+	}
+	if err = j.create(cfg, databaseID, configPath, startAt); err != nil {
+		return err
+	}
+	defer j.delete()
+
+	if err = j.wait(timeout); err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(outputDir, 0777); err != nil {
+		return err
+	}
+	rs := results(cfg)
+	paths, err := j.collect(outputDir, rs)
+	if err != nil {
+		return err
+	}
+	for _, r := range rs {
+		if err = os.MkdirAll(filepath.Dir(r.path), 0777); err != nil {
+			return err
+		}
+	}
+	if err = aggregateTimeseries(paths[resultTimeseries], cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath); err != nil {
+		return err
+	}
+	if err = aggregateSummary(paths[resultSummary], cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath); err != nil {
+		return err
+	}
+	counts, err := aggregateDistribution(paths[resultDistribution], cfg.ConfigClientMachineInitial.ClientLatencyDistributionAllPath)
+	if err != nil {
+		return err
+	}
+	if err = aggregatePercentiles(paths[resultHistogramLog], counts, cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath); err != nil {
+		return err
+	}
+	plog.Infof("aggregated %d pods' results at %q", len(paths[resultTimeseries]), filepath.Dir(cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath))
+
+	if gcfg.ConfigClientMachineBenchmarkSteps.Step3StopDatabase {
+		plog.Info("step 3: stopping databases...")
+		idxToResp, err := cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Stop)
+		if err != nil {
+			return err
+		}
+		if err = cfg.SaveDiskSpaceUsageSummary(databaseID, idxToResp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester"
+)
+
+const (
+	resultTimeseries   = "latency-throughput-timeseries"
+	resultSummary      = "latency-distribution-summary"
+	resultDistribution = "latency-distribution-all"
+	resultPercentile   = "latency-distribution-percentile"
+	resultHistogramLog = "latency-histogram-log"
+)
+
+// result is an output file of 'dbtester control' in pods.
+type result struct {
+	name string
+	path string
+}
+
+// results returns the output files to collect from pods,
+// in the order they are printed to pod logs.
+func results(cfg *dbtester.Config) []result {
+	rs := []result{
+		{resultTimeseries, cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath},
+		{resultSummary, cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath},
+		{resultDistribution, cfg.ConfigClientMachineInitial.ClientLatencyDistributionAllPath},
+		{resultPercentile, cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath},
+	}
+	if cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath != "" {
+		rs = append(rs, result{resultHistogramLog, cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath})
+	}
+	return rs
+}
+
+// resultMarker separates control logs and output files in pod logs,
+// since logs are the only output left after pods complete.
+func resultMarker(name string) string {
+	return "=== dbtester " + name + " ==="
+}
+
+// splitResults returns the output files printed after their markers in pod logs.
+func splitResults(logs []byte, rs []result) (map[string][]byte, error) {
+	starts := make([]int, len(rs))
+	for i, r := range rs {
+		idx := bytes.Index(logs, []byte(resultMarker(r.name)+"\n"))
+		if idx == -1 {
+			return nil, fmt.Errorf("no %s found", r.name)
+		}
+		if i > 0 && idx < starts[i-1] {
+			return nil, fmt.Errorf("%s found before %s", r.name, rs[i-1].name)
+		}
+		starts[i] = idx
+	}
+	outs := make(map[string][]byte, len(rs))
+	for i, r := range rs {
+		end := len(logs)
+		if i+1 < len(rs) {
+			end = starts[i+1]
+		}
+		outs[r.name] = logs[starts[i]+len(resultMarker(r.name))+1 : end]
+	}
+	return outs, nil
+}
+
+// job is a Kubernetes job that runs load generator pods in parallel.
+type job struct {
+	name      string
+	namespace string
+	image     string
+	pods      int
+}
+
+// kubectl runs 'kubectl' in the job namespace, and returns its standard output.
+func (j *job) kubectl(stdin []byte, args ...string) ([]byte, error) {
+	args = append([]string{"--namespace", j.namespace}, args...)
+	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	cmd := exec.Command("kubectl", args...)
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("kubectl %s failed with %v (%s)", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// create creates a config map with the configuration file,
+// and a job that runs step 2 of 'dbtester control' in each pod.
+// If startAt is not zero, pods wait until then to send requests.
+func (j *job) create(cfg *dbtester.Config, databaseID, configPath string, startAt time.Time) error {
+	j.delete()
+	if _, err := j.kubectl(nil, "create", "configmap", j.name, "--from-file=config.yaml="+configPath); err != nil {
+		return err
+	}
+
+	var script []string
+	if cfg.ConfigClientMachineInitial.PathPrefix != "" {
+		script = append(script, "mkdir -p "+cfg.ConfigClientMachineInitial.PathPrefix)
+	}
+	control := fmt.Sprintf("dbtester control --stress-only --database-id %s --config /etc/dbtester/config.yaml", databaseID)
+	if j.pods > 1 {
+		// each pod sends its share of the workload, by its completion index
+		control += fmt.Sprintf(" --shard-index $JOB_COMPLETION_INDEX --shards %d", j.pods)
+	}
+	if !startAt.IsZero() {
+		control += fmt.Sprintf(" --start-at %d", startAt.Unix())
+	}
+	script = append(script, control+" 1>&2")
+	for _, r := range results(cfg) {
+		script = append(script, "echo '"+resultMarker(r.name)+"'", "cat "+r.path)
+	}
+
+	manifest := map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata":   map[string]interface{}{"name": j.name},
+		"spec": map[string]interface{}{
+			"parallelism":    j.pods,
+			"completions":    j.pods,
+			"completionMode": "Indexed",
+			"backoffLimit":   0,
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]string{"app": "dbtester"},
+				},
+				"spec": map[string]interface{}{
+					"restartPolicy": "Never",
+					"containers": []map[string]interface{}{{
+						"name":    "dbtester",
+						"image":   j.image,
+						"command": []string{"/bin/sh", "-c", strings.Join(script, " && ")},
+						"volumeMounts": []map[string]string{
+							{"name": "config", "mountPath": "/etc/dbtester"},
+						},
+					}},
+					"volumes": []map[string]interface{}{{
+						"name":      "config",
+						"configMap": map[string]string{"name": j.name},
+					}},
+				},
+			},
+		},
+	}
+	bts, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	_, err = j.kubectl(bts, "create", "-f", "-")
+	return err
+}
+
+// delete deletes the job, its pods, and the config map.
+func (j *job) delete() {
+	j.kubectl(nil, "delete", "job", j.name, "--ignore-not-found")
+	j.kubectl(nil, "delete", "configmap", j.name, "--ignore-not-found")
+}
+
+// wait waits until all pods complete, or returns error
+// if any pod fails.
+func (j *job) wait(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		out, err := j.kubectl(nil, "get", "job", j.name, "--output", "json")
+		if err != nil {
+			return err
+		}
+		var st struct {
+			Status struct {
+				Active    int `json:"active"`
+				Succeeded int `json:"succeeded"`
+				Failed    int `json:"failed"`
+			} `json:"status"`
+		}
+		if err = json.Unmarshal(out, &st); err != nil {
+			return err
+		}
+		if st.Status.Failed > 0 {
+			return fmt.Errorf("%d pods failed in job %q", st.Status.Failed, j.name)
+		}
+		if st.Status.Succeeded >= j.pods {
+			plog.Infof("all %d pods completed in job %q", j.pods, j.name)
+			return nil
+		}
+		plog.Infof("job %q: %d active, %d succeeded", j.name, st.Status.Active, st.Status.Succeeded)
+		time.Sleep(10 * time.Second)
+	}
+	return fmt.Errorf("job %q did not complete in %v", j.name, timeout)
+}
+
+// collect saves the output files from each pod's logs,
+// and returns the file paths by result name.
+func (j *job) collect(dir string, rs []result) (map[string][]string, error) {
+	out, err := j.kubectl(nil, "get", "pods", "--selector", "job-name="+j.name, "--output", "jsonpath={.items[*].metadata.name}")
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[string][]string)
+	for _, pod := range strings.Fields(string(out)) {
+		logs, err := j.kubectl(nil, "logs", pod)
+		if err != nil {
+			return nil, err
+		}
+		outs, err := splitResults(logs, rs)
+		if err != nil {
+			return nil, fmt.Errorf("pod %q logs: %v", pod, err)
+		}
+		for _, r := range rs {
+			fpath := filepath.Join(dir, pod+"-"+r.name+filepath.Ext(r.path))
+			if err = ioutil.WriteFile(fpath, outs[r.name], 0644); err != nil {
+				return nil, err
+			}
+			paths[r.name] = append(paths[r.name], fpath)
+		}
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no pod found in job %q", j.name)
+	}
+	return paths, nil
+}
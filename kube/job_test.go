@@ -0,0 +1,38 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import "testing"
+
+func TestSplitResults(t *testing.T) {
+	rs := []result{{name: resultTimeseries}, {name: resultSummary}}
+	logs := "dbtester: stress started\n" +
+		resultMarker(resultTimeseries) + "\nUNIX-SECOND\n100\n" +
+		resultMarker(resultSummary) + "\nTOTAL-SECONDS,1.0000\n"
+	outs, err := splitResults([]byte(logs), rs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := string(outs[resultTimeseries]); v != "UNIX-SECOND\n100\n" {
+		t.Fatalf("unexpected %s %q", resultTimeseries, v)
+	}
+	if v := string(outs[resultSummary]); v != "TOTAL-SECONDS,1.0000\n" {
+		t.Fatalf("unexpected %s %q", resultSummary, v)
+	}
+
+	if _, err = splitResults([]byte(logs), append(rs, result{name: resultPercentile})); err == nil {
+		t.Fatal("expected error for missing result")
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// default file names in the standard result layout,
+// if not defined in the configuration
+const (
+	defaultClientSystemMetricsInterpolatedName     = "client-system-metrics-interpolated.csv"
+	defaultClientLatencyThroughputTimeseriesName   = "client-latency-throughput-timeseries.csv"
+	defaultClientLatencyDistributionAllName        = "client-latency-distribution-all.csv"
+	defaultClientLatencyDistributionPercentileName = "client-latency-distribution-percentile.csv"
+	defaultClientLatencyDistributionSummaryName    = "client-latency-distribution-summary.csv"
+	defaultClientLatencyByKeyNumberName            = "client-latency-by-key-number.csv"
+	defaultServerDiskSpaceUsageSummaryName         = "server-disk-space-usage-summary.csv"
+	defaultRunMetadataName                         = "run-metadata.yaml"
+	defaultServerSystemMetricsName                 = "server-system-metrics.csv"
+	defaultServerSystemMetricsInterpolatedName     = "server-system-metrics-interpolated.csv"
+	defaultServerMemoryByKeyNumberName             = "server-memory-by-key-number.csv"
+	defaultServerReadBytesDeltaByKeyNumberName     = "server-read-bytes-delta-by-key-number.csv"
+	defaultServerWriteBytesDeltaByKeyNumberName    = "server-write-bytes-delta-by-key-number.csv"
+	defaultAllAggregatedOutputName                 = "all-aggregated.csv"
+)
+
+// ApplyResultLayout moves all client-side result paths of the database
+// into the standard layout '<path_prefix>/<run_id>/<database_tag>/client',
+// and creates the result directories. It is no-op if 'run_id' is empty.
+func (cfg *Config) ApplyResultLayout(databaseID string) error {
+	if cfg.ConfigClientMachineInitial.RunID == "" {
+		return nil
+	}
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+
+	dir := filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, dbtesterpb.ClientResultDir(cfg.ConfigClientMachineInitial.RunID, gcfg.DatabaseTag))
+	for _, p := range cfg.clientResultPaths() {
+		if *p != "" {
+			*p = filepath.Join(dir, filepath.Base(*p))
+		}
+	}
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	for idx := range gcfg.AgentEndpoints {
+		if err := os.MkdirAll(filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, dbtesterpb.ServerResultDir(cfg.ConfigClientMachineInitial.RunID, gcfg.DatabaseTag, idx)), 0777); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clientResultPaths returns all client-side result paths in the configuration.
+func (cfg *Config) clientResultPaths() []*string {
+	return []*string{
+		&cfg.ConfigClientMachineInitial.LogPath,
+		&cfg.ConfigClientMachineInitial.ClientSystemMetricsPath,
+		&cfg.ConfigClientMachineInitial.ClientSystemMetricsInterpolatedPath,
+		&cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath,
+		&cfg.ConfigClientMachineInitial.ClientLatencyDistributionAllPath,
+		&cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath,
+		&cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath,
+		&cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath,
+		&cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath,
+		&cfg.ConfigClientMachineInitial.NemesisEventsPath,
+		&cfg.ConfigClientMachineInitial.RunMetadataPath,
+		&cfg.ConfigClientMachineInitial.ClientThroughputCeilingPath,
+		&cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath,
+		&cfg.ConfigClientMachineInitial.ClientRequestLogPath,
+		&cfg.ConfigClientMachineInitial.ClientWatchEventsPath,
+		&cfg.ConfigClientMachineInitial.ClientLeaseExpiryPath,
+		&cfg.ConfigClientMachineInitial.ClientBatchWritesPath,
+		&cfg.ConfigClientMachineInitial.ClientRollingRestartPath,
+		&cfg.ConfigClientMachineInitial.ClientRangeLatencyPath,
+		&cfg.ConfigClientMachineInitial.ClientSoakRollupPath,
+		&cfg.ConfigClientMachineInitial.ClientLeaseExpiryAccuracyPath,
+		&cfg.ConfigClientMachineInitial.ClientRequestTimeoutsPath,
+		&cfg.ConfigClientMachineInitial.ClientConnectionEventsPath,
+		&cfg.ConfigClientMachineInitial.ClientResultDatabasePath,
+	}
+}
+
+// Run represents a run in the standard result layout.
+type Run struct {
+	ID string
+	// DatabaseTags is the list of databases with client-side results.
+	DatabaseTags []string
+	// Tags are the run tags in run metadata of all databases,
+	// nil if none.
+	Tags map[string]string
+}
+
+// DiscoverRuns returns all runs in the standard result layout
+// under the root directory, sorted by run ID.
+func DiscoverRuns(root string) ([]Run, error) {
+	fis, err := ioutil.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	var runs []Run
+	for _, fi := range fis {
+		if !fi.IsDir() {
+			continue
+		}
+		tfis, err := ioutil.ReadDir(filepath.Join(root, fi.Name()))
+		if err != nil {
+			return nil, err
+		}
+		run := Run{ID: fi.Name()}
+		for _, tfi := range tfis {
+			if !tfi.IsDir() {
+				continue
+			}
+			clientDir := filepath.Join(root, dbtesterpb.ClientResultDir(run.ID, tfi.Name()))
+			if !exist(clientDir) {
+				continue
+			}
+			run.DatabaseTags = append(run.DatabaseTags, tfi.Name())
+			md, err := ReadRunMetadata(filepath.Join(clientDir, defaultRunMetadataName))
+			if err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+			for k, v := range md.Tags {
+				if run.Tags == nil {
+					run.Tags = make(map[string]string)
+				}
+				run.Tags[k] = v
+			}
+		}
+		if len(run.DatabaseTags) > 0 {
+			runs = append(runs, run)
+		}
+	}
+	sort.Slice(runs, func(i, j int) bool { return runs[i].ID < runs[j].ID })
+	return runs, nil
+}
+
+// UseResultLayout sets the analyze paths of all databases to the results
+// of the run under the root directory, discovered in the standard layout.
+// Only runs with all 'key=value' tags are discovered, if any.
+// If 'runID' is empty, the root directory must have only one run.
+func (cfg *Config) UseResultLayout(root, runID string, tags []string) error {
+	all, err := DiscoverRuns(root)
+	if err != nil {
+		return err
+	}
+	var runs []Run
+	for _, run := range all {
+		if MatchTags(run.Tags, tags) {
+			runs = append(runs, run)
+		}
+	}
+	if len(runs) == 0 {
+		if len(tags) > 0 {
+			return fmt.Errorf("no run found in %q with tags %q", root, tags)
+		}
+		return fmt.Errorf("no run found in %q", root)
+	}
+	if runID == "" {
+		if len(runs) > 1 {
+			ids := make([]string, len(runs))
+			for i := range runs {
+				ids[i] = runs[i].ID
+			}
+			return fmt.Errorf("found %d runs in %q, run ID must be specified (%s)", len(runs), root, strings.Join(ids, ", "))
+		}
+		runID = runs[0].ID
+	}
+	found := false
+	for _, run := range runs {
+		found = found || run.ID == runID
+	}
+	if !found {
+		return fmt.Errorf("run %q is not found in %q (tags %q)", runID, root, tags)
+	}
+	plog.Infof("using results of run %q in %q", runID, root)
+
+	if cfg.DatabaseIDToConfigAnalyzeMachineInitial == nil {
+		cfg.DatabaseIDToConfigAnalyzeMachineInitial = make(map[string]dbtesterpb.ConfigAnalyzeMachineInitial)
+	}
+	ci := cfg.ConfigClientMachineInitial
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+		dir := filepath.Join(root, runID, gcfg.DatabaseTag)
+		clientDir := filepath.Join(root, dbtesterpb.ClientResultDir(runID, gcfg.DatabaseTag))
+		if !exist(clientDir) {
+			return fmt.Errorf("%q has no results in run %q (%q does not exist)", databaseID, runID, clientDir)
+		}
+
+		amc := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
+		amc.PathPrefix = ""
+		amc.DatabaseID = databaseID
+		amc.DatabaseTag = gcfg.DatabaseTag
+		amc.DatabaseDescription = gcfg.DatabaseDescription
+
+		amc.ClientSystemMetricsInterpolatedPath = filepath.Join(clientDir, baseOr(ci.ClientSystemMetricsInterpolatedPath, defaultClientSystemMetricsInterpolatedName))
+		amc.ClientLatencyThroughputTimeseriesPath = filepath.Join(clientDir, baseOr(ci.ClientLatencyThroughputTimeseriesPath, defaultClientLatencyThroughputTimeseriesName))
+		amc.ClientLatencyDistributionAllPath = filepath.Join(clientDir, baseOr(ci.ClientLatencyDistributionAllPath, defaultClientLatencyDistributionAllName))
+		amc.ClientLatencyDistributionPercentilePath = filepath.Join(clientDir, baseOr(ci.ClientLatencyDistributionPercentilePath, defaultClientLatencyDistributionPercentileName))
+		amc.ClientLatencyDistributionSummaryPath = filepath.Join(clientDir, baseOr(ci.ClientLatencyDistributionSummaryPath, defaultClientLatencyDistributionSummaryName))
+		amc.ClientLatencyByKeyNumberPath = filepath.Join(clientDir, baseOr(ci.ClientLatencyByKeyNumberPath, defaultClientLatencyByKeyNumberName))
+		amc.ServerDiskSpaceUsageSummaryPath = filepath.Join(clientDir, baseOr(ci.ServerDiskSpaceUsageSummaryPath, defaultServerDiskSpaceUsageSummaryName))
+		amc.RunMetadataPath = filepath.Join(clientDir, baseOr(ci.RunMetadataPath, defaultRunMetadataName))
+		if ci.ClientLatencyHistogramLogPath != "" {
+			amc.ClientLatencyHistogramLogPath = filepath.Join(clientDir, filepath.Base(ci.ClientLatencyHistogramLogPath))
+		}
+		if ci.ClientConnectionEventsPath != "" {
+			amc.ClientConnectionEventsPath = filepath.Join(clientDir, filepath.Base(ci.ClientConnectionEventsPath))
+		}
+		if ci.NemesisEventsPath != "" {
+			amc.NemesisEventsPath = filepath.Join(clientDir, filepath.Base(ci.NemesisEventsPath))
+		}
+
+		// outputs of analyze
+		amc.ServerMemoryByKeyNumberPath = filepath.Join(dir, baseOr(amc.ServerMemoryByKeyNumberPath, defaultServerMemoryByKeyNumberName))
+		amc.ServerReadBytesDeltaByKeyNumberPath = filepath.Join(dir, baseOr(amc.ServerReadBytesDeltaByKeyNumberPath, defaultServerReadBytesDeltaByKeyNumberName))
+		amc.ServerWriteBytesDeltaByKeyNumberPath = filepath.Join(dir, baseOr(amc.ServerWriteBytesDeltaByKeyNumberPath, defaultServerWriteBytesDeltaByKeyNumberName))
+		amc.AllAggregatedOutputPath = filepath.Join(dir, baseOr(amc.AllAggregatedOutputPath, defaultAllAggregatedOutputName))
+
+		amc.ServerSystemMetricsInterpolatedPathList, err = discoverServerResults(dir, baseOr(ci.ServerSystemMetricsInterpolatedPath, defaultServerSystemMetricsInterpolatedName))
+		if err != nil {
+			return err
+		}
+		if len(amc.ServerSystemMetricsInterpolatedPathList) == 0 {
+			return fmt.Errorf("%q has no server system metrics in run %q", databaseID, runID)
+		}
+		plog.Infof("found %d server results for %q in run %q", len(amc.ServerSystemMetricsInterpolatedPathList), databaseID, runID)
+
+		// raw system metrics are optional, only to flag suspect runs
+		amc.ServerSystemMetricsPathList, err = discoverServerResults(dir, baseOr(ci.ServerSystemMetricsPath, defaultServerSystemMetricsName))
+		if err != nil {
+			return err
+		}
+
+		cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID] = amc
+	}
+	return nil
+}
+
+// discoverServerResults returns the paths of the file in all 'server-N'
+// directories, sorted by N.
+func discoverServerResults(dir, name string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "server-*", name))
+	if err != nil {
+		return nil, err
+	}
+	serverN := func(fpath string) int {
+		n, _ := strconv.Atoi(strings.TrimPrefix(filepath.Base(filepath.Dir(fpath)), "server-"))
+		return n
+	}
+	sort.Slice(matches, func(i, j int) bool { return serverN(matches[i]) < serverN(matches[j]) })
+	return matches, nil
+}
+
+func baseOr(fpath, name string) string {
+	if fpath == "" {
+		return name
+	}
+	return filepath.Base(fpath)
+}
+
+// hasGlobMeta returns true if the path has any of glob special characters.
+func hasGlobMeta(fpath string) bool {
+	return strings.ContainsAny(fpath, `*?[`)
+}
+
+// resolvePathPrefix resolves the path prefix glob pattern, with the file
+// that must exist in the test data (e.g. 'client-latency-throughput-timeseries.csv').
+// The pattern must match test data of only one database.
+func resolvePathPrefix(pattern, name string) (string, error) {
+	if name == "" {
+		name = defaultClientLatencyThroughputTimeseriesName
+	}
+	suffix := "-" + name
+	matches, err := filepath.Glob(pattern + suffix)
+	if err != nil {
+		return "", err
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no file matches %q", pattern+suffix)
+	case 1:
+		return strings.TrimSuffix(matches[0], suffix), nil
+	default:
+		return "", fmt.Errorf("path prefix %q is ambiguous (matched %q)", pattern, matches)
+	}
+}
+
+// inferServerSystemMetricsPaths returns the paths of server system metrics
+// with the path prefix (e.g. '<prefix>-1-server-system-metrics-interpolated.csv'),
+// sorted by the server number.
+func inferServerSystemMetricsPaths(prefix string) ([]string, error) {
+	matches, err := filepath.Glob(prefix + "-*-" + defaultServerSystemMetricsInterpolatedName)
+	if err != nil {
+		return nil, err
+	}
+	serverN := make(map[string]int)
+	var ps []string
+	for _, p := range matches {
+		n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(p, prefix+"-"), "-"+defaultServerSystemMetricsInterpolatedName))
+		if err != nil {
+			continue
+		}
+		serverN[p] = n
+		ps = append(ps, p)
+	}
+	if len(ps) == 0 {
+		return nil, fmt.Errorf("no server system metrics found with prefix %q", prefix)
+	}
+	sort.Slice(ps, func(i, j int) bool { return serverN[ps[i]] < serverN[ps[j]] })
+	return ps, nil
+}
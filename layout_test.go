@@ -0,0 +1,155 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestResultLayout(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "dbtester-layout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	cfg := &Config{
+		AllDatabaseIDList: []string{"etcd__tip"},
+		DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+			"etcd__tip": {
+				DatabaseTag:                         "etcd-tip-go1.8.3",
+				AgentEndpoints:                      make([]string, 11),
+				ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{},
+			},
+		},
+	}
+	cfg.ConfigClientMachineInitial.PathPrefix = root
+	cfg.ConfigClientMachineInitial.RunID = "run-1"
+	cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath = filepath.Join(root, "timeseries.csv")
+	if err = cfg.ApplyResultLayout("etcd__tip"); err != nil {
+		t.Fatal(err)
+	}
+	if exp := filepath.Join(root, "run-1", "etcd-tip-go1.8.3", "client", "timeseries.csv"); cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath != exp {
+		t.Fatalf("expected %q, got %q", exp, cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath)
+	}
+
+	// servers without results are ignored
+	for _, n := range []string{"server-10", "server-2", "server-1"} {
+		if err = ioutil.WriteFile(filepath.Join(root, "run-1", "etcd-tip-go1.8.3", n, defaultServerSystemMetricsInterpolatedName), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = os.MkdirAll(filepath.Join(root, "not-a-run", "etcd-tip-go1.8.3"), 0777); err != nil {
+		t.Fatal(err)
+	}
+
+	runs, err := DiscoverRuns(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := []Run{{ID: "run-1", DatabaseTags: []string{"etcd-tip-go1.8.3"}}}; !reflect.DeepEqual(runs, exp) {
+		t.Fatalf("expected %+v, got %+v", exp, runs)
+	}
+
+	if err = cfg.UseResultLayout(root, "", nil); err != nil {
+		t.Fatal(err)
+	}
+	amc := cfg.DatabaseIDToConfigAnalyzeMachineInitial["etcd__tip"]
+	if exp := cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath; amc.ClientLatencyThroughputTimeseriesPath != exp {
+		t.Fatalf("expected %q, got %q", exp, amc.ClientLatencyThroughputTimeseriesPath)
+	}
+	var servers []string
+	for _, p := range amc.ServerSystemMetricsInterpolatedPathList {
+		servers = append(servers, filepath.Base(filepath.Dir(p)))
+	}
+	if exp := []string{"server-1", "server-2", "server-10"}; !reflect.DeepEqual(servers, exp) {
+		t.Fatalf("expected %q, got %q", exp, servers)
+	}
+
+	if err = cfg.UseResultLayout(root, "run-2", nil); err == nil {
+		t.Fatal("expected error for unknown run")
+	}
+
+	cfg.ConfigClientMachineInitial.RunMetadataPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, "run-1", "etcd-tip-go1.8.3", "client", defaultRunMetadataName)
+	cfg.ConfigClientMachineInitial.RunTags = []string{"env=gce", "purpose=nightly"}
+	if err = cfg.SaveRunMetadata(cfg.NewRunMetadata("etcd__tip")); err != nil {
+		t.Fatal(err)
+	}
+	if runs, err = DiscoverRuns(root); err != nil {
+		t.Fatal(err)
+	}
+	if exp := map[string]string{"env": "gce", "purpose": "nightly"}; !reflect.DeepEqual(runs[0].Tags, exp) {
+		t.Fatalf("expected tags %v, got %v", exp, runs[0].Tags)
+	}
+	if err = cfg.UseResultLayout(root, "", []string{"purpose=nightly", "env"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = cfg.UseResultLayout(root, "", []string{"purpose=release"}); err == nil {
+		t.Fatal("expected error for no run with tags")
+	}
+}
+
+func TestResolvePathPrefix(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "dbtester-prefix")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{
+		"test-01-etcd-tip-" + defaultClientLatencyThroughputTimeseriesName,
+		"test-01-zookeeper-" + defaultClientLatencyThroughputTimeseriesName,
+		"test-01-etcd-tip-1-" + defaultServerSystemMetricsInterpolatedName,
+		"test-01-etcd-tip-10-" + defaultServerSystemMetricsInterpolatedName,
+		"test-01-etcd-tip-2-" + defaultServerSystemMetricsInterpolatedName,
+	} {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	prefix, err := resolvePathPrefix(filepath.Join(dir, "test-*-etcd-*"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := filepath.Join(dir, "test-01-etcd-tip"); prefix != exp {
+		t.Fatalf("expected %q, got %q", exp, prefix)
+	}
+	if _, err = resolvePathPrefix(filepath.Join(dir, "test-*"), ""); err == nil {
+		t.Fatal("expected error for ambiguous prefix")
+	}
+	if _, err = resolvePathPrefix(filepath.Join(dir, "test-*-consul"), ""); err == nil {
+		t.Fatal("expected error for no match")
+	}
+
+	ps, err := inferServerSystemMetricsPaths(prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []string{
+		prefix + "-1-" + defaultServerSystemMetricsInterpolatedName,
+		prefix + "-2-" + defaultServerSystemMetricsInterpolatedName,
+		prefix + "-10-" + defaultServerSystemMetricsInterpolatedName,
+	}
+	if !reflect.DeepEqual(ps, exp) {
+		t.Fatalf("expected %q, got %q", exp, ps)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// LiveSample is the results of requests completed in one second.
+type LiveSample struct {
+	UnixSecond int64
+	Requests   int64
+	Errors     int64
+	// P50Ms and P99Ms are latency percentiles in milliseconds.
+	P50Ms float64
+	P99Ms float64
+}
+
+// LiveSnapshot is the current state of the running benchmark.
+type LiveSnapshot struct {
+	DatabaseID string
+	Phase      string
+
+	Requests int64
+	Errors   int64
+	// RequestNumber is the number of requests to send, 0 if unknown.
+	RequestNumber int64
+	// History is the samples of last seconds, oldest first.
+	History []LiveSample
+
+	// ServerN is the number of servers with system metrics, streamed
+	// when 'server_system_metrics_path' is set.
+	ServerN int
+	// ServerCPU is the average CPU usage of servers, in percent.
+	ServerCPU float64
+	// ServerVMRSS is the total memory usage of servers, in bytes.
+	ServerVMRSS uint64
+}
+
+// Last returns the sample of last completed second, if any.
+func (s LiveSnapshot) Last() (LiveSample, bool) {
+	if len(s.History) == 0 {
+		return LiveSample{}, false
+	}
+	return s.History[len(s.History)-1], true
+}
+
+// LiveStats aggregates results of the running benchmark per second,
+// for live monitoring during runs (e.g. 'control --tui').
+type LiveStats struct {
+	mu       sync.Mutex
+	historyN int
+
+	databaseID string
+	phase      string
+	requests   int64
+	errors     int64
+	requestN   int64
+	history    []LiveSample
+
+	// in-progress second
+	second int64
+	lats   []float64
+	errs   int64
+
+	serverCPU   map[int]float64
+	serverVMRSS map[int]uint64
+}
+
+// liveStats, if not nil, receives results of benchmarks.
+var liveStats *LiveStats
+
+// SetLiveStats sets the live stats to receive results of following
+// benchmarks and streamed server metrics. nil to disable.
+func SetLiveStats(l *LiveStats) { liveStats = l }
+
+// NewLiveStats returns a new LiveStats that keeps samples
+// of last 'historyN' seconds.
+func NewLiveStats(historyN int) *LiveStats {
+	return &LiveStats{
+		historyN:    historyN,
+		serverCPU:   make(map[int]float64),
+		serverVMRSS: make(map[int]uint64),
+	}
+}
+
+// SetPhase updates the database and phase of the run.
+// All stats are reset when database changes.
+func (l *LiveStats) SetPhase(databaseID, phase string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if databaseID != l.databaseID {
+		l.databaseID = databaseID
+		l.requests, l.errors, l.requestN, l.history = 0, 0, 0, nil
+		l.second, l.lats, l.errs = 0, nil, 0
+		l.serverCPU = make(map[int]float64)
+		l.serverVMRSS = make(map[int]uint64)
+	}
+	l.phase = phase
+}
+
+// SetRequestNumber sets the number of requests to send
+// in the current run, to report progress.
+func (l *LiveStats) SetRequestNumber(n int64) {
+	l.mu.Lock()
+	l.requestN = n
+	l.mu.Unlock()
+}
+
+func (l *LiveStats) observe(end time.Time, lat time.Duration, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if sec := end.Unix(); sec > l.second {
+		l.flush(sec)
+	}
+	l.requests++
+	if err != nil {
+		l.errors++
+		l.errs++
+		return
+	}
+	l.lats = append(l.lats, float64(lat)/float64(time.Millisecond))
+}
+
+func (l *LiveStats) observeServer(idx int, cpu float64, vmrss uint64) {
+	l.mu.Lock()
+	l.serverCPU[idx] = cpu
+	l.serverVMRSS[idx] = vmrss
+	l.mu.Unlock()
+}
+
+// flush completes the in-progress second, and starts the second 'sec',
+// with empty samples of seconds with no result in between.
+func (l *LiveStats) flush(sec int64) {
+	if l.second != 0 {
+		s := LiveSample{UnixSecond: l.second, Requests: int64(len(l.lats)) + l.errs, Errors: l.errs}
+		if len(l.lats) > 0 {
+			sort.Float64s(l.lats)
+			s.P50Ms = l.lats[int(0.5*float64(len(l.lats)-1))]
+			s.P99Ms = l.lats[int(0.99*float64(len(l.lats)-1))]
+		}
+		l.history = append(l.history, s)
+		from := l.second + 1
+		if from < sec-int64(l.historyN) {
+			from = sec - int64(l.historyN)
+		}
+		for t := from; t < sec; t++ {
+			l.history = append(l.history, LiveSample{UnixSecond: t})
+		}
+		if len(l.history) > l.historyN {
+			l.history = append([]LiveSample(nil), l.history[len(l.history)-l.historyN:]...)
+		}
+	}
+	l.second, l.lats, l.errs = sec, l.lats[:0], 0
+}
+
+// Snapshot returns the state, where seconds before 'now' are completed.
+func (l *LiveStats) Snapshot(now time.Time) LiveSnapshot {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if sec := now.Unix(); l.second != 0 && sec > l.second {
+		l.flush(sec)
+	}
+	s := LiveSnapshot{
+		DatabaseID:    l.databaseID,
+		Phase:         l.phase,
+		Requests:      l.requests,
+		Errors:        l.errors,
+		RequestNumber: l.requestN,
+		History:       append([]LiveSample(nil), l.history...),
+		ServerN:       len(l.serverCPU),
+	}
+	for idx, cpu := range l.serverCPU {
+		s.ServerCPU += cpu
+		s.ServerVMRSS += l.serverVMRSS[idx]
+	}
+	if s.ServerN > 0 {
+		s.ServerCPU /= float64(s.ServerN)
+	}
+	return s
+}
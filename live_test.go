@@ -0,0 +1,64 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLiveStats(t *testing.T) {
+	l := NewLiveStats(3)
+	l.SetPhase("etcd__tip", "stressing")
+
+	base := time.Unix(100, 0)
+	for i := 1; i <= 100; i++ {
+		l.observe(base, time.Duration(i)*time.Millisecond, nil)
+	}
+	l.observe(base, time.Millisecond, errors.New("timeout"))
+	l.observe(base.Add(3*time.Second), time.Millisecond, nil)
+	l.observeServer(0, 50, 1000)
+	l.observeServer(1, 100, 3000)
+
+	s := l.Snapshot(base.Add(3 * time.Second))
+	if s.Requests != 102 || s.Errors != 1 {
+		t.Fatalf("expected 102 requests and 1 error, got %d and %d", s.Requests, s.Errors)
+	}
+	// second 100, and empty seconds 101 and 102
+	if len(s.History) != 3 {
+		t.Fatalf("expected 3 seconds, got %+v", s.History)
+	}
+	if h := s.History[0]; h.Requests != 101 || h.Errors != 1 || h.P50Ms != 50 || h.P99Ms != 99 {
+		t.Fatalf("unexpected sample %+v", h)
+	}
+	if last, _ := s.Last(); last.UnixSecond != 102 || last.Requests != 0 {
+		t.Fatalf("unexpected last sample %+v", last)
+	}
+	if s.ServerN != 2 || s.ServerCPU != 75 || s.ServerVMRSS != 4000 {
+		t.Fatalf("unexpected server usage %+v", s)
+	}
+
+	// history is bounded
+	s = l.Snapshot(base.Add(10 * time.Second))
+	if len(s.History) != 3 || s.History[2].UnixSecond != 109 {
+		t.Fatalf("unexpected history %+v", s.History)
+	}
+
+	l.SetPhase("zookeeper__r3_5_3_beta", "starting")
+	if s = l.Snapshot(base); s.Requests != 0 || len(s.History) != 0 || s.ServerN != 0 {
+		t.Fatalf("expected reset for another database, got %+v", s)
+	}
+}
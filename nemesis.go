@@ -0,0 +1,164 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"google.golang.org/grpc"
+)
+
+// NemesisEvent is a fault injected, or recovered, by an agent.
+type NemesisEvent struct {
+	UnixNanosecond int64
+	Operation      string
+	TargetIndex    int64
+	Recover        bool
+	Error          string
+}
+
+// RunNemesis executes 'nemesis_schedule', the restarts of 'rolling_restart',
+// or the faults of 'random_nemesis', in order until all steps finish or the context
+// is canceled. A fault being injected is always recovered, even after
+// cancel. Events are saved at 'nemesis_events_path' to annotate plots.
+// The returned channel receives all events after they are saved.
+func (cfg *Config) RunNemesis(ctx context.Context, databaseID string) (<-chan []NemesisEvent, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return nil, fmt.Errorf("database id %q does not exist", databaseID)
+	}
+	steps := gcfg.NemesisSchedule
+	switch {
+	case gcfg.ConfigRollingRestart != nil:
+		steps = rollingRestartSteps(gcfg.ConfigRollingRestart, len(gcfg.AgentEndpoints))
+	case gcfg.ConfigRandomNemesis != nil:
+		steps = randomNemesisSteps(gcfg.ConfigRandomNemesis, len(gcfg.AgentEndpoints))
+	}
+	for i, step := range steps {
+		switch step.Operation {
+		case "partition", "kill", "stop", "clock-skew":
+		default:
+			return nil, fmt.Errorf("unknown nemesis operation %q at step %d", step.Operation, i)
+		}
+		if step.TargetIndex < 0 || int(step.TargetIndex) >= len(gcfg.AgentEndpoints) {
+			return nil, fmt.Errorf("nemesis target index %d is out of range at step %d", step.TargetIndex, i)
+		}
+	}
+
+	eventc := make(chan []NemesisEvent, 1)
+	go func() {
+		var events []NemesisEvent
+		defer func() {
+			eventc <- events
+			close(eventc)
+		}()
+
+		send := func(step *dbtesterpb.ConfigNemesisStep, recover bool) {
+			ev := NemesisEvent{
+				UnixNanosecond: time.Now().UnixNano(),
+				Operation:      step.Operation,
+				TargetIndex:    step.TargetIndex,
+				Recover:        recover,
+			}
+			if err := cfg.sendNemesis(databaseID, step, recover); err != nil {
+				plog.Warningf("nemesis %q failed (%v) [index: %d | recover: %v]", step.Operation, err, step.TargetIndex, recover)
+				ev.Error = err.Error()
+			} else {
+				plog.Infof("nemesis %q [index: %d | recover: %v]", step.Operation, step.TargetIndex, recover)
+			}
+			events = append(events, ev)
+		}
+
+	loop:
+		for _, step := range steps {
+			select {
+			case <-time.After(time.Duration(step.DelaySeconds) * time.Second):
+			case <-ctx.Done():
+				break loop
+			}
+			send(step, false)
+			select {
+			case <-time.After(time.Duration(step.DurationSeconds) * time.Second):
+			case <-ctx.Done():
+			}
+			send(step, true)
+		}
+
+		if cfg.ConfigClientMachineInitial.NemesisEventsPath == "" {
+			return
+		}
+		if err := saveNemesisEvents(cfg.ConfigClientMachineInitial.NemesisEventsPath, events); err != nil {
+			plog.Warningf("failed to save nemesis events (%v)", err)
+			return
+		}
+		plog.Infof("CSV saved at %q", cfg.ConfigClientMachineInitial.NemesisEventsPath)
+	}()
+	return eventc, nil
+}
+
+func (cfg *Config) sendNemesis(databaseID string, step *dbtesterpb.ConfigNemesisStep, recover bool) error {
+	req, err := cfg.ToRequest(databaseID, dbtesterpb.Operation_Nemesis, int(step.TargetIndex))
+	if err != nil {
+		return err
+	}
+	req.NemesisStep = step
+	req.NemesisRecover = recover
+
+	ep := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].AgentEndpoints[step.TargetIndex]
+	conn, err := grpc.Dial(ep, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err = dbtesterpb.NewTransporterClient(conn).Transfer(ctx, req)
+	return err
+}
+
+func saveNemesisEvents(fpath string, events []NemesisEvent) error {
+	f, err := os.Create(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	if err = wr.Write([]string{"UNIX-NANOSECOND", "UNIX-SECOND", "OPERATION", "TARGET-INDEX", "RECOVER", "ERROR"}); err != nil {
+		return err
+	}
+	for _, ev := range events {
+		row := []string{
+			fmt.Sprintf("%d", ev.UnixNanosecond),
+			fmt.Sprintf("%d", ev.UnixNanosecond/int64(time.Second)),
+			ev.Operation,
+			fmt.Sprintf("%d", ev.TargetIndex),
+			fmt.Sprintf("%t", ev.Recover),
+			ev.Error,
+		}
+		if err = wr.Write(row); err != nil {
+			return err
+		}
+	}
+	wr.Flush()
+	return wr.Error()
+}
@@ -0,0 +1,73 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	mrand "math/rand"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// randomNemesisSteps returns the nemesis steps of 'random_nemesis',
+// generated from its seed. The same seed and number of servers
+// always return the same steps.
+func randomNemesisSteps(rn *dbtesterpb.ConfigRandomNemesis, serverN int) []*dbtesterpb.ConfigNemesisStep {
+	rnd := mrand.New(mrand.NewSource(rn.Seed))
+	between := func(min, max int64) int64 {
+		if max <= min {
+			return min
+		}
+		return min + rnd.Int63n(max-min+1)
+	}
+	steps := make([]*dbtesterpb.ConfigNemesisStep, rn.Steps)
+	for i := range steps {
+		steps[i] = &dbtesterpb.ConfigNemesisStep{
+			Operation:             rn.Operations[rnd.Intn(len(rn.Operations))],
+			TargetIndex:           int64(rnd.Intn(serverN)),
+			DelaySeconds:          between(rn.MinDelaySeconds, rn.MaxDelaySeconds),
+			DurationSeconds:       between(rn.MinDurationSeconds, rn.MaxDurationSeconds),
+			ClockSkewMilliseconds: rn.ClockSkewMilliseconds,
+		}
+	}
+	return steps
+}
+
+// ReplayNemesis sets 'random_nemesis' of the database to the one
+// recorded in the run metadata, with its seed, to inject the same
+// faults as the recorded run.
+func (cfg *Config) ReplayNemesis(databaseID, runMetadataPath string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+	md, err := ReadRunMetadata(runMetadataPath)
+	if err != nil {
+		return err
+	}
+	if md.RandomNemesis == nil || md.RandomNemesis.Seed == 0 {
+		return fmt.Errorf("%q has no random_nemesis to replay", runMetadataPath)
+	}
+	if len(gcfg.NemesisSchedule) > 0 || gcfg.ConfigRollingRestart != nil {
+		return fmt.Errorf("%q got nemesis_schedule or rolling_restart to replay random_nemesis", databaseID)
+	}
+	// the schedule differs with the number of servers
+	if n := len(md.ServerHardware); n > 0 && n != len(gcfg.AgentEndpoints) {
+		return fmt.Errorf("%q recorded %d servers, but %q has %d", runMetadataPath, n, databaseID, len(gcfg.AgentEndpoints))
+	}
+	gcfg.ConfigRandomNemesis = md.RandomNemesis
+	cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = gcfg
+	return nil
+}
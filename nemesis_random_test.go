@@ -0,0 +1,95 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestRandomNemesisSteps(t *testing.T) {
+	rn := &dbtesterpb.ConfigRandomNemesis{
+		Operations:         []string{"partition", "kill"},
+		Steps:              20,
+		MinDelaySeconds:    10,
+		MaxDelaySeconds:    20,
+		MinDurationSeconds: 5,
+		MaxDurationSeconds: 5,
+		Seed:               7,
+	}
+	steps := randomNemesisSteps(rn, 3)
+	if len(steps) != 20 {
+		t.Fatalf("expected 20 steps, got %d", len(steps))
+	}
+	ops := make(map[string]bool)
+	for i, step := range steps {
+		ops[step.Operation] = true
+		if step.TargetIndex < 0 || step.TargetIndex >= 3 {
+			t.Fatalf("#%d: target index %d out of range", i, step.TargetIndex)
+		}
+		if step.DelaySeconds < 10 || step.DelaySeconds > 20 {
+			t.Fatalf("#%d: delay %d out of range", i, step.DelaySeconds)
+		}
+		if step.DurationSeconds != 5 {
+			t.Fatalf("#%d: expected duration 5, got %d", i, step.DurationSeconds)
+		}
+	}
+	if len(ops) != 2 {
+		t.Fatalf("expected both operations, got %v", ops)
+	}
+
+	if again := randomNemesisSteps(rn, 3); !reflect.DeepEqual(steps, again) {
+		t.Fatalf("expected the same steps with the same seed, got %+v and %+v", steps, again)
+	}
+	rn.Seed = 8
+	if other := randomNemesisSteps(rn, 3); reflect.DeepEqual(steps, other) {
+		t.Fatal("expected different steps with a different seed")
+	}
+}
+
+func TestReplayNemesis(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "nemesis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rn := &dbtesterpb.ConfigRandomNemesis{Operations: []string{"kill"}, Steps: 3, Seed: 42}
+	fpath := filepath.Join(dir, "run-metadata.yaml")
+	cfg := &Config{
+		ConfigClientMachineInitial: dbtesterpb.ConfigClientMachineInitial{RunMetadataPath: fpath},
+		DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+			"etcd__tip":  {AgentEndpoints: []string{"a", "b", "c"}},
+			"etcd__v3_2": {AgentEndpoints: []string{"a"}},
+		},
+	}
+	if err = cfg.SaveRunMetadata(RunMetadata{RandomNemesis: rn, ServerHardware: make([]Hardware, 3)}); err != nil {
+		t.Fatal(err)
+	}
+	if err = cfg.ReplayNemesis("etcd__tip", fpath); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.DatabaseIDToConfigClientMachineAgentControl["etcd__tip"].ConfigRandomNemesis; !reflect.DeepEqual(got, rn) {
+		t.Fatalf("expected %+v, got %+v", rn, got)
+	}
+	if err = cfg.ReplayNemesis("etcd__v3_2", fpath); err == nil {
+		t.Fatal("expected error for different number of servers")
+	}
+}
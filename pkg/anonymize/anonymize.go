@@ -0,0 +1,184 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package anonymize strips hostnames, IPs, and other identifiers
+// from result files, so that they can be published.
+package anonymize
+
+import (
+	"io/ioutil"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// RedactedIP replaces IPv4 addresses without names.
+	RedactedIP = "x.x.x.x"
+	// RedactedIPv6 replaces IPv6 addresses without names.
+	RedactedIPv6 = "x:x:x:x:x:x:x:x"
+)
+
+var (
+	ipv4Regex = regexp.MustCompile(`\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}`)
+	// candidates of IPv6 addresses, validated when parsed
+	// (e.g. "fe80::1", "::ffff:10.0.0.1")
+	ipv6Regex = regexp.MustCompile(`[0-9A-Fa-f]*:[0-9A-Fa-f:]*:[0-9A-Fa-f.]*[0-9A-Fa-f]`)
+)
+
+// Anonymizer replaces identifiers in text.
+type Anonymizer struct {
+	// ips maps the canonical form of IP addresses to their names.
+	ips   map[string]string
+	names map[string]string
+	// namesRegex matches any of names, the longest first.
+	namesRegex *regexp.Regexp
+}
+
+// New returns an Anonymizer that replaces each key of 'names' with its
+// value (e.g. "10.0.0.1" with "server-1"). Other IPv4 and IPv6 addresses
+// are replaced with RedactedIP and RedactedIPv6, except loopback and
+// unspecified addresses. Names and IPs are matched as whole tokens, so
+// that "10.0.0.1" does not replace the prefix of "10.0.0.12", and "db-1"
+// is not replaced in "db-10".
+func New(names map[string]string) *Anonymizer {
+	a := &Anonymizer{ips: make(map[string]string), names: make(map[string]string)}
+	var keys []string
+	for k, v := range names {
+		if k == "" {
+			continue
+		}
+		if ip := net.ParseIP(k); ip != nil {
+			a.ips[ip.String()] = v
+			continue
+		}
+		a.names[k] = v
+		keys = append(keys, regexp.QuoteMeta(k))
+	}
+	if len(keys) > 0 {
+		// prefer longer names at the same position
+		sort.Slice(keys, func(i, j int) bool {
+			if len(keys[i]) != len(keys[j]) {
+				return len(keys[i]) > len(keys[j])
+			}
+			return keys[i] < keys[j]
+		})
+		a.namesRegex = regexp.MustCompile(strings.Join(keys, "|"))
+	}
+	return a
+}
+
+// String returns 's' with all identifiers replaced.
+func (a *Anonymizer) String(s string) string {
+	s = replaceTokens(s, ipv6Regex, ipv6Boundary, func(tok string) (string, bool) {
+		return a.ip(tok, RedactedIPv6)
+	})
+	s = replaceTokens(s, ipv4Regex, ipv4Boundary, func(tok string) (string, bool) {
+		return a.ip(tok, RedactedIP)
+	})
+	if a.namesRegex != nil {
+		s = replaceTokens(s, a.namesRegex, nameBoundary, func(tok string) (string, bool) {
+			return a.names[tok], true
+		})
+	}
+	return s
+}
+
+// ip returns the name of the IP, or 'redacted' if it has no name.
+// It returns false to keep loopback and unspecified addresses, and
+// the ones that do not parse (e.g. "12:34:56").
+func (a *Anonymizer) ip(tok, redacted string) (string, bool) {
+	ip := net.ParseIP(tok)
+	if ip == nil || ip.IsLoopback() || ip.IsUnspecified() {
+		return "", false
+	}
+	if v, ok := a.ips[ip.String()]; ok {
+		return v, true
+	}
+	return redacted, true
+}
+
+// replaceTokens replaces the matches of 're' that are whole tokens,
+// where 'boundary' reports if the bytes around the match separate it
+// from other tokens. 'repl' returns false to keep the match.
+func replaceTokens(s string, re *regexp.Regexp, boundary func(s string, start, end int) bool, repl func(string) (string, bool)) string {
+	idxs := re.FindAllStringIndex(s, -1)
+	if len(idxs) == 0 {
+		return s
+	}
+	out := make([]byte, 0, len(s))
+	last := 0
+	for _, idx := range idxs {
+		start, end := idx[0], idx[1]
+		if !boundary(s, start, end) {
+			continue
+		}
+		v, ok := repl(s[start:end])
+		if !ok {
+			continue
+		}
+		out = append(out, s[last:start]...)
+		out = append(out, v...)
+		last = end
+	}
+	out = append(out, s[last:]...)
+	return string(out)
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || ('0' <= b && b <= '9') || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+}
+
+// ipv4Boundary rejects IPs within longer dotted numbers
+// (e.g. version "1.2.3.4.5"), but accepts a trailing period.
+func ipv4Boundary(s string, start, end int) bool {
+	if start > 0 && (isWordByte(s[start-1]) || s[start-1] == '.') {
+		return false
+	}
+	if end < len(s) {
+		if isWordByte(s[end]) {
+			return false
+		}
+		if s[end] == '.' && end+1 < len(s) && isWordByte(s[end+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func ipv6Boundary(s string, start, end int) bool {
+	if start > 0 && (isWordByte(s[start-1]) || s[start-1] == ':' || s[start-1] == '.') {
+		return false
+	}
+	return end == len(s) || !isWordByte(s[end])
+}
+
+// nameBoundary rejects names within longer hostnames or identifiers
+// (e.g. "db-1" in "db-10"), where '.' and '/' separate tokens.
+func nameBoundary(s string, start, end int) bool {
+	if start > 0 && (isWordByte(s[start-1]) || s[start-1] == '-') {
+		return false
+	}
+	return end == len(s) || !(isWordByte(s[end]) || s[end] == '-')
+}
+
+// File writes the anonymized content of 'src' to 'dst'.
+func (a *Anonymizer) File(src, dst string) error {
+	b, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(dst, []byte(a.String(string(b))), 0644)
+}
@@ -0,0 +1,50 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anonymize
+
+import "testing"
+
+func TestAnonymizer(t *testing.T) {
+	a := New(map[string]string{
+		"10.0.0.1":            "server-1",
+		"db-2.internal":       "server-2",
+		"db-2.internal.extra": "server-2-extra",
+		"my-project":          "project",
+		"2001:db8:0::10":      "server-3",
+		"":                    "empty",
+	})
+	tests := []struct {
+		in, exp string
+	}{
+		{"peer 10.0.0.1:2379", "peer server-1:2379"},
+		{"peer 10.0.0.12:2379", "peer x.x.x.x:2379"},
+		{"listen 127.0.0.1, 0.0.0.0", "listen 127.0.0.1, 0.0.0.0"},
+		{"host db-2.internal.extra db-2.internal", "host server-2-extra server-2"},
+		{"gs://my-project/bucket", "gs://project/bucket"},
+		{"1234,5678", "1234,5678"},
+		{"version 1.10.0.1.2, go1.8.3.1", "version 1.10.0.1.2, go1.8.3.1"},
+		{"peer 10.0.0.1.", "peer server-1."},
+		{"db-2.internals db-2.internal-x xdb-2.internal", "db-2.internals db-2.internal-x xdb-2.internal"},
+		{"my-project-2, my-projects", "my-project-2, my-projects"},
+		{"peer [fe80::1]:2379, [::1]:2379", "peer [x:x:x:x:x:x:x:x]:2379, [::1]:2379"},
+		{"peer [2001:db8::10]:2379 ::ffff:10.0.0.1", "peer [server-3]:2379 server-1"},
+		{"at 12:34:56, std::string", "at 12:34:56, std::string"},
+	}
+	for i, tt := range tests {
+		if s := a.String(tt.in); s != tt.exp {
+			t.Errorf("#%d: expected %q, got %q", i, tt.exp, s)
+		}
+	}
+}
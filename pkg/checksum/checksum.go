@@ -0,0 +1,99 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package checksum writes and verifies SHA-256 checksums of result files,
+// to detect files truncated by interrupted copies or uploads.
+package checksum
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Suffix is the suffix of checksum files.
+const Suffix = ".sha256"
+
+// ErrNoChecksum is returned when the file has no checksum file.
+var ErrNoChecksum = errors.New("checksum: no checksum file")
+
+// Path returns the path of the checksum file of the file.
+func Path(fpath string) string {
+	return fpath + Suffix
+}
+
+// Sum returns the hex-encoded SHA-256 checksum of the file.
+func Sum(fpath string) (string, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Write writes the checksum of the file to its checksum file, in the
+// format of 'sha256sum' command, so that 'sha256sum -c' also verifies it.
+func Write(fpath string) error {
+	return WriteAs(fpath, filepath.Base(fpath))
+}
+
+// WriteAs writes the checksum of the file to its checksum file, with
+// the file named 'name' (e.g. the name of the uploaded object), so that
+// 'sha256sum -c' verifies the file downloaded with that name.
+func WriteAs(fpath, name string) error {
+	sum, err := Sum(fpath)
+	if err != nil {
+		return err
+	}
+	line := fmt.Sprintf("%s  %s\n", sum, name)
+	return ioutil.WriteFile(Path(fpath), []byte(line), 0644)
+}
+
+// Verify verifies the file against its checksum file. It returns
+// ErrNoChecksum if the checksum file does not exist.
+func Verify(fpath string) error {
+	bts, err := ioutil.ReadFile(Path(fpath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrNoChecksum
+		}
+		return err
+	}
+	fields := strings.Fields(string(bts))
+	if len(fields) == 0 {
+		return fmt.Errorf("%q is empty", Path(fpath))
+	}
+	expected := strings.ToLower(fields[0])
+
+	sum, err := Sum(fpath)
+	if err != nil {
+		return err
+	}
+	if sum != expected {
+		return fmt.Errorf("%q checksum mismatch (expected %s, got %s); file may be truncated or modified", fpath, expected, sum)
+	}
+	return nil
+}
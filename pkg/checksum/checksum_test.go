@@ -0,0 +1,86 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checksum
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestChecksum(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "checksum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "test.csv")
+	if err = ioutil.WriteFile(fpath, []byte("A,B\n1,2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = Verify(fpath); err != ErrNoChecksum {
+		t.Fatalf("expected %v, got %v", ErrNoChecksum, err)
+	}
+
+	if err = Write(fpath); err != nil {
+		t.Fatal(err)
+	}
+	if err = Verify(fpath); err != nil {
+		t.Fatal(err)
+	}
+	bts, err := ioutil.ReadFile(Path(fpath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "fb7d9248934ca9e856ccab5151226a61e2a36a61b049d8b9a08a829d7e6fb885  test.csv\n"; string(bts) != exp {
+		t.Fatalf("expected %q, got %q", exp, bts)
+	}
+
+	// truncated
+	if err = ioutil.WriteFile(fpath, []byte("A,B\n1,"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = Verify(fpath); err == nil {
+		t.Fatal("expected checksum mismatch")
+	}
+}
+
+func TestWriteAs(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "checksum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "test.csv")
+	if err = ioutil.WriteFile(fpath, []byte("A,B\n1,2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = WriteAs(fpath, "etcd-test.csv"); err != nil {
+		t.Fatal(err)
+	}
+	bts, err := ioutil.ReadFile(Path(fpath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := "fb7d9248934ca9e856ccab5151226a61e2a36a61b049d8b9a08a829d7e6fb885  etcd-test.csv\n"; string(bts) != exp {
+		t.Fatalf("expected %q, got %q", exp, bts)
+	}
+	if err = Verify(fpath); err != nil {
+		t.Fatal(err)
+	}
+}
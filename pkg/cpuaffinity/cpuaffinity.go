@@ -0,0 +1,98 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpuaffinity pins the current process to CPUs or NUMA nodes.
+package cpuaffinity
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseList parses CPU list in Linux format (e.g. "0-3,8,10-11").
+func ParseList(s string) ([]int, error) {
+	seen := make(map[int]struct{})
+	for _, field := range strings.Split(strings.TrimSpace(s), ",") {
+		if field == "" {
+			continue
+		}
+		lo, hi := field, field
+		if i := strings.Index(field, "-"); i != -1 {
+			lo, hi = field[:i], field[i+1:]
+		}
+		from, err := strconv.Atoi(lo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CPU list %q (%v)", s, err)
+		}
+		to, err := strconv.Atoi(hi)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CPU list %q (%v)", s, err)
+		}
+		if from < 0 || from > to {
+			return nil, fmt.Errorf("invalid CPU range %q", field)
+		}
+		for cpu := from; cpu <= to; cpu++ {
+			seen[cpu] = struct{}{}
+		}
+	}
+	if len(seen) == 0 {
+		return nil, fmt.Errorf("empty CPU list %q", s)
+	}
+	cpus := make([]int, 0, len(seen))
+	for cpu := range seen {
+		cpus = append(cpus, cpu)
+	}
+	sort.Ints(cpus)
+	return cpus, nil
+}
+
+// FormatList formats the CPUs as a list that ParseList reads (e.g. "0,1,8").
+func FormatList(cpus []int) string {
+	ss := make([]string, len(cpus))
+	for i, cpu := range cpus {
+		ss[i] = strconv.Itoa(cpu)
+	}
+	return strings.Join(ss, ",")
+}
+
+// Split splits the CPUs into n disjoint sets of (nearly) equal size,
+// in order. It returns an error if there are fewer CPUs than sets.
+func Split(cpus []int, n int) ([][]int, error) {
+	if n <= 0 || len(cpus) < n {
+		return nil, fmt.Errorf("cannot split %d CPU(s) into %d set(s)", len(cpus), n)
+	}
+	sets := make([][]int, n)
+	start := 0
+	for i := range sets {
+		size := len(cpus) / n
+		if i < len(cpus)%n {
+			size++
+		}
+		sets[i] = cpus[start : start+size]
+		start += size
+	}
+	return sets, nil
+}
+
+// NUMANodeCPUs returns the CPUs in the NUMA node.
+func NUMANodeCPUs(node int) ([]int, error) {
+	bts, err := ioutil.ReadFile(fmt.Sprintf("/sys/devices/system/node/node%d/cpulist", node))
+	if err != nil {
+		return nil, err
+	}
+	return ParseList(string(bts))
+}
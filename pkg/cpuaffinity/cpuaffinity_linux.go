@@ -0,0 +1,47 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpuaffinity
+
+import (
+	"io/ioutil"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+)
+
+// Set pins all OS threads of the current process to the CPUs.
+// Threads created later inherit the affinity from their parents.
+func Set(cpus []int) error {
+	var set unix.CPUSet
+	set.Zero()
+	for _, cpu := range cpus {
+		set.Set(cpu)
+	}
+
+	tasks, err := ioutil.ReadDir("/proc/self/task")
+	if err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		tid, err := strconv.Atoi(task.Name())
+		if err != nil {
+			continue
+		}
+		if err = unix.SchedSetaffinity(tid, &set); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpuaffinity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseList(t *testing.T) {
+	tests := []struct {
+		list string
+		cpus []int
+		err  bool
+	}{
+		{"0", []int{0}, false},
+		{"0-3", []int{0, 1, 2, 3}, false},
+		{"0-1,8,10-11\n", []int{0, 1, 8, 10, 11}, false},
+		{"3,1-2,2", []int{1, 2, 3}, false},
+		{"", nil, true},
+		{"3-1", nil, true},
+		{"a-b", nil, true},
+	}
+	for i, tt := range tests {
+		cpus, err := ParseList(tt.list)
+		if (err != nil) != tt.err {
+			t.Fatalf("#%d: expected error %v, got %v", i, tt.err, err)
+		}
+		if !reflect.DeepEqual(cpus, tt.cpus) {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.cpus, cpus)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	sets, err := Split([]int{0, 1, 2, 3, 8}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(sets, [][]int{{0, 1, 2}, {3, 8}}) {
+		t.Fatalf("unexpected sets %v", sets)
+	}
+	if s := FormatList(sets[1]); s != "3,8" {
+		t.Fatalf("expected \"3,8\", got %q", s)
+	}
+	cpus, err := ParseList(FormatList(sets[0]))
+	if err != nil || !reflect.DeepEqual(cpus, sets[0]) {
+		t.Fatalf("expected %v, got %v (%v)", sets[0], cpus, err)
+	}
+	if _, err = Split([]int{0}, 2); err == nil {
+		t.Fatal("expected error on fewer CPUs than sets")
+	}
+}
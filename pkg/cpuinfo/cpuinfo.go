@@ -0,0 +1,103 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpuinfo reads the CPU model, core count, and frequency of the machine.
+package cpuinfo
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Info describes the CPUs of a machine.
+type Info struct {
+	Model string
+	// Cores is the number of logical CPUs.
+	Cores int64
+	// MHz is the maximum frequency, or the current average frequency
+	// if the maximum is unknown.
+	MHz float64
+}
+
+const (
+	procCPUInfoPath = "/proc/cpuinfo"
+	cpuMaxFreqPath  = "/sys/devices/system/cpu/cpu0/cpufreq/cpuinfo_max_freq"
+)
+
+// Read returns the CPU information of this machine. The core count is
+// always set, even if '/proc/cpuinfo' cannot be read.
+func Read() (Info, error) {
+	f, err := os.Open(procCPUInfoPath)
+	if err != nil {
+		return Info{Cores: int64(runtime.NumCPU())}, err
+	}
+	defer f.Close()
+
+	info, err := parse(f)
+	if err != nil {
+		return Info{Cores: int64(runtime.NumCPU())}, err
+	}
+	if info.Cores == 0 {
+		info.Cores = int64(runtime.NumCPU())
+	}
+	// current frequency varies with CPU scaling
+	if bts, err := ioutil.ReadFile(cpuMaxFreqPath); err == nil {
+		if khz, err := strconv.ParseFloat(strings.TrimSpace(string(bts)), 64); err == nil && khz > 0 {
+			info.MHz = khz / 1000
+		}
+	}
+	return info, nil
+}
+
+// parse parses '/proc/cpuinfo'.
+func parse(r io.Reader) (Info, error) {
+	var (
+		info   Info
+		mhzSum float64
+		mhzN   int
+	)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		fs := strings.SplitN(sc.Text(), ":", 2)
+		if len(fs) != 2 {
+			continue
+		}
+		key, val := strings.TrimSpace(fs[0]), strings.TrimSpace(fs[1])
+		switch key {
+		case "processor":
+			info.Cores++
+		case "model name":
+			if info.Model == "" {
+				info.Model = val
+			}
+		case "cpu MHz":
+			if v, err := strconv.ParseFloat(val, 64); err == nil {
+				mhzSum += v
+				mhzN++
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return Info{}, err
+	}
+	if mhzN > 0 {
+		info.MHz = mhzSum / float64(mhzN)
+	}
+	return info, nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpuinfo
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	txt := `processor	: 0
+vendor_id	: GenuineIntel
+model name	: Intel(R) Xeon(R) CPU @ 2.20GHz
+cpu MHz		: 2200.000
+
+processor	: 1
+vendor_id	: GenuineIntel
+model name	: Intel(R) Xeon(R) CPU @ 2.20GHz
+cpu MHz		: 2300.000
+`
+	info, err := parse(strings.NewReader(txt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := Info{Model: "Intel(R) Xeon(R) CPU @ 2.20GHz", Cores: 2, MHz: 2250}
+	if !reflect.DeepEqual(info, exp) {
+		t.Fatalf("expected %+v, got %+v", exp, info)
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package csvmmap reads large CSV files through memory-mapped I/O,
+// parsing only the columns that are requested.
+package csvmmap
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/gyuho/dataframe"
+)
+
+// Reader reads a memory-mapped CSV file, with the first row as header.
+// Rows are parsed lazily, so memory usage is proportional to the columns
+// read, not to the file size.
+type Reader struct {
+	fpath  string
+	data   []byte
+	unmap  func() error
+	header []string
+
+	// offsets of each row (excluding header) in data,
+	// indexed on first use
+	rows []int
+}
+
+// Open memory-maps the CSV file and reads its header.
+func Open(fpath string) (*Reader, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() == 0 {
+		return nil, fmt.Errorf("empty CSV %s", fpath)
+	}
+	data, unmap, err := mmap(f, int(fi.Size()))
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Reader{fpath: fpath, data: data, unmap: unmap}
+	end := nextRow(data, 0)
+	r.header = parseRow(data[0:end], nil)
+	return r, nil
+}
+
+// Close unmaps the file. Values returned before Close remain valid.
+func (r *Reader) Close() error {
+	if r.unmap == nil {
+		return nil
+	}
+	err := r.unmap()
+	r.data, r.unmap = nil, nil
+	return err
+}
+
+// Header returns the header of the CSV file.
+func (r *Reader) Header() []string {
+	return r.header
+}
+
+// RowNumber returns the number of rows, excluding header.
+func (r *Reader) RowNumber() int {
+	r.index()
+	return len(r.rows)
+}
+
+// Columns returns the values of the named columns, in the same order.
+// Rows with fewer fields than header are filled with empty values.
+func (r *Reader) Columns(names ...string) ([][]string, error) {
+	idx := make(map[int]int, len(names))
+	for i, name := range names {
+		found := false
+		for j, h := range r.header {
+			if h == name {
+				idx[j], found = i, true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%q does not exist in %s", name, r.fpath)
+		}
+	}
+
+	r.index()
+	cols := make([][]string, len(names))
+	for i := range cols {
+		cols[i] = make([]string, len(r.rows))
+	}
+	for i, start := range r.rows {
+		end := nextRow(r.data, start)
+		parseRow(r.data[start:end], func(field int, v []byte) {
+			if ci, ok := idx[field]; ok {
+				cols[ci][i] = string(v)
+			}
+		})
+	}
+	return cols, nil
+}
+
+// Frame returns a frame of the named columns.
+func (r *Reader) Frame(names ...string) (dataframe.Frame, error) {
+	cols, err := r.Columns(names...)
+	if err != nil {
+		return nil, err
+	}
+	fr := dataframe.New()
+	for i, name := range names {
+		col := dataframe.NewColumn(name)
+		for _, v := range cols[i] {
+			col.PushBack(dataframe.NewStringValue(v))
+		}
+		if err = fr.AddColumn(col); err != nil {
+			return nil, err
+		}
+	}
+	return fr, nil
+}
+
+func (r *Reader) index() {
+	if r.rows != nil {
+		return
+	}
+	r.rows = []int{}
+	for start := nextRow(r.data, 0); start < len(r.data); {
+		end := nextRow(r.data, start)
+		if len(bytes.TrimRight(r.data[start:end], "\r\n")) > 0 {
+			r.rows = append(r.rows, start)
+		}
+		start = end
+	}
+}
+
+// nextRow returns the offset of the row after the one starting at start,
+// skipping newlines in quoted fields.
+func nextRow(data []byte, start int) int {
+	quoted := false
+	for i := start; i < len(data); i++ {
+		switch data[i] {
+		case '"':
+			quoted = !quoted
+		case '\n':
+			if !quoted {
+				return i + 1
+			}
+		}
+	}
+	return len(data)
+}
+
+// parseRow splits a row into fields, calling fn with the field index
+// and unquoted value if fn is not nil. It returns the fields as strings
+// only when fn is nil.
+func parseRow(row []byte, fn func(int, []byte)) []string {
+	row = bytes.TrimRight(row, "\r\n")
+	var fields []string
+	emit := func(i int, v []byte) {
+		if fn != nil {
+			fn(i, v)
+			return
+		}
+		fields = append(fields, string(v))
+	}
+
+	for i, pos := 0, 0; pos <= len(row); i++ {
+		if pos < len(row) && row[pos] == '"' {
+			var v []byte
+			pos++
+			for pos < len(row) {
+				if row[pos] == '"' {
+					if pos+1 < len(row) && row[pos+1] == '"' {
+						v = append(v, '"')
+						pos += 2
+						continue
+					}
+					pos++
+					break
+				}
+				v = append(v, row[pos])
+				pos++
+			}
+			emit(i, v)
+			if n := bytes.IndexByte(row[pos:], ','); n != -1 {
+				pos += n + 1
+				continue
+			}
+			break
+		}
+		n := bytes.IndexByte(row[pos:], ',')
+		if n == -1 {
+			emit(i, row[pos:])
+			break
+		}
+		emit(i, row[pos:pos+n])
+		pos += n + 1
+	}
+	return fields
+}
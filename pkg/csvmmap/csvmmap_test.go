@@ -0,0 +1,75 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csvmmap
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReader(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "csvmmap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "test.csv")
+	data := "UNIX-SECOND,CPU-NUM,EXTRA\r\n1,10.5,\"a,\"\"b\"\"\"\n2,11\n\n3,12,\"multi\nline\"\n"
+	if err = ioutil.WriteFile(fpath, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rd, err := Open(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rd.Close()
+
+	if h := rd.Header(); !reflect.DeepEqual(h, []string{"UNIX-SECOND", "CPU-NUM", "EXTRA"}) {
+		t.Fatalf("unexpected header %q", h)
+	}
+	if n := rd.RowNumber(); n != 3 {
+		t.Fatalf("expected 3 rows, got %d", n)
+	}
+
+	cols, err := rd.Columns("EXTRA", "UNIX-SECOND")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := [][]string{{`a,"b"`, "", "multi\nline"}, {"1", "2", "3"}}
+	if !reflect.DeepEqual(cols, exp) {
+		t.Fatalf("expected %q, got %q", exp, cols)
+	}
+
+	if _, err = rd.Columns("MISSING"); err == nil {
+		t.Fatal("expected error for missing column")
+	}
+
+	fr, err := rd.Frame("CPU-NUM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	col, err := fr.Column("CPU-NUM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if col.Count() != 3 {
+		t.Fatalf("expected 3 values, got %d", col.Count())
+	}
+}
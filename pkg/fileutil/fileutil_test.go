@@ -0,0 +1,48 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCopy(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "fileutil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	src, dst := filepath.Join(dir, "src"), filepath.Join(dir, "dst")
+	if err = ioutil.WriteFile(src, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(dst, []byte("hello world"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = Copy(src, dst); err != nil {
+		t.Fatal(err)
+	}
+	bts, err := ioutil.ReadFile(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bts) != "hello" {
+		t.Fatalf("expected %q, got %q", "hello", bts)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hdrhistogram
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"math"
+)
+
+const (
+	encodingCookieV2           = 0x1c849303 | 0x10
+	compressedEncodingCookieV2 = 0x1c849304 | 0x10
+
+	// cookie bits 4 to 7 hold the word size, which V2 ignores
+	cookieWordSizeMask = 0xf0
+
+	encodingHeaderSize = 40
+)
+
+// Encode returns the histogram in HdrHistogram V2 compressed encoding.
+func (h *Histogram) Encode() ([]byte, error) {
+	payload := h.encodeCounts()
+
+	var raw bytes.Buffer
+	binary.Write(&raw, binary.BigEndian, int32(encodingCookieV2))
+	binary.Write(&raw, binary.BigEndian, int32(len(payload)))
+	binary.Write(&raw, binary.BigEndian, int32(0)) // normalizing index offset
+	binary.Write(&raw, binary.BigEndian, int32(h.significantFigures))
+	binary.Write(&raw, binary.BigEndian, h.lowestDiscernibleValue)
+	binary.Write(&raw, binary.BigEndian, h.highestTrackableValue)
+	binary.Write(&raw, binary.BigEndian, math.Float64bits(1.0)) // integer to double value conversion ratio
+	raw.Write(payload)
+
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	if _, err := zw.Write(raw.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	binary.Write(&out, binary.BigEndian, int32(compressedEncodingCookieV2))
+	binary.Write(&out, binary.BigEndian, int32(compressed.Len()))
+	out.Write(compressed.Bytes())
+	return out.Bytes(), nil
+}
+
+// EncodeBase64 returns Encode in base64, as in histogram logs.
+func (h *Histogram) EncodeBase64() (string, error) {
+	b, err := h.Encode()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// Decode decodes a histogram in HdrHistogram V2 compressed encoding.
+func Decode(b []byte) (*Histogram, error) {
+	if len(b) < 8 {
+		return nil, fmt.Errorf("encoded histogram too short (%d bytes)", len(b))
+	}
+	cookie := binary.BigEndian.Uint32(b[0:4])
+	if cookie&^cookieWordSizeMask != compressedEncodingCookieV2&^cookieWordSizeMask {
+		return nil, fmt.Errorf("unknown compressed encoding cookie %#x", cookie)
+	}
+	n := int(binary.BigEndian.Uint32(b[4:8]))
+	if len(b) < 8+n {
+		return nil, fmt.Errorf("compressed length %d exceeds %d bytes", n, len(b)-8)
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(b[8 : 8+n]))
+	if err != nil {
+		return nil, err
+	}
+	raw, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(raw) < encodingHeaderSize {
+		return nil, fmt.Errorf("encoded histogram header too short (%d bytes)", len(raw))
+	}
+	cookie = binary.BigEndian.Uint32(raw[0:4])
+	if cookie&^cookieWordSizeMask != encodingCookieV2&^cookieWordSizeMask {
+		return nil, fmt.Errorf("unknown encoding cookie %#x (only V2 is supported)", cookie)
+	}
+	payloadLength := int(binary.BigEndian.Uint32(raw[4:8]))
+	sigfigs := int(binary.BigEndian.Uint32(raw[12:16]))
+	lowest := int64(binary.BigEndian.Uint64(raw[16:24]))
+	highest := int64(binary.BigEndian.Uint64(raw[24:32]))
+	if len(raw) < encodingHeaderSize+payloadLength {
+		return nil, fmt.Errorf("payload length %d exceeds %d bytes", payloadLength, len(raw)-encodingHeaderSize)
+	}
+
+	h, err := New(lowest, highest, sigfigs)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.decodeCounts(raw[encodingHeaderSize : encodingHeaderSize+payloadLength]); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// DecodeBase64 decodes a histogram encoded by EncodeBase64.
+func DecodeBase64(s string) (*Histogram, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(b)
+}
+
+// encodeCounts encodes counts up to the last non-zero one in ZigZag LEB128,
+// where a negative number is a run of zero counts.
+func (h *Histogram) encodeCounts() []byte {
+	last := len(h.counts) - 1
+	for last >= 0 && h.counts[last] == 0 {
+		last--
+	}
+	var buf []byte
+	for i := 0; i <= last; {
+		n := h.counts[i]
+		i++
+		if n == 0 {
+			zeros := int64(1)
+			for i <= last && h.counts[i] == 0 {
+				zeros++
+				i++
+			}
+			if zeros > 1 {
+				n = -zeros
+			}
+		}
+		buf = putZigZag(buf, n)
+	}
+	return buf
+}
+
+func (h *Histogram) decodeCounts(b []byte) error {
+	idx := 0
+	for len(b) > 0 {
+		n, sz := getZigZag(b)
+		if sz == 0 {
+			return fmt.Errorf("truncated counts at index %d", idx)
+		}
+		b = b[sz:]
+		if n < 0 {
+			idx += int(-n)
+			continue
+		}
+		if idx >= len(h.counts) {
+			return fmt.Errorf("counts index %d out of range %d", idx, len(h.counts))
+		}
+		h.counts[idx] = n
+		h.totalCount += n
+		idx++
+	}
+	return nil
+}
+
+// putZigZag appends 'v' in ZigZag LEB128 of up to 9 bytes,
+// where the 9th byte holds all remaining 8 bits.
+func putZigZag(buf []byte, v int64) []byte {
+	u := uint64((v << 1) ^ (v >> 63))
+	for i := 0; i < 8; i++ {
+		if u>>7 == 0 {
+			return append(buf, byte(u))
+		}
+		buf = append(buf, byte(u&0x7f|0x80))
+		u >>= 7
+	}
+	return append(buf, byte(u))
+}
+
+// getZigZag returns the decoded value and its size in bytes,
+// or 0 size if 'b' is truncated.
+func getZigZag(b []byte) (int64, int) {
+	var u uint64
+	for i := 0; i < 9; i++ {
+		if i >= len(b) {
+			return 0, 0
+		}
+		if i == 8 {
+			u |= uint64(b[i]) << 56
+			return int64(u>>1) ^ -int64(u&1), 9
+		}
+		u |= uint64(b[i]&0x7f) << uint(7*i)
+		if b[i]&0x80 == 0 {
+			return int64(u>>1) ^ -int64(u&1), i + 1
+		}
+	}
+	return 0, 0
+}
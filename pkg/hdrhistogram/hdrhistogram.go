@@ -0,0 +1,263 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hdrhistogram implements the High Dynamic Range histogram,
+// and reads and writes its interval log format.
+// Encoded histograms are compatible with HdrHistogram V2 compressed encoding,
+// so logs can be processed with the other HdrHistogram tools.
+package hdrhistogram
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// Histogram records integer values with a fixed number of significant
+// decimal digits, in buckets of exponentially increasing size.
+type Histogram struct {
+	lowestDiscernibleValue int64
+	highestTrackableValue  int64
+	significantFigures     int64
+
+	unitMagnitude               int64
+	subBucketHalfCountMagnitude int64
+	subBucketHalfCount          int64
+	subBucketCount              int64
+	subBucketMask               int64
+
+	totalCount int64
+	counts     []int64
+}
+
+// New returns a new Histogram that tracks values in
+// ['lowest', 'highest'] with 'sigfigs' significant digits (1 to 5).
+func New(lowest, highest int64, sigfigs int) (*Histogram, error) {
+	if sigfigs < 1 || sigfigs > 5 {
+		return nil, fmt.Errorf("significant figures must be in [1, 5] (got %d)", sigfigs)
+	}
+	if lowest < 1 {
+		return nil, fmt.Errorf("lowest discernible value must be >= 1 (got %d)", lowest)
+	}
+	if highest < 2*lowest {
+		return nil, fmt.Errorf("highest trackable value %d must be >= 2 * lowest discernible value %d", highest, lowest)
+	}
+
+	largestValueWithSingleUnitResolution := 2 * math.Pow10(sigfigs)
+	subBucketCountMagnitude := int64(math.Ceil(math.Log2(largestValueWithSingleUnitResolution)))
+	subBucketHalfCountMagnitude := subBucketCountMagnitude - 1
+	if subBucketHalfCountMagnitude < 0 {
+		subBucketHalfCountMagnitude = 0
+	}
+	unitMagnitude := int64(bits.Len64(uint64(lowest)) - 1)
+	subBucketCount := int64(1) << uint(subBucketHalfCountMagnitude+1)
+
+	// bucket count to cover 'highest', where each bucket doubles the size
+	smallestUntrackableValue := subBucketCount << uint(unitMagnitude)
+	bucketCount := int64(1)
+	for smallestUntrackableValue <= highest {
+		if smallestUntrackableValue > math.MaxInt64/2 {
+			bucketCount++
+			break
+		}
+		smallestUntrackableValue <<= 1
+		bucketCount++
+	}
+
+	return &Histogram{
+		lowestDiscernibleValue:      lowest,
+		highestTrackableValue:       highest,
+		significantFigures:          int64(sigfigs),
+		unitMagnitude:               unitMagnitude,
+		subBucketHalfCountMagnitude: subBucketHalfCountMagnitude,
+		subBucketHalfCount:          subBucketCount / 2,
+		subBucketCount:              subBucketCount,
+		subBucketMask:               (subBucketCount - 1) << uint(unitMagnitude),
+		counts:                      make([]int64, (bucketCount+1)*(subBucketCount/2)),
+	}, nil
+}
+
+// LowestDiscernibleValue returns the lowest value the histogram can tell from 0.
+func (h *Histogram) LowestDiscernibleValue() int64 { return h.lowestDiscernibleValue }
+
+// HighestTrackableValue returns the highest value the histogram can record.
+func (h *Histogram) HighestTrackableValue() int64 { return h.highestTrackableValue }
+
+// SignificantFigures returns the number of significant decimal digits.
+func (h *Histogram) SignificantFigures() int { return int(h.significantFigures) }
+
+// TotalCount returns the number of recorded values.
+func (h *Histogram) TotalCount() int64 { return h.totalCount }
+
+// Reset clears all recorded values.
+func (h *Histogram) Reset() {
+	h.totalCount = 0
+	for i := range h.counts {
+		h.counts[i] = 0
+	}
+}
+
+// RecordValue records 'v' once.
+func (h *Histogram) RecordValue(v int64) error {
+	return h.RecordValues(v, 1)
+}
+
+// RecordValues records 'v' 'n' times.
+func (h *Histogram) RecordValues(v, n int64) error {
+	if v < 0 {
+		return fmt.Errorf("value %d is negative", v)
+	}
+	idx := h.countsIndexFor(v)
+	if idx < 0 || int(idx) >= len(h.counts) || v > h.highestTrackableValue {
+		return fmt.Errorf("value %d is out of range [0, %d]", v, h.highestTrackableValue)
+	}
+	h.counts[idx] += n
+	h.totalCount += n
+	return nil
+}
+
+// Merge adds all recorded values of 'other' to 'h'. Values of 'other'
+// are re-bucketed, if the histograms have different ranges or precisions.
+func (h *Histogram) Merge(other *Histogram) error {
+	for i, n := range other.counts {
+		if n == 0 {
+			continue
+		}
+		if err := h.RecordValues(other.valueFromIndex(int64(i)), n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Min returns the lowest recorded value, or 0 if empty.
+func (h *Histogram) Min() int64 {
+	for i, n := range h.counts {
+		if n != 0 {
+			return h.lowestEquivalentValue(h.valueFromIndex(int64(i)))
+		}
+	}
+	return 0
+}
+
+// Max returns the highest recorded value, or 0 if empty.
+func (h *Histogram) Max() int64 {
+	for i := len(h.counts) - 1; i >= 0; i-- {
+		if h.counts[i] != 0 {
+			return h.highestEquivalentValue(h.valueFromIndex(int64(i)))
+		}
+	}
+	return 0
+}
+
+// ForEach calls 'fn' with the lowest equivalent value and the count of
+// each bucket with recorded values, in increasing order of values.
+func (h *Histogram) ForEach(fn func(v, n int64)) {
+	for i, n := range h.counts {
+		if n != 0 {
+			fn(h.lowestEquivalentValue(h.valueFromIndex(int64(i))), n)
+		}
+	}
+}
+
+// Mean returns the mean of all recorded values, or 0 if empty.
+func (h *Histogram) Mean() float64 {
+	if h.totalCount == 0 {
+		return 0
+	}
+	var sum float64
+	for i, n := range h.counts {
+		if n != 0 {
+			sum += float64(n) * float64(h.medianEquivalentValue(h.valueFromIndex(int64(i))))
+		}
+	}
+	return sum / float64(h.totalCount)
+}
+
+// ValueAtQuantile returns the value that 'q' percent (0 to 100) of
+// recorded values are less than or equal to, or 0 if empty.
+func (h *Histogram) ValueAtQuantile(q float64) int64 {
+	if q > 100 {
+		q = 100
+	}
+	countAtPercentile := int64(q/100*float64(h.totalCount) + 0.5)
+	if countAtPercentile < 1 {
+		countAtPercentile = 1
+	}
+	var total int64
+	for i, n := range h.counts {
+		total += n
+		if total >= countAtPercentile {
+			v := h.valueFromIndex(int64(i))
+			if q == 0 {
+				return h.lowestEquivalentValue(v)
+			}
+			return h.highestEquivalentValue(v)
+		}
+	}
+	return 0
+}
+
+func (h *Histogram) bucketIndex(v int64) int64 {
+	pow2Ceiling := int64(64 - bits.LeadingZeros64(uint64(v|h.subBucketMask)))
+	return pow2Ceiling - h.unitMagnitude - (h.subBucketHalfCountMagnitude + 1)
+}
+
+func (h *Histogram) subBucketIndex(v, bucketIdx int64) int64 {
+	return v >> uint(bucketIdx+h.unitMagnitude)
+}
+
+func (h *Histogram) countsIndex(bucketIdx, subBucketIdx int64) int64 {
+	bucketBaseIndex := (bucketIdx + 1) << uint(h.subBucketHalfCountMagnitude)
+	offsetInBucket := subBucketIdx - h.subBucketHalfCount
+	return bucketBaseIndex + offsetInBucket
+}
+
+func (h *Histogram) countsIndexFor(v int64) int64 {
+	bucketIdx := h.bucketIndex(v)
+	return h.countsIndex(bucketIdx, h.subBucketIndex(v, bucketIdx))
+}
+
+func (h *Histogram) valueFromIndex(idx int64) int64 {
+	bucketIdx := (idx >> uint(h.subBucketHalfCountMagnitude)) - 1
+	subBucketIdx := (idx & (h.subBucketHalfCount - 1)) + h.subBucketHalfCount
+	if bucketIdx < 0 {
+		subBucketIdx -= h.subBucketHalfCount
+		bucketIdx = 0
+	}
+	return subBucketIdx << uint(bucketIdx+h.unitMagnitude)
+}
+
+func (h *Histogram) sizeOfEquivalentValueRange(v int64) int64 {
+	bucketIdx := h.bucketIndex(v)
+	subBucketIdx := h.subBucketIndex(v, bucketIdx)
+	adjustedBucket := bucketIdx
+	if subBucketIdx >= h.subBucketCount {
+		adjustedBucket++
+	}
+	return int64(1) << uint(h.unitMagnitude+adjustedBucket)
+}
+
+func (h *Histogram) lowestEquivalentValue(v int64) int64 {
+	bucketIdx := h.bucketIndex(v)
+	return h.subBucketIndex(v, bucketIdx) << uint(bucketIdx+h.unitMagnitude)
+}
+
+func (h *Histogram) highestEquivalentValue(v int64) int64 {
+	return h.lowestEquivalentValue(v) + h.sizeOfEquivalentValueRange(v) - 1
+}
+
+func (h *Histogram) medianEquivalentValue(v int64) int64 {
+	return h.lowestEquivalentValue(v) + h.sizeOfEquivalentValueRange(v)>>1
+}
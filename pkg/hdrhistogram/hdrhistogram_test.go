@@ -0,0 +1,160 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hdrhistogram
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHistogramValueAtQuantile(t *testing.T) {
+	h, err := New(1, 3600*1000*1000, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := int64(1); i <= 10000; i++ {
+		if err = h.RecordValue(i * 100); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if h.TotalCount() != 10000 {
+		t.Fatalf("expected 10000, got %d", h.TotalCount())
+	}
+	tests := []struct {
+		q    float64
+		want int64
+	}{
+		{50, 500000},
+		{90, 900000},
+		{99, 990000},
+		{99.9, 999000},
+		{100, 1000000},
+	}
+	for i, tt := range tests {
+		v := h.ValueAtQuantile(tt.q)
+		if d := v - tt.want; d < 0 || float64(d) > float64(tt.want)*0.001 {
+			t.Fatalf("#%d: p%v expected %d within 0.1%%, got %d", i, tt.q, tt.want, v)
+		}
+	}
+	if v := h.Min(); v != 100 {
+		t.Fatalf("min expected 100, got %d", v)
+	}
+	if err = h.RecordValue(3600*1000*1000 + 1); err == nil {
+		t.Fatal("expected error for out of range value")
+	}
+}
+
+func TestHistogramEncodeDecode(t *testing.T) {
+	h, err := New(1, 60*1000*1000, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []int64{0, 1, 1, 7, 1000, 2047, 2048, 123456, 59999999} {
+		if err = h.RecordValue(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s, err := h.EncodeBase64()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(s, "HISTF") {
+		t.Fatalf("expected V2 compressed cookie prefix 'HISTF', got %q", s[:5])
+	}
+	decoded, err := DecodeBase64(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(h, decoded) {
+		t.Fatal("decoded histogram is different")
+	}
+}
+
+func TestHistogramMerge(t *testing.T) {
+	h1, _ := New(1, 1000000, 3)
+	h2, _ := New(1, 1000000, 2)
+	for i := int64(1); i <= 100; i++ {
+		h1.RecordValue(i)
+		h2.RecordValue(i * 1000)
+	}
+	if err := h1.Merge(h2); err != nil {
+		t.Fatal(err)
+	}
+	if h1.TotalCount() != 200 {
+		t.Fatalf("expected 200, got %d", h1.TotalCount())
+	}
+	if v := h1.ValueAtQuantile(50); v != 100 {
+		t.Fatalf("p50 expected 100, got %d", v)
+	}
+}
+
+func TestHistogramForEach(t *testing.T) {
+	h, _ := New(1, 1000000, 3)
+	h.RecordValues(5, 2)
+	h.RecordValue(100000)
+	var vs, ns []int64
+	h.ForEach(func(v, n int64) {
+		vs = append(vs, v)
+		ns = append(ns, n)
+	})
+	if len(vs) != 2 || vs[0] != 5 || ns[0] != 2 || ns[1] != 1 {
+		t.Fatalf("unexpected buckets %v (counts %v)", vs, ns)
+	}
+	// lowest equivalent value within 3 significant figures
+	if vs[1] > 100000 || vs[1] < 99900 {
+		t.Fatalf("unexpected value %d", vs[1])
+	}
+}
+
+func TestLogWriteRead(t *testing.T) {
+	start := time.Unix(1500000000, 0)
+	buf := new(bytes.Buffer)
+	lw, err := NewLogWriter(buf, start, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []LogEntry
+	for i := 0; i < 3; i++ {
+		h, _ := New(1, 1000000, 3)
+		h.RecordValue(int64(i+1) * 1000)
+		e := LogEntry{Start: start.Add(time.Duration(i) * time.Second), Length: time.Second, Histogram: h}
+		if i == 2 {
+			e.Tag = "read"
+		}
+		if err = lw.Write(e); err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, e)
+	}
+
+	got, err := ReadLog(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d entries, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || got[i].Length != want[i].Length || got[i].Tag != want[i].Tag {
+			t.Fatalf("#%d: expected %+v, got %+v", i, want[i], got[i])
+		}
+		if !reflect.DeepEqual(got[i].Histogram, want[i].Histogram) {
+			t.Fatalf("#%d: histogram is different", i)
+		}
+	}
+}
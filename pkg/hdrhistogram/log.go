@@ -0,0 +1,177 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hdrhistogram
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogEntry is one interval histogram in a histogram log.
+type LogEntry struct {
+	// Tag is empty if the entry is not tagged.
+	Tag string
+	// Start is the start of the interval.
+	Start time.Time
+	// Length is the length of the interval.
+	Length time.Duration
+	// Histogram has all values recorded in the interval.
+	Histogram *Histogram
+}
+
+// LogWriter writes interval histograms in HdrHistogram log format
+// version 1.3, with timestamps relative to the start time.
+type LogWriter struct {
+	w     io.Writer
+	start time.Time
+	ratio float64
+}
+
+// NewLogWriter writes the log header to 'w' and returns a new LogWriter.
+// Interval max values are divided by 'maxValueUnitRatio' (e.g. 1000 to
+// write microsecond values in milliseconds).
+func NewLogWriter(w io.Writer, start time.Time, maxValueUnitRatio float64) (*LogWriter, error) {
+	if maxValueUnitRatio <= 0 {
+		maxValueUnitRatio = 1
+	}
+	sec := float64(start.UnixNano()) / 1e9
+	header := fmt.Sprintf("#[Histogram log format version 1.3]\n#[StartTime: %.3f (seconds since epoch), %s]\n#[BaseTime: %.3f (seconds since epoch)]\n%s\n",
+		sec, start.UTC().Format(time.UnixDate),
+		sec,
+		`"StartTimestamp","Interval_Length","Interval_Max","Interval_Compressed_Histogram"`,
+	)
+	if _, err := io.WriteString(w, header); err != nil {
+		return nil, err
+	}
+	return &LogWriter{w: w, start: start, ratio: maxValueUnitRatio}, nil
+}
+
+// Write writes one interval histogram.
+func (lw *LogWriter) Write(e LogEntry) error {
+	enc, err := e.Histogram.EncodeBase64()
+	if err != nil {
+		return err
+	}
+	line := fmt.Sprintf("%.3f,%.3f,%.3f,%s\n",
+		e.Start.Sub(lw.start).Seconds(),
+		e.Length.Seconds(),
+		float64(e.Histogram.Max())/lw.ratio,
+		enc,
+	)
+	if e.Tag != "" {
+		line = "Tag=" + e.Tag + "," + line
+	}
+	_, err = io.WriteString(lw.w, line)
+	return err
+}
+
+// ReadLog reads all interval histograms in a HdrHistogram log.
+// Timestamps are relative to the base time (or start time if no base
+// time is given), unless they are already in seconds since epoch.
+func ReadLog(r io.Reader) ([]LogEntry, error) {
+	var (
+		entries   []LogEntry
+		startTime float64
+		baseTime  float64
+		hasBase   bool
+	)
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for lineNum := 1; sc.Scan(); lineNum++ {
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#[StartTime: "):
+			v, err := parseLogHeaderTime(line, "#[StartTime: ")
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", lineNum, err)
+			}
+			startTime = v
+			continue
+		case strings.HasPrefix(line, "#[BaseTime: "):
+			v, err := parseLogHeaderTime(line, "#[BaseTime: ")
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", lineNum, err)
+			}
+			baseTime, hasBase = v, true
+			continue
+		case strings.HasPrefix(line, "#"), strings.HasPrefix(line, `"StartTimestamp"`):
+			continue
+		}
+
+		var tag string
+		if strings.HasPrefix(line, "Tag=") {
+			idx := strings.Index(line, ",")
+			if idx < 0 {
+				return nil, fmt.Errorf("line %d: missing fields after tag", lineNum)
+			}
+			tag, line = line[len("Tag="):idx], line[idx+1:]
+		}
+		fields := strings.Split(line, ",")
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("line %d: expected 4 fields, got %d", lineNum, len(fields))
+		}
+		ts, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNum, err)
+		}
+		length, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNum, err)
+		}
+		h, err := DecodeBase64(fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNum, err)
+		}
+
+		// relative timestamps are less than a year
+		if ts < 365*24*60*60 {
+			if hasBase {
+				ts += baseTime
+			} else {
+				ts += startTime
+			}
+		}
+		entries = append(entries, LogEntry{
+			Tag:       tag,
+			Start:     secondsToTime(ts),
+			Length:    time.Duration(length * float64(time.Second)),
+			Histogram: h,
+		})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func parseLogHeaderTime(line, prefix string) (float64, error) {
+	s := strings.TrimPrefix(line, prefix)
+	if idx := strings.IndexAny(s, " ]"); idx >= 0 {
+		s = s[:idx]
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func secondsToTime(sec float64) time.Time {
+	whole, frac := math.Modf(sec)
+	return time.Unix(int64(whole), int64(math.Floor(frac*1e3+0.5))*int64(time.Millisecond))
+}
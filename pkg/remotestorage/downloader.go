@@ -0,0 +1,123 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotestorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/oauth2/google"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// Downloader defines storage downloader.
+type Downloader interface {
+	// DownloadDir downloads all objects under the prefix to the directory,
+	// skipping the ones already downloaded with the same size.
+	DownloadDir(bucket, prefix, dst string) error
+}
+
+// NewGoogleCloudStorageDownloader creates a new downloader
+// with read-only access.
+func NewGoogleCloudStorageDownloader(key []byte, project string) (Downloader, error) {
+	conf, err := google.JWTConfigFromJSON(
+		key,
+		storage.ScopeReadOnly,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &GoogleCloudStorage{
+		JSONKey: key,
+		Project: project,
+		Config:  conf,
+	}, nil
+}
+
+// DownloadDir downloads objects under the prefix from Google Cloud Storage.
+func (g *GoogleCloudStorage) DownloadDir(bucket, prefix, dst string) error {
+	if g == nil {
+		return fmt.Errorf("GoogleCloudStorage is nil")
+	}
+	ctx := context.Background()
+
+	client, err := storage.NewClient(ctx, option.WithTokenSource(g.Config.TokenSource(ctx)))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	bkt := client.Bucket(bucket)
+	it := bkt.Objects(ctx, &storage.Query{Prefix: prefix})
+	var cnt int
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		rel := strings.TrimPrefix(strings.TrimPrefix(attrs.Name, prefix), "/")
+		if rel == "" || strings.HasSuffix(rel, "/") {
+			continue
+		}
+		fpath := filepath.Join(dst, filepath.FromSlash(rel))
+		if fi, err := os.Stat(fpath); err == nil && fi.Size() == attrs.Size {
+			continue
+		}
+		plog.Printf("downloading %q ---> %q", attrs.Name, fpath)
+		if err = downloadObject(ctx, bkt.Object(attrs.Name), fpath); err != nil {
+			return err
+		}
+		cnt++
+	}
+	plog.Printf("finished downloading %d objects from %q", cnt, bucket+"/"+prefix)
+	return nil
+}
+
+func downloadObject(ctx context.Context, obj *storage.ObjectHandle, fpath string) error {
+	if err := os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+		return err
+	}
+	rd, err := obj.NewReader(ctx)
+	if err != nil {
+		return err
+	}
+	defer rd.Close()
+
+	// write to temporary file, not to leave partial downloads
+	// that would be skipped as already downloaded
+	tmp := fpath + ".download"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, rd); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, fpath)
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resample resamples system metrics and collector samples
+// taken at intervals other than one second, to one row per second.
+package resample
+
+import (
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/gyuho/linux-inspect/inspect"
+)
+
+// Interpolate returns the CSV with one row per second. Deltas of samples
+// (e.g. READ-BYTES-DELTA) are scaled to per-second rates by the elapsed
+// time from the previous sample, if the interval is not one second.
+// Then rows in the same second are averaged, and missing seconds are
+// filled by inspect.CSV.Interpolate.
+func Interpolate(c *inspect.CSV, interval time.Duration) (*inspect.CSV, error) {
+	if c == nil || interval == time.Second {
+		return c.Interpolate()
+	}
+	copied := *c
+	copied.Rows = make([]inspect.Proc, len(c.Rows))
+	copy(copied.Rows, c.Rows)
+	for i := 1; i < len(copied.Rows); i++ {
+		elapsed := copied.Rows[i].UnixNanosecond - copied.Rows[i-1].UnixNanosecond
+		if elapsed <= 0 {
+			continue
+		}
+		perSecond(&copied.Rows[i], float64(time.Second)/float64(elapsed))
+	}
+	return copied.Interpolate()
+}
+
+// perSecond scales the deltas of the sample by 'f'.
+func perSecond(p *inspect.Proc, f float64) {
+	scale := func(v uint64) uint64 { return uint64(float64(v)*f + 0.5) }
+
+	p.ReadsCompletedDelta = scale(p.ReadsCompletedDelta)
+	p.SectorsReadDelta = scale(p.SectorsReadDelta)
+	p.WritesCompletedDelta = scale(p.WritesCompletedDelta)
+	p.SectorsWrittenDelta = scale(p.SectorsWrittenDelta)
+	p.ReadBytesDelta = scale(p.ReadBytesDelta)
+	p.ReadMegabytesDelta = scale(p.ReadMegabytesDelta)
+	p.WriteBytesDelta = scale(p.WriteBytesDelta)
+	p.WriteMegabytesDelta = scale(p.WriteMegabytesDelta)
+
+	p.ReceivePacketsDelta = scale(p.ReceivePacketsDelta)
+	p.TransmitPacketsDelta = scale(p.TransmitPacketsDelta)
+	p.ReceiveBytesNumDelta = scale(p.ReceiveBytesNumDelta)
+	p.TransmitBytesNumDelta = scale(p.TransmitBytesNumDelta)
+	p.ReceiveBytesDelta = humanize.Bytes(p.ReceiveBytesNumDelta)
+	p.TransmitBytesDelta = humanize.Bytes(p.TransmitBytesNumDelta)
+}
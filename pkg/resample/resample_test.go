@@ -0,0 +1,89 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resample
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gyuho/linux-inspect/inspect"
+)
+
+func TestInterpolate(t *testing.T) {
+	// sampled every 500ms, writing 100 bytes each sample
+	c := &inspect.CSV{}
+	for i := int64(0); i < 5; i++ {
+		ns := 100*int64(time.Second) + i*int64(500*time.Millisecond)
+		p := inspect.Proc{UnixNanosecond: ns, UnixSecond: ns / int64(time.Second)}
+		if i > 0 {
+			p.WriteBytesDelta = 100
+		}
+		c.Rows = append(c.Rows, p)
+	}
+	c.MinUnixNanosecond, c.MinUnixSecond = c.Rows[0].UnixNanosecond, c.Rows[0].UnixSecond
+	c.MaxUnixNanosecond, c.MaxUnixSecond = c.Rows[4].UnixNanosecond, c.Rows[4].UnixSecond
+
+	cc, err := Interpolate(c, 500*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cc.Rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(cc.Rows))
+	}
+	for i, sec := range []int64{100, 101, 102} {
+		if cc.Rows[i].UnixSecond != sec {
+			t.Fatalf("#%d: expected unix second %d, got %d", i, sec, cc.Rows[i].UnixSecond)
+		}
+	}
+	if cc.Rows[1].WriteBytesDelta != 200 {
+		t.Fatalf("expected 200 bytes per second, got %d", cc.Rows[1].WriteBytesDelta)
+	}
+	if c.Rows[1].WriteBytesDelta != 100 {
+		t.Fatalf("original rows changed, got %d", c.Rows[1].WriteBytesDelta)
+	}
+}
+
+func TestRows(t *testing.T) {
+	// sampled every 2 seconds, with a failed sample at 104
+	rows := [][]string{
+		{"100", "10", "leader"},
+		{"102", "20", "follower"},
+		{"104", "", ""},
+		{"106", "40", "leader"},
+	}
+	resampled, err := Rows(rows, 99, 107)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{
+		{"99", "10", "leader"},
+		{"100", "10", "leader"},
+		{"101", "15", "leader"},
+		{"102", "20", "follower"},
+		{"103", "25", "follower"},
+		{"104", "30", "follower"},
+		{"105", "35", "follower"},
+		{"106", "40", "leader"},
+		{"107", "40", "leader"},
+	}
+	if !reflect.DeepEqual(resampled, expected) {
+		t.Fatalf("expected %v, got %v", expected, resampled)
+	}
+
+	if _, err = Rows([][]string{{"x", "1"}}, 0, 1); err == nil {
+		t.Fatal("expected error on invalid unix second")
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resample
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Rows returns the rows with one row per second, from 'minUnixSecond'
+// to 'maxUnixSecond', so that they can be joined with other per-second
+// timeseries. The first column of each row is the unix second.
+// Numeric values in the same second are averaged, and missing seconds
+// are linearly interpolated between samples. Seconds before the first
+// or after the last sample take the nearest sample. Columns with any
+// value that is not a number take the previous sample as is.
+func Rows(rows [][]string, minUnixSecond, maxUnixSecond int64) ([][]string, error) {
+	if len(rows) == 0 || minUnixSecond > maxUnixSecond {
+		return nil, nil
+	}
+	secs := make([]int64, len(rows))
+	columnN := 0
+	for i, row := range rows {
+		if len(row) == 0 {
+			return nil, fmt.Errorf("empty row at %d", i)
+		}
+		sec, err := strconv.ParseInt(row[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid unix second %q at %d (%v)", row[0], i, err)
+		}
+		secs[i] = sec
+		if len(row) > columnN {
+			columnN = len(row)
+		}
+	}
+
+	resampled := make([][]string, 0, maxUnixSecond-minUnixSecond+1)
+	for sec := minUnixSecond; sec <= maxUnixSecond; sec++ {
+		row := make([]string, columnN)
+		row[0] = fmt.Sprintf("%d", sec)
+		resampled = append(resampled, row)
+	}
+	for j := 1; j < columnN; j++ {
+		col := newColumn(secs, rows, j)
+		for _, row := range resampled {
+			sec, _ := strconv.ParseInt(row[0], 10, 64)
+			row[j] = col.at(sec)
+		}
+	}
+	return resampled, nil
+}
+
+// column is the non-empty samples of a column, sorted by unix second.
+type column struct {
+	secs []int64
+	// numbers are the averages per second, if all samples are numbers
+	numbers []float64
+	// values are the last samples per second, otherwise
+	values []string
+}
+
+func newColumn(secs []int64, rows [][]string, j int) column {
+	var (
+		sum     = make(map[int64]float64)
+		cnt     = make(map[int64]int)
+		last    = make(map[int64]string)
+		numeric = true
+	)
+	for i, row := range rows {
+		if j >= len(row) || row[j] == "" {
+			continue
+		}
+		sec := secs[i]
+		last[sec] = row[j]
+		v, err := strconv.ParseFloat(row[j], 64)
+		if err != nil {
+			numeric = false
+			continue
+		}
+		sum[sec] += v
+		cnt[sec]++
+	}
+
+	var c column
+	for sec := range last {
+		c.secs = append(c.secs, sec)
+	}
+	sort.Slice(c.secs, func(a, b int) bool { return c.secs[a] < c.secs[b] })
+	for _, sec := range c.secs {
+		if numeric {
+			c.numbers = append(c.numbers, sum[sec]/float64(cnt[sec]))
+		} else {
+			c.values = append(c.values, last[sec])
+		}
+	}
+	return c
+}
+
+// at returns the value of the column at 'sec'.
+func (c column) at(sec int64) string {
+	if len(c.secs) == 0 {
+		return ""
+	}
+	// index of the first sample at or after 'sec'
+	i := sort.Search(len(c.secs), func(i int) bool { return c.secs[i] >= sec })
+	switch {
+	case i < len(c.secs) && c.secs[i] == sec:
+	case i == 0:
+	case i == len(c.secs):
+		i--
+	default:
+		if c.numbers == nil {
+			// previous sample
+			i--
+			break
+		}
+		s0, s1 := c.secs[i-1], c.secs[i]
+		v0, v1 := c.numbers[i-1], c.numbers[i]
+		return formatFloat(v0 + (v1-v0)*float64(sec-s0)/float64(s1-s0))
+	}
+	if c.numbers == nil {
+		return c.values[i]
+	}
+	return formatFloat(c.numbers[i])
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
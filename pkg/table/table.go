@@ -0,0 +1,348 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package table implements join, filter, and sort operations on CSV tables,
+// for ad-hoc analyses of test results.
+package table
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Table is a CSV table with a header row.
+type Table struct {
+	Header []string
+	Rows   [][]string
+
+	// Units are the units of columns in the order of the header
+	// (e.g. "ms", "MB", "ops/s"), empty for unitless columns.
+	// They are saved in the units file next to the CSV file, if any.
+	Units []string
+}
+
+// Row is a row of the table, with values looked up by column.
+type Row struct {
+	t      *Table
+	Values []string
+}
+
+// Get returns the value of the column, or an empty string
+// if the column does not exist.
+func (r Row) Get(column string) string {
+	idx, err := r.t.ColumnIndex(column)
+	if err != nil || idx >= len(r.Values) {
+		return ""
+	}
+	return r.Values[idx]
+}
+
+// Float64 returns the value of the column as a float.
+func (r Row) Float64(column string) (float64, error) {
+	return strconv.ParseFloat(r.Get(column), 64)
+}
+
+// New returns a new table with the header.
+func New(header ...string) *Table {
+	return &Table{Header: header}
+}
+
+// ReadCSV reads the CSV file, whose first row is the header.
+func ReadCSV(fpath string) (*Table, error) {
+	f, err := os.OpenFile(fpath, os.O_RDONLY, 0444)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%q has no header", fpath)
+	}
+	t := &Table{Header: rows[0], Rows: rows[1:]}
+
+	units, err := ReadUnits(fpath)
+	if err != nil {
+		return nil, err
+	}
+	if units != nil {
+		t.Units = make([]string, len(t.Header))
+		for i, h := range t.Header {
+			t.Units[i] = units[h]
+		}
+	}
+	return t, nil
+}
+
+// WriteCSV writes the table to the CSV file.
+func (t *Table) WriteCSV(fpath string) error {
+	f, err := os.OpenFile(fpath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0777)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	if err = wr.Write(t.Header); err != nil {
+		return err
+	}
+	if err = wr.WriteAll(t.Rows); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	if len(t.Units) == 0 {
+		return nil
+	}
+	return WriteUnits(fpath, t.Header, t.Units)
+}
+
+// Unit returns the unit of the column, or an empty string
+// if unknown.
+func (t *Table) Unit(column string) string {
+	idx, err := t.ColumnIndex(column)
+	if err != nil || idx >= len(t.Units) {
+		return ""
+	}
+	return t.Units[idx]
+}
+
+// UnitsSuffix is the suffix of units files.
+const UnitsSuffix = ".units.csv"
+
+// UnitsPath returns the path of the units file of the CSV file
+// (e.g. "timeseries.units.csv" for "timeseries.csv").
+func UnitsPath(fpath string) string {
+	return strings.TrimSuffix(fpath, ".csv") + UnitsSuffix
+}
+
+// WriteUnits writes the units of the CSV file columns to its units
+// file, with "COLUMN" and "UNIT" columns. Columns without unit are
+// skipped.
+func WriteUnits(fpath string, header, units []string) error {
+	if len(header) != len(units) {
+		return fmt.Errorf("%d columns, but %d units", len(header), len(units))
+	}
+	f, err := os.OpenFile(UnitsPath(fpath), os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0777)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	if err = wr.Write([]string{"COLUMN", "UNIT"}); err != nil {
+		return err
+	}
+	for i, h := range header {
+		if units[i] == "" {
+			continue
+		}
+		if err = wr.Write([]string{h, units[i]}); err != nil {
+			return err
+		}
+	}
+	wr.Flush()
+	if err = wr.Error(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// ReadUnits reads the units file of the CSV file, and returns
+// the unit of each column. It returns nil if the units file
+// does not exist.
+func ReadUnits(fpath string) (map[string]string, error) {
+	f, err := os.OpenFile(UnitsPath(fpath), os.O_RDONLY, 0444)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	units := make(map[string]string)
+	for i, row := range rows {
+		if i == 0 || len(row) != 2 {
+			// header
+			continue
+		}
+		units[row[0]] = row[1]
+	}
+	return units, nil
+}
+
+// ColumnIndex returns the index of the column.
+func (t *Table) ColumnIndex(column string) (int, error) {
+	for i, h := range t.Header {
+		if h == column {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("column %q does not exist (%q)", column, t.Header)
+}
+
+// Column returns all values of the column.
+func (t *Table) Column(column string) ([]string, error) {
+	idx, err := t.ColumnIndex(column)
+	if err != nil {
+		return nil, err
+	}
+	vs := make([]string, len(t.Rows))
+	for i, row := range t.Rows {
+		if idx < len(row) {
+			vs[i] = row[idx]
+		}
+	}
+	return vs, nil
+}
+
+// Filter returns a new table with the rows that satisfy the predicate.
+func (t *Table) Filter(keep func(Row) bool) *Table {
+	nt := New(t.Header...)
+	nt.Units = t.Units
+	for _, row := range t.Rows {
+		if keep(Row{t: t, Values: row}) {
+			nt.Rows = append(nt.Rows, row)
+		}
+	}
+	return nt
+}
+
+// SortBy sorts the rows by the column in ascending order. Values are
+// compared as numbers if both are numeric, and as strings otherwise.
+// The sort is stable, so sorting by multiple columns works from the least
+// significant column.
+func (t *Table) SortBy(column string, descending bool) error {
+	idx, err := t.ColumnIndex(column)
+	if err != nil {
+		return err
+	}
+	value := func(i int) string {
+		if idx < len(t.Rows[i]) {
+			return t.Rows[i][idx]
+		}
+		return ""
+	}
+	sort.SliceStable(t.Rows, func(i, j int) bool {
+		a, b := value(i), value(j)
+		if descending {
+			a, b = b, a
+		}
+		fa, errA := strconv.ParseFloat(a, 64)
+		fb, errB := strconv.ParseFloat(b, 64)
+		if errA == nil && errB == nil {
+			return fa < fb
+		}
+		return a < b
+	})
+	return nil
+}
+
+// InnerJoin joins two tables on the column, keeping only the rows whose
+// value exists in both tables. The result has all columns of 'left',
+// followed by the columns of 'right' except the join column.
+func InnerJoin(left, right *Table, column string) (*Table, error) {
+	return join(left, right, column, false)
+}
+
+// LeftJoin joins two tables on the column, keeping all rows of 'left'.
+// Columns of 'right' are empty for the rows without a match.
+func LeftJoin(left, right *Table, column string) (*Table, error) {
+	return join(left, right, column, true)
+}
+
+func join(left, right *Table, column string, keepLeft bool) (*Table, error) {
+	li, err := left.ColumnIndex(column)
+	if err != nil {
+		return nil, err
+	}
+	ri, err := right.ColumnIndex(column)
+	if err != nil {
+		return nil, err
+	}
+
+	nt := New(left.Header...)
+	for i, h := range right.Header {
+		if i == ri {
+			continue
+		}
+		if _, err = left.ColumnIndex(h); err == nil {
+			return nil, fmt.Errorf("column %q exists in both tables", h)
+		}
+		nt.Header = append(nt.Header, h)
+	}
+	if len(left.Units) > 0 || len(right.Units) > 0 {
+		for _, h := range left.Header {
+			nt.Units = append(nt.Units, left.Unit(h))
+		}
+		for i, h := range right.Header {
+			if i != ri {
+				nt.Units = append(nt.Units, right.Unit(h))
+			}
+		}
+	}
+
+	valueToRightRows := make(map[string][][]string)
+	for _, row := range right.Rows {
+		if ri < len(row) {
+			valueToRightRows[row[ri]] = append(valueToRightRows[row[ri]], row)
+		}
+	}
+	for _, lrow := range left.Rows {
+		var rrows [][]string
+		if li < len(lrow) {
+			rrows = valueToRightRows[lrow[li]]
+		}
+		if len(rrows) == 0 {
+			if keepLeft {
+				nrow := make([]string, len(nt.Header))
+				copy(nrow, lrow)
+				nt.Rows = append(nt.Rows, nrow)
+			}
+			continue
+		}
+		for _, rrow := range rrows {
+			nrow := make([]string, 0, len(nt.Header))
+			nrow = append(nrow, lrow...)
+			for len(nrow) < len(left.Header) {
+				nrow = append(nrow, "")
+			}
+			for i := range right.Header {
+				if i == ri {
+					continue
+				}
+				v := ""
+				if i < len(rrow) {
+					v = rrow[i]
+				}
+				nrow = append(nrow, v)
+			}
+			nt.Rows = append(nt.Rows, nrow)
+		}
+	}
+	return nt, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	left := &Table{
+		Header: []string{"KEYS", "AVG-LATENCY-MS"},
+		Rows:   [][]string{{"1000", "1.5"}, {"2000", "2.5"}, {"3000", "3.5"}},
+	}
+	right := &Table{
+		Header: []string{"KEYS", "AVG-VMRSS-MB"},
+		Rows:   [][]string{{"3000", "30"}, {"1000", "10"}},
+	}
+
+	inner, err := InnerJoin(left, right, "KEYS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Table{
+		Header: []string{"KEYS", "AVG-LATENCY-MS", "AVG-VMRSS-MB"},
+		Rows:   [][]string{{"1000", "1.5", "10"}, {"3000", "3.5", "30"}},
+	}
+	if !reflect.DeepEqual(inner, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, inner)
+	}
+
+	outer, err := LeftJoin(left, right, "KEYS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected.Rows = [][]string{{"1000", "1.5", "10"}, {"2000", "2.5", ""}, {"3000", "3.5", "30"}}
+	if !reflect.DeepEqual(outer, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, outer)
+	}
+
+	if _, err = InnerJoin(left, left, "KEYS"); err == nil {
+		t.Fatal("expected error for duplicate columns")
+	}
+}
+
+func TestFilterSortBy(t *testing.T) {
+	tb := &Table{
+		Header: []string{"KEYS", "AVG-LATENCY-MS"},
+		Rows:   [][]string{{"1000", "10.5"}, {"2000", "9.5"}, {"3000", "100"}, {"4000", "x"}},
+	}
+	ft := tb.Filter(func(r Row) bool {
+		v, err := r.Float64("AVG-LATENCY-MS")
+		return err == nil && v > 9
+	})
+	if err := ft.SortBy("AVG-LATENCY-MS", true); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := ft.Column("KEYS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(keys, []string{"3000", "1000", "2000"}) {
+		t.Fatalf("unexpected keys %q", keys)
+	}
+	if err = ft.SortBy("NOT-EXIST", false); err == nil {
+		t.Fatal("expected error for unknown column")
+	}
+}
+
+func TestCSV(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "table")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tb := &Table{Header: []string{"A", "B"}, Rows: [][]string{{"1", "2"}}}
+	fpath := filepath.Join(dir, "test.csv")
+	if err = tb.WriteCSV(fpath); err != nil {
+		t.Fatal(err)
+	}
+	rt, err := ReadCSV(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tb, rt) {
+		t.Fatalf("expected %+v, got %+v", tb, rt)
+	}
+
+	tb.Header = append(tb.Header, "AVG-LATENCY-MS")
+	tb.Rows[0] = append(tb.Rows[0], "1.5")
+	tb.Units = []string{"", "", "ms"}
+	if err = tb.WriteCSV(fpath); err != nil {
+		t.Fatal(err)
+	}
+	if rt, err = ReadCSV(fpath); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tb, rt) {
+		t.Fatalf("expected %+v, got %+v", tb, rt)
+	}
+	if u := rt.Unit("AVG-LATENCY-MS"); u != "ms" {
+		t.Fatalf("expected unit ms, got %q", u)
+	}
+	if p := UnitsPath(fpath); p != filepath.Join(dir, "test.units.csv") {
+		t.Fatalf("unexpected units path %q", p)
+	}
+}
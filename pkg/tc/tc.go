@@ -0,0 +1,47 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tc runs 'tc' commands to shape traffic on network interfaces.
+package tc
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/coreos/pkg/capnslog"
+)
+
+var plog = capnslog.NewPackageLogger("github.com/coreos/dbtester", "tc")
+
+// Run runs 'tc' with the arguments.
+func Run(args []string) error {
+	plog.Infof("tc %s", strings.Join(args, " "))
+	if out, err := exec.Command("tc", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("tc %s failed %v (%q)", strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// DeleteRootArgs returns the arguments to remove the root qdisc
+// of the network interface, with all rules under it.
+func DeleteRootArgs(dev string) []string {
+	return []string{"qdisc", "del", "dev", dev, "root"}
+}
+
+// Reset removes the rules left by previous runs on the network
+// interface, if any.
+func Reset(dev string) {
+	exec.Command("tc", DeleteRootArgs(dev)...).Run()
+}
@@ -0,0 +1,131 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"google.golang.org/grpc"
+)
+
+// ProfilePaths returns the paths to save CPU and heap profiles, and
+// 'perf script' output captured from the agent at index 'idx', 'at'
+// seconds after the stress starts (e.g. 'etcd-tip-go1.8.3-1-cpu-60s.pprof').
+func (cfg *Config) ProfilePaths(databaseID string, idx int, at int64) (cpuPath, heapPath, perfPath string) {
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	dir := filepath.Dir(cfg.ConfigClientMachineInitial.LogPath)
+	cpuPath = filepath.Join(dir, fmt.Sprintf("%s-%d-cpu-%ds.pprof", gcfg.DatabaseTag, idx+1, at))
+	heapPath = filepath.Join(dir, fmt.Sprintf("%s-%d-heap-%ds.pprof", gcfg.DatabaseTag, idx+1, at))
+	perfPath = filepath.Join(dir, fmt.Sprintf("%s-%d-perf-%ds.txt", gcfg.DatabaseTag, idx+1, at))
+	return cpuPath, heapPath, perfPath
+}
+
+// CaptureProfiles captures CPU and heap profiles from all agents at
+// 'at_seconds' in 'profile' configuration, until the context is canceled.
+// The returned channel is closed after all profiles are saved.
+func (cfg *Config) CaptureProfiles(ctx context.Context, databaseID string) (<-chan struct{}, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return nil, fmt.Errorf("database id %q does not exist", databaseID)
+	}
+	if gcfg.ConfigProfile == nil {
+		return nil, fmt.Errorf("profile is not defined for %q", databaseID)
+	}
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+	default:
+		if !gcfg.ConfigProfile.Perf {
+			return nil, fmt.Errorf("profile is not supported for %q (set 'perf' instead)", databaseID)
+		}
+	}
+	ats := append([]int64{}, gcfg.ConfigProfile.AtSeconds...)
+	sort.Slice(ats, func(i, j int) bool { return ats[i] < ats[j] })
+
+	donec := make(chan struct{})
+	go func() {
+		defer close(donec)
+
+		start := time.Now()
+		for _, at := range ats {
+			select {
+			case <-time.After(time.Until(start.Add(time.Duration(at) * time.Second))):
+			case <-ctx.Done():
+				return
+			}
+
+			var wg sync.WaitGroup
+			wg.Add(len(gcfg.AgentEndpoints))
+			for i := range gcfg.AgentEndpoints {
+				go func(i int) {
+					defer wg.Done()
+					if err := cfg.captureProfile(databaseID, i, at); err != nil {
+						plog.Warningf("failed to capture profiles (%v) [index: %d | at: %ds]", err, i, at)
+					}
+				}(i)
+			}
+			wg.Wait()
+		}
+	}()
+	return donec, nil
+}
+
+func (cfg *Config) captureProfile(databaseID string, idx int, at int64) error {
+	req, err := cfg.ToRequest(databaseID, dbtesterpb.Operation_Profile, idx)
+	if err != nil {
+		return err
+	}
+	ep := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].AgentEndpoints[idx]
+	conn, err := grpc.Dial(ep, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(req.ProfileSeconds)*time.Second+2*time.Minute)
+	defer cancel()
+	// 'perf script' output can exceed the default 4 MB
+	resp, err := dbtesterpb.NewTransporterClient(conn).Transfer(ctx, req, grpc.MaxCallRecvMsgSize(math.MaxInt32))
+	if err != nil {
+		return err
+	}
+
+	cpuPath, heapPath, perfPath := cfg.ProfilePaths(databaseID, idx, at)
+	for _, f := range []struct {
+		path string
+		data []byte
+	}{
+		{cpuPath, resp.CPUProfile},
+		{heapPath, resp.HeapProfile},
+		{perfPath, resp.PerfScript},
+	} {
+		if len(f.data) == 0 {
+			continue
+		}
+		if err = ioutil.WriteFile(f.path, f.data, 0644); err != nil {
+			return err
+		}
+		plog.Infof("profile saved at %q", f.path)
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provision
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// Command implements 'provision' command.
+var Command = &cobra.Command{
+	Use:   "provision",
+	Short: "Provisions machines and runs tests.",
+	RunE:  commandFunc,
+}
+
+var configPath string
+var keepMachines bool
+
+func init() {
+	Command.PersistentFlags().StringVarP(&configPath, "config", "c", "", "YAML provision configuration file path.")
+	Command.PersistentFlags().BoolVar(&keepMachines, "keep", false, "'true' to not delete machines after tests.")
+}
+
+func commandFunc(cmd *cobra.Command, args []string) error {
+	cfg, err := ReadConfig(configPath)
+	if err != nil {
+		return err
+	}
+	var servers, clients []machine
+	if cfg.InventoryPath != "" {
+		servers, clients, err = readInventory(cfg.InventoryPath)
+		if err != nil {
+			return err
+		}
+		if len(servers) == 0 || len(clients) == 0 {
+			return fmt.Errorf("%q needs at least 1 server and 1 client (got %d, %d)", cfg.InventoryPath, len(servers), len(clients))
+		}
+		plog.Infof("found %d servers and %d clients in %q", len(servers), len(clients), cfg.InventoryPath)
+	} else {
+		p, err := newProvider(cfg)
+		if err != nil {
+			return err
+		}
+		servers, err = p.create(cfg.ServerMachine, cfg.names("server", cfg.ServerMachine.Number))
+		if err != nil {
+			return err
+		}
+		defer cfg.teardown(p, cfg.ServerMachine, servers)
+
+		clients, err = p.create(cfg.ClientMachine, cfg.names("client", cfg.ClientMachine.Number))
+		if err != nil {
+			return err
+		}
+		defer cfg.teardown(p, cfg.ClientMachine, clients)
+	}
+
+	for _, m := range append(append([]machine{}, servers...), clients...) {
+		if err = cfg.waitSSH(m); err != nil {
+			return err
+		}
+	}
+	for _, m := range servers {
+		if err = cfg.install(m, cfg.ServerInstallScripts); err != nil {
+			return err
+		}
+		cmd := fmt.Sprintf("nohup dbtester agent --agent-port :%d > agent-stdout.log 2>&1 &", cfg.AgentPort)
+		if _, err = cfg.runSSH(m, cmd); err != nil {
+			return err
+		}
+		plog.Infof("started agent on %q (%s)", m.name, m.internalIP)
+	}
+	for _, m := range clients {
+		if err = cfg.install(m, cfg.ClientInstallScripts); err != nil {
+			return err
+		}
+	}
+
+	// all clients share the same servers, so tests run one at a time
+	idx := 0
+	for _, fpath := range cfg.TestConfigPaths {
+		ids, err := databaseIDs(fpath)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			client := clients[idx%len(clients)]
+			idx++
+			if err = cfg.runTest(client, servers, fpath, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, m := range clients {
+		dir := filepath.Join(cfg.ResultsDir, fmt.Sprintf("client-%d", i+1))
+		if err = os.MkdirAll(dir, 0777); err != nil {
+			return err
+		}
+		args := append(cfg.sshArgs(), "-r", cfg.sshTarget(m)+":results", dir)
+		if _, err = run("scp", args...); err != nil {
+			return err
+		}
+		plog.Infof("downloaded results from %q to %q", m.name, dir)
+	}
+	return nil
+}
+
+func (cfg *Config) names(role string, n int) []string {
+	names := make([]string, n)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s-%s-%d", cfg.NamePrefix, role, i+1)
+	}
+	return names
+}
+
+func (cfg *Config) teardown(p provider, spec MachineSpec, ms []machine) {
+	if keepMachines {
+		for _, m := range ms {
+			plog.Infof("keeping %q (external IP %s)", m.name, m.externalIP)
+		}
+		return
+	}
+	if err := p.delete(spec, ms); err != nil {
+		plog.Warningf("failed to delete machines (%v)", err)
+		return
+	}
+	plog.Infof("deleted %d machines", len(ms))
+}
+
+// install copies and runs the install scripts in the remote machine.
+func (cfg *Config) install(m machine, scripts []string) error {
+	for _, script := range scripts {
+		dst := filepath.Base(script)
+		if err := cfg.copyFile(m, script, dst); err != nil {
+			return err
+		}
+		if _, err := cfg.runSSH(m, "bash "+dst); err != nil {
+			return err
+		}
+		plog.Infof("ran %q on %q", script, m.name)
+	}
+	return nil
+}
+
+// runTest runs 'dbtester control' for the database in the client machine,
+// with the test configuration pointed at the provisioned servers.
+func (cfg *Config) runTest(client machine, servers []machine, fpath, databaseID string) error {
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return err
+	}
+	var doc yaml.MapSlice
+	if err = yaml.Unmarshal(bts, &doc); err != nil {
+		return err
+	}
+
+	testName := strings.TrimSuffix(filepath.Base(fpath), filepath.Ext(fpath))
+	resultDir := filepath.Join("results", testName, databaseID)
+
+	ips := make([]string, len(servers))
+	for i, m := range servers {
+		ips[i] = m.internalIP
+	}
+	initial, _ := getMapSlice(doc, "config_client_machine_initial")
+	initial = setMapSlice(initial, "path_prefix", resultDir)
+	doc = setMapSlice(doc, "config_client_machine_initial", initial)
+
+	groups, _ := getMapSlice(doc, "datatbase_id_to_config_client_machine_agent_control")
+	group, ok := getMapSlice(groups, databaseID)
+	if !ok {
+		return fmt.Errorf("%q has no configuration for %q", fpath, databaseID)
+	}
+	group = setMapSlice(group, "peer_ips", ips)
+	group = setMapSlice(group, "agent_port_to_connect", cfg.AgentPort)
+	groups = setMapSlice(groups, databaseID, group)
+	doc = setMapSlice(doc, "datatbase_id_to_config_client_machine_agent_control", groups)
+
+	bts, err = yaml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile("", "dbtester-provision")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp.Name())
+	if _, err = tmp.Write(bts); err != nil {
+		tmp.Close()
+		return err
+	}
+	tmp.Close()
+
+	remoteConfig := testName + ".yaml"
+	if err = cfg.copyFile(client, tmp.Name(), remoteConfig); err != nil {
+		return err
+	}
+	if _, err = cfg.runSSH(client, "mkdir -p "+resultDir); err != nil {
+		return err
+	}
+
+	plog.Infof("running %q with %q on %q", databaseID, fpath, client.name)
+	cmd := fmt.Sprintf("dbtester control --database-id %s --config %s > %s/control-stdout.log 2>&1", databaseID, remoteConfig, resultDir)
+	if _, err = cfg.runSSH(client, cmd); err != nil {
+		return err
+	}
+	plog.Infof("finished %q with %q", databaseID, fpath)
+	return nil
+}
+
+// databaseIDs returns 'all_database_id_list' in the test configuration.
+func databaseIDs(fpath string) ([]string, error) {
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	var doc struct {
+		AllDatabaseIDList []string `yaml:"all_database_id_list"`
+	}
+	if err = yaml.Unmarshal(bts, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.AllDatabaseIDList) == 0 {
+		return nil, fmt.Errorf("%q has no database to test", fpath)
+	}
+	return doc.AllDatabaseIDList, nil
+}
+
+func getMapSlice(ms yaml.MapSlice, key string) (yaml.MapSlice, bool) {
+	for _, item := range ms {
+		if item.Key == key {
+			v, ok := item.Value.(yaml.MapSlice)
+			return v, ok
+		}
+	}
+	return nil, false
+}
+
+func setMapSlice(ms yaml.MapSlice, key string, v interface{}) yaml.MapSlice {
+	for i := range ms {
+		if ms[i].Key == key {
+			ms[i].Value = v
+			return ms
+		}
+	}
+	return append(ms, yaml.MapItem{Key: key, Value: v})
+}
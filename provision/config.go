@@ -0,0 +1,121 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provision
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Config defines machines to provision and tests to run on them.
+type Config struct {
+	// Provider is either "gce" (Google Compute Engine) or "ec2" (Amazon EC2).
+	Provider   string `yaml:"provider"`
+	NamePrefix string `yaml:"name_prefix"`
+
+	// GCEProject is the Google Cloud project to create machines in.
+	GCEProject string `yaml:"gce_project"`
+	// EC2KeyName and EC2SecurityGroupID are required for Amazon EC2.
+	EC2KeyName         string `yaml:"ec2_key_name"`
+	EC2SecurityGroupID string `yaml:"ec2_security_group_id"`
+
+	// InventoryPath is the path to Terraform state (.tfstate) or
+	// Ansible inventory file. If not empty, machines are read from
+	// the inventory instead of being created, and are not deleted.
+	InventoryPath string `yaml:"inventory_path"`
+
+	ServerMachine MachineSpec `yaml:"server_machine"`
+	ClientMachine MachineSpec `yaml:"client_machine"`
+
+	SSHUser    string `yaml:"ssh_user"`
+	SSHKeyPath string `yaml:"ssh_key_path"`
+
+	// ServerInstallScripts are run on all server machines,
+	// in order, before starting agents.
+	ServerInstallScripts []string `yaml:"server_install_scripts"`
+	// ClientInstallScripts are run on all client machines,
+	// in order, before running tests.
+	ClientInstallScripts []string `yaml:"client_install_scripts"`
+
+	AgentPort int64 `yaml:"agent_port"`
+
+	// ResultsDir is the local directory to download
+	// test results from client machines.
+	ResultsDir string `yaml:"results_dir"`
+
+	// TestConfigPaths is the test matrix; every database in
+	// each dbtester configuration file is tested one by one.
+	TestConfigPaths []string `yaml:"test_config_paths"`
+}
+
+// MachineSpec defines a group of machines of the same type.
+type MachineSpec struct {
+	Number      int    `yaml:"number"`
+	Zone        string `yaml:"zone"`
+	MachineType string `yaml:"machine_type"`
+	DiskType    string `yaml:"disk_type"`
+	DiskSizeGB  int64  `yaml:"disk_size_gb"`
+
+	// Image is the image family in Google Compute Engine,
+	// or the AMI ID in Amazon EC2.
+	Image        string `yaml:"image"`
+	ImageProject string `yaml:"image_project"`
+}
+
+// ReadConfig reads provision configuration file.
+func ReadConfig(fpath string) (*Config, error) {
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	cfg := Config{}
+	if err = yaml.Unmarshal(bts, &cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.InventoryPath == "" {
+		switch cfg.Provider {
+		case "gce":
+			if cfg.GCEProject == "" {
+				return nil, fmt.Errorf("'gce_project' is required for %q", cfg.Provider)
+			}
+		case "ec2":
+			if cfg.EC2KeyName == "" {
+				return nil, fmt.Errorf("'ec2_key_name' is required for %q", cfg.Provider)
+			}
+		default:
+			return nil, fmt.Errorf("unknown provider %q", cfg.Provider)
+		}
+		if cfg.ServerMachine.Number == 0 || cfg.ClientMachine.Number == 0 {
+			return nil, fmt.Errorf("need at least 1 server and 1 client (got %d, %d)", cfg.ServerMachine.Number, cfg.ClientMachine.Number)
+		}
+	}
+	if len(cfg.TestConfigPaths) == 0 {
+		return nil, fmt.Errorf("no test configuration is given")
+	}
+
+	if cfg.NamePrefix == "" {
+		cfg.NamePrefix = "dbtester"
+	}
+	if cfg.ResultsDir == "" {
+		cfg.ResultsDir = "provision-results"
+	}
+	if cfg.AgentPort == 0 {
+		cfg.AgentPort = 3500
+	}
+	return &cfg, nil
+}
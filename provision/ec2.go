@@ -0,0 +1,144 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provision
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ec2 provisions machines in Amazon EC2, using 'aws'.
+type ec2 struct {
+	keyName         string
+	securityGroupID string
+}
+
+func (e *ec2) create(spec MachineSpec, names []string) ([]machine, error) {
+	out, err := run("aws", e.runInstancesArgs(spec, len(names))...)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseRunInstances(out)
+	if err != nil {
+		return nil, err
+	}
+
+	// terminate the instances already running, if later steps fail
+	ms, err := e.setup(ids, names)
+	if err != nil {
+		plog.Warningf("failed to set up %s (%v)", strings.Join(ids, ", "), err)
+		created := make([]machine, len(ids))
+		for i, id := range ids {
+			created[i] = machine{name: id}
+		}
+		if derr := e.delete(spec, created); derr != nil {
+			plog.Warningf("failed to terminate %s (%v)", strings.Join(ids, ", "), derr)
+		}
+		return nil, err
+	}
+	return ms, nil
+}
+
+// setup tags the created instances with the names, and
+// returns the machines after all instances are running.
+func (e *ec2) setup(ids, names []string) ([]machine, error) {
+	if len(ids) != len(names) {
+		return nil, fmt.Errorf("expected %d instances, got %v", len(names), ids)
+	}
+	for i, id := range ids {
+		if _, err := run("aws", "ec2", "create-tags", "--resources", id, "--tags", "Key=Name,Value="+names[i]); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := run("aws", append([]string{"ec2", "wait", "instance-running", "--instance-ids"}, ids...)...); err != nil {
+		return nil, err
+	}
+	out, err := run("aws", append([]string{"ec2", "describe-instances", "--output", "json", "--instance-ids"}, ids...)...)
+	if err != nil {
+		return nil, err
+	}
+	return parseDescribeInstances(out)
+}
+
+func (e *ec2) runInstancesArgs(spec MachineSpec, n int) []string {
+	args := []string{"ec2", "run-instances",
+		"--image-id", spec.Image,
+		"--count", fmt.Sprintf("%d", n),
+		"--instance-type", spec.MachineType,
+		"--key-name", e.keyName,
+		"--placement", "AvailabilityZone=" + spec.Zone,
+		"--block-device-mappings", fmt.Sprintf("DeviceName=/dev/sda1,Ebs={VolumeSize=%d,VolumeType=%s}", spec.DiskSizeGB, spec.DiskType),
+		"--output", "json",
+	}
+	if e.securityGroupID != "" {
+		args = append(args, "--security-group-ids", e.securityGroupID)
+	}
+	return args
+}
+
+// parseRunInstances returns the instance IDs in 'aws ec2 run-instances' output.
+func parseRunInstances(out []byte) ([]string, error) {
+	var created struct {
+		Instances []struct {
+			InstanceID string `json:"InstanceId"`
+		} `json:"Instances"`
+	}
+	if err := json.Unmarshal(out, &created); err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(created.Instances))
+	for i, inst := range created.Instances {
+		ids[i] = inst.InstanceID
+	}
+	return ids, nil
+}
+
+// parseDescribeInstances returns the machines in 'aws ec2 describe-instances' output.
+func parseDescribeInstances(out []byte) ([]machine, error) {
+	var described struct {
+		Reservations []struct {
+			Instances []struct {
+				InstanceID       string `json:"InstanceId"`
+				PrivateIPAddress string `json:"PrivateIpAddress"`
+				PublicIPAddress  string `json:"PublicIpAddress"`
+			} `json:"Instances"`
+		} `json:"Reservations"`
+	}
+	if err := json.Unmarshal(out, &described); err != nil {
+		return nil, err
+	}
+	var ms []machine
+	for _, r := range described.Reservations {
+		for _, inst := range r.Instances {
+			ms = append(ms, machine{
+				name:       inst.InstanceID,
+				internalIP: inst.PrivateIPAddress,
+				externalIP: inst.PublicIPAddress,
+			})
+		}
+	}
+	return ms, nil
+}
+
+func (e *ec2) delete(spec MachineSpec, ms []machine) error {
+	ids := make([]string, len(ms))
+	for i, m := range ms {
+		ids[i] = m.name
+	}
+	plog.Infof("terminating %s", strings.Join(ids, ", "))
+	_, err := run("aws", append([]string{"ec2", "terminate-instances", "--instance-ids"}, ids...)...)
+	return err
+}
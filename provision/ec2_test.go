@@ -0,0 +1,76 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provision
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEC2RunInstancesArgs(t *testing.T) {
+	spec := MachineSpec{Zone: "us-west-2a", MachineType: "m4.2xlarge", DiskType: "gp2", DiskSizeGB: 150, Image: "ami-1"}
+	e := &ec2{keyName: "dbtester", securityGroupID: "sg-1"}
+	exp := []string{"ec2", "run-instances",
+		"--image-id", "ami-1",
+		"--count", "3",
+		"--instance-type", "m4.2xlarge",
+		"--key-name", "dbtester",
+		"--placement", "AvailabilityZone=us-west-2a",
+		"--block-device-mappings", "DeviceName=/dev/sda1,Ebs={VolumeSize=150,VolumeType=gp2}",
+		"--output", "json",
+		"--security-group-ids", "sg-1",
+	}
+	if args := e.runInstancesArgs(spec, 3); !reflect.DeepEqual(args, exp) {
+		t.Fatalf("expected %q, got %q", exp, args)
+	}
+
+	e.securityGroupID = ""
+	if args := e.runInstancesArgs(spec, 3); !reflect.DeepEqual(args, exp[:len(exp)-2]) {
+		t.Fatalf("expected %q, got %q", exp[:len(exp)-2], args)
+	}
+}
+
+func TestParseRunInstances(t *testing.T) {
+	out := `{"Instances": [{"InstanceId": "i-1", "State": {"Name": "pending"}}, {"InstanceId": "i-2"}], "ReservationId": "r-1"}`
+	ids, err := parseRunInstances([]byte(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := []string{"i-1", "i-2"}; !reflect.DeepEqual(ids, exp) {
+		t.Fatalf("expected %q, got %q", exp, ids)
+	}
+
+	if _, err = parseRunInstances([]byte("An error occurred")); err == nil {
+		t.Fatal("expected error for non-JSON output")
+	}
+}
+
+func TestParseDescribeInstances(t *testing.T) {
+	out := `{"Reservations": [
+  {"Instances": [{"InstanceId": "i-1", "PrivateIpAddress": "10.0.0.1", "PublicIpAddress": "1.1.1.1"}]},
+  {"Instances": [{"InstanceId": "i-2", "PrivateIpAddress": "10.0.0.2", "PublicIpAddress": "1.1.1.2"}]}
+]}`
+	ms, err := parseDescribeInstances([]byte(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []machine{
+		{name: "i-1", internalIP: "10.0.0.1", externalIP: "1.1.1.1"},
+		{name: "i-2", internalIP: "10.0.0.2", externalIP: "1.1.1.2"},
+	}
+	if !reflect.DeepEqual(ms, exp) {
+		t.Fatalf("expected %+v, got %+v", exp, ms)
+	}
+}
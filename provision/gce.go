@@ -0,0 +1,101 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provision
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// gce provisions machines in Google Compute Engine, using 'gcloud'.
+type gce struct {
+	project string
+}
+
+func (g *gce) create(spec MachineSpec, names []string) ([]machine, error) {
+	out, err := run("gcloud", g.createArgs(spec, names)...)
+	if err == nil {
+		var ms []machine
+		if ms, err = parseInstances(out); err == nil {
+			return ms, nil
+		}
+	}
+
+	// 'gcloud compute instances create' may fail after creating some of
+	// the instances, so delete the ones already created, by name
+	plog.Warningf("failed to create %s (%v)", strings.Join(names, ", "), err)
+	created := make([]machine, len(names))
+	for i, name := range names {
+		created[i] = machine{name: name}
+	}
+	if derr := g.delete(spec, created); derr != nil {
+		plog.Warningf("failed to delete %s (%v)", strings.Join(names, ", "), derr)
+	}
+	return nil, err
+}
+
+func (g *gce) createArgs(spec MachineSpec, names []string) []string {
+	args := append([]string{"compute", "instances", "create"}, names...)
+	return append(args,
+		"--project", g.project,
+		"--zone", spec.Zone,
+		"--machine-type", spec.MachineType,
+		"--boot-disk-type", spec.DiskType,
+		"--boot-disk-size", fmt.Sprintf("%dGB", spec.DiskSizeGB),
+		"--image-family", spec.Image,
+		"--image-project", spec.ImageProject,
+		"--format", "json",
+	)
+}
+
+// parseInstances returns the machines in 'gcloud compute instances create' output.
+func parseInstances(out []byte) ([]machine, error) {
+	var instances []struct {
+		Name              string `json:"name"`
+		NetworkInterfaces []struct {
+			NetworkIP     string `json:"networkIP"`
+			AccessConfigs []struct {
+				NatIP string `json:"natIP"`
+			} `json:"accessConfigs"`
+		} `json:"networkInterfaces"`
+	}
+	if err := json.Unmarshal(out, &instances); err != nil {
+		return nil, err
+	}
+
+	ms := make([]machine, 0, len(instances))
+	for _, inst := range instances {
+		if len(inst.NetworkInterfaces) == 0 || len(inst.NetworkInterfaces[0].AccessConfigs) == 0 {
+			return nil, fmt.Errorf("%q has no external IP", inst.Name)
+		}
+		ms = append(ms, machine{
+			name:       inst.Name,
+			internalIP: inst.NetworkInterfaces[0].NetworkIP,
+			externalIP: inst.NetworkInterfaces[0].AccessConfigs[0].NatIP,
+		})
+	}
+	return ms, nil
+}
+
+func (g *gce) delete(spec MachineSpec, ms []machine) error {
+	args := []string{"compute", "instances", "delete"}
+	for _, m := range ms {
+		args = append(args, m.name)
+	}
+	args = append(args, "--project", g.project, "--zone", spec.Zone, "--quiet")
+	_, err := run("gcloud", args...)
+	return err
+}
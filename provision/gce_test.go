@@ -0,0 +1,61 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provision
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGCECreateArgs(t *testing.T) {
+	spec := MachineSpec{Zone: "us-central1-a", MachineType: "n1-standard-8", DiskType: "pd-ssd", DiskSizeGB: 150, Image: "ubuntu-1604-lts", ImageProject: "ubuntu-os-cloud"}
+	g := &gce{project: "etcd-development"}
+	exp := []string{"compute", "instances", "create", "s-1", "s-2",
+		"--project", "etcd-development",
+		"--zone", "us-central1-a",
+		"--machine-type", "n1-standard-8",
+		"--boot-disk-type", "pd-ssd",
+		"--boot-disk-size", "150GB",
+		"--image-family", "ubuntu-1604-lts",
+		"--image-project", "ubuntu-os-cloud",
+		"--format", "json",
+	}
+	if args := g.createArgs(spec, []string{"s-1", "s-2"}); !reflect.DeepEqual(args, exp) {
+		t.Fatalf("expected %q, got %q", exp, args)
+	}
+}
+
+func TestParseInstances(t *testing.T) {
+	out := `[
+  {"name": "s-1", "networkInterfaces": [{"networkIP": "10.240.0.1", "accessConfigs": [{"natIP": "1.1.1.1"}]}]},
+  {"name": "s-2", "networkInterfaces": [{"networkIP": "10.240.0.2", "accessConfigs": [{"natIP": "1.1.1.2"}]}]}
+]`
+	ms, err := parseInstances([]byte(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []machine{
+		{name: "s-1", internalIP: "10.240.0.1", externalIP: "1.1.1.1"},
+		{name: "s-2", internalIP: "10.240.0.2", externalIP: "1.1.1.2"},
+	}
+	if !reflect.DeepEqual(ms, exp) {
+		t.Fatalf("expected %+v, got %+v", exp, ms)
+	}
+
+	out = `[{"name": "s-1", "networkInterfaces": [{"networkIP": "10.240.0.1"}]}]`
+	if _, err = parseInstances([]byte(out)); err == nil {
+		t.Fatal("expected error for instance without external IP")
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provision
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// readInventory reads server and client machines from Terraform state
+// or Ansible inventory (INI or YAML). Roles are decided by the 'role'
+// label (GCE) or tag (EC2) in Terraform, and by the group name in Ansible.
+// Otherwise, any name containing "server" or "client" decides the role.
+func readInventory(fpath string) (servers, clients []machine, err error) {
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles map[string][]machine
+	switch {
+	case filepath.Ext(fpath) == ".tfstate" || bytes.HasPrefix(bytes.TrimSpace(bts), []byte("{")):
+		roles, err = parseTerraformState(bts)
+	case filepath.Ext(fpath) == ".yml" || filepath.Ext(fpath) == ".yaml":
+		roles, err = parseAnsibleYAML(bts)
+	default:
+		roles, err = parseAnsibleINI(bts)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return roles["server"], roles["client"], nil
+}
+
+// roleOf returns "server" or "client" for the first name that
+// contains either, or an empty string if none matches.
+func roleOf(names ...string) string {
+	for _, name := range names {
+		name = strings.ToLower(name)
+		switch {
+		case strings.Contains(name, "server"):
+			return "server"
+		case strings.Contains(name, "client"):
+			return "client"
+		}
+	}
+	return ""
+}
+
+func addMachine(roles map[string][]machine, role string, m machine) {
+	if role == "" {
+		plog.Warningf("skipping %q with unknown role", m.name)
+		return
+	}
+	for _, v := range roles[role] {
+		if v.name == m.name {
+			return
+		}
+	}
+	if m.internalIP == "" {
+		m.internalIP = m.externalIP
+	}
+	if m.externalIP == "" {
+		m.externalIP = m.internalIP
+	}
+	roles[role] = append(roles[role], m)
+}
+
+func parseTerraformState(bts []byte) (map[string][]machine, error) {
+	var state struct {
+		Version int `json:"version"`
+
+		// version 4 and later
+		Resources []struct {
+			Type      string `json:"type"`
+			Name      string `json:"name"`
+			Instances []struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+
+		// version 3 and earlier
+		Modules []struct {
+			Resources map[string]struct {
+				Type    string `json:"type"`
+				Primary struct {
+					ID         string            `json:"id"`
+					Attributes map[string]string `json:"attributes"`
+				} `json:"primary"`
+			} `json:"resources"`
+		} `json:"modules"`
+	}
+	if err := json.Unmarshal(bts, &state); err != nil {
+		return nil, err
+	}
+
+	roles := make(map[string][]machine)
+	for _, rs := range state.Resources {
+		for _, inst := range rs.Instances {
+			attrs := make(map[string]string)
+			flatten(attrs, "", inst.Attributes)
+			if m, role, ok := terraformMachine(rs.Type, attrs); ok {
+				addMachine(roles, roleOf(role, rs.Name, m.name), m)
+			}
+		}
+	}
+	for _, mod := range state.Modules {
+		keys := make([]string, 0, len(mod.Resources))
+		for k := range mod.Resources {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			rs := mod.Resources[k]
+			attrs := rs.Primary.Attributes
+			if attrs == nil {
+				attrs = make(map[string]string)
+			}
+			if attrs["id"] == "" {
+				attrs["id"] = rs.Primary.ID
+			}
+			if m, role, ok := terraformMachine(rs.Type, attrs); ok {
+				addMachine(roles, roleOf(role, k, m.name), m)
+			}
+		}
+	}
+	return roles, nil
+}
+
+// terraformMachine returns the machine and its role label, from
+// the flattened attributes (e.g. "network_interface.0.network_ip").
+func terraformMachine(tp string, attrs map[string]string) (machine, string, bool) {
+	switch tp {
+	case "google_compute_instance":
+		return machine{
+			name:       attrs["name"],
+			internalIP: attrs["network_interface.0.network_ip"],
+			externalIP: attrs["network_interface.0.access_config.0.nat_ip"],
+		}, attrs["labels.role"], true
+
+	case "aws_instance":
+		role := attrs["tags.role"]
+		if role == "" {
+			role = attrs["tags.Role"]
+		}
+		return machine{
+			name:       attrs["id"],
+			internalIP: attrs["private_ip"],
+			externalIP: attrs["public_ip"],
+		}, role, true
+	}
+	return machine{}, "", false
+}
+
+// flatten flattens nested JSON values into dot-separated keys,
+// in the same way as Terraform state version 3.
+func flatten(attrs map[string]string, prefix string, v interface{}) {
+	key := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + "." + k
+	}
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		for k, vv := range tv {
+			flatten(attrs, key(k), vv)
+		}
+	case []interface{}:
+		for i, vv := range tv {
+			flatten(attrs, key(fmt.Sprint(i)), vv)
+		}
+	case nil:
+	default:
+		attrs[prefix] = fmt.Sprint(tv)
+	}
+}
+
+// parseAnsibleINI parses Ansible INI inventory, where each host line
+// is "name ansible_host=EXTERNAL_IP internal_ip=INTERNAL_IP".
+func parseAnsibleINI(bts []byte) (map[string][]machine, error) {
+	roles := make(map[string][]machine)
+	group := ""
+	scanner := bufio.NewScanner(bytes.NewReader(bts))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			group = strings.Trim(line, "[]")
+			continue
+		}
+		if strings.Contains(group, ":") {
+			// skip ':vars' and ':children' sections
+			continue
+		}
+
+		fields := strings.Fields(line)
+		vars := make(map[string]string)
+		for _, f := range fields[1:] {
+			if kv := strings.SplitN(f, "=", 2); len(kv) == 2 {
+				vars[kv[0]] = kv[1]
+			}
+		}
+		addMachine(roles, roleOf(group, fields[0]), ansibleMachine(fields[0], vars))
+	}
+	return roles, scanner.Err()
+}
+
+type ansibleGroup struct {
+	Hosts    map[string]map[string]interface{} `yaml:"hosts"`
+	Children map[string]ansibleGroup           `yaml:"children"`
+}
+
+// parseAnsibleYAML parses Ansible YAML inventory.
+func parseAnsibleYAML(bts []byte) (map[string][]machine, error) {
+	var groups map[string]ansibleGroup
+	if err := yaml.Unmarshal(bts, &groups); err != nil {
+		return nil, err
+	}
+	roles := make(map[string][]machine)
+	var walk func(name string, g ansibleGroup)
+	walk = func(name string, g ansibleGroup) {
+		hosts := make([]string, 0, len(g.Hosts))
+		for h := range g.Hosts {
+			hosts = append(hosts, h)
+		}
+		sort.Strings(hosts)
+		for _, h := range hosts {
+			vars := make(map[string]string)
+			for k, v := range g.Hosts[h] {
+				vars[k] = fmt.Sprint(v)
+			}
+			addMachine(roles, roleOf(name, h), ansibleMachine(h, vars))
+		}
+		for _, child := range sortedGroupNames(g.Children) {
+			walk(child, g.Children[child])
+		}
+	}
+	for _, name := range sortedGroupNames(groups) {
+		walk(name, groups[name])
+	}
+	return roles, nil
+}
+
+func sortedGroupNames(groups map[string]ansibleGroup) []string {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func ansibleMachine(name string, vars map[string]string) machine {
+	m := machine{name: name, externalIP: vars["ansible_host"]}
+	if m.externalIP == "" {
+		m.externalIP = name
+	}
+	m.internalIP = vars["internal_ip"]
+	if m.internalIP == "" {
+		m.internalIP = vars["private_ip"]
+	}
+	return m
+}
@@ -0,0 +1,138 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provision
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTerraformState(t *testing.T) {
+	v3 := `{
+  "version": 3,
+  "modules": [{
+    "resources": {
+      "aws_instance.server.0": {
+        "type": "aws_instance",
+        "primary": {"id": "i-1", "attributes": {"private_ip": "10.0.0.1", "public_ip": "1.1.1.1"}}
+      },
+      "aws_instance.load.0": {
+        "type": "aws_instance",
+        "primary": {"id": "i-2", "attributes": {"private_ip": "10.0.0.2", "public_ip": "1.1.1.2", "tags.Role": "client"}}
+      },
+      "aws_security_group.default": {"type": "aws_security_group", "primary": {"id": "sg-1"}}
+    }
+  }]
+}`
+	v4 := `{
+  "version": 4,
+  "resources": [{
+    "type": "google_compute_instance",
+    "name": "db",
+    "instances": [{"attributes": {
+      "name": "db-1",
+      "labels": {"role": "server"},
+      "network_interface": [{"network_ip": "10.240.0.7", "access_config": [{"nat_ip": "35.1.1.1"}]}]
+    }}]
+  }, {
+    "type": "google_compute_instance",
+    "name": "tester-client",
+    "instances": [{"attributes": {
+      "name": "tester-1",
+      "network_interface": [{"network_ip": "10.240.0.8", "access_config": []}]
+    }}]
+  }]
+}`
+	tests := []struct {
+		state   string
+		servers []machine
+		clients []machine
+	}{
+		{
+			v3,
+			[]machine{{name: "i-1", internalIP: "10.0.0.1", externalIP: "1.1.1.1"}},
+			[]machine{{name: "i-2", internalIP: "10.0.0.2", externalIP: "1.1.1.2"}},
+		},
+		{
+			v4,
+			[]machine{{name: "db-1", internalIP: "10.240.0.7", externalIP: "35.1.1.1"}},
+			[]machine{{name: "tester-1", internalIP: "10.240.0.8", externalIP: "10.240.0.8"}},
+		},
+	}
+	for i, tt := range tests {
+		roles, err := parseTerraformState([]byte(tt.state))
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if !reflect.DeepEqual(roles["server"], tt.servers) {
+			t.Fatalf("#%d: servers expected %+v, got %+v", i, tt.servers, roles["server"])
+		}
+		if !reflect.DeepEqual(roles["client"], tt.clients) {
+			t.Fatalf("#%d: clients expected %+v, got %+v", i, tt.clients, roles["client"])
+		}
+	}
+}
+
+func TestParseAnsible(t *testing.T) {
+	ini := `
+# dbtester machines
+[servers]
+db-1 ansible_host=35.1.1.1 internal_ip=10.240.0.7
+db-2 ansible_host=35.1.1.2 internal_ip=10.240.0.8
+
+[clients]
+10.240.0.9
+
+[servers:vars]
+ansible_user=gyuho
+`
+	yml := `
+all:
+  children:
+    servers:
+      hosts:
+        db-1:
+          ansible_host: 35.1.1.1
+          internal_ip: 10.240.0.7
+        db-2:
+          ansible_host: 35.1.1.2
+          internal_ip: 10.240.0.8
+    clients:
+      hosts:
+        10.240.0.9:
+`
+	servers := []machine{
+		{name: "db-1", internalIP: "10.240.0.7", externalIP: "35.1.1.1"},
+		{name: "db-2", internalIP: "10.240.0.8", externalIP: "35.1.1.2"},
+	}
+	clients := []machine{{name: "10.240.0.9", internalIP: "10.240.0.9", externalIP: "10.240.0.9"}}
+
+	for i, parse := range []func([]byte) (map[string][]machine, error){parseAnsibleINI, parseAnsibleYAML} {
+		inv := ini
+		if i == 1 {
+			inv = yml
+		}
+		roles, err := parse([]byte(inv))
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if !reflect.DeepEqual(roles["server"], servers) {
+			t.Fatalf("#%d: servers expected %+v, got %+v", i, servers, roles["server"])
+		}
+		if !reflect.DeepEqual(roles["client"], clients) {
+			t.Fatalf("#%d: clients expected %+v, got %+v", i, clients, roles["client"])
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provision
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// machine is a provisioned cloud machine.
+type machine struct {
+	// name is the machine name in GCE, or instance ID in EC2.
+	name       string
+	internalIP string
+	externalIP string
+}
+
+// provider creates and deletes machines.
+type provider interface {
+	// create creates machines with the names, and
+	// returns after all machines are running.
+	create(spec MachineSpec, names []string) ([]machine, error)
+	// delete deletes the machines.
+	delete(spec MachineSpec, ms []machine) error
+}
+
+func newProvider(cfg *Config) (provider, error) {
+	switch cfg.Provider {
+	case "gce":
+		return &gce{project: cfg.GCEProject}, nil
+	case "ec2":
+		return &ec2{keyName: cfg.EC2KeyName, securityGroupID: cfg.EC2SecurityGroupID}, nil
+	default:
+		return nil, fmt.Errorf("unknown provider %q", cfg.Provider)
+	}
+}
+
+// run runs the command and returns its standard output.
+func run(name string, args ...string) ([]byte, error) {
+	plog.Infof("running %s %s", name, strings.Join(args, " "))
+
+	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s failed with %v (%s)", name, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provision
+
+import (
+	"fmt"
+	"time"
+)
+
+func (cfg *Config) sshArgs() []string {
+	args := []string{
+		"-o", "StrictHostKeyChecking=no",
+		"-o", "UserKnownHostsFile=/dev/null",
+		"-o", "ConnectTimeout=10",
+	}
+	if cfg.SSHKeyPath != "" {
+		args = append(args, "-i", cfg.SSHKeyPath)
+	}
+	return args
+}
+
+func (cfg *Config) sshTarget(m machine) string {
+	if cfg.SSHUser == "" {
+		return m.externalIP
+	}
+	return cfg.SSHUser + "@" + m.externalIP
+}
+
+// runSSH runs the command in the remote machine.
+func (cfg *Config) runSSH(m machine, command string) ([]byte, error) {
+	args := append(cfg.sshArgs(), cfg.sshTarget(m), command)
+	return run("ssh", args...)
+}
+
+// copyFile copies the local file to the remote machine.
+func (cfg *Config) copyFile(m machine, src, dst string) error {
+	args := append(cfg.sshArgs(), src, cfg.sshTarget(m)+":"+dst)
+	_, err := run("scp", args...)
+	return err
+}
+
+// waitSSH waits until the remote machine accepts SSH connections.
+func (cfg *Config) waitSSH(m machine) error {
+	var err error
+	for i := 0; i < 30; i++ {
+		if _, err = cfg.runSSH(m, "true"); err == nil {
+			return nil
+		}
+		plog.Warningf("%q is not ready (%v)", m.name, err)
+		time.Sleep(10 * time.Second)
+	}
+	return fmt.Errorf("%q did not become reachable (%v)", m.name, err)
+}
@@ -0,0 +1,187 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+)
+
+// DefaultRawResultPatterns are the file name patterns of raw data in
+// results: per-request latencies and logs, histogram logs, system metrics
+// before interpolation, database and agent logs, and profiles.
+// Summaries, percentiles, timeseries, interpolated system metrics,
+// run metadata, and aggregated CSVs are never pruned.
+var DefaultRawResultPatterns = []string{
+	"*" + defaultClientLatencyDistributionAllName,
+	"*client-request-log.csv",
+	"*.hlog",
+	"*client-system-metrics.csv",
+	"*server-system-metrics.csv",
+	"*.log",
+	"*.pprof",
+	"*-perf-*s.txt",
+}
+
+// PrunePolicy represents which raw result files to prune.
+type PrunePolicy struct {
+	// MaxAge is the age of raw files to prune,
+	// by the modification time of each file.
+	MaxAge time.Duration
+	// Patterns are the file name patterns of raw files,
+	// DefaultRawResultPatterns if empty.
+	Patterns []string
+	// ArchiveDir, if not empty, keeps gzipped copies of pruned files
+	// in the same relative paths under the directory.
+	ArchiveDir string
+	// DryRun, if true, only returns the files to prune.
+	DryRun bool
+}
+
+// PrunedFile represents a pruned raw result file.
+type PrunedFile struct {
+	Path    string
+	Size    int64
+	ModTime time.Time
+}
+
+// PruneResults deletes raw result files under the root directory,
+// modified before 'now - MaxAge', and returns them sorted by path.
+func PruneResults(root string, p PrunePolicy, now time.Time) ([]PrunedFile, error) {
+	if p.MaxAge <= 0 {
+		return nil, fmt.Errorf("max age must be positive (got %v)", p.MaxAge)
+	}
+	patterns := p.Patterns
+	if len(patterns) == 0 {
+		patterns = DefaultRawResultPatterns
+	}
+	for _, pattern := range patterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q (%v)", pattern, err)
+		}
+	}
+	archiveDir := ""
+	if p.ArchiveDir != "" {
+		var err error
+		if archiveDir, err = filepath.Abs(p.ArchiveDir); err != nil {
+			return nil, err
+		}
+	}
+
+	var pruned []PrunedFile
+	err := filepath.Walk(root, func(fpath string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			// do not prune the archives if under the root directory
+			if abs, aerr := filepath.Abs(fpath); aerr == nil && abs == archiveDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !fi.Mode().IsRegular() || now.Sub(fi.ModTime()) < p.MaxAge || !matchAny(patterns, fi.Name()) {
+			return nil
+		}
+		pruned = append(pruned, PrunedFile{Path: fpath, Size: fi.Size(), ModTime: fi.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pruned, func(i, j int) bool { return pruned[i].Path < pruned[j].Path })
+	if p.DryRun {
+		return pruned, nil
+	}
+
+	for _, f := range pruned {
+		if archiveDir != "" {
+			rel, err := filepath.Rel(root, f.Path)
+			if err != nil {
+				return nil, err
+			}
+			if err = gzipFile(f.Path, filepath.Join(archiveDir, rel+".gz"), f.ModTime); err != nil {
+				return nil, err
+			}
+		}
+		if err := os.Remove(f.Path); err != nil {
+			return nil, err
+		}
+	}
+	return pruned, nil
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// gzipFile compresses the file into 'dst', with the same modification time.
+func gzipFile(src, dst string, modTime time.Time) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0777); err != nil {
+		return err
+	}
+	sf, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer sf.Close()
+
+	tmp := dst + ".tmp"
+	df, err := os.OpenFile(tmp, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0777)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(df)
+	zw.Name = filepath.Base(src)
+	zw.ModTime = modTime
+	if _, err = io.Copy(zw, sf); err == nil {
+		err = zw.Close()
+	}
+	if err == nil {
+		err = df.Sync()
+	}
+	if cerr := df.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err = os.Rename(tmp, dst); err != nil {
+		return err
+	}
+	return os.Chtimes(dst, modTime, modTime)
+}
+
+// PruneSummary returns the number of files and total bytes of pruned files.
+func PruneSummary(pruned []PrunedFile) string {
+	var size int64
+	for _, f := range pruned {
+		size += f.Size
+	}
+	return fmt.Sprintf("%d file(s), %s", len(pruned), humanize.Bytes(uint64(size)))
+}
@@ -0,0 +1,87 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prune
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/coreos/dbtester"
+
+	"github.com/spf13/cobra"
+)
+
+// Command implements 'prune' command.
+var Command = &cobra.Command{
+	Use:   "prune",
+	Short: "Deletes or archives raw data of old runs in results, keeping summaries and aggregated results.",
+	RunE:  commandFunc,
+}
+
+var resultsRoot string
+var maxAgeDays int
+var patterns []string
+var archiveDir string
+var dryRun bool
+var interval time.Duration
+
+func init() {
+	Command.PersistentFlags().StringVar(&resultsRoot, "results-root", "", "Root directory of results to prune.")
+	Command.PersistentFlags().IntVar(&maxAgeDays, "max-age-days", 30, "Prune raw files modified more than this many days ago.")
+	Command.PersistentFlags().StringSliceVar(&patterns, "raw-pattern", dbtester.DefaultRawResultPatterns, "File name patterns of raw files to prune.")
+	Command.PersistentFlags().StringVar(&archiveDir, "archive-dir", "", "Directory to keep gzipped copies of pruned files (empty to delete).")
+	Command.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "'true' to only print files to prune.")
+	Command.PersistentFlags().DurationVar(&interval, "interval", 0, "Interval to keep pruning as a daemon (e.g. 24h; 0 to prune once and exit).")
+}
+
+func commandFunc(cmd *cobra.Command, args []string) error {
+	if resultsRoot == "" {
+		return fmt.Errorf("'--results-root' is required")
+	}
+	if maxAgeDays <= 0 {
+		return fmt.Errorf("'--max-age-days' must be positive (got %d)", maxAgeDays)
+	}
+	policy := dbtester.PrunePolicy{
+		MaxAge:     time.Duration(maxAgeDays) * 24 * time.Hour,
+		Patterns:   patterns,
+		ArchiveDir: archiveDir,
+		DryRun:     dryRun,
+	}
+
+	for {
+		pruned, err := dbtester.PruneResults(resultsRoot, policy, time.Now())
+		if err != nil {
+			if interval == 0 {
+				return err
+			}
+			// keep running as a daemon, and retry next time
+			plog.Warningf("failed to prune %q (%v)", resultsRoot, err)
+		}
+		verb := "pruned"
+		if dryRun {
+			verb = "would prune"
+		}
+		for _, f := range pruned {
+			plog.Infof("%s %q (%d bytes, modified at %s)", verb, f.Path, f.Size, f.ModTime.Format(time.RFC3339))
+		}
+		plog.Infof("%s %s in %q", verb, dbtester.PruneSummary(pruned), resultsRoot)
+
+		if interval == 0 {
+			return nil
+		}
+		plog.Infof("pruning again in %v", interval)
+		time.Sleep(interval)
+	}
+}
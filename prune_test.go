@@ -0,0 +1,128 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPruneResults(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "dbtester-prune")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	now := time.Unix(1500000000, 0)
+	old, recent := now.Add(-40*24*time.Hour), now.Add(-time.Hour)
+	files := map[string]time.Time{
+		"run-1/etcd/client/client-latency-distribution-all.csv":        old,
+		"run-1/etcd/client/client-latency-distribution-summary.csv":    old,
+		"run-1/etcd/client/client-system-metrics.csv":                  old,
+		"run-1/etcd/client/client-system-metrics-interpolated.csv":     old,
+		"run-1/etcd/server-1/server-system-metrics.csv":                old,
+		"run-1/etcd/server-1/database.log":                             old,
+		"run-1/etcd/all-aggregated.csv":                                old,
+		"run-2/etcd/client/client-latency-distribution-all.csv":        recent,
+		"run-2/etcd/client/client-latency-distribution-percentile.csv": recent,
+	}
+	for name, mt := range files {
+		fpath := filepath.Join(root, name)
+		if err = os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(fpath, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.Chtimes(fpath, mt, mt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := []string{
+		"run-1/etcd/client/client-latency-distribution-all.csv",
+		"run-1/etcd/client/client-system-metrics.csv",
+		"run-1/etcd/server-1/database.log",
+		"run-1/etcd/server-1/server-system-metrics.csv",
+	}
+	archiveDir := filepath.Join(root, "archive")
+	prunedPaths := func(policy PrunePolicy) []string {
+		pruned, err := PruneResults(root, policy, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ps []string
+		for _, f := range pruned {
+			rel, err := filepath.Rel(root, f.Path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ps = append(ps, rel)
+		}
+		return ps
+	}
+
+	policy := PrunePolicy{MaxAge: 30 * 24 * time.Hour, ArchiveDir: archiveDir, DryRun: true}
+	if ps := prunedPaths(policy); !reflect.DeepEqual(ps, expected) {
+		t.Fatalf("expected %q, got %q", expected, ps)
+	}
+	for _, name := range expected {
+		if !exist(filepath.Join(root, name)) {
+			t.Fatalf("%q is removed in dry run", name)
+		}
+	}
+
+	policy.DryRun = false
+	if ps := prunedPaths(policy); !reflect.DeepEqual(ps, expected) {
+		t.Fatalf("expected %q, got %q", expected, ps)
+	}
+	for name := range files {
+		pruned := false
+		for _, e := range expected {
+			pruned = pruned || e == name
+		}
+		if exist(filepath.Join(root, name)) == pruned {
+			t.Fatalf("%q exists %v, expected pruned %v", name, !pruned, pruned)
+		}
+	}
+	for _, name := range expected {
+		f, err := os.Open(filepath.Join(archiveDir, name+".gz"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ioutil.ReadAll(zr)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != name {
+			t.Fatalf("archived %q has %q", name, b)
+		}
+	}
+
+	// archives under the root are not pruned again
+	if ps := prunedPaths(policy); len(ps) != 0 {
+		t.Fatalf("expected nothing to prune, got %q", ps)
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"google.golang.org/grpc"
+)
+
+// installChunkSize is the size of each binary chunk sent to agents,
+// smaller than the default gRPC maximum message size.
+const installChunkSize = 1 << 20
+
+// release is the download location of the upstream release archive.
+type release struct {
+	archiveURL string
+	// sumsURL is the checksum file of all archives in the release
+	sumsURL string
+	// binary is the base name of the database binary in the archive
+	binary string
+}
+
+func releaseOf(databaseID, version string) (release, error) {
+	if version == "" {
+		return release{}, fmt.Errorf("release version is not defined")
+	}
+	version = "v" + strings.TrimPrefix(version, "v")
+	switch databaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		base := fmt.Sprintf("https://github.com/coreos/etcd/releases/download/%s", version)
+		return release{
+			archiveURL: fmt.Sprintf("%s/etcd-%s-linux-amd64.tar.gz", base, version),
+			sumsURL:    base + "/SHA256SUMS",
+			binary:     "etcd",
+		}, nil
+	case "consul__v1_0_2":
+		v := strings.TrimPrefix(version, "v")
+		base := fmt.Sprintf("https://releases.hashicorp.com/consul/%s", v)
+		return release{
+			archiveURL: fmt.Sprintf("%s/consul_%s_linux_amd64.zip", base, v),
+			sumsURL:    fmt.Sprintf("%s/consul_%s_SHA256SUMS", base, v),
+			binary:     "consul",
+		}, nil
+	default:
+		return release{}, fmt.Errorf("release download is not supported for %q", databaseID)
+	}
+}
+
+// DownloadRelease downloads the release archive in 'release' configuration,
+// verifies its checksum, and returns the database binary in the archive.
+func (cfg *Config) DownloadRelease(databaseID string) ([]byte, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return nil, fmt.Errorf("database id %q does not exist", databaseID)
+	}
+	if gcfg.ConfigRelease == nil {
+		return nil, fmt.Errorf("release is not defined for %q", databaseID)
+	}
+	rel, err := releaseOf(gcfg.DatabaseID, gcfg.ConfigRelease.Version)
+	if err != nil {
+		return nil, err
+	}
+	if gcfg.ConfigRelease.URL != "" {
+		rel.archiveURL = gcfg.ConfigRelease.URL
+	}
+
+	expected := strings.ToLower(gcfg.ConfigRelease.SHA256)
+	if expected == "" {
+		plog.Infof("downloading checksums %q", rel.sumsURL)
+		sums, err := download(rel.sumsURL)
+		if err != nil {
+			return nil, err
+		}
+		if expected, err = findSHA256(sums, path.Base(rel.archiveURL)); err != nil {
+			return nil, err
+		}
+	}
+
+	plog.Infof("downloading release %q", rel.archiveURL)
+	archive, err := download(rel.archiveURL)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(archive)
+	if got := hex.EncodeToString(sum[:]); got != expected {
+		return nil, fmt.Errorf("checksum mismatch on %q (expected %s, got %s)", rel.archiveURL, expected, got)
+	}
+	plog.Infof("verified checksum %s of %q", expected, rel.archiveURL)
+
+	return extractBinary(archive, rel.archiveURL, rel.binary)
+}
+
+// InstallRelease downloads the release binary, and installs it on all agents.
+func (cfg *Config) InstallRelease(databaseID string) error {
+	bin, err := cfg.DownloadRelease(databaseID)
+	if err != nil {
+		return err
+	}
+	return cfg.installOnAgents(databaseID, cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].ConfigRelease.Version, bin)
+}
+
+// installOnAgents installs the database binary on all agents.
+// 'version' is only used for logging.
+func (cfg *Config) installOnAgents(databaseID, version string, bin []byte) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("database id %q does not exist", databaseID)
+	}
+	sum := sha256.Sum256(bin)
+	chunk := dbtesterpb.InstallChunk{
+		DatabaseID: dbtesterpb.DatabaseID(dbtesterpb.DatabaseID_value[gcfg.DatabaseID]),
+		Version:    version,
+		SHA256:     hex.EncodeToString(sum[:]),
+	}
+
+	var wg sync.WaitGroup
+	errc := make(chan error, len(gcfg.AgentEndpoints))
+	for i, ep := range gcfg.AgentEndpoints {
+		wg.Add(1)
+		go func(i int, ep string) {
+			defer wg.Done()
+			plog.Infof("installing %q %s [index: %d | endpoint: %q]", databaseID, version, i, ep)
+			if err := installBinary(ep, chunk, bin); err != nil {
+				errc <- fmt.Errorf("%v (%q)", err, ep)
+				return
+			}
+			plog.Infof("installed %q %s [index: %d | endpoint: %q]", databaseID, version, i, ep)
+		}(i, ep)
+	}
+	wg.Wait()
+	close(errc)
+	return <-errc
+}
+
+func installBinary(ep string, chunk dbtesterpb.InstallChunk, bin []byte) error {
+	conn, err := grpc.Dial(ep, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	stream, err := dbtesterpb.NewTransporterClient(conn).Install(ctx)
+	if err != nil {
+		return err
+	}
+	for len(bin) > 0 {
+		n := installChunkSize
+		if n > len(bin) {
+			n = len(bin)
+		}
+		chunk.Data = bin[:n]
+		if err = stream.Send(&chunk); err != nil {
+			return err
+		}
+		bin = bin[n:]
+		chunk = dbtesterpb.InstallChunk{}
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+func download(u string) ([]byte, error) {
+	resp, err := http.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %q (%s)", u, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// findSHA256 finds the checksum of the file 'name'
+// in 'sha256sum' output format.
+func findSHA256(sums []byte, name string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(sums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("checksum of %q is not found", name)
+}
+
+// extractBinary returns the file named 'binary'
+// in the '.tar.gz' or '.zip' archive.
+func extractBinary(archive []byte, name, binary string) ([]byte, error) {
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		gr, err := gzip.NewReader(bytes.NewReader(archive))
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		tr := tar.NewReader(gr)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if hdr.Typeflag == tar.TypeReg && path.Base(hdr.Name) == binary {
+				return ioutil.ReadAll(tr)
+			}
+		}
+
+	case strings.HasSuffix(name, ".zip"):
+		zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range zr.File {
+			if path.Base(f.Name) != binary || f.FileInfo().IsDir() {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer rc.Close()
+			return ioutil.ReadAll(rc)
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown archive format %q", name)
+	}
+	return nil, fmt.Errorf("%q is not found in %q", binary, name)
+}
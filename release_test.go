@@ -0,0 +1,85 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"testing"
+)
+
+func TestFindSHA256(t *testing.T) {
+	sums := []byte(`2f4ee3d7f4b1bce1f52ce5f0f1b4cd4c1f4a1e7c4bd3c5bbf6e31ac6ab6f2bfe  etcd-v3.1.0-darwin-amd64.zip
+8e6a7cb16d83e8a8b5b8f2b8e0a3c2bda1f2a3cdbd3fe0ad6c4ea1bd5a0e1f11 *etcd-v3.1.0-linux-amd64.tar.gz
+`)
+	sum, err := findSHA256(sums, "etcd-v3.1.0-linux-amd64.tar.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum != "8e6a7cb16d83e8a8b5b8f2b8e0a3c2bda1f2a3cdbd3fe0ad6c4ea1bd5a0e1f11" {
+		t.Fatalf("unexpected checksum %q", sum)
+	}
+	if _, err = findSHA256(sums, "etcd-v3.1.0-linux-arm64.tar.gz"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestExtractBinary(t *testing.T) {
+	bin := []byte("etcd binary")
+
+	tbuf := new(bytes.Buffer)
+	gw := gzip.NewWriter(tbuf)
+	tw := tar.NewWriter(gw)
+	for _, name := range []string{"etcd-v3.1.0-linux-amd64/README.md", "etcd-v3.1.0-linux-amd64/etcd"} {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0755, Size: int64(len(bin)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(bin); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tw.Close()
+	gw.Close()
+
+	got, err := extractBinary(tbuf.Bytes(), "etcd-v3.1.0-linux-amd64.tar.gz", "etcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, bin) {
+		t.Fatalf("expected %q, got %q", bin, got)
+	}
+
+	zbuf := new(bytes.Buffer)
+	zw := zip.NewWriter(zbuf)
+	w, err := zw.Create("consul")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(bin)
+	zw.Close()
+
+	got, err = extractBinary(zbuf.Bytes(), "consul_1.0.2_linux_amd64.zip", "consul")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, bin) {
+		t.Fatalf("expected %q, got %q", bin, got)
+	}
+	if _, err = extractBinary(zbuf.Bytes(), "consul_1.0.2_linux_amd64.zip", "etcd"); err == nil {
+		t.Fatal("expected error")
+	}
+}
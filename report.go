@@ -16,6 +16,8 @@ package dbtester
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"sync"
 	"time"
 
@@ -31,6 +33,42 @@ type benchmark struct {
 	reportDone <-chan report.Stats
 	stats      report.Stats
 
+	// correctedReport measures latency from intended start time
+	// in fixed-QPS mode, to correct coordinated omission
+	correctedReport     report.Report
+	correctedReportDone <-chan report.Stats
+	correctedStats      report.Stats
+
+	// combinedReport, if not nil, also receives all results
+	// (e.g. when multiple tenant groups run concurrently)
+	combinedReport report.Report
+
+	// slo, if not nil, counts requests under latency SLO thresholds
+	slo *sloCounter
+
+	// retry, if not nil, retries failed requests, and
+	// retries counts them apart from first-attempt successes
+	retry   *retryPolicy
+	retries *retryCounter
+
+	// hist, if not nil, records latency histograms per second
+	hist *latencyHistograms
+
+	// live, if not nil, aggregates results for live monitoring
+	live *LiveStats
+
+	// soak, if not nil, rolls up results of every interval
+	soak *soakRollup
+
+	// budget, if not nil, aborts load generation on too many errors
+	budget *errorBudget
+
+	// opReports, if not nil, receives results by operation type
+	// (e.g. reads and writes in "mixed" type benchmark)
+	opReports     map[string]report.Report
+	opReportsDone map[string]<-chan report.Stats
+	opStats       map[string]report.Stats
+
 	reqHandlers []ReqHandler
 	reqGen      func(chan<- request)
 	reqDone     func()
@@ -47,6 +85,9 @@ func newBenchmark(totalN int64, clientsN int64, reqHandlers []ReqHandler, reqDon
 		reqHandlers: reqHandlers,
 		reqGen:      reqGen,
 		reqDone:     reqDone,
+		live:        liveStats,
+		soak:        activeSoak,
+		budget:      activeErrorBudget,
 		wg:          sync.WaitGroup{},
 	}
 	b.inflightReqs = make(chan request, clientsN)
@@ -80,6 +121,7 @@ func (b *benchmark) getInflightsReqs() (ch chan request) {
 }
 
 func (b *benchmark) startRequests() {
+	waitStartBarrier()
 	for i := range b.reqHandlers {
 		b.wg.Add(1)
 		go func(rh ReqHandler) {
@@ -89,14 +131,63 @@ func (b *benchmark) startRequests() {
 					panic(fmt.Errorf("got nil rh"))
 				}
 				st := time.Now()
-				err := rh(context.Background(), &req)
-				b.report.Results() <- report.Result{Err: err, Start: st, End: time.Now()}
+				var err error
+				if b.retry != nil {
+					var n int64
+					n, err = b.retry.do(context.Background(), rh, &req)
+					b.retries.observe(st, n, err)
+				} else {
+					err = rh(context.Background(), &req)
+				}
+				end := time.Now()
+				b.report.Results() <- report.Result{Err: err, Start: st, End: end}
+				if l := connEvents; l != nil && err != nil {
+					l.observeError(err)
+				}
+				if b.slo != nil {
+					b.slo.observe(st, end.Sub(st), err)
+				}
+				if b.hist != nil && err == nil {
+					b.hist.observe(st, end.Sub(st), req.opType)
+				}
+				if b.live != nil {
+					b.live.observe(end, end.Sub(st), err)
+				}
+				if b.soak != nil {
+					b.soak.observe(end.Sub(st), err)
+				}
+				if b.budget != nil {
+					b.budget.observe(end, err)
+				}
+				if b.combinedReport != nil {
+					b.combinedReport.Results() <- report.Result{Err: err, Start: st, End: end}
+				}
+				if r, ok := b.opReports[req.opType]; ok {
+					r.Results() <- report.Result{Err: err, Start: st, End: end}
+				}
+				if b.correctedReport != nil && !req.intendedStart.IsZero() {
+					intended := req.intendedStart
+					if st.Before(intended) {
+						// rate limiter allows bursts
+						intended = st
+					}
+					b.correctedReport.Results() <- report.Result{Err: err, Start: intended, End: end}
+				}
 				b.bar.Increment()
 			}
 		}(b.reqHandlers[i])
 	}
 	go b.reqGen(b.getInflightsReqs())
 	b.reportDone = b.report.Stats()
+	if b.correctedReport != nil {
+		b.correctedReportDone = b.correctedReport.Stats()
+	}
+	if b.opReports != nil {
+		b.opReportsDone = make(map[string]<-chan report.Stats, len(b.opReports))
+		for op, r := range b.opReports {
+			b.opReportsDone[op] = r.Stats()
+		}
+	}
 }
 
 func (b *benchmark) waitRequestsEnd() {
@@ -111,6 +202,17 @@ func (b *benchmark) finishReports() {
 	b.bar.Finish()
 	st := <-b.reportDone
 	b.stats = st
+	if b.correctedReport != nil {
+		close(b.correctedReport.Results())
+		b.correctedStats = <-b.correctedReportDone
+	}
+	if b.opReports != nil {
+		b.opStats = make(map[string]report.Stats, len(b.opReports))
+		for op, r := range b.opReports {
+			close(r.Results())
+			b.opStats[op] = <-b.opReportsDone[op]
+		}
+	}
 }
 
 func (b *benchmark) waitAll() {
@@ -118,9 +220,57 @@ func (b *benchmark) waitAll() {
 	b.finishReports()
 }
 
+// combineStats merges the stats of multiple ranges of requests
+// that were run one after another.
+func combineStats(stats []report.Stats) report.Stats {
+	combined := report.Stats{ErrorDist: make(map[string]int)}
+	for _, st := range stats {
+		combined.AvgTotal += st.AvgTotal
+		combined.Total += st.Total
+		combined.Lats = append(combined.Lats, st.Lats...)
+		combined.TimeSeries = append(combined.TimeSeries, st.TimeSeries...)
+		for k, v := range st.ErrorDist {
+			if _, ok := combined.ErrorDist[k]; !ok {
+				combined.ErrorDist[k] = v
+			} else {
+				combined.ErrorDist[k] += v
+			}
+		}
+	}
+
+	combined.Average = combined.AvgTotal / float64(len(combined.Lats))
+	combined.RPS = float64(len(combined.Lats)) / combined.Total.Seconds()
+	plog.Printf("got total %d data points and total %f seconds (RPS %f)", len(combined.Lats), combined.Total.Seconds(), combined.RPS)
+
+	for i := range combined.Lats {
+		dev := combined.Lats[i] - combined.Average
+		combined.Stddev += dev * dev
+	}
+	combined.Stddev = math.Sqrt(combined.Stddev / float64(len(combined.Lats)))
+
+	sort.Float64s(combined.Lats)
+	if len(combined.Lats) > 0 {
+		combined.Fastest = combined.Lats[0]
+		combined.Slowest = combined.Lats[len(combined.Lats)-1]
+	}
+	return combined
+}
+
+// sortedErrors returns the error messages sorted by name,
+// so that summaries are the same across runs with the same errors.
+func sortedErrors(dist map[string]int) []string {
+	errs := make([]string, 0, len(dist))
+	for k := range dist {
+		errs = append(errs, k)
+	}
+	sort.Strings(errs)
+	return errs
+}
+
 func printStats(st report.Stats) {
 	// to be piped to cfg.Log via stdout when dbtester executed
-	if len(st.Lats) > 0 {
+	// soak runs truncating raw data have no 'Lats'
+	if len(st.Lats) > 0 || st.RPS > 0 {
 		fmt.Printf("Total: %v\n", st.Total)
 		fmt.Printf("Slowest: %f secs\n", st.Slowest)
 		fmt.Printf("Fastest: %f secs\n", st.Fastest)
@@ -128,8 +278,8 @@ func printStats(st report.Stats) {
 		fmt.Printf("Requests/sec: %4.4f\n", st.RPS)
 	}
 	if len(st.ErrorDist) > 0 {
-		for k, v := range st.ErrorDist {
-			fmt.Printf("ERROR %q : %d\n", k, v)
+		for _, k := range sortedErrors(st.ErrorDist) {
+			fmt.Printf("ERROR %q : %d\n", k, st.ErrorDist[k])
 		}
 	} else {
 		fmt.Println("ERRRO: 0")
@@ -138,9 +288,38 @@ func printStats(st report.Stats) {
 
 func (cfg *Config) generateReport(gcfg dbtesterpb.ConfigClientMachineAgentControl, h []ReqHandler, reqDone func(), reqGen func(chan<- request)) {
 	b := newBenchmark(gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, h, reqDone, reqGen)
+	// soak runs truncating raw data do not keep every request latency
+	var soakReports []*soakReport
+	if activeSoak != nil && activeSoak.truncate {
+		sr := newSoakReport()
+		b.report, soakReports = sr, append(soakReports, sr)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
+		if soakReports != nil {
+			sr := newSoakReport()
+			b.correctedReport, soakReports = sr, append(soakReports, sr)
+		} else {
+			b.correctedReport = report.NewReportSample("%4.4f")
+		}
+	}
+	b.slo = newSLOCounter(gcfg.ConfigClientMachineBenchmarkOptions.LatencySLOMs)
+	b.retry = newRetryPolicy(gcfg.ConfigClientMachineBenchmarkOptions.Retry)
+	b.retries = newRetryCounter(b.retry)
+	b.hist = newLatencyHistograms(cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath)
 	b.startRequests()
 	b.waitAll()
 
 	printStats(b.stats)
-	cfg.saveAllStats(gcfg, b.stats, nil)
+	b.hist.save()
+	if soakReports != nil {
+		cfg.saveSoakStats(gcfg, b, soakReports)
+		return
+	}
+	if b.correctedReport == nil {
+		cfg.saveAllStats(gcfg, b.stats, nil, nil, b.slo, b.retries, nil)
+		return
+	}
+	fmt.Println("Corrected for coordinated omission:")
+	printStats(b.correctedStats)
+	cfg.saveAllStats(gcfg, b.stats, &b.correctedStats, nil, b.slo, b.retries, nil)
 }
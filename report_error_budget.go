@@ -0,0 +1,110 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// errorBudget aborts load generation once the percentage of failed
+// requests over a sliding window exceeds the budget. Load generated
+// outside of benchmarks (e.g. lease grants, connection churn) stops
+// through 'withLoadAbort'.
+type errorBudget struct {
+	maxPercent  float64
+	window      int64 // in seconds
+	minRequests int64
+
+	mu sync.Mutex
+	// total and errors are the number of requests by end second
+	total  map[int64]int64
+	errors map[int64]int64
+}
+
+// activeErrorBudget is the error budget of the database being stressed, if any.
+var activeErrorBudget *errorBudget
+
+var (
+	errorBudgetMu sync.Mutex
+	// errorBudgetFailure is the reason the last run exceeded
+	// its error budget, empty if not exceeded
+	errorBudgetFailure string
+)
+
+// ErrorBudgetExceeded returns the reason the last stressed database
+// exceeded its error budget, or an empty string if not exceeded.
+func ErrorBudgetExceeded() string {
+	errorBudgetMu.Lock()
+	defer errorBudgetMu.Unlock()
+	return errorBudgetFailure
+}
+
+func resetErrorBudgetFailure() {
+	errorBudgetMu.Lock()
+	errorBudgetFailure = ""
+	errorBudgetMu.Unlock()
+}
+
+func newErrorBudget(cfg *dbtesterpb.ConfigClientMachineErrorBudget) *errorBudget {
+	return &errorBudget{
+		maxPercent:  cfg.MaxErrorPercent,
+		window:      cfg.WindowSeconds,
+		minRequests: cfg.MinRequests,
+		total:       make(map[int64]int64),
+		errors:      make(map[int64]int64),
+	}
+}
+
+// observe records one request, and aborts load generation if errors in
+// the window up to its end second exceed the budget.
+func (e *errorBudget) observe(end time.Time, err error) {
+	sec := end.Unix()
+	e.mu.Lock()
+	e.total[sec]++
+	if err != nil {
+		e.errors[sec]++
+	}
+	var n, errs int64
+	for s := sec - e.window + 1; s <= sec; s++ {
+		n += e.total[s]
+		errs += e.errors[s]
+	}
+	if int64(len(e.total)) > 2*e.window {
+		// requests end out of order, so keep one more window
+		for s := range e.total {
+			if s <= sec-2*e.window {
+				delete(e.total, s)
+				delete(e.errors, s)
+			}
+		}
+	}
+	e.mu.Unlock()
+
+	if err == nil || n < e.minRequests || float64(errs)*100 <= e.maxPercent*float64(n) {
+		return
+	}
+	errorBudgetMu.Lock()
+	if errorBudgetFailure == "" {
+		errorBudgetFailure = fmt.Sprintf("%.2f %% errors (%d of %d requests) in %d-second window ending at unix second %d, over error budget %.2f %%",
+			float64(errs)*100/float64(n), errs, n, e.window, sec, e.maxPercent)
+		plog.Warningf("error budget exceeded: %s", errorBudgetFailure)
+	}
+	errorBudgetMu.Unlock()
+	AbortLoad()
+}
@@ -0,0 +1,68 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestErrorBudget(t *testing.T) {
+	defer func() {
+		resetErrorBudgetFailure()
+		loadPauser = &pauser{}
+	}()
+	resetErrorBudgetFailure()
+	loadPauser = &pauser{}
+
+	e := newErrorBudget(&dbtesterpb.ConfigClientMachineErrorBudget{MaxErrorPercent: 5, WindowSeconds: 30, MinRequests: 10})
+	errTimeout := errors.New("timeout")
+
+	// 1 error out of 9 requests, under min_requests
+	e.observe(time.Unix(100, 0), errTimeout)
+	for i := 0; i < 8; i++ {
+		e.observe(time.Unix(101, 0), nil)
+	}
+	if ErrorBudgetExceeded() != "" || LoadAborted() {
+		t.Fatal("expected no abort under min_requests")
+	}
+
+	// 2 errors out of 40 requests (5 %) in the window
+	for i := 0; i < 30; i++ {
+		e.observe(time.Unix(110, 0), nil)
+	}
+	e.observe(time.Unix(120, 0), errTimeout)
+	if ErrorBudgetExceeded() != "" || LoadAborted() {
+		t.Fatal("expected no abort within budget")
+	}
+
+	// the first error is out of the window
+	for i := 0; i < 20; i++ {
+		e.observe(time.Unix(135, 0), nil)
+	}
+	e.observe(time.Unix(135, 0), errTimeout)
+	if ErrorBudgetExceeded() != "" {
+		t.Fatalf("expected no abort, got %q", ErrorBudgetExceeded())
+	}
+
+	// 3 errors out of 53 requests in the window ending at 136
+	e.observe(time.Unix(136, 0), errTimeout)
+	if ErrorBudgetExceeded() == "" || !LoadAborted() {
+		t.Fatal("expected abort over budget")
+	}
+}
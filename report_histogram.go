@@ -0,0 +1,211 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+)
+
+const (
+	// latency histograms record in microseconds, up to an hour
+	histogramLowestMicrosecond  = 1
+	histogramHighestMicrosecond = int64(time.Hour / time.Microsecond)
+	histogramSignificantFigures = 3
+)
+
+// histogramCloseSeconds is how many seconds an interval is kept open after
+// requests start in a later second, for requests of the interval still in flight.
+const histogramCloseSeconds = 5
+
+// latencyHistograms records the full latency histogram per second,
+// grouped by request start second as in report time series.
+// Requests with an operation type are also recorded under that tag.
+// Each interval is written to the histogram log as it closes, so that
+// only open intervals and the running total of each tag are kept in memory.
+// A request that finishes after its interval is closed is written as another
+// interval of the same second, which readers merge.
+type latencyHistograms struct {
+	mu      sync.Mutex
+	logPath string
+	// parent, if not nil, also records all closed intervals
+	// (e.g. combined histograms of tenant groups)
+	parent *latencyHistograms
+
+	// open is the intervals not written yet, by tag and start second
+	open map[string]map[int64]*hdrhistogram.Histogram
+	// latest is the latest start second recorded
+	latest int64
+	// total is the running total of each tag
+	total map[string]*hdrhistogram.Histogram
+
+	f       *os.File
+	lw      *hdrhistogram.LogWriter
+	written int
+	err     error
+}
+
+// newLatencyHistograms returns nil if the histogram log path is empty.
+func newLatencyHistograms(logPath string) *latencyHistograms {
+	if logPath == "" {
+		return nil
+	}
+	return &latencyHistograms{
+		logPath: logPath,
+		open:    make(map[string]map[int64]*hdrhistogram.Histogram),
+		total:   make(map[string]*hdrhistogram.Histogram),
+	}
+}
+
+// observe records one successful request.
+func (hs *latencyHistograms) observe(start time.Time, took time.Duration, opType string) {
+	us := int64(took / time.Microsecond)
+	if us > histogramHighestMicrosecond {
+		us = histogramHighestMicrosecond
+	}
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	sec := start.Unix()
+	hs.histogram("", sec).RecordValue(us)
+	hs.totalHistogram("").RecordValue(us)
+	if opType != "" {
+		hs.histogram(opType, sec).RecordValue(us)
+		hs.totalHistogram(opType).RecordValue(us)
+	}
+	hs.advance(sec)
+}
+
+// add records the closed interval of a child.
+func (hs *latencyHistograms) add(tag string, sec int64, h *hdrhistogram.Histogram) {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	hs.histogram(tag, sec).Merge(h)
+	hs.totalHistogram(tag).Merge(h)
+	hs.advance(sec)
+}
+
+// advance closes the intervals 'histogramCloseSeconds' before the second,
+// if it is the latest. It must be called with the lock held.
+func (hs *latencyHistograms) advance(sec int64) {
+	if sec <= hs.latest {
+		return
+	}
+	hs.latest = sec
+	hs.closeBefore(sec - histogramCloseSeconds + 1)
+}
+
+// closeBefore writes the open intervals before the second in order
+// and drops them. It must be called with the lock held.
+func (hs *latencyHistograms) closeBefore(sec int64) {
+	var es []hdrhistogram.LogEntry
+	for tag, secs := range hs.open {
+		for s, h := range secs {
+			if s < sec {
+				es = append(es, hdrhistogram.LogEntry{Tag: tag, Start: time.Unix(s, 0), Length: time.Second, Histogram: h})
+				delete(secs, s)
+			}
+		}
+	}
+	sort.Slice(es, func(i, j int) bool {
+		if !es[i].Start.Equal(es[j].Start) {
+			return es[i].Start.Before(es[j].Start)
+		}
+		return es[i].Tag < es[j].Tag
+	})
+	for _, e := range es {
+		hs.write(e)
+		if hs.parent != nil {
+			hs.parent.add(e.Tag, e.Start.Unix(), e.Histogram)
+		}
+	}
+}
+
+// write writes the interval to the log, which is created at the first
+// interval. The first error is kept and returned by 'save'.
+func (hs *latencyHistograms) write(e hdrhistogram.LogEntry) {
+	if hs.err != nil {
+		return
+	}
+	if hs.lw == nil {
+		hs.f, hs.err = os.OpenFile(hs.logPath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0777)
+		if hs.err != nil {
+			return
+		}
+		if hs.lw, hs.err = hdrhistogram.NewLogWriter(hs.f, e.Start, float64(time.Millisecond/time.Microsecond)); hs.err != nil {
+			return
+		}
+	}
+	if hs.err = hs.lw.Write(e); hs.err == nil {
+		hs.written++
+	}
+}
+
+// histogram must be called with the lock held.
+func (hs *latencyHistograms) histogram(tag string, sec int64) *hdrhistogram.Histogram {
+	secs, ok := hs.open[tag]
+	if !ok {
+		secs = make(map[int64]*hdrhistogram.Histogram)
+		hs.open[tag] = secs
+	}
+	h, ok := secs[sec]
+	if !ok {
+		h, _ = hdrhistogram.New(histogramLowestMicrosecond, histogramHighestMicrosecond, histogramSignificantFigures)
+		secs[sec] = h
+	}
+	return h
+}
+
+// totalHistogram must be called with the lock held.
+func (hs *latencyHistograms) totalHistogram(tag string) *hdrhistogram.Histogram {
+	h, ok := hs.total[tag]
+	if !ok {
+		h, _ = hdrhistogram.New(histogramLowestMicrosecond, histogramHighestMicrosecond, histogramSignificantFigures)
+		hs.total[tag] = h
+	}
+	return h
+}
+
+// save writes all open intervals and closes the histogram log, in HdrHistogram
+// log format with maximum latencies in milliseconds. It is no-op if 'hs' is nil.
+func (hs *latencyHistograms) save() {
+	if hs == nil {
+		return
+	}
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	hs.closeBefore(hs.latest + 1)
+	if hs.f != nil {
+		if err := hs.f.Close(); err != nil && hs.err == nil {
+			hs.err = err
+		}
+		hs.f, hs.lw = nil, nil
+	}
+	if hs.err != nil {
+		plog.Fatal(hs.err)
+	}
+	if hs.written == 0 {
+		plog.Warning("no latency histogram to save")
+		return
+	}
+	if h := hs.total[""]; h != nil {
+		plog.Printf("saved %d latency histograms to %q [requests: %d | p99: %.3f ms]", hs.written, hs.logPath, h.TotalCount(), float64(h.ValueAtQuantile(99))/1000)
+		return
+	}
+	plog.Printf("saved %d latency histograms to %q", hs.written, hs.logPath)
+}
@@ -0,0 +1,79 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+)
+
+func TestLatencyHistograms(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "hist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	parent := newLatencyHistograms(filepath.Join(dir, "all.hlog"))
+	hs := newLatencyHistograms(filepath.Join(dir, "group.hlog"))
+	hs.parent = parent
+	for sec := int64(100); sec < 120; sec++ {
+		hs.observe(time.Unix(sec, 0), time.Millisecond, "write")
+		if n := len(hs.open[""]); n > histogramCloseSeconds {
+			t.Fatalf("second %d: expected at most %d open intervals, got %d", sec, histogramCloseSeconds, n)
+		}
+	}
+	if hs.written == 0 {
+		t.Fatal("expected closed intervals written before save")
+	}
+	// finished after its interval is closed
+	hs.observe(time.Unix(100, 0), 2*time.Millisecond, "")
+	hs.save()
+	parent.save()
+
+	for _, fpath := range []string{hs.logPath, parent.logPath} {
+		f, err := os.Open(fpath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		es, err := hdrhistogram.ReadLog(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts := make(map[string]int64)
+		first := make(map[int64]int64)
+		for _, e := range es {
+			counts[e.Tag] += e.Histogram.TotalCount()
+			if e.Tag == "" {
+				first[e.Start.Unix()] += e.Histogram.TotalCount()
+			}
+		}
+		if counts[""] != 21 || counts["write"] != 20 {
+			t.Fatalf("%q: expected 21 and 20 requests, got %v", fpath, counts)
+		}
+		if first[100] != 2 || first[119] != 1 {
+			t.Fatalf("%q: unexpected counts by second %v", fpath, first)
+		}
+	}
+	if h := hs.total[""]; h.TotalCount() != 21 {
+		t.Fatalf("expected total of 21 requests, got %d", h.TotalCount())
+	}
+}
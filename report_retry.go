@@ -0,0 +1,190 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/clientv3"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"golang.org/x/net/context"
+)
+
+// driverRetryDisabled is true while the retry policy is configured, so
+// that retries inside the client library do not hide failed attempts
+// from the retry counts. ZooKeeper and Consul clients do not retry
+// requests by themselves.
+var driverRetryDisabled bool
+
+// disableEtcdv3Retry replaces the retrying KV of the client with
+// one that sends each request once, if driver retries are disabled.
+func disableEtcdv3Retry(cli *clientv3.Client) {
+	if !driverRetryDisabled {
+		return
+	}
+	cli.KV = clientv3.NewKVFromKVClient(pb.NewKVClient(cli.ActiveConnection()), cli)
+}
+
+// retryPolicy retries failed requests with exponential backoff.
+type retryPolicy struct {
+	maxAttempts    int64
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// newRetryPolicy returns nil if retry is not configured,
+// or allows only one attempt.
+func newRetryPolicy(rcfg *dbtesterpb.ConfigClientMachineRetry) *retryPolicy {
+	if rcfg == nil || rcfg.MaxAttempts <= 1 {
+		return nil
+	}
+	p := &retryPolicy{
+		maxAttempts:    rcfg.MaxAttempts,
+		initialBackoff: time.Duration(rcfg.InitialBackoffMs) * time.Millisecond,
+		maxBackoff:     time.Duration(rcfg.MaxBackoffMs) * time.Millisecond,
+	}
+	if p.maxBackoff < p.initialBackoff {
+		p.maxBackoff = p.initialBackoff
+	}
+	return p
+}
+
+// backoff returns the wait before the n-th retry (n >= 1).
+func (p *retryPolicy) backoff(n int64) time.Duration {
+	d := p.initialBackoff
+	for i := int64(1); i < n && d < p.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.maxBackoff {
+		d = p.maxBackoff
+	}
+	return d
+}
+
+// do runs the handler until it succeeds or runs out of attempts,
+// and returns the number of retries with the last error.
+func (p *retryPolicy) do(ctx context.Context, rh ReqHandler, req *request) (int64, error) {
+	var retries int64
+	for {
+		err := rh(ctx, req)
+		if err == nil || retries+1 >= p.maxAttempts {
+			return retries, err
+		}
+		retries++
+		time.Sleep(p.backoff(retries))
+	}
+}
+
+// retryCounter counts first-attempt successes apart from
+// successes after retries, per second.
+type retryCounter struct {
+	mu           sync.Mutex
+	firstSuccess map[int64]int64
+	retriedOK    map[int64]int64
+	failed       map[int64]int64
+	retries      map[int64]int64
+}
+
+// newRetryCounter returns nil if the policy is nil.
+func newRetryCounter(p *retryPolicy) *retryCounter {
+	if p == nil {
+		return nil
+	}
+	return &retryCounter{
+		firstSuccess: make(map[int64]int64),
+		retriedOK:    make(map[int64]int64),
+		failed:       make(map[int64]int64),
+		retries:      make(map[int64]int64),
+	}
+}
+
+// observe records one request with its number of retries, grouped
+// by its start second as in report time series.
+func (c *retryCounter) observe(start time.Time, retries int64, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sec := start.Unix()
+	c.retries[sec] += retries
+	switch {
+	case err != nil:
+		c.failed[sec]++
+	case retries == 0:
+		c.firstSuccess[sec]++
+	default:
+		c.retriedOK[sec]++
+	}
+}
+
+// merge adds all counts of 'other'.
+func (c *retryCounter) merge(other *retryCounter) {
+	other.mu.Lock()
+	defer other.mu.Unlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for sec, n := range other.firstSuccess {
+		c.firstSuccess[sec] += n
+	}
+	for sec, n := range other.retriedOK {
+		c.retriedOK[sec] += n
+	}
+	for sec, n := range other.failed {
+		c.failed[sec] += n
+	}
+	for sec, n := range other.retries {
+		c.retries[sec] += n
+	}
+}
+
+// retryCounts are the counts of requests by retries.
+type retryCounts struct {
+	firstSuccess int64
+	retriedOK    int64
+	failed       int64
+	retries      int64
+}
+
+// second returns the counts of requests that started in the second.
+func (c *retryCounter) second(sec int64) retryCounts {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return retryCounts{
+		firstSuccess: c.firstSuccess[sec],
+		retriedOK:    c.retriedOK[sec],
+		failed:       c.failed[sec],
+		retries:      c.retries[sec],
+	}
+}
+
+// total returns the counts of all requests.
+func (c *retryCounter) total() retryCounts {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var rc retryCounts
+	for _, n := range c.firstSuccess {
+		rc.firstSuccess += n
+	}
+	for _, n := range c.retriedOK {
+		rc.retriedOK += n
+	}
+	for _, n := range c.failed {
+		rc.failed += n
+	}
+	for _, n := range c.retries {
+		rc.retries += n
+	}
+	return rc
+}
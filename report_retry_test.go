@@ -0,0 +1,63 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"golang.org/x/net/context"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	if newRetryPolicy(nil) != nil || newRetryPolicy(&dbtesterpb.ConfigClientMachineRetry{MaxAttempts: 1}) != nil {
+		t.Fatal("expected nil policy without retries")
+	}
+	p := newRetryPolicy(&dbtesterpb.ConfigClientMachineRetry{MaxAttempts: 5, InitialBackoffMs: 10, MaxBackoffMs: 35})
+	for i, exp := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 35 * time.Millisecond, 35 * time.Millisecond} {
+		if d := p.backoff(int64(i + 1)); d != exp {
+			t.Fatalf("#%d: expected %v, got %v", i, exp, d)
+		}
+	}
+}
+
+func TestRetryPolicyDo(t *testing.T) {
+	p := newRetryPolicy(&dbtesterpb.ConfigClientMachineRetry{MaxAttempts: 3})
+	c := newRetryCounter(p)
+	now := time.Unix(100, 0)
+
+	for _, failures := range []int{0, 1, 5} {
+		n := 0
+		rh := func(ctx context.Context, req *request) error {
+			n++
+			if n <= failures {
+				return errors.New("fail")
+			}
+			return nil
+		}
+		retries, err := p.do(context.Background(), rh, &request{})
+		c.observe(now, retries, err)
+	}
+
+	rc := c.second(100)
+	if rc.firstSuccess != 1 || rc.retriedOK != 1 || rc.failed != 1 || rc.retries != 3 {
+		t.Fatalf("unexpected counts %+v", rc)
+	}
+	if c.total() != rc {
+		t.Fatalf("expected total %+v, got %+v", rc, c.total())
+	}
+}
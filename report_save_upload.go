@@ -15,14 +15,18 @@
 package dbtester
 
 import (
+	"encoding/csv"
 	"fmt"
 	"math"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/checksum"
 	"github.com/coreos/dbtester/pkg/remotestorage"
+	"github.com/coreos/dbtester/pkg/table"
 	"github.com/coreos/etcd/pkg/report"
 	humanize "github.com/dustin/go-humanize"
 	"github.com/gyuho/dataframe"
@@ -71,7 +75,7 @@ func (cfg *Config) SaveDiskSpaceUsageSummary(databaseID string, idxToResponse ma
 	return fr.CSV(cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath)
 }
 
-func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats) {
+func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats, slo *sloCounter, retries *retryCounter, ops map[string]report.Stats) {
 	fr := dataframe.New()
 
 	c1 := dataframe.NewColumn("TOTAL-SECONDS")
@@ -111,9 +115,9 @@ func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats) {
 	}
 
 	if len(st.ErrorDist) > 0 {
-		for errName, errN := range st.ErrorDist {
+		for _, errName := range sortedErrors(st.ErrorDist) {
 			errcol := dataframe.NewColumn(fmt.Sprintf("ERROR: %q", errName))
-			errcol.PushBack(dataframe.NewStringValue(errN))
+			errcol.PushBack(dataframe.NewStringValue(st.ErrorDist[errName]))
 			if err := fr.AddColumn(errcol); err != nil {
 				plog.Fatal(err)
 			}
@@ -126,13 +130,99 @@ func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats) {
 		}
 	}
 
+	if slo != nil {
+		for i, pct := range slo.compliance() {
+			c := dataframe.NewColumn(sloComplianceColumn(slo.thresholds[i]))
+			c.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", pct)))
+			if err := fr.AddColumn(c); err != nil {
+				plog.Fatal(err)
+			}
+		}
+	}
+
+	if retries != nil {
+		rc := retries.total()
+		for _, kv := range []struct {
+			name string
+			n    int64
+		}{
+			{"FIRST-ATTEMPT-SUCCESS", rc.firstSuccess},
+			{"RETRIED-SUCCESS", rc.retriedOK},
+			{"FAILED-AFTER-RETRIES", rc.failed},
+			{"TOTAL-RETRIES", rc.retries},
+		} {
+			c := dataframe.NewColumn(kv.name)
+			c.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", kv.n)))
+			if err := fr.AddColumn(c); err != nil {
+				plog.Fatal(err)
+			}
+		}
+	}
+
+	for _, op := range OperationTypes {
+		ost, ok := ops[op]
+		if !ok {
+			continue
+		}
+		// OperationSummaryColumns
+		for i, v := range []float64{ost.RPS, 1000 * ost.Average, 1000 * ost.Slowest} {
+			c := dataframe.NewColumn(OperationColumn(op, OperationSummaryColumns[i]))
+			c.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", v)))
+			if err := fr.AddColumn(c); err != nil {
+				plog.Fatal(err)
+			}
+		}
+	}
+
+	if consistency.isEnabled() {
+		// stop the checker first, so that the count is final
+		consistency.stop()
+		c := dataframe.NewColumn("CONSISTENCY-VIOLATIONS")
+		c.PushBack(dataframe.NewStringValue(consistency.violations()))
+		if err := fr.AddColumn(c); err != nil {
+			plog.Fatal(err)
+		}
+	}
+
 	if err := fr.CSVHorizontal(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath); err != nil {
 		plog.Fatal(err)
 	}
 }
 
-func (cfg *Config) saveDataLatencyDistributionPercentile(st report.Stats) {
+// appendLatencyDistributionSummary adds the rows of 'name,value' to the
+// saved latency distribution summary, for results measured after the
+// stress finishes.
+func (cfg *Config) appendLatencyDistributionSummary(rows [][]string) error {
+	if cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath == "" {
+		return nil
+	}
+	f, err := os.OpenFile(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	wr := csv.NewWriter(f)
+	if err = wr.WriteAll(rows); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// saveDataLatencyDistributionPercentile saves latency percentiles.
+// If corrected is not nil, latencies measured from intended start
+// times are saved together.
+func (cfg *Config) saveDataLatencyDistributionPercentile(st report.Stats, corrected *report.Stats) {
 	pctls, seconds := report.Percentiles(st.Lats)
+	var correctedSeconds []float64
+	if corrected != nil {
+		_, correctedSeconds = report.Percentiles(corrected.Lats)
+	}
+	cfg.saveLatencyPercentiles(pctls, seconds, correctedSeconds)
+}
+
+// saveLatencyPercentiles saves the latency of each percentile in seconds,
+// and the corrected latency if not nil.
+func (cfg *Config) saveLatencyPercentiles(pctls, seconds, correctedSeconds []float64) {
 	c1 := dataframe.NewColumn("LATENCY-PERCENTILE")
 	c2 := dataframe.NewColumn("LATENCY-MS")
 	for i := range pctls {
@@ -152,9 +242,19 @@ func (cfg *Config) saveDataLatencyDistributionPercentile(st report.Stats) {
 	if err := fr.AddColumn(c2); err != nil {
 		plog.Fatal(err)
 	}
+	if correctedSeconds != nil {
+		c3 := dataframe.NewColumn("LATENCY-MS-CORRECTED")
+		for i := range correctedSeconds {
+			c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*correctedSeconds[i])))
+		}
+		if err := fr.AddColumn(c3); err != nil {
+			plog.Fatal(err)
+		}
+	}
 	if err := fr.CSV(cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath); err != nil {
 		plog.Fatal(err)
 	}
+	saveColumnUnits(cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath, fr.Headers())
 }
 
 func (cfg *Config) saveDataLatencyDistributionAll(st report.Stats) {
@@ -181,7 +281,12 @@ func (cfg *Config) saveDataLatencyDistributionAll(st report.Stats) {
 			max = v
 		}
 	}
+	cfg.saveLatencyDistribution(rm, min, max)
+}
 
+// saveLatencyDistribution saves the counts of latencies in 10ms buckets,
+// from 'min' to 'max' milliseconds.
+func (cfg *Config) saveLatencyDistribution(rm map[int64]int64, min, max int64) {
 	c1 := dataframe.NewColumn("LATENCY-MS")
 	c2 := dataframe.NewColumn("COUNT")
 	cur := min
@@ -208,9 +313,10 @@ func (cfg *Config) saveDataLatencyDistributionAll(st report.Stats) {
 	if err := fr.CSV(cfg.ConfigClientMachineInitial.ClientLatencyDistributionAllPath); err != nil {
 		plog.Fatal(err)
 	}
+	saveColumnUnits(cfg.ConfigClientMachineInitial.ClientLatencyDistributionAllPath, fr.Headers())
 }
 
-func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigClientMachineAgentControl, st report.Stats, clientNs []int64) {
+func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigClientMachineAgentControl, st report.Stats, corrected *report.Stats, clientNs []int64, slo *sloCounter, retries *retryCounter, ops map[string]report.Stats) {
 	if len(clientNs) == 0 && len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) == 0 {
 		clientNs = make([]int64, len(st.TimeSeries))
 		for i := range clientNs {
@@ -252,10 +358,134 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 	if err := fr.AddColumn(c6); err != nil {
 		plog.Fatal(err)
 	}
+	if loadPauser.pausedAny() {
+		c := dataframe.NewColumn("PAUSED")
+		for i := range st.TimeSeries {
+			c.PushBack(dataframe.NewStringValue(fmt.Sprintf("%t", loadPauser.paused(st.TimeSeries[i].Timestamp))))
+		}
+		if err := fr.AddColumn(c); err != nil {
+			plog.Fatal(err)
+		}
+	}
+	if sessionStorm != nil {
+		c := dataframe.NewColumn("SESSION-EXPIRY")
+		for i := range st.TimeSeries {
+			c.PushBack(dataframe.NewStringValue(sessionStorm.phase(st.TimeSeries[i].Timestamp)))
+		}
+		if err := fr.AddColumn(c); err != nil {
+			plog.Fatal(err)
+		}
+	}
+	if slo != nil {
+		cs := make([]dataframe.Column, len(slo.thresholds))
+		for i, ms := range slo.thresholds {
+			cs[i] = dataframe.NewColumn(sloFractionColumn(ms))
+		}
+		for i := range st.TimeSeries {
+			fs, ok := slo.fractions(st.TimeSeries[i].Timestamp)
+			for j := range cs {
+				if !ok {
+					cs[j].PushBack(dataframe.NewStringValue(""))
+					continue
+				}
+				cs[j].PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", fs[j])))
+			}
+		}
+		for _, c := range cs {
+			if err := fr.AddColumn(c); err != nil {
+				plog.Fatal(err)
+			}
+		}
+	}
+	if retries != nil {
+		// retries are grouped by the start second of the first attempt
+		cf := dataframe.NewColumn("FIRST-ATTEMPT-SUCCESS")
+		cr := dataframe.NewColumn("RETRIED-SUCCESS")
+		cn := dataframe.NewColumn("RETRIES")
+		for i := range st.TimeSeries {
+			rc := retries.second(st.TimeSeries[i].Timestamp)
+			cf.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", rc.firstSuccess)))
+			cr.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", rc.retriedOK)))
+			cn.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", rc.retries)))
+		}
+		for _, c := range []dataframe.Column{cf, cr, cn} {
+			if err := fr.AddColumn(c); err != nil {
+				plog.Fatal(err)
+			}
+		}
+	}
+	if corrected != nil {
+		// corrected latencies are grouped by intended start second
+		secondToPoint := make(map[int64]report.DataPoint)
+		for _, pt := range corrected.TimeSeries {
+			secondToPoint[pt.Timestamp] = pt
+		}
+		c7 := dataframe.NewColumn("MIN-LATENCY-MS-CORRECTED")
+		c8 := dataframe.NewColumn("AVG-LATENCY-MS-CORRECTED")
+		c9 := dataframe.NewColumn("MAX-LATENCY-MS-CORRECTED")
+		for i := range st.TimeSeries {
+			pt, ok := secondToPoint[st.TimeSeries[i].Timestamp]
+			if !ok {
+				c7.PushBack(dataframe.NewStringValue(""))
+				c8.PushBack(dataframe.NewStringValue(""))
+				c9.PushBack(dataframe.NewStringValue(""))
+				continue
+			}
+			c7.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(pt.MinLatency))))
+			c8.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(pt.AvgLatency))))
+			c9.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(pt.MaxLatency))))
+		}
+		if err := fr.AddColumn(c7); err != nil {
+			plog.Fatal(err)
+		}
+		if err := fr.AddColumn(c8); err != nil {
+			plog.Fatal(err)
+		}
+		if err := fr.AddColumn(c9); err != nil {
+			plog.Fatal(err)
+		}
+	}
+
+	for _, op := range OperationTypes {
+		ost, ok := ops[op]
+		if !ok {
+			continue
+		}
+		secondToPoint := make(map[int64]report.DataPoint)
+		for _, pt := range ost.TimeSeries {
+			secondToPoint[pt.Timestamp] = pt
+		}
+		// OperationTimeseriesColumns
+		cMin := dataframe.NewColumn(OperationColumn(op, OperationTimeseriesColumns[0]))
+		cAvg := dataframe.NewColumn(OperationColumn(op, OperationTimeseriesColumns[1]))
+		cMax := dataframe.NewColumn(OperationColumn(op, OperationTimeseriesColumns[2]))
+		cThr := dataframe.NewColumn(OperationColumn(op, OperationTimeseriesColumns[3]))
+		for i := range st.TimeSeries {
+			pt, ok := secondToPoint[st.TimeSeries[i].Timestamp]
+			if !ok {
+				// no request of the operation type in this second
+				cMin.PushBack(dataframe.NewStringValue("0"))
+				cAvg.PushBack(dataframe.NewStringValue("0"))
+				cMax.PushBack(dataframe.NewStringValue("0"))
+				cThr.PushBack(dataframe.NewStringValue("0"))
+				continue
+			}
+			cMin.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(pt.MinLatency))))
+			cAvg.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(pt.AvgLatency))))
+			cMax.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(pt.MaxLatency))))
+			cThr.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", pt.ThroughPut)))
+		}
+		for _, c := range []dataframe.Column{cMin, cAvg, cMax, cThr} {
+			if err := fr.AddColumn(c); err != nil {
+				plog.Fatal(err)
+			}
+		}
+	}
 
 	if err := fr.CSV(cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath); err != nil {
 		plog.Fatal(err)
 	}
+	saveColumnUnits(cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath, fr.Headers())
 
 	// aggregate latency by the number of keys
 	tss := FindRangesLatency(st.TimeSeries, 1000, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
@@ -287,16 +517,58 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 	if err := frr.CSV(cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath); err != nil {
 		plog.Fatal(err)
 	}
+	saveColumnUnits(cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath, frr.Headers())
+}
+
+// saveAllStats saves all stats. 'corrected' is not nil in fixed-QPS mode,
+// with latencies corrected for coordinated omission. 'slo' is not nil
+// when latency SLO thresholds are configured. 'retries' is not nil when
+// retry policy is configured. 'ops' is not nil in "mixed"
+// type benchmark, with stats of each operation type.
+// saveSoakStats saves the stats of soak runs that truncate raw data, with
+// percentiles from the histograms of the reports (the first is of the
+// stats, and the second, if any, is corrected for coordinated omission).
+// The latency distribution is also built from the histogram, since the
+// latency of each request is not kept.
+func (cfg *Config) saveSoakStats(gcfg dbtesterpb.ConfigClientMachineAgentControl, b *benchmark, reports []*soakReport) {
+	cfg.saveDataLatencyDistributionSummary(b.stats, b.slo, b.retries, nil)
+	pctls, seconds := reports[0].percentiles()
+	var corrected *report.Stats
+	var correctedSeconds []float64
+	if len(reports) > 1 {
+		corrected = &b.correctedStats
+		_, correctedSeconds = reports[1].percentiles()
+	}
+	cfg.saveLatencyPercentiles(pctls, seconds, correctedSeconds)
+	if reports[0].latency.TotalCount() > 0 {
+		rm := make(map[int64]int64)
+		min, max := int64(math.MaxInt64), int64(0)
+		reports[0].latency.ForEach(func(us, n int64) {
+			// truncate all digits below 10ms, as saveDataLatencyDistributionAll
+			v := us / 1000 / 10 * 10
+			rm[v] += n
+			if min > v {
+				min = v
+			}
+			if max < v {
+				max = v
+			}
+		})
+		cfg.saveLatencyDistribution(rm, min, max)
+	}
+	cfg.saveDataLatencyThroughputTimeseries(gcfg, b.stats, corrected, nil, b.slo, b.retries, nil)
 }
 
-func (cfg *Config) saveAllStats(gcfg dbtesterpb.ConfigClientMachineAgentControl, stats report.Stats, clientNs []int64) {
-	cfg.saveDataLatencyDistributionSummary(stats)
-	cfg.saveDataLatencyDistributionPercentile(stats)
+func (cfg *Config) saveAllStats(gcfg dbtesterpb.ConfigClientMachineAgentControl, stats report.Stats, corrected *report.Stats, clientNs []int64, slo *sloCounter, retries *retryCounter, ops map[string]report.Stats) {
+	cfg.saveDataLatencyDistributionSummary(stats, slo, retries, ops)
+	cfg.saveDataLatencyDistributionPercentile(stats, corrected)
 	cfg.saveDataLatencyDistributionAll(stats)
-	cfg.saveDataLatencyThroughputTimeseries(gcfg, stats, clientNs)
+	cfg.saveDataLatencyThroughputTimeseries(gcfg, stats, corrected, clientNs, slo, retries, ops)
 }
 
-// UploadToGoogle uploads target file to Google Cloud Storage.
+// UploadToGoogle uploads target file to Google Cloud Storage, with
+// the checksum of the uploaded file. The file is verified against its
+// checksum before uploading, if any.
 func (cfg *Config) UploadToGoogle(databaseID string, targetPath string) error {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 	if !ok {
@@ -305,26 +577,69 @@ func (cfg *Config) UploadToGoogle(databaseID string, targetPath string) error {
 	if !exist(targetPath) {
 		return fmt.Errorf("%q does not exist", targetPath)
 	}
+	if err := checksum.Verify(targetPath); err != nil && err != checksum.ErrNoChecksum {
+		return err
+	}
 	u, err := remotestorage.NewGoogleCloudStorage([]byte(cfg.ConfigClientMachineInitial.GoogleCloudStorageKey), cfg.ConfigClientMachineInitial.GoogleCloudProjectName)
 	if err != nil {
 		return err
 	}
 
 	srcPath := targetPath
+	if cfg.ConfigClientMachineInitial.Anonymize && targetPath != cfg.ConfigClientMachineInitial.ClientResultDatabasePath {
+		// result database is anonymized when written
+		var cleanup func()
+		srcPath, cleanup, err = cfg.anonymizedCopy(gcfg, targetPath)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+	} else if targetPath == cfg.ConfigClientMachineInitial.LogPath {
+		// log is still being written
+		var cleanup func()
+		srcPath, cleanup, err = snapshotCopy(targetPath)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+	}
 	dstPath := filepath.Base(targetPath)
 	if !strings.HasPrefix(dstPath, gcfg.DatabaseTag) {
 		dstPath = fmt.Sprintf("%s-%s", gcfg.DatabaseTag, dstPath)
 	}
+	if cfg.ConfigClientMachineInitial.RunID != "" {
+		// keep the standard layout in the storage
+		rel, err := filepath.Rel(cfg.ConfigClientMachineInitial.PathPrefix, targetPath)
+		if err == nil && !strings.HasPrefix(rel, "..") {
+			dstPath = rel
+		}
+	}
 	dstPath = filepath.Join(cfg.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstPath)
 
-	var uerr error
-	for k := 0; k < 30; k++ {
-		if uerr = u.UploadFile(cfg.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcPath, dstPath); uerr != nil {
-			plog.Printf("#%d: error %v while uploading %q", k, uerr, targetPath)
-			time.Sleep(2 * time.Second)
-			continue
+	upload := func(src, dst string) (uerr error) {
+		for k := 0; k < 30; k++ {
+			if uerr = u.UploadFile(cfg.ConfigClientMachineInitial.GoogleCloudStorageBucketName, src, dst); uerr != nil {
+				plog.Printf("#%d: error %v while uploading %q", k, uerr, src)
+				time.Sleep(2 * time.Second)
+				continue
+			}
+			break
 		}
-		break
+		return uerr
+	}
+	if err = upload(srcPath, dstPath); err != nil {
+		return err
+	}
+	if err = checksum.WriteAs(srcPath, filepath.Base(dstPath)); err != nil {
+		return err
+	}
+	if err = upload(checksum.Path(srcPath), dstPath+checksum.Suffix); err != nil {
+		return err
+	}
+
+	// units of the CSV columns, if any
+	if up := table.UnitsPath(targetPath); strings.HasSuffix(targetPath, ".csv") && !strings.HasSuffix(targetPath, table.UnitsSuffix) && exist(up) {
+		return cfg.UploadToGoogle(databaseID, up)
 	}
-	return uerr
+	return nil
 }
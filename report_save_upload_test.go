@@ -0,0 +1,90 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/pkg/report"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files in testdata")
+
+// testGolden compares the file with the golden file in testdata,
+// or overwrites the golden file with '-update'.
+func testGolden(t *testing.T, fpath, golden string) {
+	b, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden = filepath.Join("testdata", golden)
+	if *updateGolden {
+		if err = ioutil.WriteFile(golden, b, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exp, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != string(exp) {
+		t.Fatalf("%q differs from %q (run with '-update' to update)\nexpected:\n%s\ngot:\n%s", fpath, golden, exp, b)
+	}
+}
+
+func TestSaveDataLatencyDistributionSummaryGolden(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "dbtester-summary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := &Config{}
+	cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath = filepath.Join(dir, "summary.csv")
+
+	st := report.Stats{
+		Total:   10 * time.Second,
+		RPS:     1000,
+		Slowest: 0.1,
+		Fastest: 0.001,
+		Average: 0.01,
+		Stddev:  0.005,
+		ErrorDist: map[string]int{
+			"etcdserver: request timed out": 3,
+			"context deadline exceeded":     5,
+			"connection refused":            1,
+			"zk: node already exists":       2,
+		},
+	}
+	slo := newSLOCounter([]int64{10, 100})
+	slo.observe(time.Unix(100, 0), 5*time.Millisecond, nil)
+	slo.observe(time.Unix(100, 0), 50*time.Millisecond, nil)
+	ops := map[string]report.Stats{
+		"write": {RPS: 400, Average: 0.02, Slowest: 0.1},
+		"read":  {RPS: 600, Average: 0.005, Slowest: 0.05},
+	}
+
+	// errors and operations are in maps, so save multiple times
+	// to make sure that the order of columns does not change
+	for i := 0; i < 5; i++ {
+		cfg.saveDataLatencyDistributionSummary(st, slo, nil, ops)
+		testGolden(t, cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath, "client-latency-distribution-summary.golden")
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// sloCounter counts successful requests under each latency threshold,
+// out of all requests, per second.
+type sloCounter struct {
+	mu         sync.Mutex
+	thresholds []int64 // in milliseconds
+	total      map[int64]int64
+	under      map[int64][]int64
+}
+
+// newSLOCounter returns nil if no threshold is given.
+func newSLOCounter(thresholdsMs []int64) *sloCounter {
+	if len(thresholdsMs) == 0 {
+		return nil
+	}
+	return &sloCounter{
+		thresholds: thresholdsMs,
+		total:      make(map[int64]int64),
+		under:      make(map[int64][]int64),
+	}
+}
+
+// observe records one request, grouped by its start second as in report
+// time series. Failed requests count as over every threshold, so that
+// errors never improve compliance.
+func (c *sloCounter) observe(start time.Time, took time.Duration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sec := start.Unix()
+	c.total[sec]++
+	if _, ok := c.under[sec]; !ok {
+		c.under[sec] = make([]int64, len(c.thresholds))
+	}
+	if err != nil {
+		return
+	}
+	for i, ms := range c.thresholds {
+		if took <= time.Duration(ms)*time.Millisecond {
+			c.under[sec][i]++
+		}
+	}
+}
+
+// merge adds all counts of 'other', which must have the same thresholds.
+func (c *sloCounter) merge(other *sloCounter) {
+	other.mu.Lock()
+	defer other.mu.Unlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for sec, n := range other.total {
+		c.total[sec] += n
+		if _, ok := c.under[sec]; !ok {
+			c.under[sec] = make([]int64, len(c.thresholds))
+		}
+		for i, v := range other.under[sec] {
+			c.under[sec][i] += v
+		}
+	}
+}
+
+// fractions returns the fraction of requests under each threshold
+// in the given second. It returns false if no request started then.
+func (c *sloCounter) fractions(sec int64) ([]float64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := c.total[sec]
+	if n == 0 {
+		return nil, false
+	}
+	fs := make([]float64, len(c.thresholds))
+	for i, v := range c.under[sec] {
+		fs[i] = float64(v) / float64(n)
+	}
+	return fs, true
+}
+
+// compliance returns the percentage of all requests under each threshold.
+func (c *sloCounter) compliance() []float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var n int64
+	under := make([]int64, len(c.thresholds))
+	for sec, v := range c.total {
+		n += v
+		for i, u := range c.under[sec] {
+			under[i] += u
+		}
+	}
+	ps := make([]float64, len(c.thresholds))
+	if n == 0 {
+		return ps
+	}
+	for i := range under {
+		ps[i] = 100 * float64(under[i]) / float64(n)
+	}
+	return ps
+}
+
+// sloFractionColumn returns the per-second column name of a threshold.
+func sloFractionColumn(ms int64) string {
+	return fmt.Sprintf("LATENCY-UNDER-%dMS-FRACTION", ms)
+}
+
+// SLOComplianceColumnPrefix is the prefix of summary columns
+// with the percentage of requests under each latency threshold.
+const SLOComplianceColumnPrefix = "SLO-COMPLIANCE-UNDER-"
+
+func sloComplianceColumn(ms int64) string {
+	return fmt.Sprintf("%s%dMS", SLOComplianceColumnPrefix, ms)
+}
@@ -0,0 +1,65 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSLOCounter(t *testing.T) {
+	if newSLOCounter(nil) != nil {
+		t.Fatal("expected nil counter without thresholds")
+	}
+
+	c := newSLOCounter([]int64{10, 100})
+	now := time.Unix(100, 0)
+	c.observe(now, 5*time.Millisecond, nil)
+	c.observe(now, 50*time.Millisecond, nil)
+	c.observe(now, 500*time.Millisecond, nil)
+	c.observe(now.Add(time.Second), 10*time.Millisecond, nil)
+
+	fs, ok := c.fractions(100)
+	if !ok {
+		t.Fatal("expected requests at second 100")
+	}
+	if exp := []float64{1.0 / 3, 2.0 / 3}; !reflect.DeepEqual(fs, exp) {
+		t.Fatalf("expected %v, got %v", exp, fs)
+	}
+	if _, ok = c.fractions(102); ok {
+		t.Fatal("expected no request at second 102")
+	}
+	if exp, ps := []float64{50, 75}, c.compliance(); !reflect.DeepEqual(ps, exp) {
+		t.Fatalf("expected %v, got %v", exp, ps)
+	}
+
+	other := newSLOCounter([]int64{10, 100})
+	other.observe(now, time.Second, nil)
+	c.merge(other)
+	if exp, ps := []float64{40, 60}, c.compliance(); !reflect.DeepEqual(ps, exp) {
+		t.Fatalf("expected %v, got %v", exp, ps)
+	}
+
+	// fast errors are not under any threshold
+	c.observe(now.Add(time.Second), time.Millisecond, errors.New("timeout"))
+	if exp, ps := []float64{100.0 / 3, 50}, c.compliance(); !reflect.DeepEqual(ps, exp) {
+		t.Fatalf("expected %v, got %v", exp, ps)
+	}
+	if fs, _ := c.fractions(101); !reflect.DeepEqual(fs, []float64{0.5, 0.5}) {
+		t.Fatalf("expected half of second 101 under thresholds, got %v", fs)
+	}
+}
@@ -0,0 +1,349 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/hdrhistogram"
+	"github.com/coreos/etcd/pkg/report"
+
+	"golang.org/x/net/context"
+)
+
+// SoakRollupColumns are the columns of soak rollups,
+// one row per interval.
+var SoakRollupColumns = []string{
+	"START-UNIX-SECOND",
+	"END-UNIX-SECOND",
+	"REQUESTS",
+	"ERRORS",
+	"AVG-THROUGHPUT",
+	"AVG-LATENCY-MS",
+	"P50-LATENCY-MS",
+	"P90-LATENCY-MS",
+	"P99-LATENCY-MS",
+	"P99.9-LATENCY-MS",
+	"MAX-LATENCY-MS",
+}
+
+// soakRollup summarizes the results of every interval in soak runs.
+// Each summary is appended to the rollup CSV as soon as it is rolled up,
+// so that the summaries of interrupted runs are kept.
+type soakRollup struct {
+	interval time.Duration
+	truncate bool
+
+	mu       sync.Mutex
+	start    time.Time
+	requests int64
+	errors   int64
+	latency  *hdrhistogram.Histogram
+
+	f *os.File
+	w *csv.Writer
+}
+
+// activeSoak is the soak rollup of the database being stressed, if any.
+var activeSoak *soakRollup
+
+func newSoakRollup(fpath string, cfg *dbtesterpb.ConfigClientMachineSoak) (*soakRollup, error) {
+	if fpath == "" {
+		return nil, fmt.Errorf("'soak' requires 'client_soak_rollup_path'")
+	}
+	h, err := hdrhistogram.New(histogramLowestMicrosecond, histogramHighestMicrosecond, histogramSignificantFigures)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Create(fpath)
+	if err != nil {
+		return nil, err
+	}
+	r := &soakRollup{
+		interval: time.Duration(cfg.RollupMinutes) * time.Minute,
+		truncate: cfg.TruncateRawData,
+		start:    time.Now(),
+		latency:  h,
+		f:        f,
+		w:        csv.NewWriter(f),
+	}
+	if err = r.w.Write(SoakRollupColumns); err != nil {
+		f.Close()
+		return nil, err
+	}
+	r.w.Flush()
+	return r, r.w.Error()
+}
+
+// observe records one request.
+func (r *soakRollup) observe(took time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests++
+	if err != nil {
+		r.errors++
+		return
+	}
+	us := int64(took / time.Microsecond)
+	if us > histogramHighestMicrosecond {
+		us = histogramHighestMicrosecond
+	}
+	r.latency.RecordValue(us)
+}
+
+// run rolls up every interval until the context is canceled,
+// and then rolls up the last partial interval. The returned
+// channel is closed after the last rollup is saved.
+func (r *soakRollup) run(ctx context.Context) <-chan struct{} {
+	plog.Infof("rolling up soak summaries every %v (truncate raw data %v)", r.interval, r.truncate)
+	donec := make(chan struct{})
+	go func() {
+		defer close(donec)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case now := <-ticker.C:
+				if err := r.rollup(now); err != nil {
+					plog.Warningf("failed to save soak rollup (%v)", err)
+				}
+			case <-ctx.Done():
+				if err := r.rollup(time.Now()); err != nil {
+					plog.Warningf("failed to save soak rollup (%v)", err)
+				}
+				return
+			}
+		}
+	}()
+	return donec
+}
+
+// rollup saves the summary since the last rollup, and resets the counts.
+func (r *soakRollup) rollup(now time.Time) error {
+	r.mu.Lock()
+	row := soakRollupRow(r.start, now, r.requests, r.errors, r.latency)
+	r.start = now
+	r.requests, r.errors = 0, 0
+	r.latency.Reset()
+	r.mu.Unlock()
+
+	if err := r.w.Write(row); err != nil {
+		return err
+	}
+	r.w.Flush()
+	if err := r.w.Error(); err != nil {
+		return err
+	}
+	if err := r.f.Sync(); err != nil {
+		return err
+	}
+	plog.Infof("soak rollup saved [requests: %s | errors: %s | average throughput: %s | p99 latency: %s ms]", row[2], row[3], row[4], row[8])
+
+	if r.truncate {
+		if requestLog != nil {
+			if err := requestLog.truncate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func soakRollupRow(start, end time.Time, requests, errors int64, h *hdrhistogram.Histogram) []string {
+	ms := func(us int64) string { return fmt.Sprintf("%.4f", float64(us)/1000) }
+	throughput := 0.0
+	if d := end.Sub(start).Seconds(); d > 0 {
+		throughput = float64(requests-errors) / d
+	}
+	return []string{
+		fmt.Sprintf("%d", start.Unix()),
+		fmt.Sprintf("%d", end.Unix()),
+		fmt.Sprintf("%d", requests),
+		fmt.Sprintf("%d", errors),
+		fmt.Sprintf("%.4f", throughput),
+		fmt.Sprintf("%.4f", h.Mean()/1000),
+		ms(h.ValueAtQuantile(50)),
+		ms(h.ValueAtQuantile(90)),
+		ms(h.ValueAtQuantile(99)),
+		ms(h.ValueAtQuantile(99.9)),
+		ms(h.Max()),
+	}
+}
+
+// Close closes the rollup CSV.
+func (r *soakRollup) Close() error {
+	return r.f.Close()
+}
+
+// soakTruncateSupported returns true if the benchmark saves its results
+// in one report, without combining the latencies of multiple reports,
+// so that soak runs can truncate raw data.
+func soakTruncateSupported(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) bool {
+	switch {
+	case opts.Type != "write" && opts.Type != "read",
+		len(opts.ConnectionClientNumbers) > 0,
+		len(opts.TenantGroups) > 0,
+		opts.ThroughputCeiling != nil,
+		len(opts.BatchSizes) > 0,
+		len(opts.RangeSizes) > 0,
+		len(opts.RequestTimeoutsMs) > 0:
+		return false
+	}
+	return true
+}
+
+// soakReport is the report of soak runs that truncate raw data. Unlike
+// report.NewReportSample, it does not keep every request latency: the
+// summary and percentiles come from a histogram of latencies, and the
+// timeseries from per-second counts.
+type soakReport struct {
+	results chan report.Result
+
+	stats   report.Stats
+	sumSq   float64
+	latency *hdrhistogram.Histogram
+	seconds map[int64]report.DataPoint
+}
+
+func newSoakReport() *soakReport {
+	h, _ := hdrhistogram.New(histogramLowestMicrosecond, histogramHighestMicrosecond, histogramSignificantFigures)
+	return &soakReport{
+		results: make(chan report.Result, 16),
+		stats:   report.Stats{ErrorDist: make(map[string]int)},
+		latency: h,
+		seconds: make(map[int64]report.DataPoint),
+	}
+}
+
+func (r *soakReport) Results() chan<- report.Result { return r.results }
+
+func (r *soakReport) Run() <-chan string {
+	donec := make(chan string, 1)
+	go func() {
+		defer close(donec)
+		r.processResults()
+		donec <- fmt.Sprintf("Requests/sec: %4.4f", r.stats.RPS)
+	}()
+	return donec
+}
+
+// Stats returns the stats without 'Lats'.
+func (r *soakReport) Stats() <-chan report.Stats {
+	donec := make(chan report.Stats, 1)
+	go func() {
+		defer close(donec)
+		r.processResults()
+		donec <- r.stats
+	}()
+	return donec
+}
+
+func (r *soakReport) processResults() {
+	st := time.Now()
+	var n int64
+	for res := range r.results {
+		if res.Err != nil {
+			r.stats.ErrorDist[res.Err.Error()]++
+			continue
+		}
+		dur := res.Duration()
+		sec := dur.Seconds()
+		n++
+		r.stats.AvgTotal += sec
+		r.sumSq += sec * sec
+		if n == 1 || sec < r.stats.Fastest {
+			r.stats.Fastest = sec
+		}
+		if sec > r.stats.Slowest {
+			r.stats.Slowest = sec
+		}
+		us := int64(dur / time.Microsecond)
+		if us > histogramHighestMicrosecond {
+			us = histogramHighestMicrosecond
+		}
+		r.latency.RecordValue(us)
+
+		// AvgLatency is the sum until all results are processed
+		ts := res.Start.Unix()
+		dp := r.seconds[ts]
+		if dp.ThroughPut == 0 || (dur != 0 && dur < dp.MinLatency) {
+			dp.MinLatency = dur
+		}
+		if dur > dp.MaxLatency {
+			dp.MaxLatency = dur
+		}
+		dp.AvgLatency += dur
+		dp.ThroughPut++
+		r.seconds[ts] = dp
+	}
+	r.stats.Total = time.Since(st)
+
+	if n > 0 {
+		r.stats.RPS = float64(n) / r.stats.Total.Seconds()
+		r.stats.Average = r.stats.AvgTotal / float64(n)
+		if v := r.sumSq/float64(n) - r.stats.Average*r.stats.Average; v > 0 {
+			r.stats.Stddev = math.Sqrt(v)
+		}
+	}
+	r.stats.TimeSeries = soakTimeSeries(r.seconds)
+}
+
+// soakTimeSeries returns the per-second points sorted by time, with
+// empty points in the missing seconds as report.NewReportSample does.
+func soakTimeSeries(seconds map[int64]report.DataPoint) report.TimeSeries {
+	if len(seconds) == 0 {
+		return nil
+	}
+	min, max := int64(math.MaxInt64), int64(math.MinInt64)
+	for ts := range seconds {
+		if ts < min {
+			min = ts
+		}
+		if ts > max {
+			max = ts
+		}
+	}
+	tss := make(report.TimeSeries, 0, max-min+1)
+	for ts := min; ts <= max; ts++ {
+		dp := seconds[ts]
+		dp.Timestamp = ts
+		if dp.ThroughPut > 0 {
+			dp.AvgLatency /= time.Duration(dp.ThroughPut)
+		}
+		tss = append(tss, dp)
+	}
+	return tss
+}
+
+// percentiles returns the latency percentiles in seconds,
+// as report.Percentiles does with all latencies.
+func (r *soakReport) percentiles() (pctls, seconds []float64) {
+	pctls, _ = report.Percentiles(nil)
+	seconds = make([]float64, len(pctls))
+	if r.latency.TotalCount() == 0 {
+		return pctls, seconds
+	}
+	for i, p := range pctls {
+		seconds[i] = float64(r.latency.ValueAtQuantile(p)) / float64(time.Second/time.Microsecond)
+	}
+	return pctls, seconds
+}
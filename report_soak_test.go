@@ -0,0 +1,107 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/table"
+	"github.com/coreos/etcd/pkg/report"
+)
+
+func TestSoakRollup(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "soak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "soak.csv")
+	r, err := newSoakRollup(fpath, &dbtesterpb.ConfigClientMachineSoak{RollupMinutes: 1, TruncateRawData: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	start := time.Unix(100, 0)
+	r.start = start
+
+	for i := 1; i <= 4; i++ {
+		r.observe(time.Duration(i)*10*time.Millisecond, nil)
+	}
+	r.observe(time.Second, errors.New("timeout"))
+	if err = r.rollup(start.Add(3 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err = r.rollup(start.Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	tb, err := table.ReadCSV(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tb.Rows) != 2 {
+		t.Fatalf("expected 2 rollups, got %d", len(tb.Rows))
+	}
+	exp := []string{"100", "103", "5", "1", "1.3333", "25.0090", "20.0150", "40.0310", "40.0310", "40.0310", "40.0310"}
+	for i, v := range exp {
+		if tb.Rows[0][i] != v {
+			t.Fatalf("%s: expected %q, got %q", SoakRollupColumns[i], v, tb.Rows[0][i])
+		}
+	}
+	if tb.Rows[1][2] != "0" {
+		t.Fatalf("expected no request in second rollup, got %q", tb.Rows[1][2])
+	}
+}
+
+func TestSoakReport(t *testing.T) {
+	r := newSoakReport()
+	statsc := r.Stats()
+	start := time.Unix(100, 0)
+	for i, ms := range []int64{10, 20, 30} {
+		st := start.Add(time.Duration(i) * 2 * time.Second)
+		r.Results() <- report.Result{Start: st, End: st.Add(time.Duration(ms) * time.Millisecond)}
+	}
+	r.Results() <- report.Result{Start: start, End: start, Err: errors.New("fail")}
+	close(r.Results())
+	st := <-statsc
+
+	if st.Lats != nil {
+		t.Fatalf("expected no latency kept, got %v", st.Lats)
+	}
+	if st.Fastest != 0.01 || st.Slowest != 0.03 || math.Abs(st.Average-0.02) > 1e-9 || st.ErrorDist["fail"] != 1 {
+		t.Fatalf("unexpected stats %+v", st)
+	}
+	if math.Abs(st.Stddev-math.Sqrt(2.0/3)/100) > 1e-9 {
+		t.Fatalf("unexpected stddev %f", st.Stddev)
+	}
+	// missing seconds are filled
+	if len(st.TimeSeries) != 5 || st.TimeSeries[1].ThroughPut != 0 || st.TimeSeries[2].AvgLatency != 20*time.Millisecond {
+		t.Fatalf("unexpected timeseries %v", st.TimeSeries)
+	}
+	pctls, seconds := r.percentiles()
+	for i, p := range pctls {
+		if p == 50 && math.Abs(seconds[i]-0.02) > 0.0001 {
+			t.Fatalf("expected p50 20ms, got %f", seconds[i])
+		}
+	}
+}
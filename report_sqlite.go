@@ -0,0 +1,214 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/dbtester/pkg/table"
+)
+
+// resultTable is a result CSV file to write into the result database.
+type resultTable struct {
+	name  string
+	fpath string
+}
+
+// resultTables returns the per-second samples and summaries of the run,
+// saved in the client machine, including the server system metrics
+// streamed from agents and the nemesis events.
+func (cfg *Config) resultTables(databaseID string) []resultTable {
+	ci := cfg.ConfigClientMachineInitial
+	tables := []resultTable{
+		{"client_system_metrics", ci.ClientSystemMetricsInterpolatedPath},
+		{"client_latency_throughput_timeseries", ci.ClientLatencyThroughputTimeseriesPath},
+		{"client_latency_distribution_summary", ci.ClientLatencyDistributionSummaryPath},
+		{"client_latency_distribution_percentile", ci.ClientLatencyDistributionPercentilePath},
+		{"client_latency_by_key_number", ci.ClientLatencyByKeyNumberPath},
+		{"server_disk_space_usage_summary", ci.ServerDiskSpaceUsageSummaryPath},
+		{"client_throughput_ceiling", ci.ClientThroughputCeilingPath},
+		{"client_watch_events", ci.ClientWatchEventsPath},
+		{"client_lease_expiry", ci.ClientLeaseExpiryPath},
+		{"client_batch_writes", ci.ClientBatchWritesPath},
+		{"client_rolling_restart", ci.ClientRollingRestartPath},
+		{"client_range_latency", ci.ClientRangeLatencyPath},
+		{"client_soak_rollup", ci.ClientSoakRollupPath},
+		{"client_lease_expiry_accuracy", ci.ClientLeaseExpiryAccuracyPath},
+		{"client_request_timeouts", ci.ClientRequestTimeoutsPath},
+		{"client_connection_events", ci.ClientConnectionEventsPath},
+		{"nemesis_events", ci.NemesisEventsPath},
+	}
+	if ci.ServerSystemMetricsPath != "" && ci.ServerSystemMetricsInterpolatedPath != "" {
+		for i := range cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].AgentEndpoints {
+			_, interpolatedPath := cfg.ServerSystemMetricsPaths(databaseID, i)
+			tables = append(tables, resultTable{fmt.Sprintf("server_%d_system_metrics", i+1), interpolatedPath})
+		}
+	}
+	return tables
+}
+
+// CheckResultDatabase returns an error if the result database is enabled
+// but 'sqlite3' command is not found, so that the run fails before
+// the benchmark, not after.
+func (cfg *Config) CheckResultDatabase() error {
+	if cfg.ConfigClientMachineInitial.ClientResultDatabasePath == "" {
+		return nil
+	}
+	if _, err := exec.LookPath("sqlite3"); err != nil {
+		return fmt.Errorf("'client_result_database_path' requires 'sqlite3' command (%v)", err)
+	}
+	return nil
+}
+
+// SaveResultDatabase writes all result CSV files of the run into
+// a single SQLite database, one table per file. It requires 'sqlite3'
+// command in the client machine. Existing database is overwritten.
+// Values are anonymized when writing, if enabled, since the database
+// file is binary.
+func (cfg *Config) SaveResultDatabase(databaseID string) error {
+	dbPath := cfg.ConfigClientMachineInitial.ClientResultDatabasePath
+	if dbPath == "" {
+		return nil
+	}
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+
+	anonymizeRows := func(rows [][]string) {}
+	if cfg.ConfigClientMachineInitial.Anonymize {
+		an := cfg.newAnonymizer(gcfg)
+		anonymizeRows = func(rows [][]string) {
+			for _, row := range rows {
+				for i := range row {
+					row[i] = an.String(row[i])
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("BEGIN TRANSACTION;\n")
+	runRows := [][]string{
+		{"DATABASE-ID", databaseID},
+		{"DATABASE-TAG", gcfg.DatabaseTag},
+		{"DATABASE-DESCRIPTION", gcfg.DatabaseDescription},
+		{"RUN-ID", cfg.ConfigClientMachineInitial.RunID},
+		{"TEST-TITLE", cfg.TestTitle},
+		{"BENCHMARK-TYPE", gcfg.ConfigClientMachineBenchmarkOptions.Type},
+	}
+	anonymizeRows(runRows)
+	writeSQLTable(&buf, "run", []string{"KEY", "VALUE"}, runRows)
+	if len(cfg.ConfigClientMachineInitial.RunTags) > 0 {
+		tags, err := ParseTags(cfg.ConfigClientMachineInitial.RunTags)
+		if err != nil {
+			return err
+		}
+		var tagRows [][]string
+		for _, s := range FormatTags(tags) {
+			tagRows = append(tagRows, strings.SplitN(s, "=", 2))
+		}
+		writeSQLTable(&buf, "run_tags", []string{"KEY", "VALUE"}, tagRows)
+	}
+	var unitRows [][]string
+	for _, tb := range cfg.resultTables(databaseID) {
+		if tb.fpath == "" || !exist(tb.fpath) {
+			continue
+		}
+		rows, err := readCSVRows(tb.fpath)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		anonymizeRows(rows)
+		writeSQLTable(&buf, tb.name, rows[0], rows[1:])
+
+		units, err := table.ReadUnits(tb.fpath)
+		if err != nil {
+			return err
+		}
+		for _, h := range rows[0] {
+			if u, ok := units[h]; ok {
+				unitRows = append(unitRows, []string{tb.name, h, u})
+			}
+		}
+	}
+	if len(unitRows) > 0 {
+		writeSQLTable(&buf, "column_units", []string{"TABLE", "COLUMN", "UNIT"}, unitRows)
+	}
+	buf.WriteString("COMMIT;\n")
+
+	os.Remove(dbPath)
+	cmd := exec.Command("sqlite3", dbPath)
+	cmd.Stdin = &buf
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("sqlite3 %q failed %v (%s)", dbPath, err, strings.TrimSpace(string(out)))
+	}
+	plog.Infof("saved result database at %q", dbPath)
+	return nil
+}
+
+func readCSVRows(fpath string) ([][]string, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rd := csv.NewReader(f)
+	rd.FieldsPerRecord = -1
+	return rd.ReadAll()
+}
+
+// writeSQLTable writes SQL statements to create the table and insert rows.
+// Columns have no declared type, so numeric values are stored as numbers
+// and the others as text.
+func writeSQLTable(w io.Writer, name string, header []string, rows [][]string) {
+	cols := make([]string, len(header))
+	for i, h := range header {
+		cols[i] = sqlIdent(h)
+	}
+	fmt.Fprintf(w, "CREATE TABLE %s (%s);\n", sqlIdent(name), strings.Join(cols, ", "))
+	for _, row := range rows {
+		vals := make([]string, len(header))
+		for i := range header {
+			vals[i] = "NULL"
+			if i < len(row) && row[i] != "" {
+				vals[i] = sqlValue(row[i])
+			}
+		}
+		fmt.Fprintf(w, "INSERT INTO %s VALUES (%s);\n", sqlIdent(name), strings.Join(vals, ", "))
+	}
+}
+
+func sqlIdent(s string) string {
+	return `"` + strings.Replace(s, `"`, `""`, -1) + `"`
+}
+
+func sqlValue(s string) string {
+	if _, err := strconv.ParseFloat(s, 64); err == nil && !strings.ContainsAny(s, "xXnN") {
+		return s
+	}
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
@@ -0,0 +1,65 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestWriteSQLTable(t *testing.T) {
+	var buf bytes.Buffer
+	writeSQLTable(&buf, "client_latency_throughput_timeseries", []string{"UNIX-SECOND", "AVG-LATENCY-MS", "PAUSED"}, [][]string{
+		{"1500000000", "1.25", "paused"},
+		{"1500000001", "0.5"},
+		{"1500000002", "NaN", "it's"},
+	})
+	exp := `CREATE TABLE "client_latency_throughput_timeseries" ("UNIX-SECOND", "AVG-LATENCY-MS", "PAUSED");
+INSERT INTO "client_latency_throughput_timeseries" VALUES (1500000000, 1.25, 'paused');
+INSERT INTO "client_latency_throughput_timeseries" VALUES (1500000001, 0.5, NULL);
+INSERT INTO "client_latency_throughput_timeseries" VALUES (1500000002, 'NaN', 'it''s');
+`
+	if buf.String() != exp {
+		t.Fatalf("expected\n%s\ngot\n%s", exp, buf.String())
+	}
+}
+
+func TestResultTables(t *testing.T) {
+	cfg := &Config{
+		DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+			"etcd__tip": {DatabaseTag: "etcd-tip-go1.8.3", AgentEndpoints: []string{"10.0.0.1:3500", "10.0.0.2:3500"}},
+		},
+	}
+	cfg.ConfigClientMachineInitial.NemesisEventsPath = "nemesis-events.csv"
+	cfg.ConfigClientMachineInitial.ServerSystemMetricsPath = "server-system-metrics.csv"
+	cfg.ConfigClientMachineInitial.ServerSystemMetricsInterpolatedPath = "server-system-metrics-interpolated.csv"
+
+	name2path := make(map[string]string)
+	for _, tb := range cfg.resultTables("etcd__tip") {
+		name2path[tb.name] = tb.fpath
+	}
+	exp := map[string]string{
+		"nemesis_events":          "nemesis-events.csv",
+		"server_1_system_metrics": "etcd-tip-go1.8.3-1-server-system-metrics-interpolated.csv",
+		"server_2_system_metrics": "etcd-tip-go1.8.3-2-server-system-metrics-interpolated.csv",
+	}
+	for name, fpath := range exp {
+		if name2path[name] != fpath {
+			t.Fatalf("%q: expected %q, got %q", name, fpath, name2path[name])
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/coreos/dbtester/pkg/checksum"
+	"github.com/coreos/dbtester/pkg/fileutil"
+)
+
+// WriteResultChecksums writes SHA-256 checksums of all client-side
+// result files of the run, next to the files. The log is excluded,
+// since it is still being written; its checksum is uploaded with it.
+func (cfg *Config) WriteResultChecksums() error {
+	n := 0
+	for _, p := range cfg.clientResultPaths() {
+		if *p == "" || *p == cfg.ConfigClientMachineInitial.LogPath || !exist(*p) {
+			continue
+		}
+		if err := checksum.Write(*p); err != nil {
+			return err
+		}
+		n++
+	}
+	plog.Infof("wrote checksums of %d result files", n)
+	return nil
+}
+
+// snapshotCopy copies 'fpath' in a temporary directory, and
+// returns its path and the function to remove it.
+func snapshotCopy(fpath string) (string, func(), error) {
+	dir, err := ioutil.TempDir("", "dbtester-snapshot")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+	dst := filepath.Join(dir, filepath.Base(fpath))
+	if err = fileutil.Copy(fpath, dst); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to copy %q (%v)", fpath, err)
+	}
+	return dst, cleanup, nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/gyuho/dataframe"
+)
+
+// RollingRestartColumns are the columns of throughput dips,
+// one row per server restart in 'rolling_restart'.
+var RollingRestartColumns = []string{
+	"TARGET-INDEX",
+	"STOP-UNIX-SECOND",
+	"RESTART-UNIX-SECOND",
+	"BASELINE-THROUGHPUT",
+	"MIN-THROUGHPUT",
+	"DIP-DEPTH-PERCENT",
+	"DIP-DURATION-SECONDS",
+	"RECOVERED",
+}
+
+const (
+	// rollingRestartBaselineSeconds is the number of seconds before each
+	// stop, to average the baseline throughput.
+	rollingRestartBaselineSeconds = 10
+	// rollingRestartRecoveryRatio is the fraction of the baseline throughput,
+	// below which the throughput is in the dip.
+	rollingRestartRecoveryRatio = 0.9
+)
+
+// rollingRestartSteps returns the nemesis steps to stop, and restart,
+// each of the servers in order.
+func rollingRestartSteps(rr *dbtesterpb.ConfigRollingRestart, serverN int) []*dbtesterpb.ConfigNemesisStep {
+	op := "stop"
+	if rr.Kill {
+		op = "kill"
+	}
+	steps := make([]*dbtesterpb.ConfigNemesisStep, serverN)
+	for i := range steps {
+		steps[i] = &dbtesterpb.ConfigNemesisStep{
+			Operation:       op,
+			TargetIndex:     int64(i),
+			DelaySeconds:    rr.DelaySeconds,
+			DurationSeconds: rr.DownSeconds,
+		}
+	}
+	return steps
+}
+
+// restartDip is the throughput dip of a server restart.
+type restartDip struct {
+	targetIndex   int64
+	stopSecond    int64
+	restartSecond int64
+
+	baseline float64
+	min      float64
+	// depthPercent is the drop of the minimum from the baseline throughput.
+	depthPercent float64
+	// durationSeconds is the time from the stop until the throughput
+	// recovered to the baseline for the rest of the window.
+	durationSeconds int64
+	// recovered is false if the throughput did not recover
+	// before the next restart, or the end of the benchmark.
+	recovered bool
+}
+
+// restartDips returns the throughput dip of each restart from the
+// throughput per second and the nemesis events. Each window starts at
+// a stop, and ends at the next stop or the end of the benchmark.
+// Seconds without any completed request have zero throughput.
+func restartDips(throughput map[int64]float64, events []NemesisEvent) []restartDip {
+	if len(throughput) == 0 {
+		return nil
+	}
+	var lastSecond int64
+	for sec := range throughput {
+		if sec > lastSecond {
+			lastSecond = sec
+		}
+	}
+
+	var dips []restartDip
+	for i, ev := range events {
+		if ev.Recover || ev.Error != "" {
+			continue
+		}
+		dip := restartDip{targetIndex: ev.TargetIndex, stopSecond: ev.UnixNanosecond / 1e9}
+		end := lastSecond + 1
+		for _, next := range events[i+1:] {
+			sec := next.UnixNanosecond / 1e9
+			if !next.Recover && next.Error == "" {
+				if sec < end {
+					end = sec
+				}
+				break
+			}
+			if next.TargetIndex == ev.TargetIndex && dip.restartSecond == 0 {
+				dip.restartSecond = sec
+			}
+		}
+
+		var sum float64
+		var n int
+		for sec := dip.stopSecond - rollingRestartBaselineSeconds; sec < dip.stopSecond; sec++ {
+			if v, ok := throughput[sec]; ok {
+				sum += v
+				n++
+			}
+		}
+		if n == 0 || sum == 0 || end <= dip.stopSecond {
+			plog.Warningf("no throughput around stop of server %d at %d", ev.TargetIndex, dip.stopSecond)
+			continue
+		}
+		dip.baseline = sum / float64(n)
+
+		dip.min = dip.baseline
+		lastBelow := int64(-1)
+		for sec := dip.stopSecond; sec < end; sec++ {
+			v := throughput[sec]
+			if v < dip.min {
+				dip.min = v
+			}
+			if v < dip.baseline*rollingRestartRecoveryRatio {
+				lastBelow = sec
+			}
+		}
+		dip.depthPercent = 100 * (dip.baseline - dip.min) / dip.baseline
+		dip.recovered = lastBelow < end-1
+		if lastBelow >= 0 {
+			dip.durationSeconds = lastBelow - dip.stopSecond + 1
+		}
+		dips = append(dips, dip)
+	}
+	return dips
+}
+
+// SaveRollingRestartDips saves the throughput dip of each server restart
+// at 'client_rolling_restart_path', from the latency throughput timeseries
+// of the benchmark and the nemesis events of the restarts.
+func (cfg *Config) SaveRollingRestartDips(events []NemesisEvent) error {
+	fpath := cfg.ConfigClientMachineInitial.ClientRollingRestartPath
+	if fpath == "" {
+		return nil
+	}
+	tdf, err := dataframe.NewFromCSV(nil, cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath)
+	if err != nil {
+		return err
+	}
+	secCol, err := tdf.Column("UNIX-SECOND")
+	if err != nil {
+		return err
+	}
+	thrCol, err := tdf.Column("AVG-THROUGHPUT")
+	if err != nil {
+		return err
+	}
+	throughput := make(map[int64]float64)
+	for i := 0; i < secCol.Count() && i < thrCol.Count(); i++ {
+		sv, err := secCol.Value(i)
+		if err != nil {
+			return err
+		}
+		sec, ok := sv.Int64()
+		if !ok {
+			return fmt.Errorf("cannot Int64 %v", sv)
+		}
+		tv, err := thrCol.Value(i)
+		if err != nil {
+			return err
+		}
+		fv, _ := tv.Float64()
+		throughput[sec] += fv
+	}
+
+	dips := restartDips(throughput, events)
+	sort.Slice(dips, func(i, j int) bool { return dips[i].stopSecond < dips[j].stopSecond })
+
+	cols := make([]dataframe.Column, len(RollingRestartColumns))
+	for i, h := range RollingRestartColumns {
+		cols[i] = dataframe.NewColumn(h)
+	}
+	for _, d := range dips {
+		plog.Infof("restart of server %d: throughput dropped %.2f %% from %.2f to %.2f requests/s for %d second(s) (recovered: %v)", d.targetIndex, d.depthPercent, d.baseline, d.min, d.durationSeconds, d.recovered)
+		vs := []interface{}{
+			d.targetIndex,
+			d.stopSecond,
+			d.restartSecond,
+			fmt.Sprintf("%.2f", d.baseline),
+			fmt.Sprintf("%.2f", d.min),
+			fmt.Sprintf("%.2f", d.depthPercent),
+			d.durationSeconds,
+			d.recovered,
+		}
+		for i, v := range vs {
+			cols[i].PushBack(dataframe.NewStringValue(v))
+		}
+	}
+	fr := dataframe.New()
+	for _, col := range cols {
+		if err = fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	if err = fr.CSV(fpath); err != nil {
+		return err
+	}
+	plog.Infof("CSV saved at %q", fpath)
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestRollingRestartSteps(t *testing.T) {
+	steps := rollingRestartSteps(&dbtesterpb.ConfigRollingRestart{DelaySeconds: 30, DownSeconds: 5, Kill: true}, 3)
+	if len(steps) != 3 {
+		t.Fatalf("expected 3 steps, got %d", len(steps))
+	}
+	for i, step := range steps {
+		exp := &dbtesterpb.ConfigNemesisStep{Operation: "kill", TargetIndex: int64(i), DelaySeconds: 30, DurationSeconds: 5}
+		if !reflect.DeepEqual(step, exp) {
+			t.Fatalf("#%d: expected %+v, got %+v", i, exp, step)
+		}
+	}
+	if op := rollingRestartSteps(&dbtesterpb.ConfigRollingRestart{}, 1)[0].Operation; op != "stop" {
+		t.Fatalf("expected 'stop', got %q", op)
+	}
+}
+
+func TestRestartDips(t *testing.T) {
+	throughput := make(map[int64]float64)
+	for sec := int64(100); sec < 140; sec++ {
+		throughput[sec] = 1000
+	}
+	// server 0 stopped at 110, restarted at 115: throughput drops
+	// to 400 for 2 seconds, and no request completes in 112
+	throughput[110] = 400
+	throughput[111] = 950
+	delete(throughput, 112)
+	// server 1 stopped at 125, and never recovers
+	for sec := int64(126); sec < 140; sec++ {
+		throughput[sec] = 500
+	}
+	events := []NemesisEvent{
+		{UnixNanosecond: 110e9, Operation: "stop", TargetIndex: 0},
+		{UnixNanosecond: 115e9, Operation: "stop", TargetIndex: 0, Recover: true},
+		{UnixNanosecond: 125e9, Operation: "stop", TargetIndex: 1},
+		{UnixNanosecond: 130e9, Operation: "stop", TargetIndex: 1, Recover: true},
+		// failed stop is not a restart
+		{UnixNanosecond: 135e9, Operation: "stop", TargetIndex: 2, Error: "unavailable"},
+	}
+	dips := restartDips(throughput, events)
+	exp := []restartDip{
+		{targetIndex: 0, stopSecond: 110, restartSecond: 115, baseline: 1000, min: 0, depthPercent: 100, durationSeconds: 3, recovered: true},
+		{targetIndex: 1, stopSecond: 125, restartSecond: 130, baseline: 1000, min: 500, depthPercent: 50, durationSeconds: 15, recovered: false},
+	}
+	if !reflect.DeepEqual(dips, exp) {
+		t.Fatalf("expected %+v, got %+v", exp, dips)
+	}
+}
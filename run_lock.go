@@ -0,0 +1,202 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// AutoRunID is the 'run_id' to generate a unique run ID for each run.
+const AutoRunID = "auto"
+
+var nonRunIDChars = regexp.MustCompile(`[^a-zA-Z0-9-]+`)
+
+// NewRunID returns a unique run ID with the start time and the host name
+// (e.g. '20171016-153000-control-1-3f2a9c'), so that runs from multiple
+// control nodes never share result directories.
+func NewRunID(now time.Time) string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	host = strings.Trim(nonRunIDChars.ReplaceAllString(strings.SplitN(host, ".", 2)[0], "-"), "-")
+	b := make([]byte, 3)
+	if _, err = rand.Read(b); err != nil {
+		// fall back to nanoseconds, unique enough in one host
+		return fmt.Sprintf("%s-%s-%d", now.Format("20060102-150405"), host, now.UnixNano()%1000000)
+	}
+	return fmt.Sprintf("%s-%s-%s", now.Format("20060102-150405"), host, hex.EncodeToString(b))
+}
+
+const resultLockName = ".dbtester.lock"
+
+// LockResultLayout locks the result directory of the database in the run,
+// '<path_prefix>/<run_id>/<database_tag>', so that only one benchmark writes
+// results there at a time. It refuses to overwrite existing client results
+// of the database, unless 'force' is true, which removes them first. Stale
+// locks of exited processes on this host are removed, and 'force' breaks
+// any lock. It returns the function to unlock. If 'run_id' is empty, all
+// databases write results to the same client paths, so the directory of
+// those paths is locked instead, and existing results there are only
+// overwritten with 'force'.
+func (cfg *Config) LockResultLayout(databaseID string, force bool) (unlock func(), err error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return nil, fmt.Errorf("%q does not exist", databaseID)
+	}
+	runID := cfg.ConfigClientMachineInitial.RunID
+	if runID == "" {
+		dir := filepath.Dir(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)
+		if err = os.MkdirAll(dir, 0777); err != nil {
+			return nil, err
+		}
+		if unlock, err = lockDir(dir, fmt.Sprintf("results in %q", dir), force); err != nil {
+			return nil, err
+		}
+		for _, fpath := range []string{
+			cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath,
+			cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath,
+		} {
+			if fpath == "" || !exist(fpath) {
+				continue
+			}
+			if !force {
+				unlock()
+				return nil, fmt.Errorf("%q already has results of a previous run (use '--force' to overwrite, or 'run_id: %s' to save results of each run separately)", fpath, AutoRunID)
+			}
+			plog.Warningf("overwriting existing results %q", fpath)
+		}
+		return unlock, nil
+	}
+
+	dir := filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, runID, gcfg.DatabaseTag)
+	if err = os.MkdirAll(dir, 0777); err != nil {
+		return nil, err
+	}
+	if unlock, err = lockDir(dir, fmt.Sprintf("results of %q in run %q", databaseID, runID), force); err != nil {
+		return nil, err
+	}
+
+	clientDir := filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, dbtesterpb.ClientResultDir(runID, gcfg.DatabaseTag))
+	fis, err := ioutil.ReadDir(clientDir)
+	if err != nil && !os.IsNotExist(err) {
+		unlock()
+		return nil, err
+	}
+	if len(fis) > 0 {
+		if !force {
+			unlock()
+			return nil, fmt.Errorf("run %q already has results of %q in %q (use '--force' to overwrite)", runID, databaseID, clientDir)
+		}
+		plog.Warningf("removing existing results of %q in run %q", databaseID, runID)
+		if err = removeResults(dir); err != nil {
+			unlock()
+			return nil, err
+		}
+	}
+	return unlock, nil
+}
+
+// lockDir creates the lock file in the directory, where 'what' describes
+// the locked results in errors. Stale locks are removed, and 'force'
+// breaks any lock.
+func lockDir(dir, what string, force bool) (unlock func(), err error) {
+	lockPath := filepath.Join(dir, resultLockName)
+	if err = createLockFile(lockPath); os.IsExist(err) {
+		holder, _ := ioutil.ReadFile(lockPath)
+		switch {
+		case isStaleLock(string(holder)):
+			plog.Warningf("removing stale lock %q (held by %q)", lockPath, strings.TrimSpace(string(holder)))
+		case force:
+			plog.Warningf("breaking lock %q (held by %q)", lockPath, strings.TrimSpace(string(holder)))
+		default:
+			return nil, fmt.Errorf("%s are locked by %q (%q)", what, strings.TrimSpace(string(holder)), lockPath)
+		}
+		if err = os.Remove(lockPath); err != nil {
+			return nil, err
+		}
+		err = createLockFile(lockPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		if rerr := os.Remove(lockPath); rerr != nil {
+			plog.Warningf("failed to unlock %q (%v)", lockPath, rerr)
+		}
+	}, nil
+}
+
+// createLockFile creates the lock file with the process ID, host name,
+// and time, failing with os.ErrExist if the file already exists.
+func createLockFile(fpath string) error {
+	f, err := os.OpenFile(fpath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	host, _ := os.Hostname()
+	if _, err = fmt.Fprintf(f, "%d %s %s\n", os.Getpid(), host, time.Now().Format(time.RFC3339)); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// isStaleLock returns true if the lock is held by a process on this host
+// that has exited. Locks of other hosts (e.g. on network storage) are
+// never stale.
+func isStaleLock(holder string) bool {
+	fields := strings.Fields(holder)
+	if len(fields) < 2 {
+		return false
+	}
+	pid, err := strconv.Atoi(fields[0])
+	if err != nil || pid <= 0 {
+		return false
+	}
+	if host, _ := os.Hostname(); host != fields[1] {
+		return false
+	}
+	return syscall.Kill(pid, syscall.Signal(0)) == syscall.ESRCH
+}
+
+// removeResults removes all results under the directory, except the lock.
+func removeResults(dir string) error {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, fi := range fis {
+		if fi.Name() == resultLockName {
+			continue
+		}
+		if err = os.RemoveAll(filepath.Join(dir, fi.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
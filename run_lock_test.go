@@ -0,0 +1,126 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestNewRunID(t *testing.T) {
+	now := time.Date(2017, time.October, 16, 15, 30, 0, 0, time.UTC)
+	id1, id2 := NewRunID(now), NewRunID(now)
+	if id1 == id2 {
+		t.Fatalf("expected unique run IDs, got %q twice", id1)
+	}
+	if !regexp.MustCompile(`^20171016-153000-[a-zA-Z0-9-]+-[0-9a-f]{6}$`).MatchString(id1) {
+		t.Fatalf("unexpected run ID %q", id1)
+	}
+}
+
+func TestLockResultLayout(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "dbtester-lock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	cfg := &Config{
+		DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+			"etcd__tip": {DatabaseTag: "etcd-tip-go1.8.3"},
+		},
+	}
+	cfg.ConfigClientMachineInitial.PathPrefix = root
+	cfg.ConfigClientMachineInitial.RunID = "run-1"
+
+	unlock, err := cfg.LockResultLayout("etcd__tip", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cfg.LockResultLayout("etcd__tip", false); err == nil || !strings.Contains(err.Error(), "locked") {
+		t.Fatalf("expected lock error, got %v", err)
+	}
+	unlock()
+
+	// existing results are not overwritten without force
+	if err = cfg.ApplyResultLayout("etcd__tip"); err != nil {
+		t.Fatal(err)
+	}
+	result := filepath.Join(root, dbtesterpb.ClientResultDir("run-1", "etcd-tip-go1.8.3"), "timeseries.csv")
+	if err = ioutil.WriteFile(result, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cfg.LockResultLayout("etcd__tip", false); err == nil || !strings.Contains(err.Error(), "already has results") {
+		t.Fatalf("expected existing results error, got %v", err)
+	}
+	lockPath := filepath.Join(root, "run-1", "etcd-tip-go1.8.3", resultLockName)
+	if exist(lockPath) {
+		t.Fatalf("%q must be unlocked after error", lockPath)
+	}
+	if unlock, err = cfg.LockResultLayout("etcd__tip", true); err != nil {
+		t.Fatal(err)
+	}
+	if exist(result) {
+		t.Fatalf("%q must be removed with force", result)
+	}
+	unlock()
+
+	// stale lock of exited process is removed
+	host, _ := os.Hostname()
+	if err = ioutil.WriteFile(lockPath, []byte(fmt.Sprintf("%d %s %s\n", 1<<30, host, time.Now().Format(time.RFC3339))), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if unlock, err = cfg.LockResultLayout("etcd__tip", false); err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+
+	// without run ID, the directory of client results is locked
+	cfg.ConfigClientMachineInitial.RunID = ""
+	cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath = filepath.Join(root, "client-latency-distribution-summary.csv")
+	if unlock, err = cfg.LockResultLayout("etcd__tip", false); err != nil {
+		t.Fatal(err)
+	}
+	if !exist(filepath.Join(root, resultLockName)) {
+		t.Fatalf("%q must be locked without run ID", root)
+	}
+	if _, err = cfg.LockResultLayout("etcd__tip", false); err == nil || !strings.Contains(err.Error(), "locked") {
+		t.Fatalf("expected lock error without run ID, got %v", err)
+	}
+	unlock()
+
+	// without run ID, existing results are not overwritten without force
+	if err = ioutil.WriteFile(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cfg.LockResultLayout("etcd__tip", false); err == nil || !strings.Contains(err.Error(), "previous run") {
+		t.Fatalf("expected existing results error without run ID, got %v", err)
+	}
+	if exist(filepath.Join(root, resultLockName)) {
+		t.Fatalf("%q must be unlocked after error", root)
+	}
+	if unlock, err = cfg.LockResultLayout("etcd__tip", true); err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+}
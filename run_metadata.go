@@ -0,0 +1,239 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"io/ioutil"
+	"sort"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/cpuinfo"
+
+	"gopkg.in/yaml.v2"
+)
+
+// RunMetadata describes how the database under test was built,
+// so that results can be traced back to the exact binary.
+type RunMetadata struct {
+	TestTitle   string `yaml:"test_title"`
+	DatabaseID  string `yaml:"database_id"`
+	DatabaseTag string `yaml:"database_tag"`
+	StartedAt   string `yaml:"started_at"`
+
+	ReleaseVersion string `yaml:"release_version,omitempty"`
+
+	SourceRepository string `yaml:"source_repository,omitempty"`
+	SourceRevision   string `yaml:"source_revision,omitempty"`
+	SourceCommit     string `yaml:"source_commit,omitempty"`
+
+	// Topology is "proxy" if clients connected to ProxyEndpoints,
+	// or "direct" if clients connected to the database.
+	Topology       string   `yaml:"topology"`
+	ProxyEndpoints []string `yaml:"proxy_endpoints,omitempty"`
+
+	// WANTopology is the regions of agents and injected network
+	// conditions between regions, if any.
+	WANTopology *dbtesterpb.ConfigWANTopology `yaml:"wan_topology,omitempty"`
+	// ClientBandwidthMbitPerSecond is the bandwidth limit from the client
+	// machine to database endpoints, 0 if not limited.
+	ClientBandwidthMbitPerSecond float64 `yaml:"client_bandwidth_mbit_per_second,omitempty"`
+	// DiskDelay is the disk latency injected on agents, if any.
+	DiskDelay *dbtesterpb.ConfigDiskDelay `yaml:"disk_delay,omitempty"`
+	// RollingRestart is the restarts of servers while stressing, if any.
+	RollingRestart *dbtesterpb.ConfigRollingRestart `yaml:"rolling_restart,omitempty"`
+	// RandomNemesis is the random faults injected while stressing,
+	// with the seed to replay them, if any.
+	RandomNemesis *dbtesterpb.ConfigRandomNemesis `yaml:"random_nemesis,omitempty"`
+
+	// Seed is the seed of random values and operations,
+	// to rerun the same request sequences.
+	Seed int64 `yaml:"seed"`
+	// KeyOrder is the insertion order of written keys.
+	KeyOrder string `yaml:"key_order"`
+	// KeyPrefix is the prefix of written keys, with the unique
+	// prefix of the run if 'phase_key_prefix' is set.
+	KeyPrefix string `yaml:"key_prefix,omitempty"`
+
+	// Tags are the 'run_tags' of the run, to filter and group runs.
+	Tags map[string]string `yaml:"tags,omitempty"`
+	// DatabaseEnv are the environment variables of the database process,
+	// also added to the tags unless 'run_tags' have the same keys.
+	DatabaseEnv []string `yaml:"database_env,omitempty"`
+
+	// ClientHardware and ServerHardware describe the machines of the run,
+	// so that results from different machines can be roughly normalized.
+	ClientHardware Hardware   `yaml:"client_hardware"`
+	ServerHardware []Hardware `yaml:"server_hardware,omitempty"`
+
+	// ServerConfigs are the command lines and config files of the
+	// database processes launched by agents, in order of agent index.
+	ServerConfigs []ServerConfig `yaml:"server_configs,omitempty"`
+
+	// MonitorIntervalMs is the interval of system metrics samples,
+	// before resampled to one row per second.
+	MonitorIntervalMs int64 `yaml:"monitor_interval_ms"`
+
+	// Failure is the reason the run was marked failed (e.g. the error
+	// budget was exceeded), empty if not failed.
+	Failure string `yaml:"failure,omitempty"`
+
+	// ServerClockOffsets are the clock offsets of agents from the control
+	// node, in order of agent index, to correct server timestamps.
+	ServerClockOffsets []ServerClockOffsets `yaml:"server_clock_offsets,omitempty"`
+}
+
+// Hardware describes the CPUs and disk of a machine.
+type Hardware struct {
+	CPUModel string  `yaml:"cpu_model,omitempty"`
+	CPUCores int64   `yaml:"cpu_cores"`
+	CPUMHz   float64 `yaml:"cpu_mhz"`
+
+	// DiskWriteMBPerSecond is the disk write throughput from the device
+	// spec, given to agent by '--disk-write-mb-per-second'.
+	DiskWriteMBPerSecond float64 `yaml:"disk_write_mb_per_second,omitempty"`
+}
+
+// ServerConfig describes how agent launched the database process.
+type ServerConfig struct {
+	CommandLine string `yaml:"command_line"`
+	// ProxyCommandLine is the command line of the proxy in front
+	// of the database (e.g. zetcd), if any.
+	ProxyCommandLine string `yaml:"proxy_command_line,omitempty"`
+	// ConfigPath and Config are the path and content of the
+	// config file given to the database, if any.
+	ConfigPath string `yaml:"config_path,omitempty"`
+	Config     string `yaml:"config,omitempty"`
+}
+
+// NewRunMetadata returns the run metadata of the database,
+// with release and source configurations.
+func (cfg *Config) NewRunMetadata(databaseID string) RunMetadata {
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	md := RunMetadata{
+		TestTitle:   cfg.TestTitle,
+		DatabaseID:  databaseID,
+		DatabaseTag: gcfg.DatabaseTag,
+		StartedAt:   time.Now().UTC().Format(time.RFC3339),
+
+		Topology:       dbtesterpb.Topology(gcfg),
+		ProxyEndpoints: gcfg.ProxyEndpoints,
+		WANTopology:    gcfg.ConfigWANTopology,
+		Seed:           gcfg.ConfigClientMachineBenchmarkOptions.Seed,
+
+		ClientBandwidthMbitPerSecond: gcfg.ClientBandwidthMbitPerSecond,
+		DiskDelay:                    gcfg.ConfigDiskDelay,
+		RollingRestart:               gcfg.ConfigRollingRestart,
+		RandomNemesis:                gcfg.ConfigRandomNemesis,
+		KeyPrefix:                    gcfg.ConfigClientMachineBenchmarkOptions.KeyPrefix,
+
+		MonitorIntervalMs: int64(dbtesterpb.MonitorInterval(&cfg.ConfigClientMachineInitial) / time.Millisecond),
+	}
+	if md.KeyOrder = gcfg.ConfigClientMachineBenchmarkOptions.KeyOrder; md.KeyOrder == "" {
+		md.KeyOrder = KeyOrderSequential
+	}
+	// validated in ReadConfig
+	md.Tags, _ = ParseTags(cfg.ConfigClientMachineInitial.RunTags)
+	md.DatabaseEnv = gcfg.DatabaseEnv
+	env, _ := ParseDatabaseEnv(gcfg.DatabaseEnv)
+	for k, v := range env {
+		if md.Tags == nil {
+			md.Tags = make(map[string]string)
+		}
+		if _, ok := md.Tags[k]; !ok {
+			md.Tags[k] = v
+		}
+	}
+	if gcfg.ConfigRelease != nil {
+		md.ReleaseVersion = gcfg.ConfigRelease.Version
+	}
+	if gcfg.ConfigSource != nil {
+		md.SourceRepository = gcfg.ConfigSource.Repository
+		md.SourceRevision = gcfg.ConfigSource.Revision
+	}
+	cpu, err := cpuinfo.Read()
+	if err != nil {
+		plog.Warningf("cpuinfo.Read error %v", err)
+	}
+	md.ClientHardware = Hardware{CPUModel: cpu.Model, CPUCores: cpu.Cores, CPUMHz: cpu.MHz}
+	return md
+}
+
+// SetServerHardware sets the server hardware from the responses of
+// agents to 'Start' operation, in order of agent index.
+func (md *RunMetadata) SetServerHardware(resps map[int]dbtesterpb.Response) {
+	idxs := make([]int, 0, len(resps))
+	for idx := range resps {
+		idxs = append(idxs, idx)
+	}
+	sort.Ints(idxs)
+	md.ServerHardware = make([]Hardware, 0, len(idxs))
+	for _, idx := range idxs {
+		r := resps[idx]
+		md.ServerHardware = append(md.ServerHardware, Hardware{
+			CPUModel:             r.CPUModel,
+			CPUCores:             r.CPUCores,
+			CPUMHz:               r.CPUMHz,
+			DiskWriteMBPerSecond: r.DiskWriteMBPerSecond,
+		})
+	}
+}
+
+// SetServerConfigs sets the server command lines and config files from
+// the responses of agents to 'Start' operation, in order of agent index.
+func (md *RunMetadata) SetServerConfigs(resps map[int]dbtesterpb.Response) {
+	idxs := make([]int, 0, len(resps))
+	for idx := range resps {
+		idxs = append(idxs, idx)
+	}
+	sort.Ints(idxs)
+	md.ServerConfigs = make([]ServerConfig, 0, len(idxs))
+	for _, idx := range idxs {
+		r := resps[idx]
+		md.ServerConfigs = append(md.ServerConfigs, ServerConfig{
+			CommandLine:      r.ServerCommandLine,
+			ProxyCommandLine: r.ProxyCommandLine,
+			ConfigPath:       r.ServerConfigPath,
+			Config:           r.ServerConfig,
+		})
+	}
+}
+
+// ReadRunMetadata reads the run metadata saved by SaveRunMetadata.
+func ReadRunMetadata(fpath string) (RunMetadata, error) {
+	var md RunMetadata
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return md, err
+	}
+	err = yaml.Unmarshal(bts, &md)
+	return md, err
+}
+
+// SaveRunMetadata saves the run metadata at 'run_metadata_path' in YAML.
+func (cfg *Config) SaveRunMetadata(md RunMetadata) error {
+	if cfg.ConfigClientMachineInitial.RunMetadataPath == "" {
+		return nil
+	}
+	bts, err := yaml.Marshal(md)
+	if err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(cfg.ConfigClientMachineInitial.RunMetadataPath, bts, 0644); err != nil {
+		return err
+	}
+	plog.Infof("run metadata saved at %q", cfg.ConfigClientMachineInitial.RunMetadataPath)
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// sourceBuild is the default build command and binary path of the database.
+type sourceBuild struct {
+	command    string
+	binaryPath string
+}
+
+var defaultSourceBuilds = map[string]sourceBuild{
+	"etcd__tip":      {command: "./build", binaryPath: "bin/etcd"},
+	"etcd__v3_2":     {command: "./build", binaryPath: "bin/etcd"},
+	"etcd__v3_3":     {command: "./build", binaryPath: "bin/etcd"},
+	"consul__v1_0_2": {command: "make dev", binaryPath: "bin/consul"},
+}
+
+// BuildFromSource checks out the revision in 'source' configuration
+// under the temporary directory, builds the database, and returns the
+// binary with the commit hash that was built.
+func (cfg *Config) BuildFromSource(databaseID string) (bin []byte, commit string, err error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return nil, "", fmt.Errorf("database id %q does not exist", databaseID)
+	}
+	src := gcfg.ConfigSource
+	if src == nil || src.Repository == "" || src.Revision == "" {
+		return nil, "", fmt.Errorf("source repository and revision are not defined for %q", databaseID)
+	}
+	build := defaultSourceBuilds[gcfg.DatabaseID]
+	if src.BuildCommand != "" {
+		build.command = src.BuildCommand
+	}
+	if src.BinaryPath != "" {
+		build.binaryPath = src.BinaryPath
+	}
+	if build.command == "" || build.binaryPath == "" {
+		return nil, "", fmt.Errorf("build command and binary path are not defined for %q", databaseID)
+	}
+
+	dir := filepath.Join(os.TempDir(), "dbtester-source", databaseID)
+	if !exist(filepath.Join(dir, ".git")) {
+		if err = os.MkdirAll(filepath.Dir(dir), 0777); err != nil {
+			return nil, "", err
+		}
+		if _, err = runIn("", "git", "clone", src.Repository, dir); err != nil {
+			return nil, "", err
+		}
+	}
+	// FETCH_HEAD works for commits, branches, and tags
+	if _, err = runIn(dir, "git", "fetch", src.Repository, src.Revision); err != nil {
+		return nil, "", err
+	}
+	if _, err = runIn(dir, "git", "checkout", "--force", "FETCH_HEAD"); err != nil {
+		return nil, "", err
+	}
+	if commit, err = runIn(dir, "git", "rev-parse", "HEAD"); err != nil {
+		return nil, "", err
+	}
+	plog.Infof("building %q at %s (%s)", src.Repository, commit, src.Revision)
+
+	if _, err = runIn(dir, "sh", "-c", build.command); err != nil {
+		return nil, "", err
+	}
+	bin, err = ioutil.ReadFile(filepath.Join(dir, build.binaryPath))
+	if err != nil {
+		return nil, "", err
+	}
+	plog.Infof("built %q (%d bytes)", filepath.Join(dir, build.binaryPath), len(bin))
+	return bin, commit, nil
+}
+
+// InstallFromSource builds the database from source, installs it
+// on all agents, and returns the commit hash that was built.
+func (cfg *Config) InstallFromSource(databaseID string) (string, error) {
+	bin, commit, err := cfg.BuildFromSource(databaseID)
+	if err != nil {
+		return "", err
+	}
+	return commit, cfg.installOnAgents(databaseID, commit, bin)
+}
+
+// runIn runs the command in the directory, and returns the trimmed output.
+func runIn(dir string, name string, args ...string) (string, error) {
+	plog.Infof("running %s %s", name, strings.Join(args, " "))
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%s %s failed %v (%q)", name, strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,199 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/gyuho/linux-inspect/inspect"
+	"google.golang.org/grpc"
+)
+
+// ServerSystemMetricsPaths returns the paths to save system metrics
+// streamed from the agent at index 'idx', named in the same way as
+// the agent uploads them (e.g. 'etcd-tip-go1.8.3-1-server-system-metrics.csv').
+// With 'run_id', they are saved in the 'server-N' directory of the standard layout.
+func (cfg *Config) ServerSystemMetricsPaths(databaseID string, idx int) (fpath, interpolatedPath string) {
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	rename := func(p string) string {
+		if cfg.ConfigClientMachineInitial.RunID != "" {
+			return filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, dbtesterpb.ServerResultDir(cfg.ConfigClientMachineInitial.RunID, gcfg.DatabaseTag, idx), filepath.Base(p))
+		}
+		return filepath.Join(filepath.Dir(p), fmt.Sprintf("%s-%d-%s", gcfg.DatabaseTag, idx+1, filepath.Base(p)))
+	}
+	return rename(cfg.ConfigClientMachineInitial.ServerSystemMetricsPath), rename(cfg.ConfigClientMachineInitial.ServerSystemMetricsInterpolatedPath)
+}
+
+// StreamSystemMetrics streams system metrics samples from all agents
+// and saves them on the control node, so that the analyze step does not
+// need to copy server-side CSV files. The returned channel is closed
+// after all agents finish streaming and the CSV files are saved.
+func (cfg *Config) StreamSystemMetrics(ctx context.Context, databaseID string) (<-chan struct{}, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return nil, fmt.Errorf("database id %q does not exist", databaseID)
+	}
+	if cfg.ConfigClientMachineInitial.ServerSystemMetricsPath == "" || cfg.ConfigClientMachineInitial.ServerSystemMetricsInterpolatedPath == "" {
+		return nil, fmt.Errorf("server system metrics paths are not defined")
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(gcfg.AgentEndpoints))
+	for i, ep := range gcfg.AgentEndpoints {
+		go func(i int, ep string) {
+			defer wg.Done()
+
+			fpath, interpolatedPath := cfg.ServerSystemMetricsPaths(databaseID, i)
+			if err := cfg.streamSystemMetrics(ctx, databaseID, i, ep, fpath); err != nil {
+				plog.Warningf("failed to stream system metrics (%v) [index: %d | endpoint: %q]", err, i, ep)
+			}
+			if err := interpolateSystemMetrics(fpath, interpolatedPath); err != nil {
+				plog.Warningf("failed to interpolate %q (%v)", fpath, err)
+			}
+		}(i, ep)
+	}
+
+	donec := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(donec)
+	}()
+	return donec, nil
+}
+
+func (cfg *Config) streamSystemMetrics(ctx context.Context, databaseID string, idx int, ep string, fpath string) error {
+	plog.Infof("streaming system metrics [index: %d | endpoint: %q | path: %q]", idx, ep, fpath)
+
+	conn, err := grpc.Dial(ep, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	req := &dbtesterpb.Request{
+		DatabaseID:  dbtesterpb.DatabaseID(dbtesterpb.DatabaseID_value[cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseID]),
+		DatabaseTag: cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag,
+		IPIndex:     uint32(idx),
+	}
+	stream, err := dbtesterpb.NewTransporterClient(conn).Monitor(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if err = os.RemoveAll(fpath); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(fpath, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0777)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for cnt := 0; ; cnt++ {
+		s, err := stream.Recv()
+		if err == io.EOF {
+			plog.Infof("finished streaming %d samples [index: %d | endpoint: %q]", cnt, idx, ep)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if s.Header != "" {
+			if _, err = f.WriteString(s.Header + "\n"); err != nil {
+				return err
+			}
+		}
+		if _, err = f.WriteString(s.Row + "\n"); err != nil {
+			return err
+		}
+
+		if cnt%10 == 0 {
+			plog.Infof("[index: %d | endpoint: %q] %s", idx, ep, sampleStatus(s.Row))
+		}
+		if liveStats != nil {
+			if cpu, vmrss, ok := sampleUsage(s.Row); ok {
+				liveStats.observeServer(idx, cpu, vmrss)
+			}
+		}
+	}
+}
+
+// sampleStatus returns a one-line summary of system metrics row.
+func sampleStatus(row string) string {
+	fields, err := csv.NewReader(strings.NewReader(row)).Read()
+	if err != nil || len(fields) != len(inspect.ProcHeader) {
+		return row
+	}
+	return fmt.Sprintf("CPU %s%% | VMRSS %s | read %s bytes | write %s bytes | receive %s | transmit %s",
+		fields[inspect.ProcHeaderIndex["CPU-NUM"]],
+		fields[inspect.ProcHeaderIndex["VMRSS"]],
+		fields[inspect.ProcHeaderIndex["READ-BYTES-DELTA"]],
+		fields[inspect.ProcHeaderIndex["WRITE-BYTES-DELTA"]],
+		fields[inspect.ProcHeaderIndex["RECEIVE-BYTES-DELTA"]],
+		fields[inspect.ProcHeaderIndex["TRANSMIT-BYTES-DELTA"]],
+	)
+}
+
+// sampleUsage returns CPU usage in percent and memory usage in bytes
+// of system metrics row.
+func sampleUsage(row string) (cpu float64, vmrss uint64, ok bool) {
+	fields, err := csv.NewReader(strings.NewReader(row)).Read()
+	if err != nil || len(fields) != len(inspect.ProcHeader) {
+		return 0, 0, false
+	}
+	if cpu, err = strconv.ParseFloat(fields[inspect.ProcHeaderIndex["CPU-NUM"]], 64); err != nil {
+		return 0, 0, false
+	}
+	mem, err := strconv.ParseFloat(fields[inspect.ProcHeaderIndex["VMRSS-NUM"]], 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return cpu, uint64(mem), true
+}
+
+func interpolateSystemMetrics(fpath, interpolatedPath string) error {
+	tb, err := inspect.ReadCSV(fpath)
+	if err != nil {
+		return err
+	}
+	interpolated, err := tb.Interpolate()
+	if err != nil {
+		return err
+	}
+	if interpolated == nil {
+		// no need to interpolate
+		interpolated = tb
+	}
+	if err = os.RemoveAll(interpolatedPath); err != nil {
+		return err
+	}
+	interpolated.FilePath = interpolatedPath
+	if err = interpolated.Save(); err != nil {
+		return err
+	}
+	plog.Infof("CSV saved at %q", interpolatedPath)
+	return nil
+}
@@ -16,9 +16,8 @@ package dbtester
 
 import (
 	"fmt"
-	"math"
+	mrand "math/rand"
 	"os"
-	"sort"
 	"sync"
 	"time"
 
@@ -37,8 +36,18 @@ type values struct {
 	sampleSize int
 }
 
+// newWorkloadSource returns the source of random values and operations.
+// Runs with the same 'seed' issue identical request sequences.
+func newWorkloadSource(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) mrand.Source {
+	seed := opts.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return mrand.NewSource(seed)
+}
+
 func newValues(gcfg dbtesterpb.ConfigClientMachineAgentControl) (v values, rerr error) {
-	v.bytes = [][]byte{randBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)}
+	v.bytes = [][]byte{randBytes(newWorkloadSource(gcfg.ConfigClientMachineBenchmarkOptions), gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)}
 	v.strings = []string{string(v.bytes[0])}
 	v.sampleSize = 1
 	return
@@ -51,11 +60,131 @@ func (cfg *Config) Stress(databaseID string) error {
 		return fmt.Errorf("%q does not exist", databaseID)
 	}
 
+	if fpath := cfg.ConfigClientMachineInitial.ClientConnectionEventsPath; fpath != "" {
+		l := newConnEventLogger()
+		connEvents = l
+		defer func() {
+			connEvents = nil
+			if err := l.save(fpath); err != nil {
+				plog.Warningf("failed to save connection events (%v)", err)
+			} else {
+				plog.Infof("connection events saved at %q", fpath)
+			}
+		}()
+	}
+
+	if gcfg.DiscoverySRV != "" {
+		d, err := newSRVDiscovery(gcfg.DiscoverySRV)
+		if err != nil {
+			return err
+		}
+		gcfg.DatabaseEndpoints = d.Endpoints()
+		interval := defaultDiscoverySRVRefresh
+		if gcfg.DiscoverySRVRefreshSeconds > 0 {
+			interval = time.Duration(gcfg.DiscoverySRVRefreshSeconds) * time.Second
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		activeDiscovery = d
+		discoveryc := d.run(ctx, interval)
+		defer func() {
+			cancel()
+			<-discoveryc
+			activeDiscovery = nil
+		}()
+	}
+
+	if gcfg.ConfigClientMachineBenchmarkOptions.Connection != nil {
+		connSettings = gcfg.ConfigClientMachineBenchmarkOptions.Connection
+		defer func() { connSettings = nil }()
+	}
+	if newRetryPolicy(gcfg.ConfigClientMachineBenchmarkOptions.Retry) != nil {
+		driverRetryDisabled = true
+		defer func() { driverRetryDisabled = false }()
+	}
+
+	if cfg.ConfigClientMachineInitial.ClientRequestLogPath != "" {
+		rl, err := newRequestLogger(cfg.ConfigClientMachineInitial.ClientRequestLogPath)
+		if err != nil {
+			return err
+		}
+		requestLog = rl
+		defer func() {
+			requestLog = nil
+			if err := rl.Close(); err != nil {
+				plog.Warningf("failed to save request log (%v)", err)
+			} else {
+				plog.Infof("request log saved at %q", cfg.ConfigClientMachineInitial.ClientRequestLogPath)
+			}
+		}()
+	}
+
+	resetErrorBudgetFailure()
+	if eb := gcfg.ConfigClientMachineBenchmarkOptions.ErrorBudget; eb != nil {
+		plog.Infof("aborting on more than %.2f %% errors in any %d-second window", eb.MaxErrorPercent, eb.WindowSeconds)
+		activeErrorBudget = newErrorBudget(eb)
+		defer func() { activeErrorBudget = nil }()
+	}
+
+	if soak := gcfg.ConfigClientMachineBenchmarkOptions.Soak; soak != nil {
+		r, err := newSoakRollup(cfg.ConfigClientMachineInitial.ClientSoakRollupPath, soak)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		activeSoak = r
+		soakc := r.run(ctx)
+		defer func() {
+			cancel()
+			<-soakc
+			activeSoak = nil
+			if err := r.Close(); err != nil {
+				plog.Warningf("failed to save soak rollups (%v)", err)
+			} else {
+				plog.Infof("soak rollups saved at %q", cfg.ConfigClientMachineInitial.ClientSoakRollupPath)
+			}
+		}()
+	}
+
 	vals, err := newValues(gcfg)
 	if err != nil {
 		return err
 	}
 
+	if gcfg.ConfigClientMachineBenchmarkOptions.ConnectionChurnPerSecond > 0 {
+		// churn is extra load, stopped with the benchmark on abort
+		ctx, cancel := withLoadAbort(context.Background())
+		churnc := churnConnections(ctx, gcfg)
+		defer func() {
+			cancel()
+			// summary is saved when the stress finishes, before churn stops
+			res := <-churnc
+			if err := cfg.appendLatencyDistributionSummary(res.summaryRows()); err != nil {
+				plog.Warningf("failed to save connection churn summary (%v)", err)
+			}
+		}()
+	}
+
+	if gcfg.ConfigClientMachineBenchmarkOptions.ConsistencyCheck {
+		checkConsistency(gcfg)
+		defer consistency.stop()
+	}
+
+	if len(gcfg.ConfigClientMachineBenchmarkOptions.TenantGroups) > 0 {
+		return cfg.stressTenantGroups(gcfg, vals)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions.ThroughputCeiling != nil {
+		return cfg.stressThroughputCeiling(gcfg, vals)
+	}
+	if len(gcfg.ConfigClientMachineBenchmarkOptions.BatchSizes) > 0 {
+		return cfg.stressBatchWrites(gcfg, vals)
+	}
+	if len(gcfg.ConfigClientMachineBenchmarkOptions.RangeSizes) > 0 {
+		return cfg.stressRangeReads(gcfg, vals)
+	}
+	if len(gcfg.ConfigClientMachineBenchmarkOptions.RequestTimeoutsMs) > 0 {
+		return cfg.stressRequestTimeouts(gcfg, vals)
+	}
+
 	switch gcfg.ConfigClientMachineBenchmarkOptions.Type {
 	case "write":
 		plog.Println("write generateReport is started...")
@@ -70,7 +199,11 @@ func (cfg *Config) Stress(databaseID string) error {
 			// variable client numbers
 			rs := assignRequest(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
 
-			var stats []report.Stats
+			var stats, correctedStats []report.Stats
+			slo := newSLOCounter(gcfg.ConfigClientMachineBenchmarkOptions.LatencySLOMs)
+			retry := newRetryPolicy(gcfg.ConfigClientMachineBenchmarkOptions.Retry)
+			retries := newRetryCounter(retry)
+			hist := newLatencyHistograms(cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath)
 			reqCompleted := int64(0)
 			for i := 0; i < len(rs); i++ {
 				copied := gcfg
@@ -90,6 +223,13 @@ func (cfg *Config) Stress(databaseID string) error {
 				h, done := newWriteHandlers(copied)
 				reqGen := func(inflightReqs chan<- request) { generateWrites(copied, reqCompleted, vals, inflightReqs) }
 				b := newBenchmark(copied.ConfigClientMachineBenchmarkOptions.RequestNumber, copied.ConfigClientMachineBenchmarkOptions.ClientNumber, h, done, reqGen)
+				if copied.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
+					b.correctedReport = report.NewReportSample("%4.4f")
+				}
+				b.slo = slo
+				b.retry = retry
+				b.retries = retries
+				b.hist = hist
 
 				// wait until rs[i] requests are finished
 				// do not end reports yet
@@ -103,16 +243,15 @@ func (cfg *Config) Stress(databaseID string) error {
 
 				reqCompleted += rs[i]
 				stats = append(stats, b.stats)
+				if b.correctedReport != nil {
+					correctedStats = append(correctedStats, b.correctedStats)
+				}
 			}
 			plog.Info("combining all reports")
 
-			combined := report.Stats{ErrorDist: make(map[string]int)}
+			combined := combineStats(stats)
 			combinedClientNumber := make([]int64, 0, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
 			for i, st := range stats {
-				combined.AvgTotal += st.AvgTotal
-				combined.Total += st.Total
-				combined.Lats = append(combined.Lats, st.Lats...)
-				combined.TimeSeries = append(combined.TimeSeries, st.TimeSeries...)
 				//
 				// Need to handle duplicate unix second timestamps when two ranges are merged.
 				// This can happen when the following run happens within the same unix timesecond,
@@ -135,38 +274,22 @@ func (cfg *Config) Stress(databaseID string) error {
 					clientNs[i] = clientN
 				}
 				combinedClientNumber = append(combinedClientNumber, clientNs...)
-
-				for k, v := range st.ErrorDist {
-					if _, ok := combined.ErrorDist[k]; !ok {
-						combined.ErrorDist[k] = v
-					} else {
-						combined.ErrorDist[k] += v
-					}
-				}
 			}
 			if len(combined.TimeSeries) != len(combinedClientNumber) {
 				return fmt.Errorf("len(combined.TimeSeries) %d != len(combinedClientNumber) %d", len(combined.TimeSeries), len(combinedClientNumber))
 			}
 
-			combined.Average = combined.AvgTotal / float64(len(combined.Lats))
-			combined.RPS = float64(len(combined.Lats)) / combined.Total.Seconds()
-			plog.Printf("got total %d data points and total %f seconds (RPS %f)", len(combined.Lats), combined.Total.Seconds(), combined.RPS)
-
-			for i := range combined.Lats {
-				dev := combined.Lats[i] - combined.Average
-				combined.Stddev += dev * dev
-			}
-			combined.Stddev = math.Sqrt(combined.Stddev / float64(len(combined.Lats)))
-
-			sort.Float64s(combined.Lats)
-			if len(combined.Lats) > 0 {
-				combined.Fastest = combined.Lats[0]
-				combined.Slowest = combined.Lats[len(combined.Lats)-1]
-			}
-
 			plog.Info("combined all reports")
 			printStats(combined)
-			cfg.saveAllStats(gcfg, combined, combinedClientNumber)
+			var corrected *report.Stats
+			if len(correctedStats) > 0 {
+				cs := combineStats(correctedStats)
+				fmt.Println("Corrected for coordinated omission:")
+				printStats(cs)
+				corrected = &cs
+			}
+			cfg.saveAllStats(gcfg, combined, corrected, combinedClientNumber, slo, retries, nil)
+			hist.save()
 		}
 
 		plog.Println("write generateReport is finished...")
@@ -189,82 +312,92 @@ func (cfg *Config) Stress(databaseID string) error {
 		}
 
 	case "read":
-		key, value := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes), vals.strings[0]
-
-		switch gcfg.DatabaseID {
-		case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
-			for i := 0; i < 7; i++ {
-				clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-					totalConns:   1,
-					totalClients: 1,
-				})
-				_, err = clients[0].Do(context.Background(), clientv3.OpPut(key, value))
+		// keys of the imported keyspace snapshot, or one key written here
+		keys, err := readKeyspaceKeys(gcfg.ConfigClientMachineBenchmarkOptions.KeyspaceSnapshotPath)
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			plog.Infof("reading %d keys of keyspace snapshot %q", len(keys), gcfg.ConfigClientMachineBenchmarkOptions.KeyspaceSnapshotPath)
+		} else {
+			key, value := prefixedSameKey(gcfg.ConfigClientMachineBenchmarkOptions), vals.strings[0]
+
+			switch gcfg.DatabaseID {
+			case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+				plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+				var err error
+				for i := 0; i < 7; i++ {
+					clients := mustCreateClientsEtcdv3(clientEndpoints(gcfg), etcdv3ClientCfg{
+						totalConns:   1,
+						totalClients: 1,
+					})
+					_, err = clients[0].Do(context.Background(), clientv3.OpPut(key, value))
+					if err != nil {
+						continue
+					}
+					plog.Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+					break
+				}
 				if err != nil {
-					continue
+					plog.Errorf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+					os.Exit(1)
 				}
-				plog.Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				break
-			}
-			if err != nil {
-				plog.Errorf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
-			}
 
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
-			for i := 0; i < 7; i++ {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				_, err = conns[0].Create("/"+key, vals.bytes[0], zkCreateFlags, zkCreateACL)
-				if err != nil {
-					continue
+			case "zookeeper__r3_5_3_beta", "zetcd__beta":
+				plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+				var err error
+				for i := 0; i < 7; i++ {
+					conns := mustCreateConnsZk(clientEndpoints(gcfg), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+					_, err = conns[0].Create("/"+key, vals.bytes[0], zkCreateFlags, zkCreateACL)
+					if err != nil {
+						continue
+					}
+					for j := range conns {
+						conns[j].Close()
+					}
+					plog.Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+					break
 				}
-				for j := range conns {
-					conns[j].Close()
+				if err != nil {
+					plog.Errorf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+					os.Exit(1)
 				}
-				plog.Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				break
-			}
-			if err != nil {
-				plog.Errorf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
-			}
 
-		case "consul__v1_0_2", "cetcd__beta":
-			plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
-			for i := 0; i < 7; i++ {
-				clients := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				_, err = clients[0].Put(&consulapi.KVPair{Key: key, Value: vals.bytes[0]}, nil)
+			case "consul__v1_0_2", "cetcd__beta":
+				plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+				var err error
+				for i := 0; i < 7; i++ {
+					clients := mustCreateConnsConsul(clientEndpoints(gcfg), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+					_, err = clients[0].Put(&consulapi.KVPair{Key: key, Value: vals.bytes[0]}, nil)
+					if err != nil {
+						continue
+					}
+					plog.Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+					break
+				}
 				if err != nil {
-					continue
+					plog.Errorf("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+					os.Exit(1)
 				}
-				plog.Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				break
-			}
-			if err != nil {
-				plog.Errorf("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
-			}
 
-		default:
-			plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+			default:
+				plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+			}
+			keys = []string{key}
 		}
 
 		h, done := newReadHandlers(gcfg)
-		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, key, inflightReqs) }
+		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, keys, inflightReqs) }
 		cfg.generateReport(gcfg, h, done, reqGen)
 		plog.Println("read generateReport is finished...")
 
 	case "read-oneshot":
-		key, value := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes), vals.strings[0]
+		key, value := prefixedSameKey(gcfg.ConfigClientMachineBenchmarkOptions), vals.strings[0]
 		plog.Infof("writing key for read-oneshot [key: %q | database: %q]", key, gcfg.DatabaseID)
 		var err error
 		switch gcfg.DatabaseID {
 		case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+			clients := mustCreateClientsEtcdv3(clientEndpoints(gcfg), etcdv3ClientCfg{
 				totalConns:   1,
 				totalClients: 1,
 			})
@@ -272,12 +405,12 @@ func (cfg *Config) Stress(databaseID string) error {
 			clients[0].Close()
 
 		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, 1)
+			conns := mustCreateConnsZk(clientEndpoints(gcfg), 1)
 			_, err = conns[0].Create("/"+key, vals.bytes[0], zkCreateFlags, zkCreateACL)
 			conns[0].Close()
 
 		case "consul__v1_0_2", "cetcd__beta":
-			clients := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 1)
+			clients := mustCreateConnsConsul(clientEndpoints(gcfg), 1)
 			_, err = clients[0].Put(&consulapi.KVPair{Key: key, Value: vals.bytes[0]}, nil)
 
 		default:
@@ -289,9 +422,24 @@ func (cfg *Config) Stress(databaseID string) error {
 		}
 
 		h := newReadOneshotHandlers(gcfg)
-		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, key, inflightReqs) }
+		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, []string{key}, inflightReqs) }
 		cfg.generateReport(gcfg, h, nil, reqGen)
 		plog.Println("read-oneshot generateReport is finished...")
+
+	case "mixed":
+		return cfg.stressMixed(gcfg, vals)
+
+	case "replay":
+		return cfg.stressReplay(gcfg)
+
+	case "watch-compaction":
+		return cfg.stressWatchCompaction(gcfg, vals)
+
+	case "session-expiry":
+		return cfg.stressSessionExpiry(gcfg, vals)
+
+	case "lease-expiry":
+		return cfg.stressLeaseExpiry(gcfg, vals)
 	}
 
 	return nil
@@ -301,7 +449,7 @@ func newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Req
 	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
 	switch gcfg.DatabaseID {
 	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-		clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		clients := mustCreateClientsEtcdv3(clientEndpoints(gcfg), etcdv3ClientCfg{
 			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
 			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
 		})
@@ -314,7 +462,7 @@ func newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Req
 			}
 		}
 	case "zookeeper__r3_5_3_beta", "zetcd__beta":
-		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns := mustCreateConnsZk(clientEndpoints(gcfg), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
 		for i := range conns {
 			rhs[i] = newGetZK(conns[i])
 		}
@@ -324,7 +472,7 @@ func newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Req
 			}
 		}
 	case "consul__v1_0_2", "cetcd__beta":
-		conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns := mustCreateConnsConsul(clientEndpoints(gcfg), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
 		for i := range conns {
 			rhs[i] = newGetConsul(conns[i])
 		}
@@ -338,7 +486,7 @@ func newWriteHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Re
 	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
 	switch gcfg.DatabaseID {
 	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-		etcdClients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		etcdClients := mustCreateClientsEtcdv3(clientEndpoints(gcfg), etcdv3ClientCfg{
 			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
 			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
 		})
@@ -352,12 +500,12 @@ func newWriteHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Re
 		}
 	case "zookeeper__r3_5_3_beta", "zetcd__beta":
 		if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
-			key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
-			valueBts := randBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)
+			key := prefixedSameKey(gcfg.ConfigClientMachineBenchmarkOptions)
+			valueBts := randBytes(newWorkloadSource(gcfg.ConfigClientMachineBenchmarkOptions), gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)
 			plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
 			var err error
 			for i := 0; i < 7; i++ {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+				conns := mustCreateConnsZk(clientEndpoints(gcfg), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
 				_, err = conns[0].Create("/"+key, valueBts, zkCreateFlags, zkCreateACL)
 				if err != nil {
 					continue
@@ -374,7 +522,7 @@ func newWriteHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Re
 			}
 		}
 
-		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns := mustCreateConnsZk(clientEndpoints(gcfg), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
 		for i := range conns {
 			if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
 				rhs[i] = newPutOverwriteZK(conns[i])
@@ -388,7 +536,7 @@ func newWriteHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Re
 			}
 		}
 	case "consul__v1_0_2", "cetcd__beta":
-		conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns := mustCreateConnsConsul(clientEndpoints(gcfg), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
 		for i := range conns {
 			rhs[i] = newPutConsul(conns[i])
 		}
@@ -410,7 +558,7 @@ func newReadOneshotHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) []R
 	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
 		for i := range rhs {
 			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+				conns := mustCreateClientsEtcdv3(clientEndpoints(gcfg), etcdv3ClientCfg{
 					totalConns:   1,
 					totalClients: 1,
 				})
@@ -421,7 +569,7 @@ func newReadOneshotHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) []R
 	case "zookeeper__r3_5_3_beta", "zetcd__beta":
 		for i := range rhs {
 			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+				conns := mustCreateConnsZk(clientEndpoints(gcfg), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
 				defer conns[0].Close()
 				return newGetZK(conns[0])(ctx, req)
 			}
@@ -429,7 +577,7 @@ func newReadOneshotHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) []R
 	case "consul__v1_0_2", "cetcd__beta":
 		for i := range rhs {
 			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 1)
+				conns := mustCreateConnsConsul(clientEndpoints(gcfg), 1)
 				return newGetConsul(conns[0])(ctx, req)
 			}
 		}
@@ -439,9 +587,16 @@ func newReadOneshotHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) []R
 	return rhs
 }
 
-func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, inflightReqs chan<- request) {
+// generateReads generates reads of the key, or of keys sampled
+// uniformly with the workload seed if more than one key is given.
+func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, keys []string, inflightReqs chan<- request) {
 	defer close(inflightReqs)
 
+	var rnd *mrand.Rand
+	if len(keys) > 1 {
+		rnd = mrand.New(newWorkloadSource(gcfg.ConfigClientMachineBenchmarkOptions))
+	}
+
 	var rateLimiter *rate.Limiter
 	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
 		rateLimiter = rate.NewLimiter(
@@ -450,35 +605,58 @@ func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string,
 		)
 	}
 
+	begin := time.Now()
 	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
+		// paused time is not counted as latency
+		begin = begin.Add(loadPauser.wait())
+		if LoadAborted() {
+			return
+		}
+
+		var req request
 		if rateLimiter != nil {
 			rateLimiter.Wait(context.TODO())
+			req.intendedStart = intendedStartTime(begin, gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond, i)
 		}
 
-		switch gcfg.DatabaseID {
-		case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			opts := []clientv3.OpOption{clientv3.WithRange("")}
-			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
-				opts = append(opts, clientv3.WithSerializable())
-			}
-			inflightReqs <- request{etcdv3Op: clientv3.OpGet(key, opts...)}
+		key := keys[0]
+		if rnd != nil {
+			key = keys[rnd.Intn(len(keys))]
+		}
+		setReadOp(gcfg, key, &req)
+		inflightReqs <- req
+	}
+}
 
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			op := zkOp{key: key}
-			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
-				op.staleRead = true
-			}
-			inflightReqs <- request{zkOp: op}
+// setReadOp sets the read operation of the key to the request.
+func setReadOp(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, req *request) {
+	req.opType = opTypeRead
+	if requestLog != nil {
+		requestLog.record(opTypeRead, key, 0)
+	}
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		opts := []clientv3.OpOption{clientv3.WithRange("")}
+		if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
+			opts = append(opts, clientv3.WithSerializable())
+		}
+		req.etcdv3Op = clientv3.OpGet(key, opts...)
 
-		case "consul__v1_0_2", "cetcd__beta":
-			op := consulOp{key: key}
-			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
-				op.staleRead = true
-			}
-			inflightReqs <- request{consulOp: op}
-		default:
-			plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		op := zkOp{key: key}
+		if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
+			op.staleRead = true
 		}
+		req.zkOp = op
+
+	case "consul__v1_0_2", "cetcd__beta":
+		op := consulOp{key: key}
+		if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
+			op.staleRead = true
+		}
+		req.consulOp = op
+	default:
+		plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
 	}
 }
 
@@ -497,28 +675,92 @@ func generateWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx in
 		wg.Wait()
 	}()
 
+	keyNum := newKeyOrder(gcfg.ConfigClientMachineBenchmarkOptions, startIdx, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
+	begin := time.Now()
 	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
-		k := sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, i+startIdx)
+		k := prefixedSequentialKey(gcfg.ConfigClientMachineBenchmarkOptions, keyNum(i))
 		if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
-			k = sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
+			k = prefixedSameKey(gcfg.ConfigClientMachineBenchmarkOptions)
 		}
 
 		v := vals.bytes[i%int64(vals.sampleSize)]
 		vs := vals.strings[i%int64(vals.sampleSize)]
 
+		// paused time is not counted as latency
+		begin = begin.Add(loadPauser.wait())
+		if LoadAborted() {
+			return
+		}
+
+		var req request
 		if rateLimiter != nil {
 			rateLimiter.Wait(context.TODO())
+			req.intendedStart = intendedStartTime(begin, gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond, i)
 		}
 
-		switch gcfg.DatabaseID {
-		case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			inflightReqs <- request{etcdv3Op: clientv3.OpPut(k, vs)}
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			inflightReqs <- request{zkOp: zkOp{key: "/" + k, value: v}}
-		case "consul__v1_0_2", "cetcd__beta":
-			inflightReqs <- request{consulOp: consulOp{key: k, value: v}}
-		default:
-			plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
-		}
+		setWriteOp(gcfg, k, v, vs, &req)
+		inflightReqs <- req
+	}
+}
+
+// setWriteOp sets the write operation of the key to the request.
+func setWriteOp(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, v []byte, vs string, req *request) {
+	req.opType = opTypeWrite
+	if requestLog != nil {
+		requestLog.record(opTypeWrite, key, len(v))
+	}
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		req.etcdv3Op = clientv3.OpPut(key, vs)
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		req.zkOp = zkOp{key: "/" + key, value: v}
+	case "consul__v1_0_2", "cetcd__beta":
+		req.consulOp = consulOp{key: key, value: v}
+	default:
+		plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+	}
+}
+
+const (
+	// KeyOrderSequential writes keys in the order of key numbers.
+	KeyOrderSequential = "sequential"
+	// KeyOrderRandom writes the same keys in random order.
+	KeyOrderRandom = "random"
+)
+
+// newKeyOrder returns the key number of i-th write, from 'startIdx'
+// to 'startIdx+n-1' in 'key_order', offset by 'key_start_index'.
+// Random order is a permutation from the workload seed, so reruns
+// write keys in the same order.
+func newKeyOrder(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions, startIdx, n int64) func(i int64) int64 {
+	startIdx += opts.KeyStartIndex
+	if opts.KeyOrder != KeyOrderRandom {
+		return func(i int64) int64 { return startIdx + i }
+	}
+	perm := mrand.New(newWorkloadSource(opts)).Perm(int(n))
+	return func(i int64) int64 { return startIdx + int64(perm[i]) }
+}
+
+// intendedStartTime returns the time when i-th request is supposed to
+// start at the fixed rate, regardless of how long previous requests took.
+func intendedStartTime(begin time.Time, requestsPerSecond int64, i int64) time.Time {
+	return begin.Add(time.Duration(i) * time.Second / time.Duration(requestsPerSecond))
+}
+
+// prefixedSequentialKey returns the sequential key with 'key_prefix',
+// padded to 'key_size_bytes' if the prefix is shorter.
+func prefixedSequentialKey(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions, num int64) string {
+	return opts.KeyPrefix + sequentialKey(unprefixedKeySize(opts), num)
+}
+
+func prefixedSameKey(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) string {
+	return opts.KeyPrefix + sameKey(unprefixedKeySize(opts))
+}
+
+func unprefixedKeySize(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) int64 {
+	size := opts.KeySizeBytes - int64(len(opts.KeyPrefix))
+	if size < 1 {
+		size = 1
 	}
+	return size
 }
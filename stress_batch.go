@@ -0,0 +1,280 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/pkg/report"
+	"github.com/gyuho/dataframe"
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+)
+
+// BatchWritesColumns defines the columns of batch write steps.
+var BatchWritesColumns = []string{
+	"BATCH-SIZE",
+	"BATCHES",
+	"BATCHES-PER-SECOND",
+	"KEYS-PER-SECOND",
+	"AVG-BATCH-LATENCY-MS",
+	"P99-BATCH-LATENCY-MS",
+	"AVG-KEY-LATENCY-MS",
+	"P99-KEY-LATENCY-MS",
+}
+
+const (
+	// etcdMaxTxnOps is the default '--max-txn-ops' of etcd.
+	etcdMaxTxnOps = 128
+	// consulMaxTxnOps is the maximum number of operations
+	// in one Consul transaction.
+	consulMaxTxnOps = 64
+)
+
+type batchStep struct {
+	size          int64
+	keys          int64
+	batches       int64
+	batchesPerSec float64
+	avgMs         float64
+	p99Ms         float64
+}
+
+// keysPerBatch returns the average number of keys in a batch,
+// where the last batch may be partial.
+func (st batchStep) keysPerBatch() float64 { return float64(st.keys) / float64(st.batches) }
+
+// keysPerSecond returns the write throughput in keys.
+func (st batchStep) keysPerSecond() float64 { return st.batchesPerSec * st.keysPerBatch() }
+
+// keyLatencyMs returns the batch latency amortized over the keys of the batch.
+func (st batchStep) keyLatencyMs(batchMs float64) float64 { return batchMs / st.keysPerBatch() }
+
+// batchCount returns the number of batches to write n keys.
+func batchCount(n, size int64) int64 { return (n + size - 1) / size }
+
+// stressBatchWrites writes 'request_number' keys for each batch size,
+// where each batch of keys is written in one request.
+func (cfg *Config) stressBatchWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.Type != "write" {
+		return fmt.Errorf("batch writes are not supported for %q", opts.Type)
+	}
+	if opts.SameKey {
+		return fmt.Errorf("batch writes are not supported with 'same_key'")
+	}
+	for _, size := range opts.BatchSizes {
+		if size <= 0 {
+			return fmt.Errorf("got non-positive batch size %d", size)
+		}
+		switch gcfg.DatabaseID {
+		case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			if size > etcdMaxTxnOps {
+				return fmt.Errorf("etcd transaction supports at most %d operations by default, got batch size %d", etcdMaxTxnOps, size)
+			}
+		case "consul__v1_0_2", "cetcd__beta":
+			if size > consulMaxTxnOps {
+				return fmt.Errorf("Consul transaction supports at most %d operations, got batch size %d", consulMaxTxnOps, size)
+			}
+		}
+	}
+
+	slo := newSLOCounter(opts.LatencySLOMs)
+	retry := newRetryPolicy(opts.Retry)
+	retries := newRetryCounter(retry)
+	hist := newLatencyHistograms(cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath)
+	var (
+		steps       []batchStep
+		stats       []report.Stats
+		keysWritten int64
+	)
+	for _, size := range opts.BatchSizes {
+		copied := gcfg
+		copiedOpts := *opts
+		copiedOpts.RequestNumber = batchCount(opts.RequestNumber, size)
+		copied.ConfigClientMachineBenchmarkOptions = &copiedOpts
+
+		plog.Infof("writing %d keys in batches of %d", opts.RequestNumber, size)
+		h, done := newBatchWriteHandlers(copied)
+		startIdx, batchSize := keysWritten, size
+		reqGen := func(inflightReqs chan<- request) {
+			generateBatchWrites(copied, startIdx, opts.RequestNumber, batchSize, vals, inflightReqs)
+		}
+		b := newBenchmark(copiedOpts.RequestNumber, copiedOpts.ClientNumber, h, done, reqGen)
+		b.slo = slo
+		b.retry = retry
+		b.retries = retries
+		b.hist = hist
+		b.startRequests()
+		b.waitAll()
+		keysWritten += opts.RequestNumber
+		stats = append(stats, b.stats)
+
+		st := batchStep{
+			size:          size,
+			keys:          opts.RequestNumber,
+			batches:       copiedOpts.RequestNumber,
+			batchesPerSec: b.stats.RPS,
+			avgMs:         1000 * b.stats.Average,
+			p99Ms:         1000 * latencyPercentile(b.stats.Lats, 99),
+		}
+		steps = append(steps, st)
+		plog.Infof("wrote batches of %d [keys/sec: %.2f | batch p99: %.3f ms | key p99: %.3f ms]", size, st.keysPerSecond(), st.p99Ms, st.keyLatencyMs(st.p99Ms))
+	}
+	if err := cfg.saveBatchWrites(steps); err != nil {
+		return err
+	}
+
+	// per-batch latency and throughput
+	combined := combineStats(stats)
+	printStats(combined)
+	cfg.saveAllStats(gcfg, combined, nil, nil, slo, retries, nil)
+	hist.save()
+	return nil
+}
+
+func newBatchWriteHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		// batches are txn ops
+		return newWriteHandlers(gcfg)
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+		conns := mustCreateConnsZk(clientEndpoints(gcfg), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		for i := range conns {
+			rhs[i] = newBatchPutZK(conns[i])
+		}
+		done = func() {
+			for i := range conns {
+				conns[i].Close()
+			}
+		}
+	case "consul__v1_0_2", "cetcd__beta":
+		rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+		conns := mustCreateConnsConsul(clientEndpoints(gcfg), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		for i := range conns {
+			rhs[i] = newBatchPutConsul(conns[i])
+		}
+	default:
+		plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+	}
+
+	for k := range rhs {
+		if rhs[k] == nil {
+			plog.Panicf("%d-th write handler is nil (out of %d)", k, len(rhs))
+		}
+	}
+	return
+}
+
+// generateBatchWrites generates the batches of keys in 'key_order',
+// starting from 'startIdx'. Rate limit applies to batches.
+func generateBatchWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx, keyN, size int64, vals values, inflightReqs chan<- request) {
+	var rateLimiter *rate.Limiter
+	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
+		rateLimiter = rate.NewLimiter(
+			rate.Limit(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
+			int(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
+		)
+	}
+
+	var wg sync.WaitGroup
+	defer func() {
+		close(inflightReqs)
+		wg.Wait()
+	}()
+
+	keyNum := newKeyOrder(gcfg.ConfigClientMachineBenchmarkOptions, startIdx, keyN)
+	begin := time.Now()
+	for i := int64(0); i*size < keyN; i++ {
+		n := size
+		if rest := keyN - i*size; rest < n {
+			n = rest
+		}
+		keys := make([]string, n)
+		for j := range keys {
+			keys[j] = prefixedSequentialKey(gcfg.ConfigClientMachineBenchmarkOptions, keyNum(i*size+int64(j)))
+		}
+		v := vals.bytes[i%int64(vals.sampleSize)]
+		vs := vals.strings[i%int64(vals.sampleSize)]
+
+		// paused time is not counted as latency
+		begin = begin.Add(loadPauser.wait())
+		if LoadAborted() {
+			return
+		}
+
+		var req request
+		if rateLimiter != nil {
+			rateLimiter.Wait(context.TODO())
+			req.intendedStart = intendedStartTime(begin, gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond, i)
+		}
+
+		setBatchWriteOp(gcfg, keys, v, vs, &req)
+		inflightReqs <- req
+	}
+}
+
+// setBatchWriteOp sets the writes of the keys to the request.
+func setBatchWriteOp(gcfg dbtesterpb.ConfigClientMachineAgentControl, keys []string, v []byte, vs string, req *request) {
+	var etcdOps []clientv3.Op
+	for _, k := range keys {
+		var r request
+		setWriteOp(gcfg, k, v, vs, &r)
+		switch gcfg.DatabaseID {
+		case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			etcdOps = append(etcdOps, r.etcdv3Op)
+		case "zookeeper__r3_5_3_beta", "zetcd__beta":
+			req.zkBatch = append(req.zkBatch, r.zkOp)
+		case "consul__v1_0_2", "cetcd__beta":
+			req.consulBatch = append(req.consulBatch, r.consulOp)
+		}
+	}
+	req.opType = opTypeWrite
+	if len(etcdOps) > 0 {
+		req.etcdv3Op = clientv3.OpTxn(nil, etcdOps, nil)
+	}
+}
+
+func (cfg *Config) saveBatchWrites(steps []batchStep) error {
+	if cfg.ConfigClientMachineInitial.ClientBatchWritesPath == "" {
+		return nil
+	}
+	cs := make([]dataframe.Column, len(BatchWritesColumns))
+	for i := range cs {
+		cs[i] = dataframe.NewColumn(BatchWritesColumns[i])
+	}
+	for _, st := range steps {
+		cs[0].PushBack(dataframe.NewStringValue(st.size))
+		cs[1].PushBack(dataframe.NewStringValue(st.batches))
+		cs[2].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.batchesPerSec)))
+		cs[3].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.keysPerSecond())))
+		cs[4].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.avgMs)))
+		cs[5].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.p99Ms)))
+		cs[6].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.keyLatencyMs(st.avgMs))))
+		cs[7].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.keyLatencyMs(st.p99Ms))))
+	}
+	fr := dataframe.New()
+	for _, c := range cs {
+		if err := fr.AddColumn(c); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientBatchWritesPath)
+}
@@ -0,0 +1,69 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestGenerateBatchWrites(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID: "consul__v1_0_2",
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			KeySizeBytes: 1,
+		},
+	}
+	vals := values{bytes: [][]byte{[]byte("v")}, strings: []string{"v"}, sampleSize: 1}
+
+	reqc := make(chan request, 10)
+	go generateBatchWrites(gcfg, 2, 7, 3, vals, reqc)
+	var sizes []int
+	var keys []string
+	for req := range reqc {
+		if req.opType != opTypeWrite {
+			t.Fatalf("expected %q, got %q", opTypeWrite, req.opType)
+		}
+		sizes = append(sizes, len(req.consulBatch))
+		for _, op := range req.consulBatch {
+			keys = append(keys, op.key)
+		}
+	}
+	if !reflect.DeepEqual(sizes, []int{3, 3, 1}) {
+		t.Fatalf("expected batch sizes [3 3 1], got %v", sizes)
+	}
+	if keys[0] != sequentialKey(1, 2) || keys[6] != sequentialKey(1, 8) {
+		t.Fatalf("unexpected keys %q", keys)
+	}
+
+	if n := batchCount(7, 3); n != 3 {
+		t.Fatalf("expected 3 batches, got %d", n)
+	}
+	st := batchStep{size: 4, keys: 8, batches: 2, batchesPerSec: 100}
+	if st.keysPerSecond() != 400 || st.keyLatencyMs(10) != 2.5 {
+		t.Fatalf("expected 400 keys/sec and 2.5 ms, got %f and %f", st.keysPerSecond(), st.keyLatencyMs(10))
+	}
+	// last batch of 2 keys
+	st = batchStep{size: 4, keys: 10, batches: 3, batchesPerSec: 100}
+	if kps := st.keysPerSecond(); math.Abs(kps-1000.0/3) > 1e-9 {
+		t.Fatalf("expected %f keys/sec, got %f", 1000.0/3, kps)
+	}
+	if ms := st.keyLatencyMs(10); ms != 3 {
+		t.Fatalf("expected 3 ms, got %f", ms)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/pkg/report"
+	"github.com/gyuho/dataframe"
+)
+
+// ThroughputCeilingColumns defines the columns of throughput ceiling steps.
+var ThroughputCeilingColumns = []string{
+	"STEP",
+	"OFFERED-REQUESTS-PER-SECOND",
+	"ACHIEVED-REQUESTS-PER-SECOND",
+	"P99-LATENCY-MS",
+	"SLO-MET",
+}
+
+// ThroughputCeilingSummaryPrefix is the prefix of latency distribution
+// summary columns of the maximum sustainable throughput.
+const ThroughputCeilingSummaryPrefix = "MAX-SUSTAINABLE-"
+
+// ceilingMinAchievedRatio is the minimum ratio of achieved to offered
+// requests per second of a step to meet the SLO, since clients that
+// cannot keep up with the offered rate hide the saturation.
+const ceilingMinAchievedRatio = 0.95
+
+type ceilingStep struct {
+	offered  int64
+	achieved float64
+	p99Ms    float64
+	met      bool
+}
+
+// stressThroughputCeiling offers increasing write rates, one step at a time,
+// until p99 latency exceeds the SLO. The highest rate that met the SLO is
+// the maximum sustainable throughput.
+func (cfg *Config) stressThroughputCeiling(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	tc := gcfg.ConfigClientMachineBenchmarkOptions.ThroughputCeiling
+	if gcfg.ConfigClientMachineBenchmarkOptions.Type != "write" {
+		return fmt.Errorf("throughput ceiling is not supported for %q", gcfg.ConfigClientMachineBenchmarkOptions.Type)
+	}
+	if tc.StartRequestsPerSecond <= 0 || tc.StepRequestsPerSecond <= 0 || tc.StepSeconds <= 0 {
+		return fmt.Errorf("throughput ceiling needs positive start, step, and step seconds (got %+v)", *tc)
+	}
+	if tc.LatencyP99Ms <= 0 {
+		return fmt.Errorf("throughput ceiling needs 'latency_p99_ms'")
+	}
+
+	slo := newSLOCounter(gcfg.ConfigClientMachineBenchmarkOptions.LatencySLOMs)
+	retry := newRetryPolicy(gcfg.ConfigClientMachineBenchmarkOptions.Retry)
+	retries := newRetryCounter(retry)
+	hist := newLatencyHistograms(cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath)
+	var (
+		steps          []ceilingStep
+		stats          []report.Stats
+		correctedStats []report.Stats
+		reqCompleted   int64
+	)
+	for qps := tc.StartRequestsPerSecond; tc.MaxRequestsPerSecond <= 0 || qps <= tc.MaxRequestsPerSecond; qps += tc.StepRequestsPerSecond {
+		copied := gcfg
+		opts := *gcfg.ConfigClientMachineBenchmarkOptions
+		opts.RateLimitRequestsPerSecond = qps
+		opts.RequestNumber = qps * tc.StepSeconds
+		copied.ConfigClientMachineBenchmarkOptions = &opts
+
+		plog.Infof("offering %d requests/sec for %d seconds", qps, tc.StepSeconds)
+		h, done := newWriteHandlers(copied)
+		startIdx := reqCompleted
+		reqGen := func(inflightReqs chan<- request) { generateWrites(copied, startIdx, vals, inflightReqs) }
+		b := newBenchmark(opts.RequestNumber, opts.ClientNumber, h, done, reqGen)
+		// latencies from intended start times, not to hide saturation
+		b.correctedReport = report.NewReportSample("%4.4f")
+		b.slo = slo
+		b.retry = retry
+		b.retries = retries
+		b.hist = hist
+		b.startRequests()
+		b.waitAll()
+		reqCompleted += opts.RequestNumber
+		stats = append(stats, b.stats)
+		correctedStats = append(correctedStats, b.correctedStats)
+
+		step := newCeilingStep(qps, b.stats.RPS, b.correctedStats.Lats, len(b.stats.ErrorDist) > 0, tc.LatencyP99Ms)
+		steps = append(steps, step)
+		plog.Infof("offered %d requests/sec [achieved: %.2f | p99: %.3f ms | SLO met: %v]", qps, step.achieved, step.p99Ms, step.met)
+		if !step.met {
+			break
+		}
+	}
+
+	ceiling, ok := maxSustainableStep(steps)
+	if ok {
+		plog.Infof("max sustainable throughput at p99 <= %.3f ms: %.2f requests/sec (offered %d)", tc.LatencyP99Ms, ceiling.achieved, ceiling.offered)
+	} else {
+		plog.Warningf("no rate met p99 <= %.3f ms", tc.LatencyP99Ms)
+	}
+	if err := cfg.saveThroughputCeiling(steps); err != nil {
+		return err
+	}
+
+	combined := combineStats(stats)
+	printStats(combined)
+	corrected := combineStats(correctedStats)
+	fmt.Println("Corrected for coordinated omission:")
+	printStats(corrected)
+	cfg.saveAllStats(gcfg, combined, &corrected, nil, slo, retries, nil)
+	hist.save()
+	return cfg.appendLatencyDistributionSummary(ceilingSummaryRows(tc.LatencyP99Ms, ceiling))
+}
+
+// ceilingSummaryRows returns the summary rows of the maximum sustainable
+// throughput, which are zero if no rate met the SLO.
+func ceilingSummaryRows(sloMs float64, ceiling ceilingStep) [][]string {
+	return [][]string{
+		{ThroughputCeilingSummaryPrefix + "REQUESTS-PER-SECOND", fmt.Sprintf("%4.4f", ceiling.achieved)},
+		{ThroughputCeilingSummaryPrefix + "OFFERED-REQUESTS-PER-SECOND", fmt.Sprintf("%d", ceiling.offered)},
+		{ThroughputCeilingSummaryPrefix + "P99-LATENCY-MS", fmt.Sprintf("%4.4f", ceiling.p99Ms)},
+		{ThroughputCeilingSummaryPrefix + "P99-SLO-MS", fmt.Sprintf("%4.4f", sloMs)},
+	}
+}
+
+// newCeilingStep returns the step offered at 'offered' requests per second.
+// The step meets the SLO if the p99 of latencies from intended start times
+// is within the SLO, the achieved rate keeps up with the offered rate, and
+// no request failed.
+func newCeilingStep(offered int64, achieved float64, correctedLats []float64, failed bool, sloMs float64) ceilingStep {
+	st := ceilingStep{
+		offered:  offered,
+		achieved: achieved,
+		p99Ms:    1000 * latencyPercentile(correctedLats, 99),
+	}
+	st.met = !failed &&
+		st.p99Ms <= sloMs &&
+		st.achieved >= ceilingMinAchievedRatio*float64(offered)
+	return st
+}
+
+// maxSustainableStep returns the step with the highest offered rate
+// that met the SLO.
+func maxSustainableStep(steps []ceilingStep) (ceilingStep, bool) {
+	var (
+		max ceilingStep
+		ok  bool
+	)
+	for _, st := range steps {
+		if st.met && st.offered > max.offered {
+			max, ok = st, true
+		}
+	}
+	return max, ok
+}
+
+// latencyPercentile returns the p-th percentile of latencies.
+func latencyPercentile(lats []float64, p float64) float64 {
+	if len(lats) == 0 {
+		return 0
+	}
+	sorted := make([]float64, len(lats))
+	copy(sorted, lats)
+	sort.Float64s(sorted)
+	idx := int(float64(len(sorted)) * p / 100)
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+func (cfg *Config) saveThroughputCeiling(steps []ceilingStep) error {
+	if cfg.ConfigClientMachineInitial.ClientThroughputCeilingPath == "" {
+		return nil
+	}
+	c1 := dataframe.NewColumn(ThroughputCeilingColumns[0])
+	c2 := dataframe.NewColumn(ThroughputCeilingColumns[1])
+	c3 := dataframe.NewColumn(ThroughputCeilingColumns[2])
+	c4 := dataframe.NewColumn(ThroughputCeilingColumns[3])
+	c5 := dataframe.NewColumn(ThroughputCeilingColumns[4])
+	for i, st := range steps {
+		c1.PushBack(dataframe.NewStringValue(i))
+		c2.PushBack(dataframe.NewStringValue(st.offered))
+		c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.achieved)))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.p99Ms)))
+		c5.PushBack(dataframe.NewStringValue(st.met))
+	}
+	fr := dataframe.New()
+	for _, c := range []dataframe.Column{c1, c2, c3, c4, c5} {
+		if err := fr.AddColumn(c); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientThroughputCeilingPath)
+}
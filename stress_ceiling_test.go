@@ -0,0 +1,93 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaxSustainableStep(t *testing.T) {
+	steps := []ceilingStep{
+		{offered: 1000, achieved: 998, p99Ms: 10, met: true},
+		{offered: 2000, achieved: 1995, p99Ms: 30, met: true},
+		{offered: 3000, achieved: 2500, p99Ms: 80, met: false},
+	}
+	st, ok := maxSustainableStep(steps)
+	if !ok {
+		t.Fatal("expected a step to meet the SLO")
+	}
+	if st.offered != 2000 {
+		t.Fatalf("expected 2000, got %d", st.offered)
+	}
+	if _, ok = maxSustainableStep(steps[2:]); ok {
+		t.Fatal("expected no step to meet the SLO")
+	}
+}
+
+func TestLatencyPercentile(t *testing.T) {
+	lats := make([]float64, 100)
+	for i := range lats {
+		lats[i] = float64(100 - i)
+	}
+	if v := latencyPercentile(lats, 99); v != 100 {
+		t.Fatalf("expected 100, got %f", v)
+	}
+	if v := latencyPercentile(lats, 50); v != 51 {
+		t.Fatalf("expected 51, got %f", v)
+	}
+	if lats[0] != 100 {
+		t.Fatal("expected latencies not to be sorted in place")
+	}
+	if v := latencyPercentile(nil, 99); v != 0 {
+		t.Fatalf("expected 0, got %f", v)
+	}
+}
+
+func TestCeilingSummaryRows(t *testing.T) {
+	rows := ceilingSummaryRows(50, ceilingStep{offered: 2000, achieved: 1995, p99Ms: 30, met: true})
+	expected := [][]string{
+		{"MAX-SUSTAINABLE-REQUESTS-PER-SECOND", "1995.0000"},
+		{"MAX-SUSTAINABLE-OFFERED-REQUESTS-PER-SECOND", "2000"},
+		{"MAX-SUSTAINABLE-P99-LATENCY-MS", "30.0000"},
+		{"MAX-SUSTAINABLE-P99-SLO-MS", "50.0000"},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Fatalf("expected %v, got %v", expected, rows)
+	}
+}
+
+func TestNewCeilingStep(t *testing.T) {
+	lats := []float64{0.010, 0.020, 0.030}
+	tests := []struct {
+		achieved float64
+		failed   bool
+		sloMs    float64
+		met      bool
+	}{
+		{achieved: 1000, sloMs: 50, met: true},
+		// corrected p99 over the SLO
+		{achieved: 1000, sloMs: 25, met: false},
+		// clients fell behind the offered rate
+		{achieved: 900, sloMs: 50, met: false},
+		{achieved: 1000, failed: true, sloMs: 50, met: false},
+	}
+	for i, tt := range tests {
+		st := newCeilingStep(1000, tt.achieved, lats, tt.failed, tt.sloMs)
+		if st.met != tt.met {
+			t.Errorf("#%d: expected met %v, got %v (%+v)", i, tt.met, st.met, st)
+		}
+	}
+}
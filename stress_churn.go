@@ -0,0 +1,184 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/samuel/go-zookeeper/zk"
+	"golang.org/x/net/context"
+)
+
+// ConnectionChurnSummaryPrefix is the prefix of latency distribution
+// summary columns of connection churn.
+const ConnectionChurnSummaryPrefix = "CONNECTION-CHURN-"
+
+// maxChurnInFlight bounds the number of churned connections open at
+// once, so that unresponsive servers do not pile up goroutines and
+// sockets on the client; new connections wait for a free slot.
+const maxChurnInFlight = 1000
+
+// churnInterval returns the interval between new connections,
+// at least one nanosecond so that very high rates do not stop
+// the ticker.
+func churnInterval(rps int64) time.Duration {
+	if rps <= 0 {
+		return time.Second
+	}
+	iv := time.Second / time.Duration(rps)
+	if iv < time.Nanosecond {
+		iv = time.Nanosecond
+	}
+	return iv
+}
+
+// churnResult is the outcome of connection churn.
+type churnResult struct {
+	opened int64
+	failed int64
+	// took is the average time from connect to the first response
+	took time.Duration
+}
+
+func (r churnResult) summaryRows() [][]string {
+	return [][]string{
+		{ConnectionChurnSummaryPrefix + "OPENED", fmt.Sprintf("%d", r.opened)},
+		{ConnectionChurnSummaryPrefix + "FAILED", fmt.Sprintf("%d", r.failed)},
+		{ConnectionChurnSummaryPrefix + "AVERAGE-CONNECT-MS", fmt.Sprintf("%4.4f", 1000*r.took.Seconds())},
+	}
+}
+
+// churnConnections opens and closes connections at the rate of
+// 'connection_churn_per_second' until the context is canceled, to
+// measure the impact of reconnecting clients (e.g. proxy restarts).
+// Each connection sends one request, so that servers establish the
+// session, not only the TCP connection. The result is sent on the
+// returned channel after all connections are closed.
+func churnConnections(ctx context.Context, gcfg dbtesterpb.ConfigClientMachineAgentControl) <-chan churnResult {
+	var connect func(ep string) error
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		connect = churnEtcdv3
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		connect = churnZk
+	case "consul__v1_0_2", "cetcd__beta":
+		connect = churnConsul
+	default:
+		plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+	}
+
+	// churn through the proxy when there is one, like other clients
+	eps := clientEndpoints(gcfg)
+	rps := gcfg.ConfigClientMachineBenchmarkOptions.ConnectionChurnPerSecond
+	plog.Infof("churning %d connections per second", rps)
+
+	resc := make(chan churnResult, 1)
+	go func() {
+		var wg sync.WaitGroup
+		var opened, failed int64
+		var took time.Duration
+		var mu sync.Mutex
+		finish := func() {
+			wg.Wait()
+			if opened > 0 {
+				took /= time.Duration(opened)
+			}
+			plog.Infof("connection churn finished [opened: %d | failed: %d | average connect-to-first-response: %v]", opened, failed, took)
+			resc <- churnResult{opened: opened, failed: failed, took: took}
+		}
+
+		sem := make(chan struct{}, maxChurnInFlight)
+		ticker := time.NewTicker(churnInterval(rps))
+		defer ticker.Stop()
+		for i := 0; ; i++ {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				finish()
+				return
+			}
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				finish()
+				return
+			}
+
+			ep := eps[i%len(eps)]
+			wg.Add(1)
+			go func() {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				now := time.Now()
+				if err := connect(ep); err != nil {
+					mu.Lock()
+					failed++
+					mu.Unlock()
+					plog.Warningf("connection churn failed on %q (%v)", ep, err)
+					return
+				}
+				mu.Lock()
+				opened++
+				took += time.Since(now)
+				mu.Unlock()
+			}()
+		}
+	}()
+	return resc
+}
+
+func churnEtcdv3(ep string) error {
+	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{ep}, DialTimeout: 5 * time.Second})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	_, err = cli.Get(ctx, "churn", clientv3.WithSerializable())
+	cancel()
+	return err
+}
+
+func churnZk(ep string) error {
+	conn, _, err := zk.Connect([]string{ep}, 5*time.Second)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// blocks until the session is established
+	_, _, err = conn.Exists("/")
+	return err
+}
+
+func churnConsul(ep string) error {
+	// non-pooled config reconnects on every client
+	dcfg := consulapi.DefaultNonPooledConfig()
+	dcfg.Address = ep
+	cli, err := consulapi.NewClient(dcfg)
+	if err != nil {
+		return err
+	}
+	_, _, err = cli.KV().Get("churn", &consulapi.QueryOptions{AllowStale: true})
+	dcfg.Transport.CloseIdleConnections()
+	return err
+}
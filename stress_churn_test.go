@@ -0,0 +1,38 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChurnInterval(t *testing.T) {
+	tests := []struct {
+		rps  int64
+		want time.Duration
+	}{
+		{0, time.Second},
+		{1, time.Second},
+		{100, 10 * time.Millisecond},
+		{int64(time.Second), time.Nanosecond},
+		{10 * int64(time.Second), time.Nanosecond},
+	}
+	for i, tt := range tests {
+		if got := churnInterval(tt.rps); got != tt.want {
+			t.Errorf("#%d: churnInterval(%d) expected %v, got %v", i, tt.rps, tt.want, got)
+		}
+	}
+}
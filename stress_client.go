@@ -15,6 +15,8 @@
 package dbtester
 
 import (
+	"time"
+
 	"github.com/coreos/etcd/clientv3"
 	"golang.org/x/net/context"
 )
@@ -23,6 +25,19 @@ type request struct {
 	etcdv3Op clientv3.Op
 	zkOp     zkOp
 	consulOp consulOp
+
+	// zkBatch and consulBatch are the writes of the batch, written in one
+	// multi (or txn) request. etcd batches are txn ops in 'etcdv3Op'.
+	zkBatch     []zkOp
+	consulBatch []consulOp
+
+	// intendedStart is the time when the request is supposed to start
+	// in fixed-QPS mode, to correct coordinated omission; latency is
+	// measured from the intended start, even if the request is delayed.
+	intendedStart time.Time
+
+	// opType is the operation type of the request (e.g. "read")
+	opType string
 }
 
 // ReqHandler wraps request handler.
@@ -15,6 +15,9 @@
 package dbtester
 
 import (
+	"fmt"
+	"time"
+
 	consulapi "github.com/hashicorp/consul/api"
 	"golang.org/x/net/context"
 )
@@ -26,19 +29,21 @@ type consulOp struct {
 }
 
 func mustCreateConnsConsul(endpoints []string, total int64) []*consulapi.KV {
+	endpoints = discoveredEndpoints(endpoints)
 	css := make([]*consulapi.KV, total)
 	for i := range css {
 		endpoint := endpoints[dialTotal%len(endpoints)]
 		dialTotal++
 
-		dcfg := consulapi.DefaultConfig()
-		dcfg.Address = endpoint // x.x.x.x:8500
-		cli, err := consulapi.NewClient(dcfg)
+		cli, err := consulapi.NewClient(newConsulConfig(endpoint))
 		if err != nil {
 			plog.Fatal(err)
 		}
 
 		css[i] = cli.KV()
+		if warmEnabled() {
+			warmConsul(css[i])
+		}
 	}
 	return css
 }
@@ -51,6 +56,26 @@ func newPutConsul(conn *consulapi.KV) ReqHandler {
 	}
 }
 
+func newBatchPutConsul(conn *consulapi.KV) ReqHandler {
+	return func(ctx context.Context, req *request) error {
+		ops := make(consulapi.KVTxnOps, len(req.consulBatch))
+		for i, op := range req.consulBatch {
+			ops[i] = &consulapi.KVTxnOp{Verb: consulapi.KVSet, Key: op.key, Value: op.value}
+		}
+		ok, resp, _, err := conn.Txn(ops, nil)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if len(resp.Errors) > 0 {
+				return fmt.Errorf("txn rolled back at op %d (%s)", resp.Errors[0].OpIndex, resp.Errors[0].What)
+			}
+			return fmt.Errorf("txn rolled back")
+		}
+		return nil
+	}
+}
+
 func newGetConsul(conn *consulapi.KV) ReqHandler {
 	return func(ctx context.Context, req *request) error {
 		opt := &consulapi.QueryOptions{}
@@ -67,6 +92,24 @@ func newGetConsul(conn *consulapi.KV) ReqHandler {
 	}
 }
 
+// newRangeConsul returns the handler of range reads, which records
+// the latency by the number of returned keys.
+func newRangeConsul(conn *consulapi.KV, rl *rangeLatencies) ReqHandler {
+	return func(ctx context.Context, req *request) error {
+		opt := &consulapi.QueryOptions{
+			AllowStale:        req.consulOp.staleRead,
+			RequireConsistent: !req.consulOp.staleRead,
+		}
+		st := time.Now()
+		pairs, _, err := conn.List(req.consulOp.key, opt)
+		if err != nil {
+			return err
+		}
+		rl.observe(int64(len(pairs)), time.Since(st))
+		return nil
+	}
+}
+
 func getTotalKeysConsul(endpoints []string) map[string]int64 {
 	rs := make(map[string]int64)
 	for _, ep := range endpoints {
@@ -21,6 +21,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/coreos/etcd/clientv3"
 	"golang.org/x/net/context"
@@ -38,16 +39,22 @@ func newPutEtcd3(conn clientv3.KV) ReqHandler {
 var dialTotal int
 
 func mustCreateConnEtcdv3(endpoints []string) *clientv3.Client {
+	endpoints = discoveredEndpoints(endpoints)
 	endpoint := endpoints[dialTotal%len(endpoints)]
 	dialTotal++
-	cfg := clientv3.Config{
-		Endpoints: []string{endpoint},
-	}
-	client, err := clientv3.New(cfg)
+	client, err := clientv3.New(newEtcdv3Config(endpoint))
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "dial error: %v\n", err)
 		os.Exit(1)
 	}
+	if warmEnabled() {
+		warmEtcdv3(client)
+	}
+	disableEtcdv3Retry(client)
+	limitEtcdv3Streams(client)
+	if activeDiscovery != nil {
+		activeDiscovery.trackEtcd(client)
+	}
 	return client
 }
 
@@ -76,6 +83,20 @@ func newGetEtcd3(conn clientv3.KV) ReqHandler {
 	}
 }
 
+// newRangeEtcd3 returns the handler of range reads, which records
+// the latency by the number of returned keys.
+func newRangeEtcd3(conn clientv3.KV, rl *rangeLatencies) ReqHandler {
+	return func(ctx context.Context, req *request) error {
+		st := time.Now()
+		resp, err := conn.Do(ctx, req.etcdv3Op)
+		if err != nil {
+			return err
+		}
+		rl.observe(int64(len(resp.Get().Kvs)), time.Since(st))
+		return nil
+	}
+}
+
 func getTotalKeysEtcdv3(endpoints []string) map[string]int64 {
 	rs := make(map[string]int64)
 	for _, ep := range endpoints {
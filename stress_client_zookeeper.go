@@ -35,14 +35,18 @@ type zkOp struct {
 }
 
 func mustCreateConnsZk(endpoints []string, total int64) []*zk.Conn {
+	endpoints = discoveredEndpoints(endpoints)
 	zks := make([]*zk.Conn, total)
 	for i := range zks {
 		endpoint := endpoints[dialTotal%len(endpoints)]
 		dialTotal++
-		conn, _, err := zk.Connect([]string{endpoint}, time.Second)
+		conn, _, err := zkConnect(endpoint, time.Second)
 		if err != nil {
 			plog.Fatal(err)
 		}
+		if warmEnabled() {
+			warmZk(conn)
+		}
 		zks[i] = conn
 	}
 	return zks
@@ -65,6 +69,25 @@ func newPutOverwriteZK(conn *zk.Conn) ReqHandler {
 	}
 }
 
+func newBatchPutZK(conn *zk.Conn) ReqHandler {
+	return func(ctx context.Context, req *request) error {
+		ops := make([]interface{}, len(req.zkBatch))
+		for i, op := range req.zkBatch {
+			ops[i] = &zk.CreateRequest{Path: op.key, Data: op.value, Acl: zkCreateACL, Flags: zkCreateFlags}
+		}
+		resps, err := conn.Multi(ops...)
+		if err != nil {
+			return err
+		}
+		for _, resp := range resps {
+			if resp.Error != nil {
+				return resp.Error
+			}
+		}
+		return nil
+	}
+}
+
 func newGetZK(conn *zk.Conn) ReqHandler {
 	return func(ctx context.Context, req *request) error {
 		errt := ""
@@ -0,0 +1,140 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"net"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/clientv3"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/samuel/go-zookeeper/zk"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// connSettings is the connection settings of the database
+// being stressed, if any.
+var connSettings *dbtesterpb.ConfigClientMachineConnection
+
+// clientEndpoints returns the endpoints of load-generating clients:
+// 'proxy_endpoints' if any, or the database endpoints otherwise.
+// Per-node checks (e.g. total keys, consistency) still use the
+// database endpoints.
+func clientEndpoints(gcfg dbtesterpb.ConfigClientMachineAgentControl) []string {
+	if len(gcfg.ProxyEndpoints) > 0 {
+		return gcfg.ProxyEndpoints
+	}
+	return gcfg.DatabaseEndpoints
+}
+
+func msToDuration(ms int64) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
+
+// newEtcdv3Config returns the etcd client configuration
+// with connection settings applied.
+func newEtcdv3Config(endpoint string) clientv3.Config {
+	cfg := clientv3.Config{Endpoints: []string{endpoint}}
+	if connEvents != nil {
+		cfg.DialOptions = []grpc.DialOption{grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+			d := &net.Dialer{Timeout: timeout}
+			return dialWithEvents("tcp", addr, d.Dial)
+		})}
+	}
+	cs := connSettings
+	if cs == nil {
+		return cfg
+	}
+	cfg.DialTimeout = msToDuration(cs.DialTimeoutMs)
+	cfg.DialKeepAliveTime = msToDuration(cs.KeepAliveTimeMs)
+	cfg.DialKeepAliveTimeout = msToDuration(cs.KeepAliveTimeoutMs)
+	return cfg
+}
+
+// limitedKV caps in-flight requests on one connection.
+type limitedKV struct {
+	clientv3.KV
+	sema chan struct{}
+}
+
+func (kv *limitedKV) Do(ctx context.Context, op clientv3.Op) (clientv3.OpResponse, error) {
+	select {
+	case kv.sema <- struct{}{}:
+	case <-ctx.Done():
+		return clientv3.OpResponse{}, ctx.Err()
+	}
+	defer func() { <-kv.sema }()
+	return kv.KV.Do(ctx, op)
+}
+
+// limitEtcdv3Streams caps in-flight requests on the client connection,
+// if configured.
+func limitEtcdv3Streams(cli *clientv3.Client) {
+	if connSettings == nil || connSettings.MaxConcurrentStreams <= 0 {
+		return
+	}
+	cli.KV = &limitedKV{KV: cli.KV, sema: make(chan struct{}, connSettings.MaxConcurrentStreams)}
+}
+
+// newConsulConfig returns the Consul client configuration
+// with connection settings applied.
+func newConsulConfig(endpoint string) *consulapi.Config {
+	dcfg := consulapi.DefaultConfig()
+	dcfg.Address = endpoint // x.x.x.x:8500
+	if cs := connSettings; cs != nil {
+		if cs.DialTimeoutMs > 0 || cs.KeepAliveTimeMs > 0 {
+			dialer := &net.Dialer{
+				Timeout:   msToDuration(cs.DialTimeoutMs),
+				KeepAlive: msToDuration(cs.KeepAliveTimeMs),
+			}
+			dcfg.Transport.DialContext = dialer.DialContext
+		}
+		if cs.MaxIdleConnsPerHost > 0 {
+			dcfg.Transport.MaxIdleConnsPerHost = int(cs.MaxIdleConnsPerHost)
+		}
+		if cs.IdleConnTimeoutMs > 0 {
+			dcfg.Transport.IdleConnTimeout = msToDuration(cs.IdleConnTimeoutMs)
+		}
+	}
+	if dial := dcfg.Transport.DialContext; connEvents != nil && dial != nil {
+		dcfg.Transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return dialWithEvents(network, addr, func(network, addr string) (net.Conn, error) {
+				return dial(ctx, network, addr)
+			})
+		}
+	}
+	return dcfg
+}
+
+// zkConnect connects to Zookeeper with connection settings applied.
+func zkConnect(endpoint string, sessionTimeout time.Duration) (*zk.Conn, <-chan zk.Event, error) {
+	cs := connSettings
+	if connEvents == nil && (cs == nil || (cs.DialTimeoutMs <= 0 && cs.KeepAliveTimeMs <= 0)) {
+		return zk.Connect([]string{endpoint}, sessionTimeout)
+	}
+	dialer := func(network, address string, timeout time.Duration) (net.Conn, error) {
+		d := &net.Dialer{Timeout: timeout}
+		if cs != nil {
+			if cs.DialTimeoutMs > 0 {
+				d.Timeout = msToDuration(cs.DialTimeoutMs)
+			}
+			d.KeepAlive = msToDuration(cs.KeepAliveTimeMs)
+		}
+		return dialWithEvents(network, address, d.Dial)
+	}
+	return zk.Connect([]string{endpoint}, sessionTimeout, zk.WithDialer(dialer))
+}
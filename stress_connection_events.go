@@ -0,0 +1,225 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ConnectionEventColumns are the columns of client connection events.
+// The same events in the same second are merged, with their COUNT.
+var ConnectionEventColumns = []string{"UNIX-NANOSECOND", "EVENT", "ENDPOINT", "DETAIL", "COUNT"}
+
+// connection events of clients
+const (
+	connEventDial           = "dial"
+	connEventDialError      = "dial-error"
+	connEventDisconnect     = "disconnect"
+	connEventIdleClose      = "idle-close"
+	connEventReResolve      = "re-resolve"
+	connEventLeaderRedirect = "leader-redirect"
+)
+
+// leaderRedirectErrors are the error messages of requests rejected or
+// redirected because the leader changed or is unknown.
+var leaderRedirectErrors = []string{
+	"etcdserver: no leader",
+	"etcdserver: not leader",
+	"possibly due to previous leader failure", // etcd
+	"No cluster leader",                       // Consul
+}
+
+type connEvent struct {
+	unixNano int64
+	event    string
+	endpoint string
+	detail   string
+	count    int64
+}
+
+type connEventKey struct {
+	unixSecond int64
+	event      string
+	endpoint   string
+	detail     string
+}
+
+// connEventLogger keeps the connection events of clients in memory,
+// merging the same events in the same second (e.g. dials of all
+// connections at start, or leader redirects of all clients).
+type connEventLogger struct {
+	now func() time.Time
+
+	mu     sync.Mutex
+	events []connEvent
+	index  map[connEventKey]int
+}
+
+// connEvents is the connection event logger of the database being
+// stressed, if any. Connections created while it is set are watched.
+var connEvents *connEventLogger
+
+func newConnEventLogger() *connEventLogger {
+	return &connEventLogger{now: time.Now, index: make(map[connEventKey]int)}
+}
+
+func (l *connEventLogger) record(event, endpoint, detail string) {
+	now := l.now()
+	k := connEventKey{unixSecond: now.Unix(), event: event, endpoint: endpoint, detail: detail}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if i, ok := l.index[k]; ok {
+		l.events[i].count++
+		return
+	}
+	l.index[k] = len(l.events)
+	l.events = append(l.events, connEvent{unixNano: now.UnixNano(), event: event, endpoint: endpoint, detail: detail, count: 1})
+}
+
+// observeError records a leader redirect if the request error is of
+// leader changes.
+func (l *connEventLogger) observeError(err error) {
+	if err == nil {
+		return
+	}
+	msg := err.Error()
+	for _, s := range leaderRedirectErrors {
+		if strings.Contains(msg, s) {
+			l.record(connEventLeaderRedirect, "", msg)
+			return
+		}
+	}
+}
+
+// dial dials with 'dial', and records the dial and the disconnect
+// of the connection.
+func (l *connEventLogger) dial(network, address string, dial func(network, address string) (net.Conn, error)) (net.Conn, error) {
+	conn, err := dial(network, address)
+	if err != nil {
+		l.record(connEventDialError, address, err.Error())
+		return nil, err
+	}
+	l.record(connEventDial, address, "")
+	return &eventConn{Conn: conn, l: l, endpoint: address}, nil
+}
+
+// save writes all events in CSV, in the order of occurrence.
+func (l *connEventLogger) save(fpath string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.Create(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	if err = wr.Write(ConnectionEventColumns); err != nil {
+		return err
+	}
+	for _, ev := range l.events {
+		if err = wr.Write([]string{
+			fmt.Sprintf("%d", ev.unixNano),
+			ev.event,
+			ev.endpoint,
+			ev.detail,
+			fmt.Sprintf("%d", ev.count),
+		}); err != nil {
+			return err
+		}
+	}
+	wr.Flush()
+	return wr.Error()
+}
+
+// eventConn records the first read or write error as a disconnect,
+// unless the client closed the connection. EOF without a request in
+// flight is recorded as an idle close, since servers close idle
+// connections (e.g. pooled HTTP connections of Consul clients).
+type eventConn struct {
+	net.Conn
+	l        *connEventLogger
+	endpoint string
+
+	mu     sync.Mutex
+	closed bool
+	lost   bool
+	// inflight is true from a write until a read returns data
+	inflight bool
+}
+
+func (c *eventConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.mu.Lock()
+		c.inflight = false
+		c.mu.Unlock()
+	}
+	if err != nil {
+		c.lose(err)
+	}
+	return n, err
+}
+
+func (c *eventConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 {
+		c.mu.Lock()
+		c.inflight = true
+		c.mu.Unlock()
+	}
+	if err != nil {
+		c.lose(err)
+	}
+	return n, err
+}
+
+func (c *eventConn) Close() error {
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+	return c.Conn.Close()
+}
+
+func (c *eventConn) lose(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed || c.lost {
+		return
+	}
+	c.lost = true
+	if err == io.EOF && !c.inflight {
+		c.l.record(connEventIdleClose, c.endpoint, err.Error())
+		return
+	}
+	c.l.record(connEventDisconnect, c.endpoint, err.Error())
+}
+
+// dialWithEvents dials with 'dial', recording connection events if enabled.
+func dialWithEvents(network, address string, dial func(network, address string) (net.Conn, error)) (net.Conn, error) {
+	if l := connEvents; l != nil {
+		return l.dial(network, address, dial)
+	}
+	return dial(network, address)
+}
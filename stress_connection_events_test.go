@@ -0,0 +1,132 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConnEventLogger(t *testing.T) {
+	l := newConnEventLogger()
+	now := time.Unix(100, 0)
+	l.now = func() time.Time { return now }
+
+	l.record(connEventDial, "a:2379", "")
+	l.record(connEventDial, "a:2379", "")
+	l.record(connEventDial, "b:2379", "")
+	l.observeError(errors.New("etcdserver: no leader"))
+	l.observeError(errors.New("etcdserver: mvcc: required revision has been compacted"))
+	now = now.Add(time.Second)
+	l.record(connEventDial, "a:2379", "")
+
+	if len(l.events) != 4 {
+		t.Fatalf("expected 4 events, got %+v", l.events)
+	}
+	if l.events[0].count != 2 || l.events[1].count != 1 || l.events[3].count != 1 {
+		t.Fatalf("expected same events in the same second to be merged, got %+v", l.events)
+	}
+	if l.events[2].event != connEventLeaderRedirect {
+		t.Fatalf("expected leader redirect, got %+v", l.events[2])
+	}
+
+	dir, err := ioutil.TempDir(os.TempDir(), "conn-events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fpath := filepath.Join(dir, "client-connection-events.csv")
+	if err = l.save(fpath); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 5 || lines[0] != strings.Join(ConnectionEventColumns, ",") || lines[1] != "100000000000,dial,a:2379,,2" {
+		t.Fatalf("unexpected CSV\n%s", b)
+	}
+}
+
+func TestConnEventDisconnect(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	l := newConnEventLogger()
+	conn, err := l.dial("tcp", ln.Addr().String(), net.Dial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// request in flight
+	conn.Write([]byte("a"))
+	if _, err = conn.Read(make([]byte, 1)); err == nil {
+		t.Fatal("expected error on closed connection")
+	}
+	conn.Read(make([]byte, 1))
+	conn.Close()
+
+	// closed by the server while idle, not a disconnect
+	conn, err = l.dial("tcp", ln.Addr().String(), net.Dial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = conn.Read(make([]byte, 1)); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+	conn.Close()
+
+	// closed by the client, not a disconnect
+	conn, err = l.dial("tcp", ln.Addr().String(), net.Dial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+	conn.Read(make([]byte, 1))
+
+	if _, err = l.dial("tcp", "127.0.0.1:1", net.Dial); err == nil {
+		t.Fatal("expected dial error")
+	}
+
+	var events []string
+	for _, ev := range l.events {
+		for i := int64(0); i < ev.count; i++ {
+			events = append(events, ev.event)
+		}
+	}
+	// dials in the same second are merged into the first
+	if s := strings.Join(events, " "); s != "dial dial dial disconnect idle-close dial-error" {
+		t.Fatalf("unexpected events %q", s)
+	}
+}
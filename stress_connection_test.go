@@ -0,0 +1,62 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestConnectionSettings(t *testing.T) {
+	if cfg := newEtcdv3Config("a:2379"); cfg.DialTimeout != 0 || cfg.DialKeepAliveTime != 0 {
+		t.Fatalf("expected client defaults, got %+v", cfg)
+	}
+
+	connSettings = &dbtesterpb.ConfigClientMachineConnection{
+		DialTimeoutMs:       500,
+		KeepAliveTimeMs:     1000,
+		KeepAliveTimeoutMs:  300,
+		MaxIdleConnsPerHost: 7,
+		IdleConnTimeoutMs:   2000,
+	}
+	defer func() { connSettings = nil }()
+
+	cfg := newEtcdv3Config("a:2379")
+	if cfg.DialTimeout != 500*time.Millisecond || cfg.DialKeepAliveTime != time.Second || cfg.DialKeepAliveTimeout != 300*time.Millisecond {
+		t.Fatalf("unexpected etcd config %+v", cfg)
+	}
+
+	dcfg := newConsulConfig("a:8500")
+	if dcfg.Address != "a:8500" {
+		t.Fatalf("unexpected address %q", dcfg.Address)
+	}
+	if dcfg.Transport.MaxIdleConnsPerHost != 7 || dcfg.Transport.IdleConnTimeout != 2*time.Second {
+		t.Fatalf("unexpected transport %+v", dcfg.Transport)
+	}
+}
+
+func TestClientEndpoints(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{DatabaseEndpoints: []string{"10.240.0.7:2379", "10.240.0.8:2379"}}
+	if eps := clientEndpoints(gcfg); !reflect.DeepEqual(eps, gcfg.DatabaseEndpoints) {
+		t.Fatalf("expected database endpoints, got %q", eps)
+	}
+	gcfg.ProxyEndpoints = []string{"10.240.0.13:23790"}
+	if eps := clientEndpoints(gcfg); !reflect.DeepEqual(eps, gcfg.ProxyEndpoints) {
+		t.Fatalf("expected proxy endpoints, got %q", eps)
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/samuel/go-zookeeper/zk"
+	"golang.org/x/net/context"
+)
+
+const (
+	consistencyCounterKey = "dbtester-consistency-counter"
+
+	consistencyCheckInterval = 10 * time.Millisecond
+)
+
+// consistencyChecker counts read-your-writes violations found
+// while the benchmark is running.
+type consistencyChecker struct {
+	mu      sync.Mutex
+	enabled bool
+	// linearizable is true if the database promises linearizable reads
+	// in the configured read mode, so that a read on any endpoint must
+	// see the writes acknowledged before the read started. Otherwise,
+	// reads are only checked to be monotonic on each endpoint.
+	linearizable bool
+
+	// cancel stops the checker, and donec is closed after it stops
+	cancel context.CancelFunc
+	donec  <-chan struct{}
+
+	// acked is the last counter value acknowledged by the writer
+	acked int64
+
+	writes int64
+	reads  int64
+
+	// staleReads is the number of reads that returned a value older than
+	// the value acknowledged before the read started
+	staleReads int64
+	// nonMonotonicReads is the number of reads that returned a value
+	// older than the previous read from the same endpoint
+	nonMonotonicReads int64
+}
+
+// consistency is shared by the checker and the summary report.
+var consistency = &consistencyChecker{}
+
+func (c *consistencyChecker) reset(linearizable bool) {
+	c.mu.Lock()
+	c.enabled = true
+	c.linearizable = linearizable
+	c.acked, c.writes, c.reads = 0, 0, 0
+	c.staleReads, c.nonMonotonicReads = 0, 0
+	c.mu.Unlock()
+}
+
+func (c *consistencyChecker) ack(v int64) {
+	c.mu.Lock()
+	c.writes++
+	if v > c.acked {
+		c.acked = v
+	}
+	c.mu.Unlock()
+}
+
+func (c *consistencyChecker) lastAcked() int64 {
+	c.mu.Lock()
+	v := c.acked
+	c.mu.Unlock()
+	return v
+}
+
+// observe records the value read from 'ep', given the value acknowledged
+// before the read started and the previous value read from the endpoint.
+func (c *consistencyChecker) observe(ep string, acked, prev, v int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reads++
+	if c.linearizable && v < acked {
+		c.staleReads++
+		plog.Warningf("consistency violation: stale read [endpoint: %q | read: %d | acknowledged: %d]", ep, v, acked)
+	}
+	if v < prev {
+		c.nonMonotonicReads++
+		plog.Warningf("consistency violation: non-monotonic read [endpoint: %q | read: %d | previous: %d]", ep, v, prev)
+	}
+}
+
+// isEnabled returns true if the checker has been started.
+func (c *consistencyChecker) isEnabled() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.enabled
+}
+
+// stop stops the checker and waits until all its clients are closed,
+// so that no read is observed afterwards. It is no-op if the checker
+// is not running.
+func (c *consistencyChecker) stop() {
+	c.mu.Lock()
+	cancel, donec := c.cancel, c.donec
+	c.cancel, c.donec = nil, nil
+	c.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-donec
+}
+
+// violations returns the total number of violations.
+func (c *consistencyChecker) violations() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.staleReads + c.nonMonotonicReads
+}
+
+func (c *consistencyChecker) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return fmt.Sprintf("writes: %d | reads: %d | stale reads: %d | non-monotonic reads: %d", c.writes, c.reads, c.staleReads, c.nonMonotonicReads)
+}
+
+// counterClient reads and writes the consistency counter on one endpoint.
+type counterClient interface {
+	put(v int64) error
+	get() (int64, error)
+	close()
+}
+
+// linearizableReads returns true if reads of the database are linearizable
+// in the read mode: etcd and Consul (with consistent mode) reads are,
+// unless 'stale_read' is set. ZooKeeper (and zetcd, which implements its
+// API) only promises sequential consistency, where a follower may serve
+// reads behind the leader.
+func linearizableReads(databaseID string, stale bool) bool {
+	switch databaseID {
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		return false
+	}
+	return !stale
+}
+
+// checkConsistency runs one writer that increments the counter and
+// one reader per endpoint, until 'consistency.stop' is called. Reads honor
+// 'stale_read', so that weaker read modes can be validated as well: reads
+// on an endpoint are compared with the acknowledged writes only if the
+// read mode is linearizable, and are checked to be monotonic otherwise.
+func checkConsistency(gcfg dbtesterpb.ConfigClientMachineAgentControl) {
+	var newClient func(ep string, stale bool) (counterClient, error)
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		newClient = newCounterEtcdv3
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		newClient = newCounterZk
+	case "consul__v1_0_2", "cetcd__beta":
+		newClient = newCounterConsul
+	default:
+		plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+	}
+	stale := gcfg.ConfigClientMachineBenchmarkOptions.StaleRead
+
+	linearizable := linearizableReads(gcfg.DatabaseID, stale)
+	consistency.reset(linearizable)
+	plog.Infof("started consistency check on %v (linearizable reads %v)", gcfg.DatabaseEndpoints, linearizable)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+	wg.Add(1 + len(gcfg.DatabaseEndpoints))
+	go func() {
+		defer wg.Done()
+		ep := gcfg.DatabaseEndpoints[0]
+		cli, err := newClient(ep, stale)
+		if err != nil {
+			plog.Warningf("consistency check writer failed on %q (%v)", ep, err)
+			return
+		}
+		defer cli.close()
+		for v := int64(1); ; v++ {
+			select {
+			case <-time.After(consistencyCheckInterval):
+			case <-ctx.Done():
+				return
+			}
+			if LoadAborted() {
+				// reads go on, to check the last acknowledged write
+				return
+			}
+			if err := cli.put(v); err != nil {
+				// the write may or may not have been applied
+				plog.Warningf("consistency check write failed on %q (%v)", ep, err)
+				continue
+			}
+			consistency.ack(v)
+		}
+	}()
+	for _, ep := range gcfg.DatabaseEndpoints {
+		go func(ep string) {
+			defer wg.Done()
+			cli, err := newClient(ep, stale)
+			if err != nil {
+				plog.Warningf("consistency check reader failed on %q (%v)", ep, err)
+				return
+			}
+			defer cli.close()
+			prev := int64(0)
+			for {
+				select {
+				case <-time.After(consistencyCheckInterval):
+				case <-ctx.Done():
+					return
+				}
+				acked := consistency.lastAcked()
+				v, err := cli.get()
+				if err != nil {
+					plog.Warningf("consistency check read failed on %q (%v)", ep, err)
+					continue
+				}
+				consistency.observe(ep, acked, prev, v)
+				if v > prev {
+					prev = v
+				}
+			}
+		}(ep)
+	}
+
+	donec := make(chan struct{})
+	go func() {
+		wg.Wait()
+		plog.Infof("finished consistency check [%s]", consistency)
+		close(donec)
+	}()
+
+	consistency.mu.Lock()
+	consistency.cancel, consistency.donec = cancel, donec
+	consistency.mu.Unlock()
+}
+
+type counterEtcdv3 struct {
+	cli   *clientv3.Client
+	stale bool
+}
+
+func newCounterEtcdv3(ep string, stale bool) (counterClient, error) {
+	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{ep}, DialTimeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	return &counterEtcdv3{cli: cli, stale: stale}, nil
+}
+
+func (c *counterEtcdv3) put(v int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	_, err := c.cli.Put(ctx, consistencyCounterKey, strconv.FormatInt(v, 10))
+	cancel()
+	return err
+}
+
+func (c *counterEtcdv3) get() (int64, error) {
+	var opts []clientv3.OpOption
+	if c.stale {
+		opts = append(opts, clientv3.WithSerializable())
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	resp, err := c.cli.Get(ctx, consistencyCounterKey, opts...)
+	cancel()
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Kvs) == 0 {
+		return 0, nil
+	}
+	return strconv.ParseInt(string(resp.Kvs[0].Value), 10, 64)
+}
+
+func (c *counterEtcdv3) close() { c.cli.Close() }
+
+type counterZk struct {
+	conn *zk.Conn
+}
+
+// newCounterZk ignores 'stale', since ZooKeeper reads are
+// always served by the connected server.
+func newCounterZk(ep string, stale bool) (counterClient, error) {
+	conn, _, err := zk.Connect([]string{ep}, 5*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &counterZk{conn: conn}, nil
+}
+
+func (c *counterZk) put(v int64) error {
+	val := []byte(strconv.FormatInt(v, 10))
+	_, err := c.conn.Set("/"+consistencyCounterKey, val, -1)
+	if err == zk.ErrNoNode {
+		_, err = c.conn.Create("/"+consistencyCounterKey, val, zkCreateFlags, zkCreateACL)
+	}
+	return err
+}
+
+func (c *counterZk) get() (int64, error) {
+	val, _, err := c.conn.Get("/" + consistencyCounterKey)
+	if err == zk.ErrNoNode {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(val), 10, 64)
+}
+
+func (c *counterZk) close() { c.conn.Close() }
+
+type counterConsul struct {
+	kv    *consulapi.KV
+	stale bool
+}
+
+func newCounterConsul(ep string, stale bool) (counterClient, error) {
+	dcfg := consulapi.DefaultConfig()
+	dcfg.Address = ep
+	cli, err := consulapi.NewClient(dcfg)
+	if err != nil {
+		return nil, err
+	}
+	return &counterConsul{kv: cli.KV(), stale: stale}, nil
+}
+
+func (c *counterConsul) put(v int64) error {
+	_, err := c.kv.Put(&consulapi.KVPair{Key: consistencyCounterKey, Value: []byte(strconv.FormatInt(v, 10))}, nil)
+	return err
+}
+
+func (c *counterConsul) get() (int64, error) {
+	pair, _, err := c.kv.Get(consistencyCounterKey, &consulapi.QueryOptions{AllowStale: c.stale, RequireConsistent: !c.stale})
+	if err != nil {
+		return 0, err
+	}
+	if pair == nil {
+		return 0, nil
+	}
+	return strconv.ParseInt(string(pair.Value), 10, 64)
+}
+
+func (c *counterConsul) close() {}
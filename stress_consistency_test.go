@@ -0,0 +1,79 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import "testing"
+
+func TestConsistencyChecker(t *testing.T) {
+	c := &consistencyChecker{}
+	c.reset(true)
+
+	c.ack(1)
+	c.ack(2)
+	if v := c.lastAcked(); v != 2 {
+		t.Fatalf("expected 2, got %d", v)
+	}
+	c.observe("a", 2, 0, 2)
+	if v := c.violations(); v != 0 {
+		t.Fatalf("expected no violation, got %d", v)
+	}
+
+	// read older than the acknowledged write
+	c.observe("a", 2, 2, 1)
+	if c.staleReads != 1 || c.nonMonotonicReads != 1 {
+		t.Fatalf("expected 1 stale and 1 non-monotonic read, got %s", c)
+	}
+	c.observe("b", 3, 0, 2)
+	if c.staleReads != 2 || c.nonMonotonicReads != 1 {
+		t.Fatalf("expected 2 stale and 1 non-monotonic read, got %s", c)
+	}
+	if v := c.violations(); v != 3 {
+		t.Fatalf("expected 3 violations, got %d", v)
+	}
+
+	// followers may serve stale reads without linearizable reads
+	c.reset(false)
+	c.observe("b", 3, 0, 2)
+	if v := c.violations(); v != 0 {
+		t.Fatalf("expected no violation, got %d", v)
+	}
+	c.observe("b", 3, 2, 1)
+	if c.staleReads != 0 || c.nonMonotonicReads != 1 {
+		t.Fatalf("expected 1 non-monotonic read, got %s", c)
+	}
+
+	// no-op if not running
+	c.stop()
+}
+
+func TestLinearizableReads(t *testing.T) {
+	tests := []struct {
+		databaseID string
+		stale      bool
+		exp        bool
+	}{
+		{"etcd__tip", false, true},
+		{"etcd__tip", true, false},
+		{"consul__v1_0_2", false, true},
+		{"consul__v1_0_2", true, false},
+		{"zookeeper__r3_5_3_beta", false, false},
+		{"zetcd__beta", false, false},
+	}
+	for i, tt := range tests {
+		if got := linearizableReads(tt.databaseID, tt.stale); got != tt.exp {
+			t.Errorf("#%d: %q (stale %v) expected %v, got %v", i, tt.databaseID, tt.stale, tt.exp, got)
+		}
+	}
+}
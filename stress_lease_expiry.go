@@ -0,0 +1,567 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/clientv3"
+	"github.com/gyuho/dataframe"
+	consulapi "github.com/hashicorp/consul/api"
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+)
+
+// leaseExpiryPrefix is the prefix of keys attached to leases
+// in "lease-expiry" type benchmark.
+const leaseExpiryPrefix = "lease-expiry/"
+
+// LeaseExpiryColumns are the columns of expired keys per second
+// in "lease-expiry" type benchmark.
+var LeaseExpiryColumns = []string{
+	"UNIX-SECOND",
+	"EXPIRED-KEYS",
+	"AVG-EXPIRY-LATENCY-MS",
+	"MAX-EXPIRY-LATENCY-MS",
+}
+
+// LeaseExpiryAccuracyColumns are the columns of the distribution of key
+// expiry deviations from the lease TTL in "lease-expiry" type benchmark.
+// Negative deviations are keys deleted before the TTL elapsed.
+var LeaseExpiryAccuracyColumns = []string{
+	"DEVIATION-MS-FROM",
+	"DEVIATION-MS-TO",
+	"KEYS",
+	"PERCENT",
+}
+
+// LeaseExpirySummaryPrefix is the prefix of latency distribution summary
+// columns of key expiry accuracy in "lease-expiry" type benchmark.
+const LeaseExpirySummaryPrefix = "LEASE-EXPIRY-"
+
+// leaseExpiryDeviationBoundsMs are the bounds of deviation buckets
+// in milliseconds, with one more bucket below the first and above the last.
+var leaseExpiryDeviationBoundsMs = []int64{-1000, -100, 0, 100, 250, 500, 1000, 2000, 5000, 10000}
+
+// leaseExpiryWaitTTLs is the number of TTLs to wait for keys to be
+// deleted after the last lease expiry. Consul invalidates sessions
+// within twice the TTL.
+const leaseExpiryWaitTTLs = 3
+
+// defaultLeaseExpiryTolerance is the maximum deviation of key expiry
+// from the TTL to count as accurate, if not configured.
+const defaultLeaseExpiryTolerance = time.Second
+
+type expirySecond struct {
+	keys       int64
+	latencySum time.Duration
+	latencyMax time.Duration
+}
+
+// leaseExpiryRecorder records the expiry propagation latency of keys,
+// from the lease expiry to the deletion of the key seen by the client.
+type leaseExpiryRecorder struct {
+	mu         sync.Mutex
+	attachedAt map[string]time.Time
+	expiresAt  map[string]time.Time
+	seconds    map[int64]*expirySecond
+	latencies  []time.Duration
+	// deviations are the signed differences of the deletion
+	// from the lease expiry, negative if deleted early.
+	deviations []time.Duration
+}
+
+func newLeaseExpiryRecorder() *leaseExpiryRecorder {
+	return &leaseExpiryRecorder{
+		attachedAt: make(map[string]time.Time),
+		expiresAt:  make(map[string]time.Time),
+		seconds:    make(map[int64]*expirySecond),
+	}
+}
+
+// attached records the keys attached to the lease expiring at 'expiresAt'.
+func (r *leaseExpiryRecorder) attached(keys []string, now, expiresAt time.Time) {
+	r.mu.Lock()
+	for _, k := range keys {
+		r.attachedAt[k] = now
+		r.expiresAt[k] = expiresAt
+	}
+	r.mu.Unlock()
+}
+
+// deleted records the deletion of the key, seen by the client.
+func (r *leaseExpiryRecorder) deleted(key string, now time.Time) {
+	r.mu.Lock()
+	r.deletedLocked(key, now)
+	r.mu.Unlock()
+}
+
+func (r *leaseExpiryRecorder) deletedLocked(key string, now time.Time) {
+	exp, ok := r.expiresAt[key]
+	if !ok {
+		return
+	}
+	delete(r.attachedAt, key)
+	delete(r.expiresAt, key)
+
+	lat := now.Sub(exp)
+	r.deviations = append(r.deviations, lat)
+	if lat < 0 {
+		lat = 0
+	}
+	sec := now.Unix()
+	es, ok := r.seconds[sec]
+	if !ok {
+		es = &expirySecond{}
+		r.seconds[sec] = es
+	}
+	es.keys++
+	es.latencySum += lat
+	if lat > es.latencyMax {
+		es.latencyMax = lat
+	}
+	r.latencies = append(r.latencies, lat)
+}
+
+// missing records the deletion of keys attached before the listing
+// started at 'listedAt', but not in the listed keys.
+func (r *leaseExpiryRecorder) missing(listed []string, listedAt, now time.Time) {
+	present := make(map[string]struct{}, len(listed))
+	for _, k := range listed {
+		present[k] = struct{}{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, at := range r.attachedAt {
+		if _, ok := present[k]; ok || !at.Before(listedAt) {
+			continue
+		}
+		r.deletedLocked(k, now)
+	}
+}
+
+// pending returns the number of keys not yet deleted.
+func (r *leaseExpiryRecorder) pending() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.expiresAt)
+}
+
+// frame returns the expired keys per second, sorted by second.
+func (r *leaseExpiryRecorder) frame() (dataframe.Frame, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	secs := make([]int64, 0, len(r.seconds))
+	for sec := range r.seconds {
+		secs = append(secs, sec)
+	}
+	sort.Slice(secs, func(i, j int) bool { return secs[i] < secs[j] })
+
+	cs := make([]dataframe.Column, len(LeaseExpiryColumns))
+	for i := range cs {
+		cs[i] = dataframe.NewColumn(LeaseExpiryColumns[i])
+	}
+	for _, sec := range secs {
+		es := r.seconds[sec]
+		cs[0].PushBack(dataframe.NewStringValue(sec))
+		cs[1].PushBack(dataframe.NewStringValue(es.keys))
+		cs[2].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", toMillisecond(es.latencySum)/float64(es.keys))))
+		cs[3].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", toMillisecond(es.latencyMax))))
+	}
+	fr := dataframe.New()
+	for _, c := range cs {
+		if err := fr.AddColumn(c); err != nil {
+			return nil, err
+		}
+	}
+	return fr, nil
+}
+
+// summary returns the number of expired keys, with the average,
+// 99th percentile, and maximum expiry propagation latency.
+func (r *leaseExpiryRecorder) summary() (expired int, avgMs, p99Ms, maxMs float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	expired = len(r.latencies)
+	if expired == 0 {
+		return 0, 0, 0, 0
+	}
+	lats := make([]time.Duration, expired)
+	copy(lats, r.latencies)
+	sort.Slice(lats, func(i, j int) bool { return lats[i] < lats[j] })
+	var sum time.Duration
+	for _, lat := range lats {
+		sum += lat
+	}
+	return expired, toMillisecond(sum) / float64(expired), toMillisecond(lats[(expired*99-1)/100]), toMillisecond(lats[expired-1])
+}
+
+// leaseExpiryAccuracy is the deviations of key expiry from the lease TTL.
+type leaseExpiryAccuracy struct {
+	deleted int
+	// notDeleted is the number of keys not deleted within
+	// leaseExpiryWaitTTLs after the last lease expiry.
+	notDeleted int
+	// early is the number of keys deleted before the TTL elapsed.
+	early int
+	// withinPercent is the percentage of all keys, including the ones
+	// not deleted, deleted within the tolerance of the TTL.
+	withinPercent float64
+
+	minMs, p50Ms, p99Ms, maxMs float64
+	// buckets are the number of keys in leaseExpiryDeviationBoundsMs.
+	buckets []int
+}
+
+// accuracy returns the deviations of deleted keys from the TTL,
+// where the keys not yet deleted are counted as not accurate.
+func (r *leaseExpiryRecorder) accuracy(tolerance time.Duration) leaseExpiryAccuracy {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a := leaseExpiryAccuracy{
+		deleted:    len(r.deviations),
+		notDeleted: len(r.expiresAt),
+		buckets:    make([]int, len(leaseExpiryDeviationBoundsMs)+1),
+	}
+	if a.deleted == 0 {
+		return a
+	}
+	devs := make([]time.Duration, a.deleted)
+	copy(devs, r.deviations)
+	sort.Slice(devs, func(i, j int) bool { return devs[i] < devs[j] })
+
+	within := 0
+	for _, dev := range devs {
+		if dev < 0 {
+			a.early++
+		}
+		if dev >= -tolerance && dev <= tolerance {
+			within++
+		}
+		i := sort.Search(len(leaseExpiryDeviationBoundsMs), func(i int) bool {
+			return dev < time.Duration(leaseExpiryDeviationBoundsMs[i])*time.Millisecond
+		})
+		a.buckets[i]++
+	}
+	a.withinPercent = 100 * float64(within) / float64(a.deleted+a.notDeleted)
+	a.minMs = toMillisecond(devs[0])
+	a.p50Ms = toMillisecond(devs[(a.deleted*50-1)/100])
+	a.p99Ms = toMillisecond(devs[(a.deleted*99-1)/100])
+	a.maxMs = toMillisecond(devs[a.deleted-1])
+	return a
+}
+
+// summaryRows returns the rows to add to the latency distribution summary.
+func (a leaseExpiryAccuracy) summaryRows() [][]string {
+	return [][]string{
+		{LeaseExpirySummaryPrefix + "DELETED-KEYS", fmt.Sprintf("%d", a.deleted)},
+		{LeaseExpirySummaryPrefix + "NOT-DELETED-KEYS", fmt.Sprintf("%d", a.notDeleted)},
+		{LeaseExpirySummaryPrefix + "EARLY-KEYS", fmt.Sprintf("%d", a.early)},
+		{LeaseExpirySummaryPrefix + "WITHIN-TOLERANCE-PERCENT", fmt.Sprintf("%4.4f", a.withinPercent)},
+		{LeaseExpirySummaryPrefix + "MIN-DEVIATION-MS", fmt.Sprintf("%4.4f", a.minMs)},
+		{LeaseExpirySummaryPrefix + "P50-DEVIATION-MS", fmt.Sprintf("%4.4f", a.p50Ms)},
+		{LeaseExpirySummaryPrefix + "P99-DEVIATION-MS", fmt.Sprintf("%4.4f", a.p99Ms)},
+		{LeaseExpirySummaryPrefix + "MAX-DEVIATION-MS", fmt.Sprintf("%4.4f", a.maxMs)},
+	}
+}
+
+// frame returns the distribution of deviations, one row per bucket.
+func (a leaseExpiryAccuracy) frame() (dataframe.Frame, error) {
+	cs := make([]dataframe.Column, len(LeaseExpiryAccuracyColumns))
+	for i := range cs {
+		cs[i] = dataframe.NewColumn(LeaseExpiryAccuracyColumns[i])
+	}
+	for i, n := range a.buckets {
+		from, to := "-inf", "inf"
+		if i > 0 {
+			from = fmt.Sprintf("%d", leaseExpiryDeviationBoundsMs[i-1])
+		}
+		if i < len(leaseExpiryDeviationBoundsMs) {
+			to = fmt.Sprintf("%d", leaseExpiryDeviationBoundsMs[i])
+		}
+		pct := 0.0
+		if a.deleted > 0 {
+			pct = 100 * float64(n) / float64(a.deleted)
+		}
+		cs[0].PushBack(dataframe.NewStringValue(from))
+		cs[1].PushBack(dataframe.NewStringValue(to))
+		cs[2].PushBack(dataframe.NewStringValue(n))
+		cs[3].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", pct)))
+	}
+	fr := dataframe.New()
+	for _, c := range cs {
+		if err := fr.AddColumn(c); err != nil {
+			return nil, err
+		}
+	}
+	return fr, nil
+}
+
+// expiringLeases grant leases that are never kept alive.
+type expiringLeases interface {
+	// grant grants a lease with the TTL, and attaches the keys to it.
+	grant(ctx context.Context, ttl time.Duration, keys []string) error
+	// watch records deleted keys until the context is canceled.
+	watch(ctx context.Context, rec *leaseExpiryRecorder)
+	close()
+}
+
+type etcdExpiringLeases struct {
+	cli     *clientv3.Client
+	watcher *clientv3.Client
+}
+
+func newEtcdExpiringLeases(endpoints []string) *etcdExpiringLeases {
+	return &etcdExpiringLeases{
+		cli:     mustCreateConnEtcdv3(endpoints),
+		watcher: mustCreateConnEtcdv3(endpoints),
+	}
+}
+
+func (l *etcdExpiringLeases) grant(ctx context.Context, ttl time.Duration, keys []string) error {
+	resp, err := l.cli.Grant(ctx, int64(ttl/time.Second))
+	if err != nil {
+		return err
+	}
+	ops := make([]clientv3.Op, len(keys))
+	for i, k := range keys {
+		ops[i] = clientv3.OpPut(k, "", clientv3.WithLease(resp.ID))
+	}
+	_, err = l.cli.Txn(ctx).Then(ops...).Commit()
+	return err
+}
+
+func (l *etcdExpiringLeases) watch(ctx context.Context, rec *leaseExpiryRecorder) {
+	for ctx.Err() == nil {
+		wch := l.watcher.Watch(ctx, leaseExpiryPrefix, clientv3.WithPrefix(), clientv3.WithFilterPut())
+		for wresp := range wch {
+			now := time.Now()
+			for _, ev := range wresp.Events {
+				if ev.Type == clientv3.EventTypeDelete {
+					rec.deleted(string(ev.Kv.Key), now)
+				}
+			}
+		}
+	}
+}
+
+func (l *etcdExpiringLeases) close() {
+	l.cli.Close()
+	l.watcher.Close()
+}
+
+// consulExpiringSessions are the Consul equivalent of etcd leases,
+// where keys are acquired by sessions with TTL, deleted on invalidation.
+type consulExpiringSessions struct {
+	cli *consulapi.Client
+}
+
+func newConsulExpiringSessions(endpoints []string) (*consulExpiringSessions, error) {
+	endpoints = discoveredEndpoints(endpoints)
+	cli, err := consulapi.NewClient(newConsulConfig(endpoints[0]))
+	if err != nil {
+		return nil, err
+	}
+	return &consulExpiringSessions{cli: cli}, nil
+}
+
+func (s *consulExpiringSessions) grant(ctx context.Context, ttl time.Duration, keys []string) error {
+	wopts := (&consulapi.WriteOptions{}).WithContext(ctx)
+	// no health checks, so that only the TTL invalidates the session
+	id, _, err := s.cli.Session().CreateNoChecks(&consulapi.SessionEntry{
+		Behavior: consulapi.SessionBehaviorDelete,
+		TTL:      ttl.String(),
+	}, wopts)
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		ok, _, err := s.cli.KV().Acquire(&consulapi.KVPair{Key: k, Session: id}, wopts)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("failed to acquire %q", k)
+		}
+	}
+	return nil
+}
+
+// watch lists the keys with blocking queries, since Consul has
+// no delete events.
+func (s *consulExpiringSessions) watch(ctx context.Context, rec *leaseExpiryRecorder) {
+	var idx uint64
+	for ctx.Err() == nil {
+		listedAt := time.Now()
+		qopts := (&consulapi.QueryOptions{WaitIndex: idx, WaitTime: time.Second, RequireConsistent: true}).WithContext(ctx)
+		keys, meta, err := s.cli.KV().Keys(leaseExpiryPrefix, "", qopts)
+		if err != nil {
+			if ctx.Err() == nil {
+				plog.Warningf("failed to list keys (%v)", err)
+				time.Sleep(100 * time.Millisecond)
+			}
+			continue
+		}
+		rec.missing(keys, listedAt, time.Now())
+		idx = meta.LastIndex
+	}
+}
+
+func (s *consulExpiringSessions) close() {}
+
+// stressLeaseExpiry runs writes while granting short TTL leases with keys
+// attached, never kept alive, to measure the expiry propagation latency
+// under load: the time from the lease expiry until the client sees the
+// keys deleted.
+func (cfg *Config) stressLeaseExpiry(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	le := gcfg.ConfigClientMachineBenchmarkOptions.LeaseExpiry
+	if le == nil || le.LeasesPerSecond <= 0 || le.LeaseTTLSeconds <= 0 {
+		return fmt.Errorf("'lease-expiry' type requires 'leases_per_second' and 'lease_ttl_seconds'")
+	}
+	keysPerLease := le.KeysPerLease
+	if keysPerLease <= 0 {
+		keysPerLease = 1
+	}
+
+	var (
+		leases expiringLeases
+		err    error
+	)
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		leases = newEtcdExpiringLeases(clientEndpoints(gcfg))
+	case "consul__v1_0_2":
+		if le.LeaseTTLSeconds < 10 {
+			return fmt.Errorf("Consul session TTL must be at least 10 seconds, got %d", le.LeaseTTLSeconds)
+		}
+		leases, err = newConsulExpiringSessions(clientEndpoints(gcfg))
+	default:
+		return fmt.Errorf("'lease-expiry' type is not supported for %q", gcfg.DatabaseID)
+	}
+	if err != nil {
+		return err
+	}
+	defer leases.close()
+
+	rec := newLeaseExpiryRecorder()
+	ttl := time.Duration(le.LeaseTTLSeconds) * time.Second
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watchc := make(chan struct{})
+	go func() {
+		defer close(watchc)
+		leases.watch(ctx, rec)
+	}()
+
+	// leases are granted outside of the benchmark, so stop on abort
+	grantCtx, stopGrant := withLoadAbort(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		limiter := rate.NewLimiter(rate.Limit(le.LeasesPerSecond), 1)
+		for n := int64(0); ; n++ {
+			if err := limiter.Wait(grantCtx); err != nil {
+				return
+			}
+			keys := make([]string, keysPerLease)
+			for j := range keys {
+				keys[j] = fmt.Sprintf("%s%d-%d", leaseExpiryPrefix, n, j)
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				// TTL starts after the server receives the grant
+				sentAt := time.Now()
+				if err := leases.grant(grantCtx, ttl, keys); err != nil {
+					if grantCtx.Err() == nil {
+						plog.Warningf("failed to grant lease (%v)", err)
+					}
+					return
+				}
+				rec.attached(keys, time.Now(), sentAt.Add(ttl))
+			}()
+		}
+	}()
+
+	plog.Printf("lease-expiry generateReport is started with %d leases per second...", le.LeasesPerSecond)
+	h, done := newWriteHandlers(gcfg)
+	reqGen := func(inflightReqs chan<- request) { generateWrites(gcfg, 0, vals, inflightReqs) }
+	cfg.generateReport(gcfg, h, done, reqGen)
+	stopGrant()
+	wg.Wait()
+
+	wait := leaseExpiryWaitTTLs * ttl
+	deadline := time.Now().Add(wait)
+	for rec.pending() > 0 && time.Now().Before(deadline) && !LoadAborted() {
+		time.Sleep(100 * time.Millisecond)
+	}
+	cancel()
+	<-watchc
+
+	expired, avgMs, p99Ms, maxMs := rec.summary()
+	if n := rec.pending(); n > 0 {
+		plog.Warningf("%d keys are not deleted %v after the last lease expiry", n, wait)
+	}
+	plog.Printf("lease-expiry generateReport is finished [expired keys: %d | average expiry latency: %.4f ms | 99th: %.4f ms | max: %.4f ms]", expired, avgMs, p99Ms, maxMs)
+
+	tolerance := defaultLeaseExpiryTolerance
+	if le.ExpiryToleranceMilliseconds > 0 {
+		tolerance = time.Duration(le.ExpiryToleranceMilliseconds) * time.Millisecond
+	}
+	if late := wait - ttl; tolerance > late {
+		plog.Warningf("expiry tolerance %v exceeds the wait of %v after the TTL, where keys not deleted are counted as not accurate", tolerance, late)
+	}
+	acc := rec.accuracy(tolerance)
+	if acc.early > 0 {
+		plog.Warningf("%d keys are deleted before the lease TTL %v", acc.early, ttl)
+	}
+	plog.Printf("lease-expiry accuracy [within %v of TTL: %.4f %% | 50th deviation: %.4f ms | 99th: %.4f ms]", tolerance, acc.withinPercent, acc.p50Ms, acc.p99Ms)
+	// summary is saved when the stress finishes, before keys expire
+	if err = cfg.appendLatencyDistributionSummary(acc.summaryRows()); err != nil {
+		return err
+	}
+	if err = cfg.saveLeaseExpiryAccuracy(acc); err != nil {
+		return err
+	}
+	return cfg.saveLeaseExpiry(rec)
+}
+
+func (cfg *Config) saveLeaseExpiryAccuracy(acc leaseExpiryAccuracy) error {
+	if cfg.ConfigClientMachineInitial.ClientLeaseExpiryAccuracyPath == "" {
+		return nil
+	}
+	fr, err := acc.frame()
+	if err != nil {
+		return err
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientLeaseExpiryAccuracyPath)
+}
+
+func (cfg *Config) saveLeaseExpiry(rec *leaseExpiryRecorder) error {
+	if cfg.ConfigClientMachineInitial.ClientLeaseExpiryPath == "" {
+		return nil
+	}
+	fr, err := rec.frame()
+	if err != nil {
+		return err
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientLeaseExpiryPath)
+}
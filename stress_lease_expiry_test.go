@@ -0,0 +1,117 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLeaseExpiryRecorder(t *testing.T) {
+	rec := newLeaseExpiryRecorder()
+	now := time.Unix(100, 0)
+	rec.attached([]string{"a", "b"}, now, now.Add(10*time.Second))
+	rec.attached([]string{"c"}, now.Add(2*time.Second), now.Add(12*time.Second))
+
+	rec.deleted("a", now.Add(10*time.Second+20*time.Millisecond))
+	rec.deleted("a", now.Add(11*time.Second))
+	rec.deleted("unknown", now)
+
+	rec.missing([]string{"c"}, now.Add(time.Second), now.Add(11*time.Second+500*time.Millisecond))
+	if n := rec.pending(); n != 1 {
+		t.Fatalf("expected 1 pending key, got %d", n)
+	}
+	// "c" is attached after the listing started, thus not deleted
+	rec.missing(nil, now.Add(time.Second), now.Add(11*time.Second+500*time.Millisecond))
+	if n := rec.pending(); n != 1 {
+		t.Fatalf("expected 1 pending key, got %d", n)
+	}
+
+	expired, avgMs, _, maxMs := rec.summary()
+	if expired != 2 {
+		t.Fatalf("expected 2 expired keys, got %d", expired)
+	}
+	if math.Abs(avgMs-760) > 1e-9 || math.Abs(maxMs-1500) > 1e-9 {
+		t.Fatalf("expected average 760 ms and max 1500 ms, got %f and %f", avgMs, maxMs)
+	}
+
+	fr, err := rec.frame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	col, err := fr.Column("EXPIRED-KEYS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if col.Count() != 2 {
+		t.Fatalf("expected 2 seconds, got %d", col.Count())
+	}
+}
+
+func TestLeaseExpiryAccuracy(t *testing.T) {
+	rec := newLeaseExpiryRecorder()
+	now := time.Unix(100, 0)
+	exp := now.Add(10 * time.Second)
+	rec.attached([]string{"a", "b", "c", "d", "e"}, now, exp)
+
+	rec.deleted("a", exp.Add(-200*time.Millisecond))
+	rec.deleted("b", exp.Add(50*time.Millisecond))
+	rec.deleted("c", exp.Add(300*time.Millisecond))
+	rec.deleted("d", exp.Add(3*time.Second))
+
+	acc := rec.accuracy(500 * time.Millisecond)
+	if acc.deleted != 4 || acc.notDeleted != 1 || acc.early != 1 {
+		t.Fatalf("unexpected deleted %d, not deleted %d, early %d", acc.deleted, acc.notDeleted, acc.early)
+	}
+	// "e" is not deleted, thus 3 out of 5 keys are within the tolerance
+	if math.Abs(acc.withinPercent-60) > 1e-9 {
+		t.Fatalf("expected 60 %%, got %f", acc.withinPercent)
+	}
+	if acc.minMs != -200 || acc.p50Ms != 50 || acc.p99Ms != 3000 || acc.maxMs != 3000 {
+		t.Fatalf("unexpected deviations %f, %f, %f, %f", acc.minMs, acc.p50Ms, acc.p99Ms, acc.maxMs)
+	}
+	// [-1000, -100), [0, 100), [250, 500), [2000, 5000)
+	expected := []int{0, 1, 0, 1, 0, 1, 0, 0, 1, 0, 0}
+	for i := range expected {
+		if acc.buckets[i] != expected[i] {
+			t.Fatalf("expected buckets %v, got %v", expected, acc.buckets)
+		}
+	}
+
+	rows := acc.summaryRows()
+	if rows[2][0] != "LEASE-EXPIRY-EARLY-KEYS" || rows[2][1] != "1" {
+		t.Fatalf("unexpected row %v", rows[2])
+	}
+
+	fr, err := acc.frame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	col, err := fr.Column("DEVIATION-MS-FROM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if col.Count() != len(expected) {
+		t.Fatalf("expected %d buckets, got %d", len(expected), col.Count())
+	}
+	v, err := col.Value(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := v.String(); s != "-inf" {
+		t.Fatalf("expected -inf, got %q", s)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/pkg/report"
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+)
+
+const (
+	opTypeRead  = "read"
+	opTypeWrite = "write"
+)
+
+// OperationTypes is the list of operation types in "mixed" type benchmark.
+var OperationTypes = []string{opTypeRead, opTypeWrite}
+
+// OperationTimeseriesColumns defines the timeseries columns
+// saved for each operation type.
+var OperationTimeseriesColumns = []string{
+	"MIN-LATENCY-MS",
+	"AVG-LATENCY-MS",
+	"MAX-LATENCY-MS",
+	"AVG-THROUGHPUT",
+}
+
+// OperationSummaryColumns defines the summary columns
+// saved for each operation type.
+var OperationSummaryColumns = []string{
+	"REQUESTS-PER-SECOND",
+	"AVERAGE-LATENCY-MS",
+	"SLOWEST-LATENCY-MS",
+}
+
+// OperationColumn returns the column of the operation type
+// (e.g. 'READ-AVG-LATENCY-MS' for "read" and 'AVG-LATENCY-MS').
+func OperationColumn(opType, column string) string {
+	return strings.ToUpper(opType) + "-" + column
+}
+
+// stressMixed runs reads and writes together, with 'read_percent' of
+// requests being reads of previously written keys. Latency and throughput
+// are saved for all requests, and for each operation type.
+func (cfg *Config) stressMixed(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	pct := gcfg.ConfigClientMachineBenchmarkOptions.ReadPercent
+	if pct < 0 || pct > 100 {
+		return fmt.Errorf("invalid read percent %d", pct)
+	}
+	plog.Printf("mixed generateReport is started with %d%% reads...", pct)
+
+	reqGen := func(inflightReqs chan<- request) { generateMixed(gcfg, vals, inflightReqs) }
+	cfg.stressOperations(gcfg, reqGen)
+	plog.Println("mixed generateReport is finished...")
+	return nil
+}
+
+// stressOperations runs reads and writes from the request generator,
+// and saves latency and throughput for all requests, and for each
+// operation type.
+func (cfg *Config) stressOperations(gcfg dbtesterpb.ConfigClientMachineAgentControl, reqGen func(chan<- request)) {
+	h, done := newMixedHandlers(gcfg)
+	b := newBenchmark(gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, h, done, reqGen)
+	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
+		b.correctedReport = report.NewReportSample("%4.4f")
+	}
+	b.slo = newSLOCounter(gcfg.ConfigClientMachineBenchmarkOptions.LatencySLOMs)
+	b.retry = newRetryPolicy(gcfg.ConfigClientMachineBenchmarkOptions.Retry)
+	b.retries = newRetryCounter(b.retry)
+	b.hist = newLatencyHistograms(cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath)
+	b.opReports = make(map[string]report.Report, len(OperationTypes))
+	for _, op := range OperationTypes {
+		b.opReports[op] = report.NewReportSample("%4.4f")
+	}
+	b.startRequests()
+	b.waitAll()
+
+	printStats(b.stats)
+	for _, op := range OperationTypes {
+		fmt.Printf("Operation %q:\n", op)
+		printStats(b.opStats[op])
+	}
+	var corrected *report.Stats
+	if b.correctedReport != nil {
+		corrected = &b.correctedStats
+	}
+	cfg.saveAllStats(gcfg, b.stats, corrected, nil, b.slo, b.retries, b.opStats)
+	b.hist.save()
+}
+
+// newMixedHandlers returns handlers that run the request
+// with read or write handler, depending on its operation type.
+func newMixedHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+	whs, wdone := newWriteHandlers(gcfg)
+	rdhs, rdone := newReadHandlers(gcfg)
+	rhs = make([]ReqHandler, len(whs))
+	for i := range rhs {
+		wh, rh := whs[i], rdhs[i]
+		rhs[i] = func(ctx context.Context, req *request) error {
+			if req.opType == opTypeRead {
+				return rh(ctx, req)
+			}
+			return wh(ctx, req)
+		}
+	}
+	done = func() {
+		if wdone != nil {
+			wdone()
+		}
+		if rdone != nil {
+			rdone()
+		}
+	}
+	return rhs, done
+}
+
+// generateMixed generates reads and writes. Reads pick a random key among
+// the ones already written, excluding the last writes that may still be in
+// flight, so the first requests are always writes. Up to 'client_number'
+// requests are being sent, and as many are queued for the clients.
+// Keys are written in 'key_order'.
+func generateMixed(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values, inflightReqs chan<- request) {
+	var rateLimiter *rate.Limiter
+	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
+		rateLimiter = rate.NewLimiter(
+			rate.Limit(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
+			int(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
+		)
+	}
+
+	defer close(inflightReqs)
+
+	rnd := rand.New(newWorkloadSource(gcfg.ConfigClientMachineBenchmarkOptions))
+	maxInflight := 2 * gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber
+	keyNum := newKeyOrder(gcfg.ConfigClientMachineBenchmarkOptions, 0, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
+	var written int64
+	begin := time.Now()
+	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
+		// paused time is not counted as latency
+		begin = begin.Add(loadPauser.wait())
+		if LoadAborted() {
+			return
+		}
+
+		var req request
+		if rateLimiter != nil {
+			rateLimiter.Wait(context.TODO())
+			req.intendedStart = intendedStartTime(begin, gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond, i)
+		}
+
+		readable := written - maxInflight
+		if readable > 0 && rnd.Int63n(100) < gcfg.ConfigClientMachineBenchmarkOptions.ReadPercent {
+			k := prefixedSequentialKey(gcfg.ConfigClientMachineBenchmarkOptions, keyNum(rnd.Int63n(readable)))
+			if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
+				k = prefixedSameKey(gcfg.ConfigClientMachineBenchmarkOptions)
+			}
+			setReadOp(gcfg, k, &req)
+		} else {
+			k := prefixedSequentialKey(gcfg.ConfigClientMachineBenchmarkOptions, keyNum(written))
+			if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
+				k = prefixedSameKey(gcfg.ConfigClientMachineBenchmarkOptions)
+			}
+			setWriteOp(gcfg, k, vals.bytes[i%int64(vals.sampleSize)], vals.strings[i%int64(vals.sampleSize)], &req)
+			written++
+		}
+		inflightReqs <- req
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestGenerateMixed(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID: "etcd__tip",
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			Type:           "mixed",
+			RequestNumber:  10000,
+			ClientNumber:   10,
+			KeySizeBytes:   8,
+			ValueSizeBytes: 8,
+			ReadPercent:    90,
+		},
+	}
+	vals, err := newValues(gcfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqs := make(chan request, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
+	generateMixed(gcfg, vals, reqs)
+
+	var i, reads, writes int
+	for req := range reqs {
+		switch req.opType {
+		case opTypeRead:
+			if !req.etcdv3Op.IsGet() {
+				t.Fatalf("#%d: expected get for read", i)
+			}
+			reads++
+		case opTypeWrite:
+			if !req.etcdv3Op.IsPut() {
+				t.Fatalf("#%d: expected put for write", i)
+			}
+			writes++
+		default:
+			t.Fatalf("#%d: unknown operation type %q", i, req.opType)
+		}
+		if i <= 20 && req.opType != opTypeWrite {
+			t.Fatalf("#%d: expected write before in-flight writes are done", i)
+		}
+		i++
+	}
+	if reads < 8500 || reads > 9500 {
+		t.Fatalf("expected about 90%% reads, got %d reads and %d writes", reads, writes)
+	}
+}
+
+func TestGenerateMixedSeed(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID: "etcd__tip",
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			Type:           "mixed",
+			RequestNumber:  1000,
+			ClientNumber:   10,
+			KeySizeBytes:   8,
+			ValueSizeBytes: 8,
+			ReadPercent:    50,
+			Seed:           7,
+		},
+	}
+	run := func() (seq []string) {
+		vals, err := newValues(gcfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reqs := make(chan request, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
+		generateMixed(gcfg, vals, reqs)
+		for req := range reqs {
+			seq = append(seq, req.opType+" "+string(req.etcdv3Op.KeyBytes())+" "+string(req.etcdv3Op.ValueBytes()))
+		}
+		return seq
+	}
+	seq1, seq2 := run(), run()
+	if !reflect.DeepEqual(seq1, seq2) {
+		t.Fatal("expected identical requests with the same seed")
+	}
+}
+
+func TestOperationColumn(t *testing.T) {
+	if c := OperationColumn(opTypeRead, "AVG-LATENCY-MS"); c != "READ-AVG-LATENCY-MS" {
+		t.Fatalf("unexpected column %q", c)
+	}
+}
+
+func TestGenerateMixedKeyOrder(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID: "etcd__tip",
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			Type:           "mixed",
+			RequestNumber:  1000,
+			ClientNumber:   10,
+			KeySizeBytes:   8,
+			ValueSizeBytes: 8,
+			ReadPercent:    50,
+			KeyOrder:       KeyOrderRandom,
+			Seed:           7,
+		},
+	}
+	vals, err := newValues(gcfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqs := make(chan request, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
+	generateMixed(gcfg, vals, reqs)
+
+	written := make(map[string]bool)
+	sequential := true
+	var writes int
+	for req := range reqs {
+		k := string(req.etcdv3Op.KeyBytes())
+		if req.opType == opTypeRead {
+			if !written[k] {
+				t.Fatalf("read of unwritten key %q", k)
+			}
+			continue
+		}
+		if k != sequentialKey(8, int64(writes)) {
+			sequential = false
+		}
+		written[k] = true
+		writes++
+	}
+	if sequential {
+		t.Fatal("expected writes in random key order")
+	}
+}
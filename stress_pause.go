@@ -0,0 +1,173 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// pauser pauses, resumes, and aborts load generation of the running benchmark.
+type pauser struct {
+	mu sync.Mutex
+	// resumec is closed on resume, and nil if not paused
+	resumec   chan struct{}
+	pausedAt  time.Time
+	intervals []pausedInterval
+	aborted   bool
+	// abortc is closed on abort, created on first use
+	abortc chan struct{}
+}
+
+type pausedInterval struct {
+	start time.Time
+	end   time.Time
+}
+
+var loadPauser = &pauser{}
+
+// PauseLoad pauses load generation. In-flight requests complete,
+// but no new request is sent until ResumeLoad is called.
+// It returns false if already paused or aborted.
+func PauseLoad() bool {
+	loadPauser.mu.Lock()
+	defer loadPauser.mu.Unlock()
+	if loadPauser.resumec != nil || loadPauser.aborted {
+		return false
+	}
+	loadPauser.resumec = make(chan struct{})
+	loadPauser.pausedAt = time.Now()
+	plog.Infof("paused load generation")
+	return true
+}
+
+// ResumeLoad resumes load generation. It returns false if not paused.
+func ResumeLoad() bool {
+	loadPauser.mu.Lock()
+	defer loadPauser.mu.Unlock()
+	if loadPauser.resumec == nil {
+		return false
+	}
+	close(loadPauser.resumec)
+	loadPauser.resumec = nil
+	iv := pausedInterval{start: loadPauser.pausedAt, end: time.Now()}
+	loadPauser.intervals = append(loadPauser.intervals, iv)
+	plog.Infof("resumed load generation (paused %v)", iv.end.Sub(iv.start))
+	return true
+}
+
+// AbortLoad stops load generation. In-flight requests complete, and
+// results of requests sent so far are saved. Paused load generation
+// is resumed to stop. It returns false if already aborted.
+func AbortLoad() bool {
+	loadPauser.mu.Lock()
+	defer loadPauser.mu.Unlock()
+	if loadPauser.aborted {
+		return false
+	}
+	loadPauser.aborted = true
+	if loadPauser.abortc != nil {
+		close(loadPauser.abortc)
+	}
+	if loadPauser.resumec != nil {
+		close(loadPauser.resumec)
+		loadPauser.resumec = nil
+		loadPauser.intervals = append(loadPauser.intervals, pausedInterval{start: loadPauser.pausedAt, end: time.Now()})
+	}
+	plog.Warningf("aborted load generation")
+	return true
+}
+
+// LoadAborted returns true if load generation has been aborted.
+func LoadAborted() bool {
+	loadPauser.mu.Lock()
+	defer loadPauser.mu.Unlock()
+	return loadPauser.aborted
+}
+
+// abortedc returns the channel that is closed when load generation
+// is aborted, for the loops that generate load outside of benchmarks.
+func (p *pauser) abortedc() <-chan struct{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.abortc == nil {
+		p.abortc = make(chan struct{})
+		if p.aborted {
+			close(p.abortc)
+		}
+	}
+	return p.abortc
+}
+
+// withLoadAbort returns the context that is canceled when
+// load generation is aborted (e.g. error budget exceeded).
+func withLoadAbort(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	abortc := loadPauser.abortedc()
+	go func() {
+		select {
+		case <-abortc:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// LoadPaused returns true if load generation is paused.
+func LoadPaused() bool {
+	loadPauser.mu.Lock()
+	defer loadPauser.mu.Unlock()
+	return loadPauser.resumec != nil
+}
+
+// wait blocks while paused, and returns how long it was blocked.
+func (p *pauser) wait() time.Duration {
+	p.mu.Lock()
+	resumec := p.resumec
+	p.mu.Unlock()
+	if resumec == nil {
+		return 0
+	}
+	now := time.Now()
+	<-resumec
+	return time.Since(now)
+}
+
+// paused returns true if load generation was paused
+// at any moment during the unix second.
+func (p *pauser) paused(unixSecond int64) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	intervals := p.intervals
+	if p.resumec != nil {
+		intervals = append(intervals, pausedInterval{start: p.pausedAt, end: time.Now()})
+	}
+	for _, iv := range intervals {
+		if iv.start.Unix() <= unixSecond && unixSecond <= iv.end.Unix() {
+			return true
+		}
+	}
+	return false
+}
+
+// pausedAny returns true if load generation has been paused.
+func (p *pauser) pausedAny() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.intervals) > 0 || p.resumec != nil
+}
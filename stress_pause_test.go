@@ -0,0 +1,117 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"golang.org/x/net/context"
+)
+
+func TestPauseLoad(t *testing.T) {
+	defer func() { loadPauser = &pauser{} }()
+
+	if loadPauser.pausedAny() {
+		t.Fatal("expected no pause")
+	}
+	if !PauseLoad() {
+		t.Fatal("expected pause")
+	}
+	if PauseLoad() {
+		t.Fatal("expected already paused")
+	}
+
+	donec := make(chan time.Duration)
+	go func() { donec <- loadPauser.wait() }()
+	select {
+	case <-donec:
+		t.Fatal("wait returned while paused")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if !ResumeLoad() {
+		t.Fatal("expected resume")
+	}
+	if d := <-donec; d < 100*time.Millisecond {
+		t.Fatalf("expected to wait at least 100ms, waited %v", d)
+	}
+	if ResumeLoad() {
+		t.Fatal("expected not paused")
+	}
+	if LoadPaused() {
+		t.Fatal("expected not paused")
+	}
+	if !loadPauser.paused(time.Now().Unix()) {
+		t.Fatal("expected paused in the last second")
+	}
+	if loadPauser.paused(time.Now().Add(-time.Hour).Unix()) {
+		t.Fatal("expected not paused an hour ago")
+	}
+}
+
+func TestAbortLoad(t *testing.T) {
+	defer func() { loadPauser = &pauser{} }()
+
+	if !PauseLoad() {
+		t.Fatal("expected pause")
+	}
+	donec := make(chan time.Duration)
+	go func() { donec <- loadPauser.wait() }()
+
+	ctx, cancel := withLoadAbort(context.Background())
+	defer cancel()
+
+	if !AbortLoad() {
+		t.Fatal("expected abort")
+	}
+	select {
+	case <-donec:
+	case <-time.After(time.Second):
+		t.Fatal("wait did not return on abort")
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("context was not canceled on abort")
+	}
+	// contexts after abort are canceled right away
+	actx, acancel := withLoadAbort(context.Background())
+	defer acancel()
+	select {
+	case <-actx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("context was not canceled after abort")
+	}
+	if AbortLoad() {
+		t.Fatal("expected already aborted")
+	}
+	if !LoadAborted() || LoadPaused() {
+		t.Fatalf("expected aborted and not paused, got aborted %v, paused %v", LoadAborted(), LoadPaused())
+	}
+	if PauseLoad() {
+		t.Fatal("expected no pause after abort")
+	}
+
+	ch := make(chan request, 10)
+	generateReads(dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID:                          "etcd__tip",
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{RequestNumber: 10},
+	}, []string{"foo"}, ch)
+	if n := len(ch); n != 0 {
+		t.Fatalf("expected no request after abort, got %d", n)
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/pkg/report"
+	"github.com/gyuho/dataframe"
+	consulapi "github.com/hashicorp/consul/api"
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+)
+
+// RangeLatencyColumns defines the columns of range read latency
+// by the number of returned keys.
+var RangeLatencyColumns = []string{
+	"RANGE-KEYS",
+	"REQUESTS",
+	"AVG-KEYS-RETURNED",
+	"AVG-LATENCY-MS",
+	"P50-LATENCY-MS",
+	"P90-LATENCY-MS",
+	"P99-LATENCY-MS",
+	"P99.9-LATENCY-MS",
+}
+
+// rangeLatencies buckets range read latencies by the number of returned
+// keys, where each bucket holds the keys up to its bound (e.g. 1, 10, 100).
+// Ranges returning more keys than the largest bound are in the last bucket.
+type rangeLatencies struct {
+	mu     sync.Mutex
+	bounds []int64
+	lats   [][]float64
+	keys   []int64
+}
+
+func newRangeLatencies(sizes []int64) *rangeLatencies {
+	bounds := append([]int64(nil), sizes...)
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i] < bounds[j] })
+	uniq := bounds[:0]
+	for i, v := range bounds {
+		if i == 0 || v != bounds[i-1] {
+			uniq = append(uniq, v)
+		}
+	}
+	return &rangeLatencies{
+		bounds: uniq,
+		lats:   make([][]float64, len(uniq)+1),
+		keys:   make([]int64, len(uniq)+1),
+	}
+}
+
+func (rl *rangeLatencies) observe(n int64, took time.Duration) {
+	idx := sort.Search(len(rl.bounds), func(i int) bool { return n <= rl.bounds[i] })
+	rl.mu.Lock()
+	rl.lats[idx] = append(rl.lats[idx], took.Seconds())
+	rl.keys[idx] += n
+	rl.mu.Unlock()
+}
+
+// rows returns the rows of 'RangeLatencyColumns', one per bucket,
+// skipping the overflow bucket if no range exceeded the largest bound.
+func (rl *rangeLatencies) rows() [][]string {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	var rows [][]string
+	for i, lats := range rl.lats {
+		var label string
+		if i < len(rl.bounds) {
+			label = fmt.Sprintf("%d", rl.bounds[i])
+		} else {
+			if len(lats) == 0 || len(rl.bounds) == 0 {
+				continue
+			}
+			label = fmt.Sprintf(">%d", rl.bounds[len(rl.bounds)-1])
+		}
+		var avgKeys, avgMs float64
+		if len(lats) > 0 {
+			var sum float64
+			for _, v := range lats {
+				sum += v
+			}
+			avgKeys = float64(rl.keys[i]) / float64(len(lats))
+			avgMs = 1000 * sum / float64(len(lats))
+		}
+		rows = append(rows, []string{
+			label,
+			fmt.Sprintf("%d", len(lats)),
+			fmt.Sprintf("%.4f", avgKeys),
+			fmt.Sprintf("%.4f", avgMs),
+			fmt.Sprintf("%.4f", 1000*latencyPercentile(lats, 50)),
+			fmt.Sprintf("%.4f", 1000*latencyPercentile(lats, 90)),
+			fmt.Sprintf("%.4f", 1000*latencyPercentile(lats, 99)),
+			fmt.Sprintf("%.4f", 1000*latencyPercentile(lats, 99.9)),
+		})
+	}
+	return rows
+}
+
+// rangeKeyPrefix returns the prefix of the keys for the range size,
+// so that listing the prefix returns exactly 'size' keys.
+func rangeKeyPrefix(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions, size int64) string {
+	return fmt.Sprintf("%srange-%d/", opts.KeyPrefix, size)
+}
+
+// stressRangeReads writes 'size' keys under a prefix for each range size,
+// and reads the prefix 'request_number' times, one step per range size.
+func (cfg *Config) stressRangeReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.Type != "read" {
+		return fmt.Errorf("range reads are not supported for %q", opts.Type)
+	}
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3", "consul__v1_0_2", "cetcd__beta":
+	default:
+		return fmt.Errorf("range reads are not supported for %q", gcfg.DatabaseID)
+	}
+	for _, size := range opts.RangeSizes {
+		if size <= 0 {
+			return fmt.Errorf("got non-positive range size %d", size)
+		}
+	}
+	for _, size := range opts.RangeSizes {
+		if err := writeRangeKeys(gcfg, size, vals); err != nil {
+			return err
+		}
+	}
+
+	rl := newRangeLatencies(opts.RangeSizes)
+	slo := newSLOCounter(opts.LatencySLOMs)
+	retry := newRetryPolicy(opts.Retry)
+	retries := newRetryCounter(retry)
+	hist := newLatencyHistograms(cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath)
+	var stats []report.Stats
+	for _, size := range opts.RangeSizes {
+		plog.Infof("range reading %d keys %d times", size, opts.RequestNumber)
+		h, done := newRangeHandlers(gcfg, rl)
+		prefix := rangeKeyPrefix(opts, size)
+		reqGen := func(inflightReqs chan<- request) { generateRangeReads(gcfg, prefix, inflightReqs) }
+		b := newBenchmark(opts.RequestNumber, opts.ClientNumber, h, done, reqGen)
+		b.slo = slo
+		b.retry = retry
+		b.retries = retries
+		b.hist = hist
+		b.startRequests()
+		b.waitAll()
+		stats = append(stats, b.stats)
+		plog.Infof("range read %d keys [requests/sec: %.2f | p99: %.3f ms]", size, b.stats.RPS, 1000*latencyPercentile(b.stats.Lats, 99))
+	}
+	if err := cfg.saveRangeLatencies(rl); err != nil {
+		return err
+	}
+
+	combined := combineStats(stats)
+	printStats(combined)
+	cfg.saveAllStats(gcfg, combined, nil, nil, slo, retries, nil)
+	hist.save()
+	return nil
+}
+
+// writeRangeKeys writes 'size' keys under the prefix of the range size.
+func writeRangeKeys(gcfg dbtesterpb.ConfigClientMachineAgentControl, size int64, vals values) error {
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	prefix := rangeKeyPrefix(opts, size)
+	plog.Infof("writing %d keys for range reads [prefix: %q | database: %q]", size, prefix, gcfg.DatabaseID)
+
+	var put func(k string, i int64) error
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		clients := mustCreateClientsEtcdv3(clientEndpoints(gcfg), etcdv3ClientCfg{
+			totalConns:   1,
+			totalClients: 1,
+		})
+		defer clients[0].Close()
+		put = func(k string, i int64) error {
+			_, err := clients[0].Do(context.Background(), clientv3.OpPut(k, vals.strings[i%int64(vals.sampleSize)]))
+			return err
+		}
+	case "consul__v1_0_2", "cetcd__beta":
+		clients := mustCreateConnsConsul(clientEndpoints(gcfg), 1)
+		put = func(k string, i int64) error {
+			_, err := clients[0].Put(&consulapi.KVPair{Key: k, Value: vals.bytes[i%int64(vals.sampleSize)]}, nil)
+			return err
+		}
+	}
+	for i := int64(0); i < size; i++ {
+		k := prefix + sequentialKey(unprefixedKeySize(opts), i)
+		if err := put(k, i); err != nil {
+			return fmt.Errorf("write error on range keys %q (%v)", k, err)
+		}
+	}
+	return nil
+}
+
+func newRangeHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl, rl *rangeLatencies) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		clients := mustCreateClientsEtcdv3(clientEndpoints(gcfg), etcdv3ClientCfg{
+			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+		})
+		for i := range clients {
+			rhs[i] = newRangeEtcd3(clients[i].KV, rl)
+		}
+		done = func() {
+			for i := range clients {
+				clients[i].Close()
+			}
+		}
+	case "consul__v1_0_2", "cetcd__beta":
+		conns := mustCreateConnsConsul(clientEndpoints(gcfg), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		for i := range conns {
+			rhs[i] = newRangeConsul(conns[i], rl)
+		}
+	default:
+		plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+	}
+	return rhs, done
+}
+
+// generateRangeReads generates 'request_number' range reads of the prefix.
+func generateRangeReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, prefix string, inflightReqs chan<- request) {
+	defer close(inflightReqs)
+
+	var rateLimiter *rate.Limiter
+	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
+		rateLimiter = rate.NewLimiter(
+			rate.Limit(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
+			int(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
+		)
+	}
+
+	begin := time.Now()
+	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
+		// paused time is not counted as latency
+		begin = begin.Add(loadPauser.wait())
+		if LoadAborted() {
+			return
+		}
+
+		var req request
+		if rateLimiter != nil {
+			rateLimiter.Wait(context.TODO())
+			req.intendedStart = intendedStartTime(begin, gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond, i)
+		}
+
+		setRangeOp(gcfg, prefix, &req)
+		inflightReqs <- req
+	}
+}
+
+// setRangeOp sets the range read of all keys under the prefix to the request.
+func setRangeOp(gcfg dbtesterpb.ConfigClientMachineAgentControl, prefix string, req *request) {
+	req.opType = opTypeRead
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		opts := []clientv3.OpOption{clientv3.WithPrefix()}
+		if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
+			opts = append(opts, clientv3.WithSerializable())
+		}
+		req.etcdv3Op = clientv3.OpGet(prefix, opts...)
+
+	case "consul__v1_0_2", "cetcd__beta":
+		req.consulOp = consulOp{key: prefix, staleRead: gcfg.ConfigClientMachineBenchmarkOptions.StaleRead}
+
+	default:
+		plog.Panicf("%q is unknown database ID", gcfg.DatabaseID)
+	}
+}
+
+func (cfg *Config) saveRangeLatencies(rl *rangeLatencies) error {
+	if cfg.ConfigClientMachineInitial.ClientRangeLatencyPath == "" {
+		return nil
+	}
+	cs := make([]dataframe.Column, len(RangeLatencyColumns))
+	for i := range cs {
+		cs[i] = dataframe.NewColumn(RangeLatencyColumns[i])
+	}
+	for _, row := range rl.rows() {
+		for i := range cs {
+			cs[i].PushBack(dataframe.NewStringValue(row[i]))
+		}
+	}
+	fr := dataframe.New()
+	for _, c := range cs {
+		if err := fr.AddColumn(c); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientRangeLatencyPath)
+}
@@ -0,0 +1,81 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/clientv3"
+)
+
+func TestRangeLatencies(t *testing.T) {
+	rl := newRangeLatencies([]int64{100, 1, 10, 10})
+	if !reflect.DeepEqual(rl.bounds, []int64{1, 10, 100}) {
+		t.Fatalf("expected bounds [1 10 100], got %v", rl.bounds)
+	}
+	rl.observe(1, time.Millisecond)
+	rl.observe(10, 2*time.Millisecond)
+	rl.observe(8, 4*time.Millisecond)
+	rl.observe(100, 8*time.Millisecond)
+
+	exp := [][]string{
+		{"1", "1", "1.0000", "1.0000", "1.0000", "1.0000", "1.0000", "1.0000"},
+		{"10", "2", "9.0000", "3.0000", "4.0000", "4.0000", "4.0000", "4.0000"},
+		{"100", "1", "100.0000", "8.0000", "8.0000", "8.0000", "8.0000", "8.0000"},
+	}
+	if rows := rl.rows(); !reflect.DeepEqual(rows, exp) {
+		t.Fatalf("expected %q, got %q", exp, rows)
+	}
+
+	rl.observe(150, 16*time.Millisecond)
+	rows := rl.rows()
+	if len(rows) != 4 || rows[3][0] != ">100" || rows[3][1] != "1" {
+		t.Fatalf("unexpected overflow bucket %q", rows)
+	}
+}
+
+func TestGenerateRangeReads(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID: "etcd__v3_3",
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			KeyPrefix:     "p/",
+			RequestNumber: 3,
+			StaleRead:     true,
+		},
+	}
+	prefix := rangeKeyPrefix(gcfg.ConfigClientMachineBenchmarkOptions, 10)
+	if prefix != "p/range-10/" {
+		t.Fatalf("unexpected prefix %q", prefix)
+	}
+
+	reqc := make(chan request, 10)
+	go generateRangeReads(gcfg, prefix, reqc)
+	n := 0
+	for req := range reqc {
+		if req.opType != opTypeRead {
+			t.Fatalf("expected %q, got %q", opTypeRead, req.opType)
+		}
+		if !req.etcdv3Op.IsGet() || string(req.etcdv3Op.KeyBytes()) != prefix || string(req.etcdv3Op.RangeBytes()) != clientv3.GetPrefixRangeEnd(prefix) {
+			t.Fatalf("unexpected range op %+v", req.etcdv3Op)
+		}
+		n++
+	}
+	if n != 3 {
+		t.Fatalf("expected 3 requests, got %d", n)
+	}
+}
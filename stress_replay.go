@@ -0,0 +1,232 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+)
+
+// RequestLogColumns are the columns of request log. External traces in
+// the same format can be replayed, where value size of reads is ignored.
+var RequestLogColumns = []string{"OPERATION", "KEY", "VALUE-SIZE-BYTES"}
+
+// loggedRequest is a request in the request log.
+type loggedRequest struct {
+	opType    string
+	key       string
+	valueSize int64
+}
+
+// requestLogger saves the sequence of requests in CSV.
+type requestLogger struct {
+	mu sync.Mutex
+	f  *os.File
+	bw *bufio.Writer
+	w  *csv.Writer
+}
+
+// requestLog is the request logger of the database being stressed, if any.
+var requestLog *requestLogger
+
+func newRequestLogger(fpath string) (*requestLogger, error) {
+	f, err := os.Create(fpath)
+	if err != nil {
+		return nil, err
+	}
+	l := &requestLogger{f: f, bw: bufio.NewWriter(f)}
+	l.w = csv.NewWriter(l.bw)
+	if err = l.w.Write(RequestLogColumns); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// record saves the request, in the order of generation.
+func (l *requestLogger) record(opType, key string, valueSize int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.w.Write([]string{opType, key, strconv.Itoa(valueSize)}); err != nil {
+		plog.Warningf("failed to log request (%v)", err)
+	}
+}
+
+// truncate drops all requests logged so far, keeping the header.
+func (l *requestLogger) truncate() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.w.Flush()
+	if err := l.bw.Flush(); err != nil {
+		return err
+	}
+	if err := l.f.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := l.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := l.w.Write(RequestLogColumns); err != nil {
+		return err
+	}
+	l.w.Flush()
+	return l.w.Error()
+}
+
+// Close flushes all requests and closes the file.
+func (l *requestLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.w.Flush()
+	if err := l.w.Error(); err != nil {
+		l.f.Close()
+		return err
+	}
+	if err := l.bw.Flush(); err != nil {
+		l.f.Close()
+		return err
+	}
+	return l.f.Close()
+}
+
+// readRequestLog reads all requests in the request log.
+func readRequestLog(fpath string) ([]loggedRequest, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rd := csv.NewReader(bufio.NewReader(f))
+	rd.FieldsPerRecord = -1
+	header, err := rd.Read()
+	if err != nil {
+		return nil, err
+	}
+	if len(header) < 2 || header[0] != RequestLogColumns[0] || header[1] != RequestLogColumns[1] {
+		return nil, fmt.Errorf("%q has unexpected header %q (expected %q)", fpath, header, RequestLogColumns)
+	}
+
+	var reqs []loggedRequest
+	for line := 2; ; line++ {
+		row, err := rd.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(row) < 2 {
+			return nil, fmt.Errorf("%q line %d: expected operation and key, got %q", fpath, line, row)
+		}
+		req := loggedRequest{opType: row[0], key: row[1]}
+		switch req.opType {
+		case opTypeRead:
+		case opTypeWrite:
+			if len(row) < 3 {
+				return nil, fmt.Errorf("%q line %d: write without value size", fpath, line)
+			}
+			if req.valueSize, err = strconv.ParseInt(row[2], 10, 64); err != nil || req.valueSize < 0 {
+				return nil, fmt.Errorf("%q line %d: invalid value size %q", fpath, line, row[2])
+			}
+		default:
+			return nil, fmt.Errorf("%q line %d: unknown operation %q", fpath, line, req.opType)
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, nil
+}
+
+// stressReplay replays the requests of 'replay_request_log_path' in order,
+// so that databases are compared with identical workloads. Values are
+// generated with 'seed', in the logged sizes.
+func (cfg *Config) stressReplay(gcfg dbtesterpb.ConfigClientMachineAgentControl) error {
+	fpath := gcfg.ConfigClientMachineBenchmarkOptions.ReplayRequestLogPath
+	if fpath == "" {
+		return fmt.Errorf("'replay' type requires 'replay_request_log_path'")
+	}
+	reqs, err := readRequestLog(fpath)
+	if err != nil {
+		return err
+	}
+	if len(reqs) == 0 {
+		return fmt.Errorf("no request to replay in %q", fpath)
+	}
+
+	// requests in the log override 'request_number'
+	opts := *gcfg.ConfigClientMachineBenchmarkOptions
+	opts.RequestNumber = int64(len(reqs))
+	gcfg.ConfigClientMachineBenchmarkOptions = &opts
+	plog.Printf("replay generateReport is started with %d requests from %q...", len(reqs), fpath)
+
+	reqGen := func(inflightReqs chan<- request) { generateReplay(gcfg, reqs, inflightReqs) }
+	cfg.stressOperations(gcfg, reqGen)
+	plog.Println("replay generateReport is finished...")
+	return nil
+}
+
+// generateReplay generates the logged requests in order.
+func generateReplay(gcfg dbtesterpb.ConfigClientMachineAgentControl, reqs []loggedRequest, inflightReqs chan<- request) {
+	var rateLimiter *rate.Limiter
+	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
+		rateLimiter = rate.NewLimiter(
+			rate.Limit(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
+			int(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
+		)
+	}
+
+	defer close(inflightReqs)
+
+	// values are shared by size
+	src := newWorkloadSource(gcfg.ConfigClientMachineBenchmarkOptions)
+	sizeToValue := make(map[int64][]byte)
+
+	begin := time.Now()
+	for i, lr := range reqs {
+		// paused time is not counted as latency
+		begin = begin.Add(loadPauser.wait())
+		if LoadAborted() {
+			return
+		}
+
+		var req request
+		if rateLimiter != nil {
+			rateLimiter.Wait(context.TODO())
+			req.intendedStart = intendedStartTime(begin, gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond, int64(i))
+		}
+
+		if lr.opType == opTypeRead {
+			setReadOp(gcfg, lr.key, &req)
+		} else {
+			v, ok := sizeToValue[lr.valueSize]
+			if !ok {
+				v = randBytes(src, lr.valueSize)
+				sizeToValue[lr.valueSize] = v
+			}
+			setWriteOp(gcfg, lr.key, v, string(v), &req)
+		}
+		inflightReqs <- req
+	}
+}
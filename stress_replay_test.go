@@ -0,0 +1,108 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestRequestLogReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "request-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fpath := filepath.Join(dir, "client-request-log.csv")
+
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID: "etcd__tip",
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+			Type:           "mixed",
+			RequestNumber:  100,
+			ClientNumber:   5,
+			KeySizeBytes:   8,
+			ValueSizeBytes: 16,
+			ReadPercent:    50,
+		},
+	}
+	vals, err := newValues(gcfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requestLog, err = newRequestLogger(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqs := make(chan request, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
+	generateMixed(gcfg, vals, reqs)
+	var recorded []string
+	for req := range reqs {
+		recorded = append(recorded, req.opType+" "+string(req.etcdv3Op.KeyBytes()))
+	}
+	if err = requestLog.Close(); err != nil {
+		t.Fatal(err)
+	}
+	requestLog = nil
+
+	lrs, err := readRequestLog(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lrs) != len(recorded) {
+		t.Fatalf("expected %d logged requests, got %d", len(recorded), len(lrs))
+	}
+	reqs = make(chan request, len(lrs))
+	generateReplay(gcfg, lrs, reqs)
+	var replayed []string
+	for req := range reqs {
+		if req.opType == opTypeWrite && len(req.etcdv3Op.ValueBytes()) != 16 {
+			t.Fatalf("expected 16-byte value, got %d bytes", len(req.etcdv3Op.ValueBytes()))
+		}
+		replayed = append(replayed, req.opType+" "+string(req.etcdv3Op.KeyBytes()))
+	}
+	if !reflect.DeepEqual(recorded, replayed) {
+		t.Fatal("expected identical requests in replay")
+	}
+}
+
+func TestReadRequestLogInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "request-log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for i, s := range []string{
+		"OP,KEY\nread,a\n",
+		"OPERATION,KEY\ndelete,a\n",
+		"OPERATION,KEY\nwrite,a\n",
+		"OPERATION,KEY,VALUE-SIZE-BYTES\nwrite,a,-1\n",
+	} {
+		fpath := filepath.Join(dir, "log.csv")
+		if err = ioutil.WriteFile(fpath, []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = readRequestLog(fpath); err == nil {
+			t.Fatalf("#%d: expected error", i)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/pkg/report"
+	"github.com/gyuho/dataframe"
+	"golang.org/x/net/context"
+)
+
+// RequestTimeoutsColumns defines the columns of request timeout steps.
+var RequestTimeoutsColumns = []string{
+	"TIMEOUT-MS",
+	"REQUESTS",
+	"SUCCESSES",
+	"TIMEOUTS",
+	"OTHER-ERRORS",
+	"ERROR-PERCENT",
+	"SUCCESSES-PER-SECOND",
+	"AVG-LATENCY-MS",
+	"P99-LATENCY-MS",
+	"RECOMMENDED",
+}
+
+// recommendedThroughputRatio is the ratio of the best effective
+// throughput that the recommended timeout must achieve.
+const recommendedThroughputRatio = 0.95
+
+type timeoutStep struct {
+	timeout       time.Duration
+	successes     int64
+	timeouts      int64
+	otherErrors   int64
+	successPerSec float64
+	avgMs         float64
+	p99Ms         float64
+}
+
+func (st timeoutStep) requests() int64 { return st.successes + st.timeouts + st.otherErrors }
+
+func (st timeoutStep) errorPercent() float64 {
+	if st.requests() == 0 {
+		return 0
+	}
+	return 100 * float64(st.timeouts+st.otherErrors) / float64(st.requests())
+}
+
+// newTimeoutStep counts successes and errors of the stats,
+// where errors of deadline exceeded are counted as timeouts.
+func newTimeoutStep(timeout time.Duration, st report.Stats) timeoutStep {
+	ts := timeoutStep{
+		timeout:       timeout,
+		successes:     int64(len(st.Lats)),
+		successPerSec: st.RPS,
+		avgMs:         1000 * st.Average,
+		p99Ms:         1000 * latencyPercentile(st.Lats, 99),
+	}
+	for msg, n := range st.ErrorDist {
+		if isTimeoutError(msg) {
+			ts.timeouts += int64(n)
+		} else {
+			ts.otherErrors += int64(n)
+		}
+	}
+	return ts
+}
+
+// isTimeoutError returns true if the error message is of deadline exceeded,
+// either from the context or from the gRPC status of etcd.
+func isTimeoutError(msg string) bool {
+	return strings.Contains(msg, context.DeadlineExceeded.Error()) || strings.Contains(msg, "DeadlineExceeded")
+}
+
+// recommendTimeout returns the index of the shortest timeout with
+// effective throughput close to the best, since shorter timeouts
+// fail faster when the database is unavailable. It returns -1 if
+// no request succeeded.
+func recommendTimeout(steps []timeoutStep) int {
+	best := 0.0
+	for _, st := range steps {
+		if st.successPerSec > best {
+			best = st.successPerSec
+		}
+	}
+	if best == 0 {
+		return -1
+	}
+	idx := -1
+	for i, st := range steps {
+		if st.successPerSec < best*recommendedThroughputRatio {
+			continue
+		}
+		if idx == -1 || st.timeout < steps[idx].timeout {
+			idx = i
+		}
+	}
+	return idx
+}
+
+// withRequestTimeout returns the handler that fails the request with
+// deadline exceeded if not done in the timeout. Zookeeper and Consul
+// clients do not take the context, so the request is left running
+// as a client would after giving up. The request holds the client
+// until it returns, so that the next request of the client waits
+// (within its own timeout) instead of piling up goroutines on a
+// database that does not respond.
+func withRequestTimeout(rh ReqHandler, timeout time.Duration) ReqHandler {
+	busy := make(chan struct{}, 1)
+	return func(ctx context.Context, req *request) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		select {
+		case busy <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		errc := make(chan error, 1)
+		go func() {
+			errc <- rh(ctx, req)
+			<-busy
+		}()
+		select {
+		case err := <-errc:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// stressRequestTimeouts runs 'request_number' requests for each client
+// request timeout, to compare error rates and effective throughput.
+// Writes of each step are new keys.
+func (cfg *Config) stressRequestTimeouts(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.Type != "write" && opts.Type != "read" {
+		return fmt.Errorf("request timeouts are not supported for %q", opts.Type)
+	}
+	for _, ms := range opts.RequestTimeoutsMs {
+		if ms <= 0 {
+			return fmt.Errorf("got non-positive request timeout %d ms", ms)
+		}
+	}
+
+	key := prefixedSameKey(opts)
+	if opts.Type == "read" {
+		plog.Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+		h, done := newWriteHandlers(gcfg)
+		var req request
+		setWriteOp(gcfg, key, vals.bytes[0], vals.strings[0], &req)
+		err := h[0](context.Background(), &req)
+		if done != nil {
+			done()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write %q for reads (%v)", key, err)
+		}
+	}
+
+	slo := newSLOCounter(opts.LatencySLOMs)
+	retry := newRetryPolicy(opts.Retry)
+	retries := newRetryCounter(retry)
+	hist := newLatencyHistograms(cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath)
+	var (
+		steps       []timeoutStep
+		stats       []report.Stats
+		keysWritten int64
+	)
+	for _, ms := range opts.RequestTimeoutsMs {
+		timeout := time.Duration(ms) * time.Millisecond
+		if LoadAborted() {
+			plog.Warningf("load generation aborted, skipping request timeouts from %v", timeout)
+			break
+		}
+		plog.Infof("sending %d %s requests with timeout %v", opts.RequestNumber, opts.Type, timeout)
+
+		var (
+			h      []ReqHandler
+			done   func()
+			reqGen func(inflightReqs chan<- request)
+		)
+		if opts.Type == "write" {
+			h, done = newWriteHandlers(gcfg)
+			startIdx := keysWritten
+			reqGen = func(inflightReqs chan<- request) { generateWrites(gcfg, startIdx, vals, inflightReqs) }
+			keysWritten += opts.RequestNumber
+		} else {
+			h, done = newReadHandlers(gcfg)
+			reqGen = func(inflightReqs chan<- request) { generateReads(gcfg, []string{key}, inflightReqs) }
+		}
+		for i := range h {
+			h[i] = withRequestTimeout(h[i], timeout)
+		}
+
+		b := newBenchmark(opts.RequestNumber, opts.ClientNumber, h, done, reqGen)
+		b.slo = slo
+		b.retry = retry
+		b.retries = retries
+		b.hist = hist
+		b.startRequests()
+		b.waitAll()
+		stats = append(stats, b.stats)
+
+		st := newTimeoutStep(timeout, b.stats)
+		steps = append(steps, st)
+		plog.Infof("sent requests with timeout %v [errors: %.2f %% | timeouts: %d | successes/sec: %.2f | p99: %.3f ms]", timeout, st.errorPercent(), st.timeouts, st.successPerSec, st.p99Ms)
+	}
+	rec := recommendTimeout(steps)
+	if rec >= 0 {
+		plog.Infof("recommended request timeout %v [errors: %.2f %% | successes/sec: %.2f]", steps[rec].timeout, steps[rec].errorPercent(), steps[rec].successPerSec)
+	}
+	if err := cfg.saveRequestTimeouts(steps, rec); err != nil {
+		return err
+	}
+
+	combined := combineStats(stats)
+	printStats(combined)
+	cfg.saveAllStats(gcfg, combined, nil, nil, slo, retries, nil)
+	hist.save()
+	return nil
+}
+
+func (cfg *Config) saveRequestTimeouts(steps []timeoutStep, recommended int) error {
+	if cfg.ConfigClientMachineInitial.ClientRequestTimeoutsPath == "" {
+		return nil
+	}
+	cs := make([]dataframe.Column, len(RequestTimeoutsColumns))
+	for i := range cs {
+		cs[i] = dataframe.NewColumn(RequestTimeoutsColumns[i])
+	}
+	for i, st := range steps {
+		cs[0].PushBack(dataframe.NewStringValue(int64(st.timeout / time.Millisecond)))
+		cs[1].PushBack(dataframe.NewStringValue(st.requests()))
+		cs[2].PushBack(dataframe.NewStringValue(st.successes))
+		cs[3].PushBack(dataframe.NewStringValue(st.timeouts))
+		cs[4].PushBack(dataframe.NewStringValue(st.otherErrors))
+		cs[5].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.errorPercent())))
+		cs[6].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.successPerSec)))
+		cs[7].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.avgMs)))
+		cs[8].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", st.p99Ms)))
+		cs[9].PushBack(dataframe.NewStringValue(fmt.Sprintf("%v", i == recommended)))
+	}
+	fr := dataframe.New()
+	for _, c := range cs {
+		if err := fr.AddColumn(c); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientRequestTimeoutsPath)
+}
@@ -0,0 +1,85 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/pkg/report"
+	"golang.org/x/net/context"
+)
+
+func TestWithRequestTimeout(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	var started int32
+	slow := func(ctx context.Context, req *request) error {
+		atomic.AddInt32(&started, 1)
+		<-block
+		return nil
+	}
+	rh := withRequestTimeout(slow, 10*time.Millisecond)
+	for i := 0; i < 2; i++ {
+		if err := rh(context.Background(), &request{}); err != context.DeadlineExceeded {
+			t.Fatalf("#%d: expected %v, got %v", i, context.DeadlineExceeded, err)
+		}
+	}
+	// the second request waits for the first, which never returns
+	if n := atomic.LoadInt32(&started); n != 1 {
+		t.Fatalf("expected 1 request in flight, got %d", n)
+	}
+
+	errFailed := errors.New("failed")
+	fast := func(ctx context.Context, req *request) error { return errFailed }
+	if err := withRequestTimeout(fast, time.Second)(context.Background(), &request{}); err != errFailed {
+		t.Fatalf("expected %v, got %v", errFailed, err)
+	}
+}
+
+func TestTimeoutStep(t *testing.T) {
+	st := newTimeoutStep(100*time.Millisecond, report.Stats{
+		Lats:    []float64{0.01, 0.02, 0.03},
+		RPS:     30,
+		Average: 0.02,
+		ErrorDist: map[string]int{
+			"context deadline exceeded": 4,
+			"rpc error: code = DeadlineExceeded desc = context deadline exceeded": 2,
+			"connection refused": 1,
+		},
+	})
+	if st.requests() != 10 || st.timeouts != 6 || st.otherErrors != 1 {
+		t.Fatalf("unexpected requests %d, timeouts %d, other errors %d", st.requests(), st.timeouts, st.otherErrors)
+	}
+	if st.errorPercent() != 70 {
+		t.Fatalf("expected 70 %%, got %f", st.errorPercent())
+	}
+}
+
+func TestRecommendTimeout(t *testing.T) {
+	steps := []timeoutStep{
+		{timeout: 5 * time.Second, successPerSec: 1000},
+		{timeout: 100 * time.Millisecond, successPerSec: 600},
+		{timeout: 500 * time.Millisecond, successPerSec: 980},
+	}
+	if i := recommendTimeout(steps); i != 2 {
+		t.Fatalf("expected 2, got %d", i)
+	}
+	if i := recommendTimeout([]timeoutStep{{timeout: time.Second}}); i != -1 {
+		t.Fatalf("expected -1, got %d", i)
+	}
+}
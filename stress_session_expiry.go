@@ -0,0 +1,291 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/clientv3"
+	"github.com/samuel/go-zookeeper/zk"
+	"golang.org/x/net/context"
+)
+
+// sessionExpiryPath is the parent of ephemeral nodes, or the prefix of
+// keys attached to leases, in "session-expiry" type benchmark.
+const sessionExpiryPath = "/session-expiry"
+
+// expiryStorm records the phases of session expiry storm.
+type expiryStorm struct {
+	mu        sync.Mutex
+	pausedAt  time.Time
+	expiresAt time.Time
+	cleanedAt time.Time
+}
+
+// sessionStorm is the session expiry storm of the running benchmark, if any.
+var sessionStorm *expiryStorm
+
+func (s *expiryStorm) paused(now time.Time, timeout time.Duration) {
+	s.mu.Lock()
+	s.pausedAt, s.expiresAt = now, now.Add(timeout)
+	s.mu.Unlock()
+}
+
+func (s *expiryStorm) cleaned(now time.Time) {
+	s.mu.Lock()
+	s.cleanedAt = now
+	s.mu.Unlock()
+}
+
+// phase returns "paused" while sessions are not yet expired after clients
+// are paused, "cleanup" until all ephemeral nodes are deleted, and empty
+// otherwise. Cleanup is ongoing if not finished.
+func (s *expiryStorm) phase(unixSecond int64) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pausedAt.IsZero() || unixSecond < s.pausedAt.Unix() {
+		return ""
+	}
+	if unixSecond < s.expiresAt.Unix() {
+		return "paused"
+	}
+	if s.cleanedAt.IsZero() || unixSecond <= s.cleanedAt.Unix() {
+		return "cleanup"
+	}
+	return ""
+}
+
+// expirySessions are sessions to expire at once.
+type expirySessions interface {
+	// pause stops heartbeats of all sessions.
+	pause()
+	// remaining returns the number of ephemeral nodes not yet deleted.
+	remaining() (int64, error)
+	close()
+}
+
+// blackholeConn drops all writes once paused, so that the server
+// receives no heartbeat, while the client does not notice.
+type blackholeConn struct {
+	net.Conn
+	pausedc <-chan struct{}
+}
+
+func (c *blackholeConn) Write(b []byte) (int, error) {
+	select {
+	case <-c.pausedc:
+		return len(b), nil
+	default:
+		return c.Conn.Write(b)
+	}
+}
+
+type zkSessions struct {
+	conns   []*zk.Conn
+	checker *zk.Conn
+	pausec  chan struct{}
+	once    sync.Once
+}
+
+func newZKSessions(endpoints []string, se *dbtesterpb.ConfigClientMachineSessionExpiry) (*zkSessions, error) {
+	endpoints = discoveredEndpoints(endpoints)
+	s := &zkSessions{pausec: make(chan struct{})}
+	dialer := func(network, address string, timeout time.Duration) (net.Conn, error) {
+		conn, err := net.DialTimeout(network, address, timeout)
+		if err != nil {
+			return nil, err
+		}
+		return &blackholeConn{Conn: conn, pausedc: s.pausec}, nil
+	}
+
+	var err error
+	s.checker, _, err = zkConnect(endpoints[0], time.Duration(se.SessionTimeoutSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.checker.Create(sessionExpiryPath, nil, zkCreateFlags, zkCreateACL); err != nil && err != zk.ErrNodeExists {
+		s.close()
+		return nil, err
+	}
+	for i := int64(0); i < se.SessionNumber; i++ {
+		ep := endpoints[int(i)%len(endpoints)]
+		conn, _, err := zk.Connect([]string{ep}, time.Duration(se.SessionTimeoutSeconds)*time.Second, zk.WithDialer(dialer))
+		if err != nil {
+			s.close()
+			return nil, err
+		}
+		s.conns = append(s.conns, conn)
+		for j := int64(0); j < se.EphemeralNodesPerSession; j++ {
+			p := fmt.Sprintf("%s/%d-%d", sessionExpiryPath, i, j)
+			if _, err = conn.Create(p, nil, zk.FlagEphemeral, zkCreateACL); err != nil {
+				s.close()
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+func (s *zkSessions) pause() { s.once.Do(func() { close(s.pausec) }) }
+
+func (s *zkSessions) remaining() (int64, error) {
+	children, _, err := s.checker.Children(sessionExpiryPath)
+	return int64(len(children)), err
+}
+
+func (s *zkSessions) close() {
+	for _, conn := range s.conns {
+		conn.Close()
+	}
+	if s.checker != nil {
+		s.checker.Close()
+	}
+}
+
+// etcdLeases are the etcd equivalent of Zookeeper sessions,
+// where keys are attached to leases kept alive by the client.
+type etcdLeases struct {
+	cli    *clientv3.Client
+	cancel context.CancelFunc
+}
+
+func newEtcdLeases(endpoints []string, se *dbtesterpb.ConfigClientMachineSessionExpiry) (*etcdLeases, error) {
+	cli := mustCreateConnEtcdv3(endpoints)
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &etcdLeases{cli: cli, cancel: cancel}
+	for i := int64(0); i < se.SessionNumber; i++ {
+		resp, err := cli.Grant(context.Background(), se.SessionTimeoutSeconds)
+		if err != nil {
+			l.close()
+			return nil, err
+		}
+		for j := int64(0); j < se.EphemeralNodesPerSession; j++ {
+			k := fmt.Sprintf("%s/%d-%d", sessionExpiryPath, i, j)
+			if _, err = cli.Put(context.Background(), k, "", clientv3.WithLease(resp.ID)); err != nil {
+				l.close()
+				return nil, err
+			}
+		}
+		kch, err := cli.KeepAlive(ctx, resp.ID)
+		if err != nil {
+			l.close()
+			return nil, err
+		}
+		go func() {
+			for range kch {
+			}
+		}()
+	}
+	return l, nil
+}
+
+func (l *etcdLeases) pause() { l.cancel() }
+
+func (l *etcdLeases) remaining() (int64, error) {
+	resp, err := l.cli.Get(context.Background(), sessionExpiryPath+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return 0, err
+	}
+	return resp.Count, nil
+}
+
+func (l *etcdLeases) close() {
+	l.cancel()
+	l.cli.Close()
+}
+
+// stressSessionExpiry runs writes while the clients of all sessions are
+// paused at once past the session timeout, to measure the latency and
+// throughput impact of cleaning up ephemeral nodes (or expired leases).
+func (cfg *Config) stressSessionExpiry(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	se := gcfg.ConfigClientMachineBenchmarkOptions.SessionExpiry
+	if se == nil || se.SessionNumber <= 0 || se.SessionTimeoutSeconds <= 0 {
+		return fmt.Errorf("'session-expiry' type requires 'session_number' and 'session_timeout_seconds'")
+	}
+
+	plog.Infof("creating %d sessions with %d ephemeral nodes each...", se.SessionNumber, se.EphemeralNodesPerSession)
+	var (
+		sessions expirySessions
+		err      error
+	)
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		sessions, err = newEtcdLeases(clientEndpoints(gcfg), se)
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		sessions, err = newZKSessions(clientEndpoints(gcfg), se)
+	default:
+		return fmt.Errorf("'session-expiry' type is not supported for %q", gcfg.DatabaseID)
+	}
+	if err != nil {
+		return err
+	}
+	defer sessions.close()
+
+	sessionStorm = &expiryStorm{}
+	defer func() { sessionStorm = nil }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stormc := make(chan struct{})
+	go func() {
+		defer close(stormc)
+		select {
+		case <-time.After(time.Duration(se.PauseAfterSeconds) * time.Second):
+		case <-ctx.Done():
+			return
+		}
+		if LoadAborted() {
+			plog.Warning("load generation aborted before pausing session clients")
+			return
+		}
+		timeout := time.Duration(se.SessionTimeoutSeconds) * time.Second
+		sessions.pause()
+		now := time.Now()
+		sessionStorm.paused(now, timeout)
+		plog.Infof("paused all session clients; sessions expire in %v", timeout)
+
+		for {
+			select {
+			case <-time.After(100 * time.Millisecond):
+			case <-ctx.Done():
+				plog.Warning("writes finished before all ephemeral nodes are cleaned up")
+				return
+			}
+			n, err := sessions.remaining()
+			if err != nil {
+				plog.Warningf("failed to count ephemeral nodes (%v)", err)
+				continue
+			}
+			if n == 0 {
+				cleaned := time.Now()
+				sessionStorm.cleaned(cleaned)
+				plog.Infof("all ephemeral nodes cleaned up %v after expiry", cleaned.Sub(now.Add(timeout)))
+				return
+			}
+		}
+	}()
+
+	plog.Println("session-expiry generateReport is started...")
+	h, done := newWriteHandlers(gcfg)
+	reqGen := func(inflightReqs chan<- request) { generateWrites(gcfg, 0, vals, inflightReqs) }
+	cfg.generateReport(gcfg, h, done, reqGen)
+	cancel()
+	<-stormc
+	plog.Println("session-expiry generateReport is finished...")
+	return nil
+}
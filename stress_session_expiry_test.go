@@ -0,0 +1,55 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestExpiryStormPhase(t *testing.T) {
+	s := &expiryStorm{}
+	if p := s.phase(100); p != "" {
+		t.Fatalf("expected no phase before pause, got %q", p)
+	}
+	s.paused(time.Unix(100, 0), 5*time.Second)
+	s.cleaned(time.Unix(107, 0))
+	tests := map[int64]string{99: "", 100: "paused", 104: "paused", 105: "cleanup", 107: "cleanup", 108: ""}
+	for sec, exp := range tests {
+		if p := s.phase(sec); p != exp {
+			t.Fatalf("second %d: expected %q, got %q", sec, exp, p)
+		}
+	}
+}
+
+func TestBlackholeConn(t *testing.T) {
+	c1, c2 := net.Pipe()
+	defer c1.Close()
+	defer c2.Close()
+
+	pausec := make(chan struct{})
+	conn := &blackholeConn{Conn: c1, pausedc: pausec}
+	go conn.Write([]byte("a"))
+	b := make([]byte, 1)
+	if _, err := c2.Read(b); err != nil || string(b) != "a" {
+		t.Fatalf("expected 'a', got %q (%v)", b, err)
+	}
+
+	close(pausec)
+	if n, err := conn.Write([]byte("b")); n != 1 || err != nil {
+		t.Fatalf("expected write to be dropped silently, got %d (%v)", n, err)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/pkg/report"
+)
+
+// TenantGroupConfig returns the configuration with client-side result
+// paths of the tenant group 'name' (e.g. 'timeseries-tenant-a.csv').
+func (cfg *Config) TenantGroupConfig(name string) *Config {
+	rename := func(p string) string {
+		ext := filepath.Ext(p)
+		return strings.TrimSuffix(p, ext) + "-" + name + ext
+	}
+	ncfg := *cfg
+	ncfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath = rename(cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath)
+	ncfg.ConfigClientMachineInitial.ClientLatencyDistributionAllPath = rename(cfg.ConfigClientMachineInitial.ClientLatencyDistributionAllPath)
+	ncfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath = rename(cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath)
+	ncfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath = rename(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)
+	ncfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath = rename(cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath)
+	if cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath != "" {
+		ncfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath = rename(cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath)
+	}
+	return &ncfg
+}
+
+// tenantGroupOptions returns the benchmark options of the tenant group,
+// inheriting the rest from 'opts'.
+func tenantGroupOptions(opts dbtesterpb.ConfigClientMachineBenchmarkOptions, tg dbtesterpb.ConfigClientMachineTenantGroup) *dbtesterpb.ConfigClientMachineBenchmarkOptions {
+	opts.TenantGroups = nil
+	opts.ConnectionClientNumbers = nil
+	opts.ClientNumber = tg.ClientNumber
+	opts.ConnectionNumber = tg.ConnectionNumber
+	if opts.ConnectionNumber == 0 {
+		opts.ConnectionNumber = tg.ClientNumber
+	}
+	opts.RequestNumber = tg.RequestNumber
+	opts.RateLimitRequestsPerSecond = tg.RateLimitRequestsPerSecond
+	opts.KeyPrefix = tg.KeyPrefix
+	if opts.KeyPrefix == "" {
+		opts.KeyPrefix = tg.Name
+	}
+	return &opts
+}
+
+// stressTenantGroups runs all tenant groups concurrently against the
+// same database, each writing to its own keyspace. Latency of each group
+// is saved separately, and the combined results are saved to the default paths.
+func (cfg *Config) stressTenantGroups(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	if gcfg.ConfigClientMachineBenchmarkOptions.Type != "write" {
+		return fmt.Errorf("tenant groups are not supported for %q", gcfg.ConfigClientMachineBenchmarkOptions.Type)
+	}
+	names := make(map[string]struct{})
+	for _, tg := range gcfg.ConfigClientMachineBenchmarkOptions.TenantGroups {
+		if tg.Name == "" {
+			return fmt.Errorf("tenant group name is not defined")
+		}
+		if _, ok := names[tg.Name]; ok {
+			return fmt.Errorf("duplicate tenant group name %q", tg.Name)
+		}
+		names[tg.Name] = struct{}{}
+		if tg.ClientNumber <= 0 || tg.RequestNumber <= 0 {
+			return fmt.Errorf("tenant group %q has no clients or requests", tg.Name)
+		}
+	}
+
+	combined := report.NewReportSample("%4.4f")
+	combinedDone := combined.Stats()
+
+	total := gcfg
+	totalOpts := *gcfg.ConfigClientMachineBenchmarkOptions
+	totalOpts.ClientNumber, totalOpts.RequestNumber = 0, 0
+	total.ConfigClientMachineBenchmarkOptions = &totalOpts
+
+	groups := make([]dbtesterpb.ConfigClientMachineAgentControl, len(gcfg.ConfigClientMachineBenchmarkOptions.TenantGroups))
+	bs := make([]*benchmark, len(groups))
+	// closed intervals of all groups are also written to the combined log
+	combinedHist := newLatencyHistograms(cfg.ConfigClientMachineInitial.ClientLatencyHistogramLogPath)
+	for i, tg := range gcfg.ConfigClientMachineBenchmarkOptions.TenantGroups {
+		groups[i] = gcfg
+		groups[i].ConfigClientMachineBenchmarkOptions = tenantGroupOptions(*gcfg.ConfigClientMachineBenchmarkOptions, *tg)
+		totalOpts.ClientNumber += tg.ClientNumber
+		totalOpts.RequestNumber += tg.RequestNumber
+
+		copied := groups[i]
+		plog.Infof("starting tenant group %q [clients: %d | requests: %d | rate limit: %d | key prefix: %q]",
+			tg.Name,
+			copied.ConfigClientMachineBenchmarkOptions.ClientNumber,
+			copied.ConfigClientMachineBenchmarkOptions.RequestNumber,
+			copied.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond,
+			copied.ConfigClientMachineBenchmarkOptions.KeyPrefix,
+		)
+		h, done := newWriteHandlers(copied)
+		reqGen := func(inflightReqs chan<- request) { generateWrites(copied, 0, vals, inflightReqs) }
+		bs[i] = newBenchmark(copied.ConfigClientMachineBenchmarkOptions.RequestNumber, copied.ConfigClientMachineBenchmarkOptions.ClientNumber, h, done, reqGen)
+		bs[i].combinedReport = combined
+		bs[i].slo = newSLOCounter(copied.ConfigClientMachineBenchmarkOptions.LatencySLOMs)
+		bs[i].retry = newRetryPolicy(copied.ConfigClientMachineBenchmarkOptions.Retry)
+		bs[i].retries = newRetryCounter(bs[i].retry)
+		bs[i].hist = newLatencyHistograms(cfg.TenantGroupConfig(tg.Name).ConfigClientMachineInitial.ClientLatencyHistogramLogPath)
+		if bs[i].hist != nil {
+			bs[i].hist.parent = combinedHist
+		}
+		if copied.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
+			bs[i].correctedReport = report.NewReportSample("%4.4f")
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(bs))
+	for i := range bs {
+		bs[i].startRequests()
+		go func(b *benchmark) {
+			defer wg.Done()
+			b.waitAll()
+		}(bs[i])
+	}
+	wg.Wait()
+
+	close(combined.Results())
+	combinedStats := <-combinedDone
+
+	for i, tg := range gcfg.ConfigClientMachineBenchmarkOptions.TenantGroups {
+		fmt.Printf("Tenant group %q:\n", tg.Name)
+		printStats(bs[i].stats)
+		var corrected *report.Stats
+		if bs[i].correctedReport != nil {
+			corrected = &bs[i].correctedStats
+		}
+		tcfg := cfg.TenantGroupConfig(tg.Name)
+		tcfg.saveAllStats(groups[i], bs[i].stats, corrected, nil, bs[i].slo, bs[i].retries, nil)
+		bs[i].hist.save()
+	}
+
+	combinedSLO := newSLOCounter(gcfg.ConfigClientMachineBenchmarkOptions.LatencySLOMs)
+	if combinedSLO != nil {
+		for i := range bs {
+			combinedSLO.merge(bs[i].slo)
+		}
+	}
+
+	combinedRetries := newRetryCounter(newRetryPolicy(gcfg.ConfigClientMachineBenchmarkOptions.Retry))
+	if combinedRetries != nil {
+		for i := range bs {
+			if bs[i].retries != nil {
+				combinedRetries.merge(bs[i].retries)
+			}
+		}
+	}
+
+	fmt.Println("All tenant groups:")
+	printStats(combinedStats)
+	cfg.saveAllStats(total, combinedStats, nil, nil, combinedSLO, combinedRetries, nil)
+	combinedHist.save()
+	return nil
+}
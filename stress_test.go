@@ -0,0 +1,55 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+func TestNewKeyOrder(t *testing.T) {
+	nums := func(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) []int64 {
+		keyNum := newKeyOrder(opts, 10, 100)
+		ns := make([]int64, 100)
+		for i := range ns {
+			ns[i] = keyNum(int64(i))
+		}
+		return ns
+	}
+
+	seq := nums(&dbtesterpb.ConfigClientMachineBenchmarkOptions{})
+	for i, n := range seq {
+		if n != int64(10+i) {
+			t.Fatalf("#%d: expected key number %d, got %d", i, 10+i, n)
+		}
+	}
+
+	opts := &dbtesterpb.ConfigClientMachineBenchmarkOptions{KeyOrder: KeyOrderRandom, Seed: 7}
+	rnd := nums(opts)
+	if reflect.DeepEqual(rnd, seq) {
+		t.Fatal("expected shuffled key numbers")
+	}
+	if !reflect.DeepEqual(rnd, nums(opts)) {
+		t.Fatal("expected the same order with the same seed")
+	}
+	sorted := append([]int64(nil), rnd...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	if !reflect.DeepEqual(sorted, seq) {
+		t.Fatalf("expected the same keys in random order, got %v", sorted)
+	}
+}
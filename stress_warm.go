@@ -0,0 +1,97 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/samuel/go-zookeeper/zk"
+	"golang.org/x/net/context"
+)
+
+// warmKey is read on each connection to complete its handshake.
+const warmKey = "dbtester-warm"
+
+const warmTimeout = 10 * time.Second
+
+// warmEnabled returns true if connections must be warmed
+// before the measured window starts.
+func warmEnabled() bool {
+	return connSettings != nil && connSettings.Warm
+}
+
+// warmEtcdv3 sends one serializable read on the client connection,
+// so that its TCP and TLS handshakes complete before the benchmark.
+func warmEtcdv3(cli *clientv3.Client) {
+	ctx, cancel := context.WithTimeout(context.Background(), warmTimeout)
+	_, err := cli.Get(ctx, warmKey, clientv3.WithSerializable())
+	cancel()
+	if err != nil {
+		plog.Warningf("failed to warm etcd connection (%v)", err)
+	}
+}
+
+// warmZk waits for the session to be established with one request.
+func warmZk(conn *zk.Conn) {
+	if _, _, err := conn.Exists("/"); err != nil {
+		plog.Warningf("failed to warm zookeeper connection (%v)", err)
+	}
+}
+
+// warmConsul sends one stale read, which opens an idle HTTP
+// connection to be reused by the benchmark.
+func warmConsul(kv *consulapi.KV) {
+	if _, _, err := kv.Get(warmKey, &consulapi.QueryOptions{AllowStale: true}); err != nil {
+		plog.Warningf("failed to warm consul connection (%v)", err)
+	}
+}
+
+// startBarrier holds the time to start sending requests, so that
+// load generators on all client machines start at the same time.
+var startBarrier struct {
+	mu sync.Mutex
+	at time.Time
+}
+
+// SetStartBarrier makes the next benchmark wait until the given time
+// before sending the first request, after its connections are dialed.
+// Zero time disables the barrier.
+func SetStartBarrier(at time.Time) {
+	startBarrier.mu.Lock()
+	startBarrier.at = at
+	startBarrier.mu.Unlock()
+}
+
+// waitStartBarrier blocks until the start barrier, if any,
+// and clears it so that following benchmarks start right away.
+func waitStartBarrier() {
+	startBarrier.mu.Lock()
+	at := startBarrier.at
+	startBarrier.at = time.Time{}
+	startBarrier.mu.Unlock()
+	if at.IsZero() {
+		return
+	}
+	d := time.Until(at)
+	if d <= 0 {
+		plog.Warningf("start barrier %s has passed %v ago; starting now", at.Format(time.RFC3339), -d)
+		return
+	}
+	plog.Infof("waiting %v for start barrier %s", d, at.Format(time.RFC3339))
+	time.Sleep(d)
+}
@@ -0,0 +1,44 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWaitStartBarrier(t *testing.T) {
+	at := time.Now().Add(100 * time.Millisecond)
+	SetStartBarrier(at)
+	waitStartBarrier()
+	if time.Now().Before(at) {
+		t.Fatalf("returned before the barrier %v", at)
+	}
+
+	// barrier is cleared after the first wait
+	st := time.Now()
+	waitStartBarrier()
+	if took := time.Since(st); took > 50*time.Millisecond {
+		t.Fatalf("expected no wait after the barrier is cleared, took %v", took)
+	}
+
+	// passed barrier starts right away
+	SetStartBarrier(time.Now().Add(-time.Second))
+	st = time.Now()
+	waitStartBarrier()
+	if took := time.Since(st); took > 50*time.Millisecond {
+		t.Fatalf("expected no wait for the passed barrier, took %v", took)
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"github.com/gyuho/dataframe"
+	"golang.org/x/net/context"
+)
+
+// WatchEventsColumns are the columns of watch events per second
+// in "watch-compaction" type benchmark.
+var WatchEventsColumns = []string{
+	"UNIX-SECOND",
+	"WATCH-EVENTS",
+	"AVG-WATCH-LATENCY-MS",
+	"MAX-WATCH-LATENCY-MS",
+	"COMPACTED-ERRORS",
+	"COMPACTIONS",
+}
+
+type watchSecond struct {
+	events     int64
+	latencySum time.Duration
+	latencyMax time.Duration
+	compacted  int64
+	compacts   int64
+}
+
+// watchRecorder records watch event latency from the start of the write,
+// and compacted errors, per second.
+type watchRecorder struct {
+	mu       sync.Mutex
+	sentAt   map[string]time.Time
+	seconds  map[int64]*watchSecond
+	watchers int64
+}
+
+func newWatchRecorder(watchers int64) *watchRecorder {
+	return &watchRecorder{
+		sentAt:   make(map[string]time.Time),
+		seconds:  make(map[int64]*watchSecond),
+		watchers: watchers,
+	}
+}
+
+func (w *watchRecorder) second(t time.Time) *watchSecond {
+	sec := t.Unix()
+	ws, ok := w.seconds[sec]
+	if !ok {
+		ws = &watchSecond{}
+		w.seconds[sec] = ws
+	}
+	return ws
+}
+
+// sent records the start of the write of the key.
+func (w *watchRecorder) sent(key string, now time.Time) {
+	w.mu.Lock()
+	w.sentAt[key] = now
+	w.mu.Unlock()
+}
+
+// received records the event of the key, received by a watcher.
+func (w *watchRecorder) received(key string, now time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	st, ok := w.sentAt[key]
+	if !ok {
+		return
+	}
+	lat := now.Sub(st)
+	if lat < 0 {
+		lat = 0
+	}
+	ws := w.second(now)
+	ws.events++
+	ws.latencySum += lat
+	if lat > ws.latencyMax {
+		ws.latencyMax = lat
+	}
+}
+
+// compacted records the "required revision has been compacted" error.
+func (w *watchRecorder) compacted(now time.Time) {
+	w.mu.Lock()
+	w.second(now).compacted++
+	w.mu.Unlock()
+}
+
+// compaction records the compaction of the database.
+func (w *watchRecorder) compaction(now time.Time) {
+	w.mu.Lock()
+	w.second(now).compacts++
+	w.mu.Unlock()
+}
+
+// frame returns the watch events per second, sorted by second.
+func (w *watchRecorder) frame() (dataframe.Frame, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	secs := make([]int64, 0, len(w.seconds))
+	for sec := range w.seconds {
+		secs = append(secs, sec)
+	}
+	sort.Slice(secs, func(i, j int) bool { return secs[i] < secs[j] })
+
+	cs := make([]dataframe.Column, len(WatchEventsColumns))
+	for i := range cs {
+		cs[i] = dataframe.NewColumn(WatchEventsColumns[i])
+	}
+	for _, sec := range secs {
+		ws := w.seconds[sec]
+		var avg float64
+		if ws.events > 0 {
+			avg = toMillisecond(ws.latencySum) / float64(ws.events)
+		}
+		cs[0].PushBack(dataframe.NewStringValue(sec))
+		cs[1].PushBack(dataframe.NewStringValue(ws.events))
+		cs[2].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", avg)))
+		cs[3].PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", toMillisecond(ws.latencyMax))))
+		cs[4].PushBack(dataframe.NewStringValue(ws.compacted))
+		cs[5].PushBack(dataframe.NewStringValue(ws.compacts))
+	}
+	fr := dataframe.New()
+	for _, c := range cs {
+		if err := fr.AddColumn(c); err != nil {
+			return nil, err
+		}
+	}
+	return fr, nil
+}
+
+// summary returns the total events, average latency,
+// and compacted errors.
+func (w *watchRecorder) summary() (events int64, avgMs float64, compacted int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var sum time.Duration
+	for _, ws := range w.seconds {
+		events += ws.events
+		sum += ws.latencySum
+		compacted += ws.compacted
+	}
+	if events > 0 {
+		avgMs = toMillisecond(sum) / float64(events)
+	}
+	return events, avgMs, compacted
+}
+
+// stressWatchCompaction runs writes with watchers on 'key_prefix' and
+// periodic compaction, measuring watch event latency and the rate of
+// "required revision has been compacted" errors of lagging watchers.
+func (cfg *Config) stressWatchCompaction(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	switch gcfg.DatabaseID {
+	case "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+	default:
+		return fmt.Errorf("'watch-compaction' type is not supported for %q", gcfg.DatabaseID)
+	}
+	wc := gcfg.ConfigClientMachineBenchmarkOptions.WatchCompaction
+	if wc == nil || wc.WatcherNumber <= 0 || wc.CompactionIntervalSeconds <= 0 {
+		return fmt.Errorf("'watch-compaction' type requires 'watcher_number' and 'compaction_interval_seconds'")
+	}
+	plog.Printf("watch-compaction generateReport is started with %d watchers...", wc.WatcherNumber)
+
+	rec := newWatchRecorder(wc.WatcherNumber)
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+
+	prefix := gcfg.ConfigClientMachineBenchmarkOptions.KeyPrefix
+	watchers := make([]*clientv3.Client, wc.WatcherNumber)
+	for i := range watchers {
+		watchers[i] = mustCreateConnEtcdv3(clientEndpoints(gcfg))
+		wg.Add(1)
+		go func(cli *clientv3.Client) {
+			defer wg.Done()
+			runWatcher(ctx, cli, prefix, rec)
+		}(watchers[i])
+	}
+
+	compactor := mustCreateConnEtcdv3(clientEndpoints(gcfg))
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		runCompactor(ctx, compactor, time.Duration(wc.CompactionIntervalSeconds)*time.Second, wc.CompactionRetainRevisions, rec)
+	}()
+
+	h, done := newWriteHandlers(gcfg)
+	for i := range h {
+		wh := h[i]
+		h[i] = func(ctx context.Context, req *request) error {
+			rec.sent(string(req.etcdv3Op.KeyBytes()), time.Now())
+			return wh(ctx, req)
+		}
+	}
+	reqGen := func(inflightReqs chan<- request) { generateWrites(gcfg, 0, vals, inflightReqs) }
+	cfg.generateReport(gcfg, h, done, reqGen)
+
+	// let watchers catch up with the last writes
+	time.Sleep(time.Second)
+	cancel()
+	wg.Wait()
+	for _, cli := range watchers {
+		cli.Close()
+	}
+	compactor.Close()
+
+	events, avgMs, compacted := rec.summary()
+	plog.Printf("watch-compaction generateReport is finished [watch events: %d | average watch latency: %.4f ms | compacted errors: %d]", events, avgMs, compacted)
+	return cfg.saveWatchEvents(rec)
+}
+
+// watchRetryBackoff is the wait before re-creating a failed watch.
+const watchRetryBackoff = 100 * time.Millisecond
+
+// runWatcher watches the prefix until the context is canceled, resuming
+// from the last received revision. Lagging watchers fail with compacted
+// error, and resume from the compacted revision, skipping lost events.
+// Other watch failures (e.g. leader loss) are retried after a short
+// backoff, so that an unavailable cluster is not hammered with watches.
+func runWatcher(ctx context.Context, cli *clientv3.Client, prefix string, rec *watchRecorder) {
+	var rev int64
+	for ctx.Err() == nil {
+		compacted := false
+		opts := []clientv3.OpOption{clientv3.WithPrefix()}
+		if rev > 0 {
+			opts = append(opts, clientv3.WithRev(rev))
+		}
+		wch := cli.Watch(clientv3.WithRequireLeader(ctx), prefix, opts...)
+		for wresp := range wch {
+			if wresp.Err() == rpctypes.ErrCompacted {
+				rec.compacted(time.Now())
+				rev = wresp.CompactRevision
+				compacted = true
+				break
+			}
+			if wresp.Err() != nil {
+				plog.Warningf("watch on %q failed (%v)", prefix, wresp.Err())
+				break
+			}
+			now := time.Now()
+			for _, ev := range wresp.Events {
+				rec.received(string(ev.Kv.Key), now)
+				rev = ev.Kv.ModRevision + 1
+			}
+		}
+		if compacted {
+			continue
+		}
+		select {
+		case <-time.After(watchRetryBackoff):
+		case <-ctx.Done():
+		}
+	}
+}
+
+// runCompactor compacts the database at the interval, keeping
+// 'retain' latest revisions, until the context is canceled or
+// load generation is aborted.
+func runCompactor(ctx context.Context, cli *clientv3.Client, interval time.Duration, retain int64, rec *watchRecorder) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if LoadAborted() {
+			return
+		}
+		resp, err := cli.Get(ctx, "compaction-probe")
+		if err != nil {
+			plog.Warningf("failed to get revision to compact (%v)", err)
+			continue
+		}
+		rev := resp.Header.Revision - retain
+		if rev <= 0 {
+			continue
+		}
+		if _, err = cli.Compact(ctx, rev); err != nil {
+			if err != rpctypes.ErrCompacted {
+				plog.Warningf("failed to compact at revision %d (%v)", rev, err)
+			}
+			continue
+		}
+		rec.compaction(time.Now())
+		plog.Infof("compacted at revision %d", rev)
+	}
+}
+
+func (cfg *Config) saveWatchEvents(rec *watchRecorder) error {
+	if cfg.ConfigClientMachineInitial.ClientWatchEventsPath == "" {
+		return nil
+	}
+	fr, err := rec.frame()
+	if err != nil {
+		return err
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientWatchEventsPath)
+}
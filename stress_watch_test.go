@@ -0,0 +1,61 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestWatchRecorder(t *testing.T) {
+	rec := newWatchRecorder(2)
+	now := time.Unix(100, 0)
+	rec.sent("a", now)
+	rec.sent("b", now)
+	rec.received("a", now.Add(10*time.Millisecond))
+	rec.received("a", now.Add(30*time.Millisecond))
+	rec.received("b", now.Add(1100*time.Millisecond))
+	rec.received("unknown", now)
+	rec.compacted(now.Add(1100 * time.Millisecond))
+	rec.compaction(now)
+
+	events, avgMs, compacted := rec.summary()
+	if events != 3 || compacted != 1 {
+		t.Fatalf("expected 3 events and 1 compacted error, got %d and %d", events, compacted)
+	}
+	if math.Abs(avgMs-380) > 1e-9 {
+		t.Fatalf("expected average 380 ms, got %f", avgMs)
+	}
+
+	fr, err := rec.frame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	col, err := fr.Column("AVG-WATCH-LATENCY-MS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if col.Count() != 2 {
+		t.Fatalf("expected 2 seconds, got %d", col.Count())
+	}
+	v, err := col.Value(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := v.String(); s != "20.0000" {
+		t.Fatalf("expected average 20 ms in the first second, got %q", s)
+	}
+}
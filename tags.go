@@ -0,0 +1,64 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParseTags parses 'key=value' tags of runs. Keys must be unique.
+func ParseTags(ss []string) (map[string]string, error) {
+	if len(ss) == 0 {
+		return nil, nil
+	}
+	tags := make(map[string]string, len(ss))
+	for _, s := range ss {
+		kv := strings.SplitN(s, "=", 2)
+		k := strings.TrimSpace(kv[0])
+		if len(kv) != 2 || k == "" {
+			return nil, fmt.Errorf("tag %q is not 'key=value'", s)
+		}
+		if _, ok := tags[k]; ok {
+			return nil, fmt.Errorf("tag %q is duplicate", k)
+		}
+		tags[k] = strings.TrimSpace(kv[1])
+	}
+	return tags, nil
+}
+
+// MatchTags returns true if the tags have all tags in the filter.
+// A filter of 'key' without value matches any value of the key.
+func MatchTags(tags map[string]string, filter []string) bool {
+	for _, f := range filter {
+		kv := strings.SplitN(f, "=", 2)
+		v, ok := tags[kv[0]]
+		if !ok || (len(kv) == 2 && v != kv[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// FormatTags returns the tags as sorted 'key=value' list.
+func FormatTags(tags map[string]string) []string {
+	ss := make([]string, 0, len(tags))
+	for k, v := range tags {
+		ss = append(ss, k+"="+v)
+	}
+	sort.Strings(ss)
+	return ss
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTags(t *testing.T) {
+	tags, err := ParseTags([]string{"env=gce-n1-standard-8", "purpose = nightly", "note="})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := map[string]string{"env": "gce-n1-standard-8", "purpose": "nightly", "note": ""}; !reflect.DeepEqual(tags, exp) {
+		t.Fatalf("expected %v, got %v", exp, tags)
+	}
+	if exp := []string{"env=gce-n1-standard-8", "note=", "purpose=nightly"}; !reflect.DeepEqual(FormatTags(tags), exp) {
+		t.Fatalf("expected %q, got %q", exp, FormatTags(tags))
+	}
+	for _, ss := range [][]string{{"env"}, {"=gce"}, {"env=a", "env=b"}} {
+		if _, err = ParseTags(ss); err == nil {
+			t.Fatalf("expected error for %q", ss)
+		}
+	}
+
+	tests := []struct {
+		filter []string
+		match  bool
+	}{
+		{nil, true},
+		{[]string{"purpose=nightly"}, true},
+		{[]string{"purpose=nightly", "env"}, true},
+		{[]string{"purpose=release"}, false},
+		{[]string{"region"}, false},
+	}
+	for i, tt := range tests {
+		if m := MatchTags(tags, tt.filter); m != tt.match {
+			t.Fatalf("#%d: %q expected %v, got %v", i, tt.filter, tt.match, m)
+		}
+	}
+}
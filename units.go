@@ -0,0 +1,63 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"strings"
+
+	"github.com/coreos/dbtester/pkg/table"
+)
+
+// tokenToUnit maps a token of result column names to the unit
+// of the column (e.g. 'AVG-LATENCY-MS' is in milliseconds).
+var tokenToUnit = map[string]string{
+	"MS":         "ms",
+	"MB":         "MB",
+	"SECOND":     "s",
+	"SECONDS":    "s",
+	"THROUGHPUT": "ops/s",
+	"CPU":        "%",
+	"PERCENT":    "%",
+	"BYTES":      "bytes",
+}
+
+// ColumnUnit returns the unit of the result column, by the first token
+// of its name with a known unit, or empty if unitless or unknown.
+// Database tag suffixes in analyzed results are ignored.
+func ColumnUnit(column string) string {
+	tokens := strings.Split(column, "-")
+	for i, tk := range tokens {
+		if tk == "CUMULATIVE" && i+1 < len(tokens) && tokens[i+1] == "THROUGHPUT" {
+			// total number of requests
+			return "ops"
+		}
+		if u, ok := tokenToUnit[tk]; ok {
+			return u
+		}
+	}
+	return ""
+}
+
+// saveColumnUnits saves the units of the CSV file columns
+// in its units file.
+func saveColumnUnits(fpath string, header []string) {
+	units := make([]string, len(header))
+	for i, h := range header {
+		units[i] = ColumnUnit(h)
+	}
+	if err := table.WriteUnits(fpath, header, units); err != nil {
+		plog.Warningf("failed to save units of %q (%v)", fpath, err)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import "testing"
+
+func TestColumnUnit(t *testing.T) {
+	tests := []struct {
+		column string
+		unit   string
+	}{
+		{"AVG-LATENCY-MS", "ms"},
+		{"READ-AVG-LATENCY-MS", "ms"},
+		{"LATENCY-MS-CORRECTED", "ms"},
+		{"AVG-VMRSS-MB-etcd-v3.2", "MB"},
+		{"UNIX-SECOND", "s"},
+		{"AVG-THROUGHPUT", "ops/s"},
+		{"CUMULATIVE-THROUGHPUT", "ops"},
+		{"MAX-CPU", "%"},
+		{"AVG-READ-BYTES-NUM-DELTA", "bytes"},
+		{"CONTROL-CLIENT-NUM", ""},
+	}
+	for i, tt := range tests {
+		if u := ColumnUnit(tt.column); u != tt.unit {
+			t.Fatalf("#%d: %q expected unit %q, got %q", i, tt.column, tt.unit, u)
+		}
+	}
+}
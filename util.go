@@ -108,14 +108,13 @@ func sameKey(size int64) string {
 	return strings.Repeat("a", int(size))
 }
 
-func randBytes(bytesN int64) []byte {
+func randBytes(src mrand.Source, bytesN int64) []byte {
 	const (
 		letterBytes   = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 		letterIdxBits = 6                    // 6 bits to represent a letter index
 		letterIdxMask = 1<<letterIdxBits - 1 // All 1-bits, as many as letterIdxBits
 		letterIdxMax  = 63 / letterIdxBits   // # of letter indices fitting in 63 bits
 	)
-	src := mrand.NewSource(time.Now().UnixNano())
 	b := make([]byte, bytesN)
 	for i, cache, remain := bytesN-1, src.Int63(), letterIdxMax; i >= 0; {
 		if remain == 0 {
@@ -0,0 +1,106 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/plotutil"
+	"gonum.org/v1/plot/vg"
+)
+
+// timeseriesColumns are the columns of client latency-throughput
+// timeseries to compare.
+var timeseriesColumns = []string{
+	"AVG-THROUGHPUT",
+	"AVG-LATENCY-MS",
+	"MIN-LATENCY-MS",
+	"MAX-LATENCY-MS",
+	"CONTROL-CLIENT-NUM",
+}
+
+// readTimeseries reads the column of client latency-throughput timeseries
+// of the series, by seconds since the start.
+func readTimeseries(root, series, column string) (plotter.XYs, error) {
+	runID, tag, err := parseSeries(series)
+	if err != nil {
+		return nil, err
+	}
+	fpath := filepath.Join(root, dbtesterpb.ClientResultDir(runID, tag), latencyTimeseriesName)
+	fr, err := dataframe.NewFromCSV(nil, fpath)
+	if err != nil {
+		return nil, err
+	}
+	col, err := fr.Column(column)
+	if err != nil {
+		return nil, fmt.Errorf("%q in %s (%v)", column, fpath, err)
+	}
+	pts := make(plotter.XYs, col.Count())
+	for i := range pts {
+		v, err := col.Value(i)
+		if err != nil {
+			return nil, err
+		}
+		y, _ := v.Float64()
+		pts[i].X = float64(i)
+		pts[i].Y = y
+	}
+	return pts, nil
+}
+
+// writeChart writes the SVG chart that overlays the column of all series.
+func writeChart(w io.Writer, root string, series []string, column string) error {
+	if len(series) == 0 {
+		return fmt.Errorf("no series to compare")
+	}
+	plt, err := plot.New()
+	if err != nil {
+		return err
+	}
+	plt.Title.Text = column
+	plt.X.Label.Text = "Second"
+	plt.Y.Label.Text = column
+	plt.Legend.Top = true
+
+	for i, s := range series {
+		pts, err := readTimeseries(root, s, column)
+		if err != nil {
+			return err
+		}
+		l, err := plotter.NewLine(pts)
+		if err != nil {
+			return err
+		}
+		l.Color = plotutil.Color(i)
+		l.Dashes = plotutil.Dashes(i)
+		plt.Add(l)
+		plt.Legend.Add(s, l)
+	}
+	plt.Add(plotter.NewGrid())
+
+	wt, err := plt.WriterTo(12*vg.Inch, 6*vg.Inch, "svg")
+	if err != nil {
+		return err
+	}
+	_, err = wt.WriteTo(w)
+	return err
+}
@@ -0,0 +1,100 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/coreos/dbtester/pkg/remotestorage"
+
+	"github.com/spf13/cobra"
+)
+
+// Command implements 'web' command.
+var Command = &cobra.Command{
+	Use:   "web",
+	Short: "Serves results of historical runs with comparison charts in the browser.",
+	RunE:  commandFunc,
+}
+
+var root string
+var port string
+var grpcPort string
+var googleCloudProjectName string
+var googleCloudStorageKeyPath string
+var googleCloudStorageBucketName string
+var googleCloudStorageSubDirectory string
+var syncInterval time.Duration
+
+func init() {
+	Command.PersistentFlags().StringVar(&root, "root", "", "Results root directory in the standard layout '<run_id>/<database_tag>', also the download directory of bucket results.")
+	Command.PersistentFlags().StringVar(&port, "port", ":3800", "Port to serve HTTP requests.")
+	Command.Flags().StringVar(&grpcPort, "grpc-port", "", "Port to serve gRPC queries of summaries, timeseries, and comparisons of runs (e.g. ':3801'), empty to disable.")
+	Command.PersistentFlags().StringVar(&googleCloudProjectName, "google-cloud-project-name", "", "Google Cloud project name of the bucket.")
+	Command.PersistentFlags().StringVar(&googleCloudStorageKeyPath, "google-cloud-storage-key-path", "", "Google Cloud Storage key file path to read the bucket.")
+	Command.PersistentFlags().StringVar(&googleCloudStorageBucketName, "google-cloud-storage-bucket-name", "", "Bucket to download results from, empty to serve only local results.")
+	Command.PersistentFlags().StringVar(&googleCloudStorageSubDirectory, "google-cloud-storage-sub-directory", "", "Directory of results in the bucket ('google_cloud_storage_sub_directory' in test configurations).")
+	Command.PersistentFlags().DurationVar(&syncInterval, "sync-interval", 10*time.Minute, "Interval to download new results from the bucket (0 to download only at start).")
+}
+
+func commandFunc(cmd *cobra.Command, args []string) error {
+	if root == "" {
+		return fmt.Errorf("'--root' is required")
+	}
+	if err := os.MkdirAll(root, 0777); err != nil {
+		return err
+	}
+
+	if googleCloudStorageBucketName != "" {
+		key, err := ioutil.ReadFile(googleCloudStorageKeyPath)
+		if err != nil {
+			return err
+		}
+		d, err := remotestorage.NewGoogleCloudStorageDownloader(key, googleCloudProjectName)
+		if err != nil {
+			return err
+		}
+		sync := func() error {
+			return d.DownloadDir(googleCloudStorageBucketName, googleCloudStorageSubDirectory, root)
+		}
+		if err = sync(); err != nil {
+			return err
+		}
+		if syncInterval > 0 {
+			go func() {
+				for range time.Tick(syncInterval) {
+					if err := sync(); err != nil {
+						plog.Warningf("failed to download results from %q (%v)", googleCloudStorageBucketName, err)
+					}
+				}
+			}()
+		}
+	}
+
+	if grpcPort != "" {
+		srv, err := startQueryServer(grpcPort, root)
+		if err != nil {
+			return err
+		}
+		defer srv.Stop()
+	}
+
+	plog.Infof("serving results in %q at %s", root, port)
+	return http.ListenAndServe(port, newHandler(root))
+}
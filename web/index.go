@@ -0,0 +1,220 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// default file names in the standard result layout
+const (
+	runMetadataName       = "run-metadata.yaml"
+	latencySummaryName    = "client-latency-distribution-summary.csv"
+	latencyTimeseriesName = "client-latency-throughput-timeseries.csv"
+)
+
+// runEntry is the results of a database in a run.
+type runEntry struct {
+	RunID       string
+	DatabaseTag string
+	// Metadata is empty if the run has no metadata.
+	Metadata dbtester.RunMetadata
+	// Summary is the latency distribution summary, by column name.
+	Summary map[string]string
+}
+
+// Series returns the series name of the results in comparisons.
+func (e runEntry) Series() string { return e.RunID + "/" + e.DatabaseTag }
+
+// Tags returns the run tags as 'key=value' list.
+func (e runEntry) Tags() string { return strings.Join(dbtester.FormatTags(e.Metadata.Tags), ", ") }
+
+// Version returns the release version or source commit of the database.
+func (e runEntry) Version() string {
+	if e.Metadata.ReleaseVersion != "" {
+		return e.Metadata.ReleaseVersion
+	}
+	if c := e.Metadata.SourceCommit; len(c) > 12 {
+		return c[:12]
+	}
+	return e.Metadata.SourceCommit
+}
+
+// buildIndex returns the results of all runs under the root directory,
+// the latest run first. Only results with all 'key=value' tags in the
+// filter are returned, if any.
+func buildIndex(root string, filter []string) ([]runEntry, error) {
+	runs, err := dbtester.DiscoverRuns(root)
+	if err != nil {
+		return nil, err
+	}
+	var entries []runEntry
+	for i := len(runs) - 1; i >= 0; i-- {
+		for _, tag := range runs[i].DatabaseTags {
+			e, err := readEntry(root, runs[i].ID+"/"+tag)
+			if err != nil {
+				return nil, err
+			}
+			if dbtester.MatchTags(e.Metadata.Tags, filter) {
+				entries = append(entries, e)
+			}
+		}
+	}
+	return entries, nil
+}
+
+// entryGroup is the results with the same value of the tag.
+type entryGroup struct {
+	// Name is 'key=value' of the group, or empty if not grouped.
+	Name    string
+	Entries []runEntry
+}
+
+// groupEntries groups the results by the value of the tag key,
+// in order of the first result of each group. Results without
+// the tag are grouped last.
+func groupEntries(entries []runEntry, key string) []entryGroup {
+	if key == "" {
+		return []entryGroup{{Entries: entries}}
+	}
+	var groups []entryGroup
+	idx := make(map[string]int)
+	var untagged []runEntry
+	for _, e := range entries {
+		v, ok := e.Metadata.Tags[key]
+		if !ok {
+			untagged = append(untagged, e)
+			continue
+		}
+		i, ok := idx[v]
+		if !ok {
+			i = len(groups)
+			idx[v] = i
+			groups = append(groups, entryGroup{Name: key + "=" + v})
+		}
+		groups[i].Entries = append(groups[i].Entries, e)
+	}
+	if len(untagged) > 0 {
+		groups = append(groups, entryGroup{Name: "no " + key, Entries: untagged})
+	}
+	return groups
+}
+
+// readEntry reads the results of the series. Missing metadata or summary
+// is not an error, since older runs may not have them.
+func readEntry(root, series string) (runEntry, error) {
+	runID, tag, err := parseSeries(series)
+	if err != nil {
+		return runEntry{}, err
+	}
+	e := runEntry{RunID: runID, DatabaseTag: tag}
+	dir := filepath.Join(root, dbtesterpb.ClientResultDir(runID, tag))
+	if e.Metadata, err = dbtester.ReadRunMetadata(filepath.Join(dir, runMetadataName)); err != nil && !os.IsNotExist(err) {
+		return e, err
+	}
+	if e.Summary, err = readSummary(filepath.Join(dir, latencySummaryName)); err != nil && !os.IsNotExist(err) {
+		return e, err
+	}
+	return e, nil
+}
+
+// readSummary reads the summary CSV, by row name.
+func readSummary(fpath string) (map[string]string, error) {
+	rows, err := readSummaryRows(fpath)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]string, len(rows))
+	for _, row := range rows {
+		m[row[0]] = row[1]
+	}
+	return m, nil
+}
+
+// readSummaryRows reads the 'name,value' rows of the summary CSV in the
+// saved order. Rows appended after the stress may have more fields, and
+// only the first value is read.
+func readSummaryRows(fpath string) ([][2]string, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rd := csv.NewReader(f)
+	rd.FieldsPerRecord = -1
+	rows, err := rd.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var out [][2]string
+	for _, row := range rows {
+		if len(row) < 2 {
+			continue
+		}
+		out = append(out, [2]string{row[0], row[1]})
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("%q has no summary", fpath)
+	}
+	return out, nil
+}
+
+// listFiles returns all result files of the series, relative to the root.
+func listFiles(root, series string) ([]string, error) {
+	runID, tag, err := parseSeries(series)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	err = filepath.Walk(filepath.Join(root, runID, tag), func(fpath string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.Mode().IsRegular() {
+			rel, err := filepath.Rel(root, fpath)
+			if err != nil {
+				return err
+			}
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	sort.Strings(files)
+	return files, err
+}
+
+// parseSeries parses "<run-id>/<database-tag>", rejecting paths
+// outside of the root directory.
+func parseSeries(series string) (runID, databaseTag string, err error) {
+	ss := strings.Split(series, "/")
+	if len(ss) != 2 {
+		return "", "", fmt.Errorf("%q is not '<run-id>/<database-tag>'", series)
+	}
+	for _, s := range ss {
+		if s == "" || s == "." || s == ".." || strings.ContainsAny(s, `\`) {
+			return "", "", fmt.Errorf("%q is not a valid series", series)
+		}
+	}
+	return ss[0], ss[1], nil
+}
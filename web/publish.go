@@ -0,0 +1,160 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"github.com/spf13/cobra"
+)
+
+// publishCommand implements 'web publish' command.
+var publishCommand = &cobra.Command{
+	Use:   "publish",
+	Short: "Renders results of historical runs as a static site, to share on GitHub Pages or a Cloud Storage bucket.",
+	RunE:  publishCommandFunc,
+}
+
+var publishOutputDir string
+var publishTags []string
+
+func init() {
+	publishCommand.Flags().StringVar(&publishOutputDir, "output-dir", "", "Directory to write 'index.html' and the pages of each run.")
+	publishCommand.Flags().StringSliceVar(&publishTags, "tag", nil, "'key=value' tags to publish only matching runs (all runs if empty).")
+	Command.AddCommand(publishCommand)
+}
+
+func publishCommandFunc(cmd *cobra.Command, args []string) error {
+	if root == "" {
+		return fmt.Errorf("'--root' is required")
+	}
+	if publishOutputDir == "" {
+		return fmt.Errorf("'--output-dir' is required")
+	}
+	n, err := publish(root, publishOutputDir, publishTags)
+	if err != nil {
+		return err
+	}
+	plog.Infof("published %d results in %q to %q", n, root, publishOutputDir)
+	return nil
+}
+
+var publishIndexTemplate = template.Must(template.Must(pageTemplate.Clone()).Parse(`{{define "body"}}
+<table>
+<tr><th>Run</th><th>Database</th><th>Version</th><th>Started</th><th>Title</th><th>Tags</th>{{range $.SummaryColumns}}<th>{{.}}</th>{{end}}</tr>
+{{range $e := .Entries}}<tr>
+<td><a href="{{$e.Series}}/index.html">{{$e.RunID}}</a></td>
+<td>{{$e.DatabaseTag}}</td>
+<td>{{$e.Version}}</td>
+<td>{{$e.Metadata.StartedAt}}</td>
+<td>{{$e.Metadata.TestTitle}}</td>
+<td>{{$e.Tags}}</td>
+{{range $.SummaryColumns}}<td>{{index $e.Summary .}}</td>{{end}}
+</tr>{{else}}<tr><td colspan="6">no run found</td></tr>{{end}}
+</table>
+{{end}}`))
+
+var publishRunTemplate = template.Must(template.Must(pageTemplate.Clone()).Parse(`{{define "body"}}
+<h2>Summary</h2>
+{{if .Summary}}<table>
+{{range .Summary}}<tr><th>{{index . 0}}</th><td>{{index . 1}}</td></tr>
+{{end}}</table>{{else}}<p>no latency summary</p>{{end}}
+<h2>Charts</h2>
+{{range .Charts}}<img src="{{.}}" alt="{{.}}" width="100%">
+{{else}}<p>no latency-throughput timeseries</p>{{end}}
+<h2>Configuration</h2>
+{{if .Metadata}}<pre>{{.Metadata}}</pre>{{else}}<p>no run metadata</p>{{end}}
+{{end}}`))
+
+// publish renders the results of all runs under the root directory,
+// with the tags in the filter if any, into the output directory:
+// 'index.html' that lists the runs, and '<run-id>/<database-tag>/index.html'
+// with the summary, charts and configuration of each result.
+// Links are relative, so that the output directory can be served
+// from any path. It returns the number of published results.
+func publish(root, outputDir string, filter []string) (int, error) {
+	entries, err := buildIndex(root, filter)
+	if err != nil {
+		return 0, err
+	}
+	for _, e := range entries {
+		if err = publishRun(root, outputDir, e); err != nil {
+			return 0, err
+		}
+	}
+	err = writePage(filepath.Join(outputDir, "index.html"), publishIndexTemplate, map[string]interface{}{
+		"Home":           "index.html",
+		"Entries":        entries,
+		"SummaryColumns": summaryColumns,
+	})
+	return len(entries), err
+}
+
+// publishRun renders the page and charts of the result.
+func publishRun(root, outputDir string, e runEntry) error {
+	dir := filepath.Join(outputDir, e.RunID, e.DatabaseTag)
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+
+	resultDir := filepath.Join(root, dbtesterpb.ClientResultDir(e.RunID, e.DatabaseTag))
+	var charts []string
+	if _, err := os.Stat(filepath.Join(resultDir, latencyTimeseriesName)); err == nil {
+		for _, column := range timeseriesColumns {
+			var buf bytes.Buffer
+			if err = writeChart(&buf, root, []string{e.Series()}, column); err != nil {
+				// older runs may not have all columns
+				plog.Warningf("skipping chart %q of %q (%v)", column, e.Series(), err)
+				continue
+			}
+			name := column + ".svg"
+			if err = ioutil.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0644); err != nil {
+				return err
+			}
+			charts = append(charts, name)
+		}
+	}
+
+	// summary in the saved order, since the index map has no order
+	summary, err := readSummaryRows(filepath.Join(resultDir, latencySummaryName))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	// show as saved, since fields differ between versions
+	md, _ := ioutil.ReadFile(filepath.Join(resultDir, runMetadataName))
+	return writePage(filepath.Join(dir, "index.html"), publishRunTemplate, map[string]interface{}{
+		"Title":    e.Series(),
+		"Home":     "../../index.html",
+		"Summary":  summary,
+		"Charts":   charts,
+		"Metadata": string(md),
+	})
+}
+
+func writePage(fpath string, tmpl *template.Template, data map[string]interface{}) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fpath, buf.Bytes(), 0644)
+}
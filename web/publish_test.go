@@ -0,0 +1,79 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPublish(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	writeRun(t, root, "2017Q4-01", "etcd-v3.3", "nightly")
+	writeRun(t, root, "2017Q4-02", "etcd-v3.3", "release")
+
+	out := filepath.Join(root, "site")
+	n, err := publish(root, out, []string{"purpose=nightly"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 published result, got %d", n)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(out, "index.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := string(b); !strings.Contains(body, `href="2017Q4-01/etcd-v3.3/index.html"`) || strings.Contains(body, "2017Q4-02") {
+		t.Fatalf("expected only nightly run in index, got %s", body)
+	}
+
+	b, err = ioutil.ReadFile(filepath.Join(out, "2017Q4-01", "etcd-v3.3", "index.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		`href="../../index.html"`,
+		`src="AVG-THROUGHPUT.svg"`,
+		"<tr><th>REQUESTS-PER-SECOND</th><td>1000.0000</td></tr>",
+		"<tr><th>AVERAGE-LATENCY-MS</th><td>2.5000</td></tr>",
+		"<tr><th>ERROR</th><td>0</td></tr>",
+		"release_version: v3.3.0",
+	} {
+		if !strings.Contains(string(b), s) {
+			t.Fatalf("expected %q in run page, got %s", s, b)
+		}
+	}
+	// in the saved order
+	if body := string(b); strings.Index(body, "TOTAL-SECONDS") > strings.Index(body, "REQUESTS-PER-SECOND") {
+		t.Fatalf("expected summary in the saved order, got %s", body)
+	}
+
+	b, err = ioutil.ReadFile(filepath.Join(out, "2017Q4-01", "etcd-v3.3", "AVG-THROUGHPUT.svg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "<svg") {
+		t.Fatalf("expected SVG, got %s", b)
+	}
+}
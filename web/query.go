@@ -0,0 +1,218 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/coreos/dbtester"
+	"github.com/coreos/dbtester/dbtesterpb"
+	"github.com/coreos/dbtester/pkg/table"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// implements dbtesterpb.QueryServer
+type queryServer struct {
+	root string
+}
+
+// startQueryServer serves queries of results under the root directory.
+func startQueryServer(port, root string) (*grpc.Server, error) {
+	ln, err := net.Listen("tcp", port)
+	if err != nil {
+		return nil, err
+	}
+	grpcServer := grpc.NewServer()
+	dbtesterpb.RegisterQueryServer(grpcServer, queryServer{root: root})
+	go grpcServer.Serve(ln)
+	plog.Infof("serving queries with gRPC %s", port)
+	return grpcServer, nil
+}
+
+func (s queryServer) GetSummary(ctx context.Context, req *dbtesterpb.QueryRunRequest) (*dbtesterpb.QueryRunSummary, error) {
+	e, err := s.readEntry(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &dbtesterpb.QueryRunSummary{
+		RunID:       e.RunID,
+		DatabaseTag: e.DatabaseTag,
+		DatabaseID:  e.Metadata.DatabaseID,
+		Version:     e.Version(),
+		StartedAt:   e.Metadata.StartedAt,
+		Tags:        dbtester.FormatTags(e.Metadata.Tags),
+		Failure:     e.Metadata.Failure,
+	}
+	// in the order of the summary, since the index map has no order
+	rows, err := readSummaryRows(s.resultPath(e, latencySummaryName))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, row := range rows {
+		resp.Columns = append(resp.Columns, row[0])
+		resp.Values = append(resp.Values, row[1])
+	}
+	return resp, nil
+}
+
+func (s queryServer) GetTimeseries(ctx context.Context, req *dbtesterpb.QueryTimeseriesRequest) (*dbtesterpb.QueryTimeseriesResponse, error) {
+	e, err := s.readEntry(&dbtesterpb.QueryRunRequest{RunID: req.RunID, DatabaseTag: req.DatabaseTag})
+	if err != nil {
+		return nil, err
+	}
+	tb, err := table.ReadCSV(s.resultPath(e, latencyTimeseriesName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, status.Errorf(codes.NotFound, "%q has no timeseries", e.Series())
+		}
+		return nil, err
+	}
+	secs, err := tb.Column("UNIX-SECOND")
+	if err != nil {
+		return nil, err
+	}
+	columns := req.Columns
+	if len(columns) == 0 {
+		columns = timeseriesColumns
+	}
+
+	resp := &dbtesterpb.QueryTimeseriesResponse{}
+	for _, column := range columns {
+		vs, err := tb.Column(column)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		pts := make([]*dbtesterpb.QueryTimeseriesPoint, len(vs))
+		for i := range vs {
+			sec, err := strconv.ParseInt(secs[i], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			// empty or non-numeric values are zero, as in charts
+			v, _ := strconv.ParseFloat(vs[i], 64)
+			pts[i] = &dbtesterpb.QueryTimeseriesPoint{UnixSecond: sec, Value: v}
+		}
+		resp.Columns = append(resp.Columns, &dbtesterpb.QueryTimeseriesColumn{
+			Name:   column,
+			Points: downsample(pts, int(req.MaxPoints)),
+		})
+	}
+	return resp, nil
+}
+
+func (s queryServer) CompareRuns(ctx context.Context, req *dbtesterpb.QueryCompareRequest) (*dbtesterpb.QueryCompareResponse, error) {
+	if len(req.Runs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no run to compare")
+	}
+	summaries := make([]*dbtesterpb.QueryRunSummary, len(req.Runs))
+	for i, r := range req.Runs {
+		sm, err := s.GetSummary(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		summaries[i] = sm
+	}
+	return compareSummaries(summaries, req.Columns), nil
+}
+
+// compareSummaries compares the numeric summary columns of the runs,
+// all numeric columns of the first run if no column is given, against
+// the first run. Columns missing in a run are skipped for the run.
+func compareSummaries(summaries []*dbtesterpb.QueryRunSummary, columns []string) *dbtesterpb.QueryCompareResponse {
+	values := make([]map[string]float64, len(summaries))
+	for i, sm := range summaries {
+		values[i] = make(map[string]float64)
+		for j, col := range sm.Columns {
+			if j >= len(sm.Values) {
+				break
+			}
+			if v, err := strconv.ParseFloat(sm.Values[j], 64); err == nil {
+				values[i][col] = v
+			}
+		}
+	}
+	if len(columns) == 0 {
+		for _, col := range summaries[0].Columns {
+			if _, ok := values[0][col]; ok {
+				columns = append(columns, col)
+			}
+		}
+	}
+
+	resp := &dbtesterpb.QueryCompareResponse{}
+	for i, sm := range summaries {
+		run := &dbtesterpb.QueryComparedRun{RunID: sm.RunID, DatabaseTag: sm.DatabaseTag}
+		for _, col := range columns {
+			v, ok := values[i][col]
+			if !ok {
+				continue
+			}
+			cv := &dbtesterpb.QueryComparedValue{Column: col, Value: v}
+			if base := values[0][col]; base != 0 {
+				cv.DeltaPercent = (v - base) / base * 100
+			}
+			run.Values = append(run.Values, cv)
+		}
+		resp.Runs = append(resp.Runs, run)
+	}
+	return resp
+}
+
+// downsample averages consecutive points, so that there are at most
+// maxPoints points, each at the second of its first point. Points are
+// returned as given if maxPoints is not positive.
+func downsample(pts []*dbtesterpb.QueryTimeseriesPoint, maxPoints int) []*dbtesterpb.QueryTimeseriesPoint {
+	if maxPoints <= 0 || len(pts) <= maxPoints {
+		return pts
+	}
+	n := (len(pts) + maxPoints - 1) / maxPoints
+	out := make([]*dbtesterpb.QueryTimeseriesPoint, 0, maxPoints)
+	for i := 0; i < len(pts); i += n {
+		end := i + n
+		if end > len(pts) {
+			end = len(pts)
+		}
+		var sum float64
+		for _, p := range pts[i:end] {
+			sum += p.Value
+		}
+		out = append(out, &dbtesterpb.QueryTimeseriesPoint{UnixSecond: pts[i].UnixSecond, Value: sum / float64(end-i)})
+	}
+	return out
+}
+
+// readEntry reads the results of the run, which must exist. It returns
+// InvalidArgument status for invalid run, and NotFound if no result.
+func (s queryServer) readEntry(req *dbtesterpb.QueryRunRequest) (runEntry, error) {
+	series := req.RunID + "/" + req.DatabaseTag
+	if _, _, err := parseSeries(series); err != nil {
+		return runEntry{}, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if _, err := os.Stat(filepath.Join(s.root, dbtesterpb.ClientResultDir(req.RunID, req.DatabaseTag))); err != nil {
+		return runEntry{}, status.Errorf(codes.NotFound, "results of %q not found (%v)", series, err)
+	}
+	return readEntry(s.root, series)
+}
+
+func (s queryServer) resultPath(e runEntry, name string) string {
+	return filepath.Join(s.root, dbtesterpb.ClientResultDir(e.RunID, e.DatabaseTag), name)
+}
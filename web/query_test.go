@@ -0,0 +1,136 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func statusCode(err error) codes.Code {
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+	return codes.Unknown
+}
+
+func TestQueryServer(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	writeRun(t, root, "2017Q4-01", "etcd-v3.3", "nightly")
+	writeRun(t, root, "2017Q4-02", "etcd-v3.3", "release")
+	s := queryServer{root: root}
+	ctx := context.Background()
+
+	sm, err := s.GetSummary(ctx, &dbtesterpb.QueryRunRequest{RunID: "2017Q4-01", DatabaseTag: "etcd-v3.3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sm.DatabaseID != "etcd__tip" || sm.Version != "v3.3.0" || !reflect.DeepEqual(sm.Tags, []string{"purpose=nightly"}) {
+		t.Fatalf("unexpected summary %+v", sm)
+	}
+	if exp := []string{"TOTAL-SECONDS", "REQUESTS-PER-SECOND", "SLOWEST-LATENCY-MS", "FASTEST-LATENCY-MS", "AVERAGE-LATENCY-MS", "STDDEV-LATENCY-MS", "ERROR"}; !reflect.DeepEqual(sm.Columns, exp) {
+		t.Fatalf("expected columns %q, got %q", exp, sm.Columns)
+	}
+	if sm.Values[1] != "1000.0000" || sm.Values[4] != "2.5000" {
+		t.Fatalf("unexpected values %q", sm.Values)
+	}
+	if _, err = s.GetSummary(ctx, &dbtesterpb.QueryRunRequest{RunID: "2017Q4-03", DatabaseTag: "etcd-v3.3"}); statusCode(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for missing run, got %v", err)
+	}
+	if _, err = s.GetSummary(ctx, &dbtesterpb.QueryRunRequest{RunID: "..", DatabaseTag: "etc"}); statusCode(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for invalid run, got %v", err)
+	}
+
+	ts, err := s.GetTimeseries(ctx, &dbtesterpb.QueryTimeseriesRequest{RunID: "2017Q4-01", DatabaseTag: "etcd-v3.3", Columns: []string{"AVG-THROUGHPUT"}, MaxPoints: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []*dbtesterpb.QueryTimeseriesPoint{{UnixSecond: 1, Value: 150}}
+	if len(ts.Columns) != 1 || !reflect.DeepEqual(ts.Columns[0].Points, exp) {
+		t.Fatalf("expected %v, got %+v", exp, ts.Columns)
+	}
+	if ts, err = s.GetTimeseries(ctx, &dbtesterpb.QueryTimeseriesRequest{RunID: "2017Q4-01", DatabaseTag: "etcd-v3.3"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(ts.Columns) != len(timeseriesColumns) || len(ts.Columns[0].Points) != 2 {
+		t.Fatalf("expected all columns of all seconds, got %+v", ts.Columns)
+	}
+
+	cmp, err := s.CompareRuns(ctx, &dbtesterpb.QueryCompareRequest{Runs: []*dbtesterpb.QueryRunRequest{
+		{RunID: "2017Q4-01", DatabaseTag: "etcd-v3.3"},
+		{RunID: "2017Q4-02", DatabaseTag: "etcd-v3.3"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cmp.Runs) != 2 || len(cmp.Runs[1].Values) != 7 || cmp.Runs[1].Values[1].Value != 1000 {
+		t.Fatalf("unexpected comparison %+v", cmp.Runs)
+	}
+	if v := cmp.Runs[1].Values[4]; v.Column != "AVERAGE-LATENCY-MS" || v.Value != 2.5 || v.DeltaPercent != 0 {
+		t.Fatalf("unexpected average latency %+v", v)
+	}
+	if _, err = s.CompareRuns(ctx, &dbtesterpb.QueryCompareRequest{}); statusCode(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for no run, got %v", err)
+	}
+}
+
+func TestCompareSummaries(t *testing.T) {
+	summaries := []*dbtesterpb.QueryRunSummary{
+		{RunID: "1", Columns: []string{"NAME", "REQUESTS-PER-SECOND", "AVERAGE-LATENCY-MS"}, Values: []string{"a", "1000", "2"}},
+		{RunID: "2", Columns: []string{"NAME", "REQUESTS-PER-SECOND"}, Values: []string{"b", "1200"}},
+	}
+	resp := compareSummaries(summaries, nil)
+	exp := []*dbtesterpb.QueryComparedRun{
+		{RunID: "1", Values: []*dbtesterpb.QueryComparedValue{
+			{Column: "REQUESTS-PER-SECOND", Value: 1000},
+			{Column: "AVERAGE-LATENCY-MS", Value: 2},
+		}},
+		{RunID: "2", Values: []*dbtesterpb.QueryComparedValue{
+			{Column: "REQUESTS-PER-SECOND", Value: 1200, DeltaPercent: 20},
+		}},
+	}
+	if !reflect.DeepEqual(resp.Runs, exp) {
+		t.Fatalf("expected %+v, got %+v", exp, resp.Runs)
+	}
+}
+
+func TestDownsample(t *testing.T) {
+	var pts []*dbtesterpb.QueryTimeseriesPoint
+	for i := int64(0); i < 5; i++ {
+		pts = append(pts, &dbtesterpb.QueryTimeseriesPoint{UnixSecond: 100 + i, Value: float64(i)})
+	}
+	exp := []*dbtesterpb.QueryTimeseriesPoint{
+		{UnixSecond: 100, Value: 1},
+		{UnixSecond: 103, Value: 3.5},
+	}
+	if got := downsample(pts, 2); !reflect.DeepEqual(got, exp) {
+		t.Fatalf("expected %v, got %v", exp, got)
+	}
+	if got := downsample(pts, 0); len(got) != 5 {
+		t.Fatalf("expected all points, got %v", got)
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/coreos/dbtester/dbtesterpb"
+)
+
+// summaryColumns are the columns of latency distribution summary
+// shown in run lists and comparisons.
+var summaryColumns = []string{
+	"TOTAL-SECONDS",
+	"REQUESTS-PER-SECOND",
+	"AVERAGE-LATENCY-MS",
+	"SLOWEST-LATENCY-MS",
+}
+
+var pageTemplate = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>dbtester{{if .Title}} - {{.Title}}{{end}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+th { background: #eee; }
+pre { background: #f6f6f6; padding: 1em; }
+</style>
+</head>
+<body>
+<h1><a href="{{if .Home}}{{.Home}}{{else}}/{{end}}">dbtester</a>{{if .Title}} - {{.Title}}{{end}}</h1>
+{{template "body" .}}
+</body>
+</html>
+`))
+
+var indexTemplate = template.Must(template.Must(pageTemplate.Clone()).Parse(`{{define "body"}}
+<form action="/" method="get">
+<p>
+Tags <input type="text" name="tag" value="{{.Filter}}" placeholder="purpose=nightly env">
+Group by <input type="text" name="group" value="{{.Group}}" placeholder="env">
+<input type="submit" value="Filter">
+</p>
+</form>
+<form action="/compare" method="get">
+<p>
+<select name="column">{{range .Columns}}<option>{{.}}</option>{{end}}</select>
+<input type="submit" value="Compare selected">
+</p>
+{{range $g := .Groups}}{{if $g.Name}}<h2>{{$g.Name}}</h2>{{end}}
+<table>
+<tr><th></th><th>Run</th><th>Database</th><th>Version</th><th>Started</th><th>Title</th><th>Tags</th>{{range $.SummaryColumns}}<th>{{.}}</th>{{end}}</tr>
+{{range $e := $g.Entries}}<tr>
+<td><input type="checkbox" name="series" value="{{$e.Series}}"></td>
+<td><a href="/runs/{{$e.Series}}">{{$e.RunID}}</a></td>
+<td>{{$e.DatabaseTag}}</td>
+<td>{{$e.Version}}</td>
+<td>{{$e.Metadata.StartedAt}}</td>
+<td>{{$e.Metadata.TestTitle}}</td>
+<td>{{$e.Tags}}</td>
+{{range $.SummaryColumns}}<td>{{index $e.Summary .}}</td>{{end}}
+</tr>{{else}}<tr><td colspan="7">no run found</td></tr>{{end}}
+</table>
+{{end}}
+</form>
+{{end}}`))
+
+var runTemplate = template.Must(template.Must(pageTemplate.Clone()).Parse(`{{define "body"}}
+<h2>Metadata</h2>
+{{if .Metadata}}<pre>{{.Metadata}}</pre>{{else}}<p>no run metadata</p>{{end}}
+<h2>Files</h2>
+<ul>
+{{range .Files}}<li><a href="/files/{{.}}">{{.}}</a></li>
+{{end}}</ul>
+{{end}}`))
+
+var compareTemplate = template.Must(template.Must(pageTemplate.Clone()).Parse(`{{define "body"}}
+<p>{{range .Columns}}<a href="/compare?column={{.}}{{range $.Series}}&amp;series={{.}}{{end}}">{{.}}</a> {{end}}</p>
+<img src="/chart.svg?column={{.Column}}{{range .Series}}&amp;series={{.}}{{end}}" alt="{{.Column}}" width="100%">
+<table>
+<tr><th>Series</th><th>Database ID</th><th>Version</th><th>Started</th>{{range .SummaryColumns}}<th>{{.}}</th>{{end}}</tr>
+{{range $e := .Entries}}<tr>
+<td><a href="/runs/{{$e.Series}}">{{$e.Series}}</a></td>
+<td>{{$e.Metadata.DatabaseID}}</td>
+<td>{{$e.Version}}</td>
+<td>{{$e.Metadata.StartedAt}}</td>
+{{range $.SummaryColumns}}<td>{{index $e.Summary .}}</td>{{end}}
+</tr>{{end}}
+</table>
+{{end}}`))
+
+// newHandler returns the handler that serves results under the root
+// directory. The index is rebuilt on each request, to show new runs.
+func newHandler(root string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/" {
+			http.NotFound(w, req)
+			return
+		}
+		// tags are space-separated in the form, or repeated in the query
+		var filter []string
+		for _, s := range req.URL.Query()["tag"] {
+			filter = append(filter, strings.Fields(s)...)
+		}
+		group := req.FormValue("group")
+		entries, err := buildIndex(root, filter)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		render(w, indexTemplate, map[string]interface{}{
+			"Filter":         strings.Join(filter, " "),
+			"Group":          group,
+			"Groups":         groupEntries(entries, group),
+			"Columns":        timeseriesColumns,
+			"SummaryColumns": summaryColumns,
+		})
+	})
+	mux.HandleFunc("/runs/", func(w http.ResponseWriter, req *http.Request) {
+		series := strings.TrimPrefix(req.URL.Path, "/runs/")
+		runID, tag, err := parseSeries(series)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		files, err := listFiles(root, series)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		// show as saved, since fields differ between versions
+		md, _ := ioutil.ReadFile(filepath.Join(root, dbtesterpb.ClientResultDir(runID, tag), runMetadataName))
+		render(w, runTemplate, map[string]interface{}{
+			"Title":    series,
+			"Metadata": string(md),
+			"Files":    files,
+		})
+	})
+	mux.HandleFunc("/compare", func(w http.ResponseWriter, req *http.Request) {
+		column := req.FormValue("column")
+		if column == "" {
+			column = timeseriesColumns[0]
+		}
+		series := req.Form["series"]
+		if len(series) == 0 {
+			http.Error(w, "no series to compare", http.StatusBadRequest)
+			return
+		}
+		entries := make([]runEntry, len(series))
+		for i, s := range series {
+			e, err := readEntry(root, s)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			entries[i] = e
+		}
+		render(w, compareTemplate, map[string]interface{}{
+			"Title":          "compare " + column,
+			"Column":         column,
+			"Columns":        timeseriesColumns,
+			"Series":         series,
+			"Entries":        entries,
+			"SummaryColumns": summaryColumns,
+		})
+	})
+	mux.HandleFunc("/chart.svg", func(w http.ResponseWriter, req *http.Request) {
+		column := req.FormValue("column")
+		if column == "" {
+			column = timeseriesColumns[0]
+		}
+		var buf bytes.Buffer
+		if err := writeChart(&buf, root, req.Form["series"], column); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "image/svg+xml")
+		w.Write(buf.Bytes())
+	})
+	mux.Handle("/files/", http.StripPrefix("/files/", http.FileServer(http.Dir(root))))
+	return mux
+}
+
+func render(w http.ResponseWriter, tmpl *template.Template, data map[string]interface{}) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(buf.Bytes())
+}
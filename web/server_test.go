@@ -0,0 +1,156 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/coreos/dbtester"
+)
+
+func writeRun(t *testing.T, root, runID, tag, purpose string) {
+	dir := filepath.Join(root, runID, tag, "client")
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		runMetadataName:       "test_title: write 1M keys\ndatabase_id: etcd__tip\ndatabase_tag: " + tag + "\nrelease_version: v3.3.0\ntags:\n  purpose: " + purpose + "\n",
+		latencyTimeseriesName: "UNIX-SECOND,CONTROL-CLIENT-NUM,MIN-LATENCY-MS,AVG-LATENCY-MS,MAX-LATENCY-MS,AVG-THROUGHPUT\n1,10,1,2,3,100\n2,10,1,2,3,200\n",
+	}
+	// as saved by the client, one 'name,value' row per column
+	summary, err := ioutil.ReadFile(filepath.Join("testdata", latencySummaryName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files[latencySummaryName] = string(summary)
+	for name, s := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	writeRun(t, root, "2017Q4-01", "etcd-v3.3", "nightly")
+	writeRun(t, root, "2017Q4-02", "etcd-v3.3", "release")
+
+	srv := httptest.NewServer(newHandler(root))
+	defer srv.Close()
+
+	body := get(t, srv.URL+"/")
+	if strings.Index(body, "2017Q4-02") > strings.Index(body, "2017Q4-01") {
+		t.Fatalf("expected latest run first, got %s", body)
+	}
+	for _, s := range []string{"v3.3.0", "<td>1000.0000</td>", "<td>2.5000</td>", "<td>35.1234</td>", "write 1M keys"} {
+		if !strings.Contains(body, s) {
+			t.Fatalf("expected %q in index, got %s", s, body)
+		}
+	}
+
+	body = get(t, srv.URL+"/?tag=purpose%3Dnightly")
+	if !strings.Contains(body, "2017Q4-01") || strings.Contains(body, "2017Q4-02") {
+		t.Fatalf("expected only nightly run, got %s", body)
+	}
+	body = get(t, srv.URL+"/?group=purpose")
+	if !strings.Contains(body, "<h2>purpose=release</h2>") || !strings.Contains(body, "<h2>purpose=nightly</h2>") {
+		t.Fatalf("expected groups by purpose, got %s", body)
+	}
+
+	body = get(t, srv.URL+"/runs/2017Q4-01/etcd-v3.3")
+	if !strings.Contains(body, "/files/2017Q4-01/etcd-v3.3/client/"+latencyTimeseriesName) {
+		t.Fatalf("expected file links, got %s", body)
+	}
+	if body = get(t, srv.URL+"/files/2017Q4-01/etcd-v3.3/client/"+latencySummaryName); !strings.HasPrefix(body, "TOTAL-SECONDS") {
+		t.Fatalf("unexpected file %s", body)
+	}
+
+	body = get(t, srv.URL+"/compare?column=AVG-THROUGHPUT&series=2017Q4-01/etcd-v3.3&series=2017Q4-02/etcd-v3.3")
+	if !strings.Contains(body, "/chart.svg?column=AVG-THROUGHPUT") {
+		t.Fatalf("expected chart, got %s", body)
+	}
+	if body = get(t, srv.URL+"/chart.svg?column=AVG-THROUGHPUT&series=2017Q4-01/etcd-v3.3&series=2017Q4-02/etcd-v3.3"); !strings.Contains(body, "<svg") {
+		t.Fatalf("expected SVG, got %s", body)
+	}
+
+	resp, err := http.Get(srv.URL + "/runs/../etc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		t.Fatalf("expected error for invalid series, got %d", resp.StatusCode)
+	}
+}
+
+func TestParseSeries(t *testing.T) {
+	if _, _, err := parseSeries("2017Q4-01/etcd-v3.3"); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"", "2017Q4-01", "../etcd", "2017Q4-01/..", "a/b/c"} {
+		if _, _, err := parseSeries(s); err == nil {
+			t.Fatalf("expected error for %q", s)
+		}
+	}
+}
+
+func get(t *testing.T, url string) string {
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("%s: expected %d, got %d (%s)", url, http.StatusOK, resp.StatusCode, b)
+	}
+	return string(b)
+}
+
+func TestGroupEntries(t *testing.T) {
+	entries := []runEntry{
+		{RunID: "3", Metadata: dbtester.RunMetadata{Tags: map[string]string{"env": "gce"}}},
+		{RunID: "2"},
+		{RunID: "1", Metadata: dbtester.RunMetadata{Tags: map[string]string{"env": "aws"}}},
+		{RunID: "0", Metadata: dbtester.RunMetadata{Tags: map[string]string{"env": "gce"}}},
+	}
+	groups := groupEntries(entries, "env")
+	var names []string
+	var ns []int
+	for _, g := range groups {
+		names = append(names, g.Name)
+		ns = append(ns, len(g.Entries))
+	}
+	if exp := []string{"env=gce", "env=aws", "no env"}; !reflect.DeepEqual(names, exp) {
+		t.Fatalf("expected %q, got %q", exp, names)
+	}
+	if exp := []int{2, 1, 1}; !reflect.DeepEqual(ns, exp) {
+		t.Fatalf("expected %v, got %v", exp, ns)
+	}
+}